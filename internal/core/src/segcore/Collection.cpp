@@ -46,6 +46,22 @@ Collection::Collection(const void* schema_proto, const int64_t length) {
     schema_ = Schema::ParseFrom(collection_schema);
 }
 
+void
+Collection::parseSchema(const void* schema_proto, const int64_t length) {
+    Assert(schema_proto != nullptr);
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc = collection_schema.ParseFromArray(schema_proto, length);
+    if (!suc) {
+        LOG_SEGCORE_ERROR_ << "unmarshal schema string failed";
+        return;
+    }
+
+    // the segments created before keep the shared pointer of the old schema
+    schema_ = Schema::ParseFrom(collection_schema);
+    LOG_SEGCORE_INFO_ << "schema of collection " << collection_name_
+                      << " updated";
+}
+
 void
 Collection::parseIndexMeta(const void* index_proto, const int64_t length) {
     Assert(index_proto != nullptr);
@@ -32,6 +32,14 @@ SetIndexMeta(CCollection collection,
     col->parseIndexMeta(proto_blob, length);
 }
 
+void
+UpdateSchema(CCollection collection,
+             const void* schema_proto_blob,
+             const int64_t length) {
+    auto col = (milvus::segcore::Collection*)collection;
+    col->parseSchema(schema_proto_blob, length);
+}
+
 void
 DeleteCollection(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
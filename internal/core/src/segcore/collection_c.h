@@ -27,6 +27,13 @@ SetIndexMeta(CCollection collection,
              const void* proto_blob,
              const int64_t length);
 
+// replace the schema of collection after a field is added or dropped,
+// only the segments created afterwards get the new schema
+void
+UpdateSchema(CCollection collection,
+             const void* schema_proto_blob,
+             const int64_t length);
+
 void
 DeleteCollection(CCollection collection);
 
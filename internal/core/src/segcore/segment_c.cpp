@@ -282,6 +282,108 @@ LoadFieldRawData(CSegmentInterface c_segment,
     }
 }
 
+CStatus
+LoadFieldDataArray(CSegmentInterface c_segment,
+                   const void* field_data_blob,
+                   int64_t length,
+                   int64_t row_count) {
+    try {
+        auto segment_interface =
+            reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment =
+            dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        milvus::proto::schema::FieldData data;
+        auto suc = data.ParseFromArray(field_data_blob, length);
+        AssertInfo(suc, "unmarshal field data failed");
+        auto data_array = &data;
+        auto field_id = milvus::FieldId(data.field_id());
+        auto& field_meta = segment->get_schema()[field_id];
+        auto data_type = field_meta.get_data_type();
+        auto field_data = milvus::storage::CreateFieldData(data_type);
+        switch (data_type) {
+            case milvus::DataType::BOOL: {
+                field_data->FillFieldData(FIELD_DATA(data_array, bool).data(),
+                                          row_count);
+                break;
+            }
+            case milvus::DataType::INT8: {
+                auto& src_data = FIELD_DATA(data_array, int);
+                std::vector<int8_t> data_raw(src_data.begin(), src_data.end());
+                field_data->FillFieldData(data_raw.data(), row_count);
+                break;
+            }
+            case milvus::DataType::INT16: {
+                auto& src_data = FIELD_DATA(data_array, int);
+                std::vector<int16_t> data_raw(src_data.begin(),
+                                              src_data.end());
+                field_data->FillFieldData(data_raw.data(), row_count);
+                break;
+            }
+            case milvus::DataType::INT32: {
+                field_data->FillFieldData(FIELD_DATA(data_array, int).data(),
+                                          row_count);
+                break;
+            }
+            case milvus::DataType::INT64: {
+                field_data->FillFieldData(FIELD_DATA(data_array, long).data(),
+                                          row_count);
+                break;
+            }
+            case milvus::DataType::FLOAT: {
+                field_data->FillFieldData(FIELD_DATA(data_array, float).data(),
+                                          row_count);
+                break;
+            }
+            case milvus::DataType::DOUBLE: {
+                field_data->FillFieldData(FIELD_DATA(data_array, double).data(),
+                                          row_count);
+                break;
+            }
+            case milvus::DataType::VARCHAR: {
+                auto& src_data = FIELD_DATA(data_array, string);
+                std::vector<std::string> data_raw(src_data.begin(),
+                                                  src_data.end());
+                field_data->FillFieldData(data_raw.data(), row_count);
+                break;
+            }
+            case milvus::DataType::JSON: {
+                std::vector<milvus::Json> data_raw{};
+                data_raw.reserve(row_count);
+                for (auto& json_bytes : FIELD_DATA(data_array, json)) {
+                    data_raw.emplace_back(simdjson::padded_string(json_bytes));
+                }
+                field_data->FillFieldData(data_raw.data(), row_count);
+                break;
+            }
+            case milvus::DataType::ARRAY: {
+                std::vector<milvus::Array> data_raw{};
+                data_raw.reserve(row_count);
+                for (auto& array_bytes : FIELD_DATA(data_array, array)) {
+                    data_raw.emplace_back(milvus::Array(array_bytes));
+                }
+                field_data->FillFieldData(data_raw.data(), row_count);
+                break;
+            }
+            default: {
+                PanicInfo(milvus::DataTypeInvalid,
+                          fmt::format("unsupported datatype {} of added field",
+                                      data_type));
+            }
+        }
+        milvus::storage::FieldDataChannelPtr channel =
+            std::make_shared<milvus::storage::FieldDataChannel>();
+        channel->push(field_data);
+        channel->close();
+        auto field_data_info = milvus::FieldDataInfo(
+            field_id.get(), static_cast<size_t>(row_count), channel);
+        segment->LoadFieldData(field_id, field_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info) {
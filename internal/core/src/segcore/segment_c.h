@@ -95,6 +95,14 @@ LoadFieldRawData(CSegmentInterface c_segment,
                  const void* data,
                  int64_t row_count);
 
+// load the serialized proto FieldData of a field that has no binlog in the segment,
+// the field is added to the collection after the segment was written
+CStatus
+LoadFieldDataArray(CSegmentInterface c_segment,
+                   const void* field_data_blob,
+                   int64_t length,
+                   int64_t row_count);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info);
@@ -315,6 +315,14 @@ func (m *mockRootCoordClient) RenameCollection(ctx context.Context, req *milvusp
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) AddCollectionField(ctx context.Context, req *rootcoordpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropCollectionField(ctx context.Context, req *rootcoordpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -762,6 +770,10 @@ func (m *mockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Lis
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordClient) OperateRowPolicy(ctx context.Context, req *rootcoordpb.OperateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 	meta *meta
 }
@@ -1604,6 +1604,10 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	// the schema is changed if a field is added or dropped
+	if req.GetSchema() != nil {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return merr.Success(), nil
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -315,11 +316,16 @@ func (t *compactionTask) merge(
 
 	// get pkID, pkType, dim
 	var pkField *schemapb.FieldSchema
+	// the values of rows written before the fields were added to the collection
+	addedFieldValues := make(map[UniqueID]interface{})
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
 		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
 			pkField = fs
 		}
+		if value, ok := defaultRowValue(fs); ok {
+			addedFieldValues[fs.GetFieldID()] = value
+		}
 	}
 
 	if pkField == nil {
@@ -414,6 +420,11 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
+			for fID, value := range addedFieldValues {
+				if _, ok := row[fID]; !ok {
+					row[fID] = value
+				}
+			}
 			for fID, vInter := range row {
 				if _, ok := fID2Content[fID]; !ok {
 					fID2Content[fID] = make([]interface{}, 0)
@@ -540,13 +551,17 @@ func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
 	allPath := make([][]string, 0)
 
 	downloadStart := time.Now()
+	schemaFields := typeutil.NewSet[int64]()
+	for _, field := range meta.GetSchema().GetFields() {
+		schemaFields.Insert(field.GetFieldID())
+	}
 	for _, s := range t.plan.GetSegmentBinlogs() {
-		// Get the number of field binlog files from non-empty segment
+		// Get the number of field binlog files from non-empty segment,
+		// the fields dropped from the collection are left out
 		var binlogNum int
 		for _, b := range s.GetFieldBinlogs() {
-			if b != nil {
+			if b != nil && schemaFields.Contain(b.GetFieldID()) && len(b.GetBinlogs()) > binlogNum {
 				binlogNum = len(b.GetBinlogs())
-				break
 			}
 		}
 		// Unable to deal with all empty segments cases, so return error
@@ -558,7 +573,15 @@ func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
 		for idx := 0; idx < binlogNum; idx++ {
 			var ps []string
 			for _, f := range s.GetFieldBinlogs() {
-				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+				if !schemaFields.Contain(f.GetFieldID()) {
+					continue
+				}
+				// the field added to the collection lacks the binlogs of the rows written before
+				offset := binlogNum - len(f.GetBinlogs())
+				if idx < offset {
+					continue
+				}
+				ps = append(ps, f.GetBinlogs()[idx-offset].GetLogPath())
 			}
 			allPath = append(allPath, ps)
 		}
@@ -635,6 +658,42 @@ func (t *compactionTask) injectDone(success bool) {
 	}
 }
 
+// defaultRowValue returns the row value of the field's default value, or the zero value if the field has no
+// default value, in the type of interface2FieldData. The compaction doesn't keep the validity of rows yet,
+// so the null rows are filled with zero values. It returns false for the fields which can't have default value.
+func defaultRowValue(field *schemapb.FieldSchema) (interface{}, bool) {
+	if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() {
+		return nil, false
+	}
+	fieldData, err := typeutil.GenDefaultFieldData(field, 1)
+	if err != nil {
+		return nil, false
+	}
+	scalars := fieldData.GetScalars()
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return scalars.GetBoolData().GetData()[0], true
+	case schemapb.DataType_Int8:
+		return int8(scalars.GetIntData().GetData()[0]), true
+	case schemapb.DataType_Int16:
+		return int16(scalars.GetIntData().GetData()[0]), true
+	case schemapb.DataType_Int32:
+		return scalars.GetIntData().GetData()[0], true
+	case schemapb.DataType_Int64:
+		return scalars.GetLongData().GetData()[0], true
+	case schemapb.DataType_Float:
+		return scalars.GetFloatData().GetData()[0], true
+	case schemapb.DataType_Double:
+		return scalars.GetDoubleData().GetData()[0], true
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return scalars.GetStringData().GetData()[0], true
+	case schemapb.DataType_JSON:
+		return scalars.GetJsonData().GetData()[0], true
+	default:
+		return nil, false
+	}
+}
+
 // TODO copy maybe expensive, but this seems to be the only convinent way.
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
@@ -132,6 +132,28 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		}
 	})
 
+	t.Run("Test defaultRowValue", func(t *testing.T) {
+		value, ok := defaultRowValue(&schemapb.FieldSchema{
+			FieldID:      common.StartOfUserFieldID,
+			DataType:     schemapb.DataType_Int16,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+		})
+		assert.True(t, ok)
+		assert.Equal(t, int16(7), value)
+		fd, err := interface2FieldData(schemapb.DataType_Int16, []interface{}{value}, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, fd.RowNum())
+
+		value, ok = defaultRowValue(&schemapb.FieldSchema{FieldID: common.StartOfUserFieldID, DataType: schemapb.DataType_VarChar})
+		assert.True(t, ok)
+		assert.Equal(t, "", value)
+
+		_, ok = defaultRowValue(&schemapb.FieldSchema{FieldID: common.StartOfUserFieldID, DataType: schemapb.DataType_FloatVector})
+		assert.False(t, ok)
+		_, ok = defaultRowValue(&schemapb.FieldSchema{FieldID: common.TimeStampField, DataType: schemapb.DataType_Int64})
+		assert.False(t, ok)
+	})
+
 	t.Run("Test mergeDeltalogs", func(t *testing.T) {
 		t.Run("One segment", func(t *testing.T) {
 			invalidBlobs := map[UniqueID][]*Blob{
@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -45,7 +46,7 @@ var _ flowgraph.Node = (*ddNode)(nil)
 //
 // ddNode recives all the messages from message stream dml channels, including insert messages,
 //
-//	delete messages and ddl messages like CreateCollectionMsg and DropCollectionMsg.
+//	delete messages and ddl messages like CreateCollectionMsg, AlterCollectionMsg and DropCollectionMsg.
 //
 // ddNode filters insert messages according to the `sealedSegment`.
 // ddNode will filter out the insert message for those who belong to `sealedSegment`
@@ -171,6 +172,24 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				fgMsg.dropPartitions = append(fgMsg.dropPartitions, dpMsg.PartitionID)
 			}
 
+		case commonpb.MsgType_AlterCollection:
+			acMsg := msg.(*msgstream.AlterCollectionMsg)
+			if acMsg.GetCollectionID() == ddn.collectionID {
+				schema := &schemapb.CollectionSchema{}
+				if err := proto.Unmarshal(acMsg.GetSchema(), schema); err != nil {
+					log.Warn("failed to unmarshal schema of alter collection msg",
+						zap.Int64("collectionID", ddn.collectionID),
+						zap.String("vChannelName", ddn.vChannelName),
+						zap.Error(err))
+					panic(err)
+				}
+				log.Info("alter collection msg received",
+					zap.Int64("collectionID", ddn.collectionID),
+					zap.Int("fieldNum", len(schema.GetFields())),
+					zap.String("vChannelName", ddn.vChannelName))
+				fgMsg.updatedSchema = schema
+			}
+
 		case commonpb.MsgType_Insert:
 			imsg := msg.(*msgstream.InsertMsg)
 			if imsg.CollectionID != ddn.collectionID {
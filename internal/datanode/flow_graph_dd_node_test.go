@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -217,6 +219,45 @@ func TestFlowGraph_DDNode_Operate(t *testing.T) {
 		}
 	})
 
+	t.Run("Test DDNode Operate AlterCollection Msg", func(t *testing.T) {
+		ddn := ddNode{
+			ctx:                context.Background(),
+			collectionID:       1,
+			vChannelName:       "ddn_alter_msg",
+			compactionExecutor: newCompactionExecutor(),
+		}
+
+		schema := &schemapb.CollectionSchema{
+			Name: "test_collection",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "added", DataType: schemapb.DataType_Int32},
+			},
+		}
+		schemaBytes, err := proto.Marshal(schema)
+		require.NoError(t, err)
+
+		for _, collID := range []UniqueID{1, 2} {
+			var alterMsg msgstream.TsMsg = &msgstream.AlterCollectionMsg{
+				CreateCollectionRequest: msgpb.CreateCollectionRequest{
+					Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+					CollectionID: collID,
+					Schema:       schemaBytes,
+				},
+			}
+			var msgStreamMsg Msg = flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{alterMsg}, 0, 0, nil, nil)
+
+			rt := ddn.Operate([]Msg{msgStreamMsg})
+			fgMsg, ok := rt[0].(*flowGraphMsg)
+			assert.True(t, ok)
+			if collID == ddn.collectionID {
+				assert.Equal(t, 2, len(fgMsg.updatedSchema.GetFields()))
+			} else {
+				assert.Nil(t, fgMsg.updatedSchema)
+			}
+		}
+	})
+
 	t.Run("Test DDNode Operate and filter insert msg", func(t *testing.T) {
 		var collectionID UniqueID = 1
 		// Prepare ddNode states
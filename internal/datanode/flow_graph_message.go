@@ -18,6 +18,7 @@ package datanode
 
 import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -53,6 +54,8 @@ type flowGraphMsg struct {
 	segmentsToSync []UniqueID
 	dropCollection bool
 	dropPartitions []UniqueID
+	// updatedSchema is the collection schema after a field is added or dropped
+	updatedSchema *schemapb.CollectionSchema
 }
 
 func (fgMsg *flowGraphMsg) TimeTick() Timestamp {
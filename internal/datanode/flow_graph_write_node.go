@@ -71,6 +71,12 @@ func (wNode *writeNode) Operate(in []Msg) []Msg {
 
 	start, end := fgMsg.startPositions[0], fgMsg.endPositions[0]
 
+	// the inserts before the schema changed are buffered with the new schema as well,
+	// it fills the fields added and leaves the fields dropped
+	if fgMsg.updatedSchema != nil {
+		wNode.wbManager.UpdateSchema(wNode.channelName, fgMsg.updatedSchema)
+	}
+
 	err := wNode.wbManager.BufferData(wNode.channelName, fgMsg.insertMessages, fgMsg.deleteMessages, start, end)
 	if err != nil {
 		log.Error("failed to buffer data", zap.Error(err))
@@ -19,6 +19,7 @@ package metacache
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
 
@@ -100,6 +101,14 @@ func (s *MetaCacheSuite) TestMetaInfo() {
 	s.Equal(s.collSchema, s.cache.Schema())
 }
 
+func (s *MetaCacheSuite) TestUpdateSchema() {
+	schema := proto.Clone(s.collSchema).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Int32, Name: "added"})
+
+	s.cache.UpdateSchema(schema)
+	s.Equal(schema, s.cache.Schema())
+}
+
 func (s *MetaCacheSuite) TestCompactSegments() {
 	for i, seg := range s.newSegments {
 		// compaction from flushed[i], unflushed[i] and invalidSeg to new[i]
@@ -460,6 +460,39 @@ func (_c *MockMetaCache_Schema_Call) RunAndReturn(run func() *schemapb.Collectio
 	return _c
 }
 
+// UpdateSchema provides a mock function with given fields: schema
+func (_m *MockMetaCache) UpdateSchema(schema *schemapb.CollectionSchema) {
+	_m.Called(schema)
+}
+
+// MockMetaCache_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type MockMetaCache_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - schema *schemapb.CollectionSchema
+func (_e *MockMetaCache_Expecter) UpdateSchema(schema interface{}) *MockMetaCache_UpdateSchema_Call {
+	return &MockMetaCache_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", schema)}
+}
+
+func (_c *MockMetaCache_UpdateSchema_Call) Run(run func(schema *schemapb.CollectionSchema)) *MockMetaCache_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockMetaCache_UpdateSchema_Call) Return() *MockMetaCache_UpdateSchema_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockMetaCache_UpdateSchema_Call) RunAndReturn(run func(*schemapb.CollectionSchema)) *MockMetaCache_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSegments provides a mock function with given fields: action, filters
 func (_m *MockMetaCache) UpdateSegments(action SegmentAction, filters ...SegmentFilter) {
 	_va := make([]interface{}, len(filters))
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -32,6 +33,8 @@ type BufferManager interface {
 	GetCheckpoint(channel string) (*msgpb.MsgPosition, bool, error)
 	// NotifyCheckpointUpdated notify write buffer checkpoint updated to reset flushTs.
 	NotifyCheckpointUpdated(channel string, ts uint64)
+	// UpdateSchema updates the collection schema of channel write buffer after a field is added or dropped.
+	UpdateSchema(channel string, schema *schemapb.CollectionSchema)
 }
 
 // NewManager returns initialized manager as `Manager`
@@ -140,6 +143,19 @@ func (m *bufferManager) NotifyCheckpointUpdated(channel string, ts uint64) {
 	}
 }
 
+// UpdateSchema updates the collection schema of channel write buffer after a field is added or dropped.
+func (m *bufferManager) UpdateSchema(channel string, schema *schemapb.CollectionSchema) {
+	m.mut.RLock()
+	buf, ok := m.buffers[channel]
+	m.mut.RUnlock()
+
+	if !ok {
+		log.Warn("write buffer not found when update schema", zap.String("channel", channel))
+		return
+	}
+	buf.UpdateSchema(schema)
+}
+
 // RemoveChannel remove channel WriteBuffer from manager.
 // this method discards all buffered data since datanode no longer has the ownership
 func (m *bufferManager) RemoveChannel(channel string) {
@@ -124,6 +124,26 @@ func (s *ManagerSuite) TestBufferData() {
 	})
 }
 
+func (s *ManagerSuite) TestUpdateSchema() {
+	manager := s.manager
+	s.Run("channel_not_found", func() {
+		manager.UpdateSchema(s.channelName, nil)
+	})
+
+	s.Run("normal_update_schema", func() {
+		wb := NewMockWriteBuffer(s.T())
+
+		s.manager.mut.Lock()
+		s.manager.buffers[s.channelName] = wb
+		s.manager.mut.Unlock()
+
+		schema := &schemapb.CollectionSchema{Name: "test_collection"}
+		wb.EXPECT().UpdateSchema(schema).Return()
+
+		manager.UpdateSchema(s.channelName, schema)
+	})
+}
+
 func (s *ManagerSuite) TestGetCheckpoint() {
 	manager := s.manager
 	s.Run("channel_not_found", func() {
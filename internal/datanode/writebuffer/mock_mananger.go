@@ -10,6 +10,8 @@ import (
 
 	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
 )
 
@@ -380,6 +382,40 @@ func (_c *MockBufferManager_RemoveChannel_Call) RunAndReturn(run func(string)) *
 	return _c
 }
 
+// UpdateSchema provides a mock function with given fields: channel, schema
+func (_m *MockBufferManager) UpdateSchema(channel string, schema *schemapb.CollectionSchema) {
+	_m.Called(channel, schema)
+}
+
+// MockBufferManager_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type MockBufferManager_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - channel string
+//   - schema *schemapb.CollectionSchema
+func (_e *MockBufferManager_Expecter) UpdateSchema(channel interface{}, schema interface{}) *MockBufferManager_UpdateSchema_Call {
+	return &MockBufferManager_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", channel, schema)}
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) Run(run func(channel string, schema *schemapb.CollectionSchema)) *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) Return() *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockBufferManager_UpdateSchema_Call) RunAndReturn(run func(string, *schemapb.CollectionSchema)) *MockBufferManager_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockBufferManager creates a new instance of MockBufferManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBufferManager(t interface {
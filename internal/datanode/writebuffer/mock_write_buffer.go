@@ -6,6 +6,9 @@ import (
 	context "context"
 
 	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+
+	schemapb "github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+
 	mock "github.com/stretchr/testify/mock"
 
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -304,6 +307,39 @@ func (_c *MockWriteBuffer_SetFlushTimestamp_Call) RunAndReturn(run func(uint64))
 	return _c
 }
 
+// UpdateSchema provides a mock function with given fields: schema
+func (_m *MockWriteBuffer) UpdateSchema(schema *schemapb.CollectionSchema) {
+	_m.Called(schema)
+}
+
+// MockWriteBuffer_UpdateSchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSchema'
+type MockWriteBuffer_UpdateSchema_Call struct {
+	*mock.Call
+}
+
+// UpdateSchema is a helper method to define mock.On call
+//   - schema *schemapb.CollectionSchema
+func (_e *MockWriteBuffer_Expecter) UpdateSchema(schema interface{}) *MockWriteBuffer_UpdateSchema_Call {
+	return &MockWriteBuffer_UpdateSchema_Call{Call: _e.mock.On("UpdateSchema", schema)}
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) Run(run func(schema *schemapb.CollectionSchema)) *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*schemapb.CollectionSchema))
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) Return() *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWriteBuffer_UpdateSchema_Call) RunAndReturn(run func(*schemapb.CollectionSchema)) *MockWriteBuffer_UpdateSchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWriteBuffer creates a new instance of MockWriteBuffer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteBuffer(t interface {
@@ -51,6 +51,8 @@ type WriteBuffer interface {
 	// If there are any non-empty segment buffer, returns the earliest buffer start position.
 	// Otherwise, returns latest buffered checkpoint.
 	GetCheckpoint() *msgpb.MsgPosition
+	// UpdateSchema syncs the data buffered with the former schema and buffers the data afterwards with the provided one.
+	UpdateSchema(schema *schemapb.CollectionSchema)
 	// Close is the method to close and sink current buffer data.
 	Close(drop bool)
 }
@@ -154,6 +156,21 @@ func (wb *writeBufferBase) GetCheckpoint() *msgpb.MsgPosition {
 	return checkpoint
 }
 
+func (wb *writeBufferBase) UpdateSchema(schema *schemapb.CollectionSchema) {
+	wb.mut.Lock()
+	defer wb.mut.Unlock()
+
+	// each binlog is written with a single schema, so the buffered data is synced with the schema it's buffered with
+	segmentIDs := lo.Keys(wb.buffers)
+	if len(segmentIDs) > 0 {
+		log.Info("write buffer sync segments before schema changed",
+			zap.String("channel", wb.channelName), zap.Int64s("segmentIDs", segmentIDs))
+		wb.syncSegments(context.Background(), segmentIDs)
+	}
+	wb.collSchema = schema
+	wb.metaCache.UpdateSchema(schema)
+}
+
 func (wb *writeBufferBase) triggerSync() (segmentIDs []int64) {
 	segmentsToSync := wb.getSegmentsToSync(wb.checkpoint.GetTimestamp())
 	if len(segmentsToSync) > 0 {
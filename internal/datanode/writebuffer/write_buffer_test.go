@@ -92,6 +92,26 @@ func (s *WriteBufferSuite) TestFlushSegments() {
 	s.NoError(err)
 }
 
+func (s *WriteBufferSuite) TestUpdateSchema() {
+	segmentID := int64(1001)
+	s.wb.getOrCreateBuffer(segmentID)
+
+	seg := metacache.NewSegmentInfo(&datapb.SegmentInfo{ID: segmentID}, metacache.NewBloomFilterSet())
+	s.metacache.EXPECT().GetSegmentByID(segmentID).Return(seg, true)
+	s.metacache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return()
+	s.syncMgr.EXPECT().SyncData(mock.Anything, mock.Anything).Return(nil)
+
+	schema := &schemapb.CollectionSchema{
+		Name:   s.collSchema.GetName(),
+		Fields: append(s.collSchema.GetFields(), &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Int32, Name: "added"}),
+	}
+	s.metacache.EXPECT().UpdateSchema(schema).Return()
+
+	s.wb.UpdateSchema(schema)
+	s.False(s.wb.HasSegment(segmentID))
+	s.Equal(schema, s.wb.collSchema)
+}
+
 func TestWriteBufferBase(t *testing.T) {
 	suite.Run(t, new(WriteBufferSuite))
 }
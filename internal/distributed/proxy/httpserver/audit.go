@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+)
+
+// auditMethod returns the name of grpc method the route is audited as, the name of request without
+// the Wrapped prefix and Request or Req suffix if it's not specified, empty if the route isn't audited.
+func auditMethod(spec routeSpec) string {
+	if spec.method != "" || spec.request == nil {
+		return spec.method
+	}
+	name := reflect.TypeOf(spec.request).Elem().Name()
+	name = strings.TrimPrefix(name, "Wrapped")
+	if trimmed := strings.TrimSuffix(name, "Request"); trimmed != name {
+		return trimmed
+	}
+	return strings.TrimSuffix(name, "Req")
+}
+
+// NewAuditLogHandler returns the middleware which audits the requests of routes registered by RegisterRoutesTo
+// under apiPrefix and RegisterRoutesToV1 under vectorPrefix, since they call proxy directly and aren't audited
+// by the grpc interceptors. It must be placed before authenticate, so the requests rejected by it are audited.
+func NewAuditLogHandler(apiPrefix, vectorPrefix string) gin.HandlerFunc {
+	methods := make(map[routeKey]string)
+	register := func(prefix string, specs map[routeKey]routeSpec) {
+		for key, spec := range specs {
+			if method := auditMethod(spec); method != "" {
+				methods[routeKey{key.method, prefix + key.path}] = method
+			}
+		}
+	}
+	register(apiPrefix, restfulRouteSpecs)
+	register(vectorPrefix, vectorRouteSpecs)
+	register(vectorPrefix, adminRouteSpecs)
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		method, ok := methods[routeKey{c.Request.Method, c.FullPath()}]
+		if !ok {
+			return
+		}
+		username, _ := c.Get(ContextUsername)
+		user, _ := username.(string)
+		req, _ := c.Get(ContextRequest)
+		accesslog.AuditHTTP(c.Request.Context(), method, user, c.ClientIP(), req, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,88 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditMethod(t *testing.T) {
+	assert.Equal(t, "CreateCollection", auditMethod(restfulRouteSpecs[routeKey{http.MethodPost, "/collection"}]))
+	assert.Equal(t, "Insert", auditMethod(restfulRouteSpecs[routeKey{http.MethodPost, "/entities"}]))
+	assert.Equal(t, "CreateRowPolicy", auditMethod(adminRouteSpecs[routeKey{http.MethodPost, RowPolicyPath}]))
+	assert.Equal(t, "ShowCollections", auditMethod(vectorRouteSpecs[routeKey{http.MethodGet, VectorCollectionsPath}]))
+	assert.Equal(t, "QueryIterator", auditMethod(vectorRouteSpecs[routeKey{http.MethodPost, VectorQueryIteratorPath}]))
+	assert.Equal(t, "HybridSearch", auditMethod(adminRouteSpecs[routeKey{http.MethodPost, HybridSearchPath}]))
+	assert.Equal(t, "", auditMethod(vectorRouteSpecs[routeKey{http.MethodGet, OpenAPIPath}]))
+}
+
+func TestAuditLogHandler(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	dir := t.TempDir()
+	params.Save(params.ProxyCfg.AuditLog.Enable.Key, "true")
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, accesslog.AuditSinkFile)
+	params.Save(params.ProxyCfg.AuditLog.LocalPath.Key, dir)
+	params.Save(params.ProxyCfg.AuditLog.Categories.Key, "")
+	params.Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "false")
+	defer func() {
+		params.Reset(params.ProxyCfg.AuditLog.Enable.Key)
+		params.Reset(params.ProxyCfg.AuditLog.Sinks.Key)
+		params.Reset(params.ProxyCfg.AuditLog.LocalPath.Key)
+		params.Reset(params.ProxyCfg.AuditLog.Categories.Key)
+		params.Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
+	}()
+	assert.NoError(t, accesslog.SetupAuditLog(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg))
+	defer accesslog.CloseAuditLog()
+
+	testEngine := gin.New()
+	testEngine.Use(NewAuditLogHandler("/api/v1", "/v1"), func(c *gin.Context) {
+		c.Set(ContextUsername, "alice")
+	})
+	NewHandlers(&mockProxyComponent{}).RegisterRoutesToV1(testEngine.Group("/v1"))
+
+	body, err := json.Marshal(WrappedHybridSearchRequest{DbName: "db1", CollectionName: "coll", Requests: []*SearchRequest{{Dsl: "some dsl"}}})
+	assert.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/v1"+HybridSearchPath, bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	// the routes not registered aren't audited
+	req = httptest.NewRequest(http.MethodPost, "/v1/unknown", nil)
+	w = httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	accesslog.CloseAuditLog()
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	var events []*accesslog.AuditEvent
+	for _, file := range files {
+		data, err := os.ReadFile(path.Join(dir, file.Name()))
+		assert.NoError(t, err)
+		for _, line := range bytes.Split(bytes.TrimSpace(data), []byte{'\n'}) {
+			event := &accesslog.AuditEvent{}
+			assert.NoError(t, json.Unmarshal(line, event))
+			events = append(events, event)
+		}
+	}
+	assert.Len(t, events, 1)
+	assert.Equal(t, "HybridSearch", events[0].Method)
+	assert.Equal(t, accesslog.AuditCategoryDQL, events[0].Category)
+	assert.Equal(t, "alice", events[0].User)
+	assert.Equal(t, "db1", events[0].DbName)
+	assert.Equal(t, "coll", events[0].CollectionName)
+	assert.Equal(t, http.StatusOK, events[0].HTTPStatus)
+}
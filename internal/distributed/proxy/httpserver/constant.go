@@ -2,6 +2,8 @@ package httpserver
 
 const (
 	ContextUsername               = "username"
+	ContextRequest                = "request"
+	ContextJWTRoles               = "jwt_roles"
 	VectorCollectionsPath         = "/vector/collections"
 	VectorCollectionsCreatePath   = "/vector/collections/create"
 	VectorCollectionsDescribePath = "/vector/collections/describe"
@@ -9,10 +11,29 @@ const (
 	VectorInsertPath              = "/vector/insert"
 	VectorUpsertPath              = "/vector/upsert"
 	VectorSearchPath              = "/vector/search"
+	VectorSearchIteratorPath      = "/vector/search/iterator"
 	VectorGetPath                 = "/vector/get"
 	VectorQueryPath               = "/vector/query"
+	VectorQueryStreamPath         = "/vector/query/stream"
+	VectorQueryIteratorPath       = "/vector/query/iterator"
 	VectorDeletePath              = "/vector/delete"
 
+	HybridSearchPath                 = "/hybrid-search"
+	ExplainPath                      = "/explain"
+	CollectionFieldPath              = "/collection/field"
+	RolePath                         = "/role"
+	RoleUserPath                     = "/role/user"
+	UserPath                         = "/user"
+	PrivilegePath                    = "/privilege"
+	RowPolicyPath                    = "/row-policy"
+	RowPoliciesPath                  = "/row-policies"
+	DatabasePath                     = "/database"
+	DatabasesPath                    = "/databases"
+	ResourceGroupPath                = "/resource-group"
+	ResourceGroupsPath               = "/resource-groups"
+	ResourceGroupNodeTransferPath    = "/resource-group/node/transfer"
+	ResourceGroupReplicaTransferPath = "/resource-group/replica/transfer"
+
 	ShardNumDefault = 1
 
 	EnableDynamic = true
@@ -22,6 +43,7 @@ const (
 	HTTPCollectionName   = "collectionName"
 	HTTPDbName           = "dbName"
 	DefaultDbName        = "default"
+	DefaultPartitionName = "_default"
 	DefaultIndexName     = "vector_idx"
 	DefaultOutputFields  = "*"
 	HTTPHeaderAllowInt64 = "Accept-Type-Allow-Int64"
@@ -29,6 +51,16 @@ const (
 	HTTPReturnMessage    = "message"
 	HTTPReturnData       = "data"
 
+	HTTPReturnIteratorToken = "iteratorToken"
+	HTTPReturnStreamError   = "error"
+
+	HTTPHeaderAccept      = "Accept"
+	HTTPContentTypeNDJSON = "application/x-ndjson"
+	HTTPContentTypeSSE    = "text/event-stream"
+	SSEEventData          = "data"
+	SSEEventError         = "error"
+	SSEEventEnd           = "end"
+
 	HTTPReturnFieldName       = "name"
 	HTTPReturnFieldType       = "type"
 	HTTPReturnFieldPrimaryKey = "primaryKey"
@@ -49,10 +81,14 @@ const (
 )
 
 const (
-	ParamAnnsField    = "anns_field"
-	Params            = "params"
-	ParamRoundDecimal = "round_decimal"
-	ParamOffset       = "offset"
-	ParamLimit        = "limit"
-	BoundedTimestamp  = 2
+	ParamAnnsField     = "anns_field"
+	Params             = "params"
+	ParamRoundDecimal  = "round_decimal"
+	ParamOffset        = "offset"
+	ParamLimit         = "limit"
+	ParamIterator      = "iterator"
+	ParamIteratorToken = "iterator_token"
+	ParamRadius        = "radius"
+	ParamRangeFilter   = "range_filter"
+	BoundedTimestamp   = 2
 )
@@ -1,24 +1,33 @@
 package httpserver
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
+type collectionSchemaGetter func(ctx context.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error)
+
 // Handlers handles http requests
 type Handlers struct {
 	proxy types.ProxyComponent
+	// getCollectionSchema is used to validate request bodies, it reads proxy meta cache by default
+	getCollectionSchema collectionSchemaGetter
 }
 
 // NewHandlers creates a new Handlers
-func NewHandlers(proxy types.ProxyComponent) *Handlers {
+func NewHandlers(proxyComponent types.ProxyComponent) *Handlers {
 	return &Handlers{
-		proxy: proxy,
+		proxy:               proxyComponent,
+		getCollectionSchema: proxy.GetCachedCollectionSchema,
 	}
 }
 
@@ -35,6 +44,10 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.PATCH("/collection", wrapHandler(h.handleAlterCollection))
+	router.POST("/collection/rename", wrapHandler(h.handleRenameCollection))
+	router.GET("/collection/load/progress", wrapHandler(h.handleGetLoadingProgress))
+	router.GET("/collection/load/state", wrapHandler(h.handleGetLoadState))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -47,19 +60,26 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/alias", wrapHandler(h.handleCreateAlias))
 	router.DELETE("/alias", wrapHandler(h.handleDropAlias))
 	router.PATCH("/alias", wrapHandler(h.handleAlterAlias))
+	router.GET("/alias", wrapHandler(h.handleDescribeAlias))
+	router.GET("/aliases", wrapHandler(h.handleListAliases))
 
 	router.POST("/index", wrapHandler(h.handleCreateIndex))
 	router.GET("/index", wrapHandler(h.handleDescribeIndex))
 	router.GET("/index/state", wrapHandler(h.handleGetIndexState))
 	router.GET("/index/progress", wrapHandler(h.handleGetIndexBuildProgress))
+	router.GET("/index/statistics", wrapHandler(h.handleGetIndexStatistics))
 	router.DELETE("/index", wrapHandler(h.handleDropIndex))
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
+	router.PUT("/entities", wrapHandler(h.handleUpsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
+	router.POST("/entities/get", wrapHandler(h.handleGet))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
+	router.POST("/persist/all", wrapHandler(h.handleFlushAll))
+	router.GET("/persist/all/state", wrapHandler(h.handleGetFlushAllState))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
 	router.GET("/persist/state", wrapHandler(h.handleGetFlushState))
 	router.GET("/persist/segment-info", wrapHandler(h.handleGetPersistentSegmentInfo))
@@ -80,6 +100,11 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
 	router.DELETE("/credential", wrapHandler(h.handleDeleteCredential))
 	router.GET("/credential/users", wrapHandler(h.handleListCredUsers))
+
+	router.GET("/version", wrapHandler(h.handleGetVersion))
+	router.GET("/health/check", wrapHandler(h.handleCheckHealth))
+	router.GET("/component-states", wrapHandler(h.handleGetComponentStates))
+	router.GET("/timestamp", wrapHandler(h.handleAllocTimestamp))
 }
 
 func (h *Handlers) handleGetHealth(c *gin.Context) (interface{}, error) {
@@ -181,6 +206,77 @@ func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	return h.proxy.ShowCollections(c, &req)
 }
 
+func (h *Handlers) handleAlterCollection(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AlterCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AlterCollection(c, &req)
+}
+
+func (h *Handlers) handleRenameCollection(c *gin.Context) (interface{}, error) {
+	req := milvuspb.RenameCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RenameCollection(c, &req)
+}
+
+func (h *Handlers) handleAddCollectionField(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedAddCollectionFieldRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	fieldProto, err := proto.Marshal(&wrappedReq.Field)
+	if err != nil {
+		return nil, fmt.Errorf("%w: marshal field failed: %v", errBadRequest, err)
+	}
+	req := &milvuspb.AddCollectionFieldRequest{
+		DbName:         wrappedReq.DbName,
+		CollectionName: wrappedReq.CollectionName,
+		Schema:         fieldProto,
+	}
+	ctx, err := authorize(c, req.GetDbName(), req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.AddCollectionField(ctx, req)
+}
+
+func (h *Handlers) handleDropCollectionField(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.DropCollectionFieldRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, req.GetDbName(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropCollectionField(ctx, &req)
+}
+
+func (h *Handlers) handleGetLoadingProgress(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetLoadingProgressRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetLoadingProgress(c, &req)
+}
+
+func (h *Handlers) handleGetLoadState(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetLoadStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetLoadState(c, &req)
+}
+
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreatePartitionRequest{}
 	err := shouldBind(c, &req)
@@ -271,6 +367,24 @@ func (h *Handlers) handleAlterAlias(c *gin.Context) (interface{}, error) {
 	return h.proxy.AlterAlias(c, &req)
 }
 
+func (h *Handlers) handleDescribeAlias(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DescribeAliasRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.DescribeAlias(c, &req)
+}
+
+func (h *Handlers) handleListAliases(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListAliasesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListAliases(c, &req)
+}
+
 func (h *Handlers) handleCreateIndex(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateIndexRequest{}
 	err := shouldBind(c, &req)
@@ -307,6 +421,15 @@ func (h *Handlers) handleGetIndexBuildProgress(c *gin.Context) (interface{}, err
 	return h.proxy.GetIndexBuildProgress(c, &req)
 }
 
+func (h *Handlers) handleGetIndexStatistics(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetIndexStatisticsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetIndexStatistics(c, &req)
+}
+
 func (h *Handlers) handleDropIndex(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DropIndexRequest{}
 	err := shouldBind(c, &req)
@@ -326,9 +449,53 @@ func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: convert body to pb failed: %v", errBadRequest, err)
 	}
+	schema, err := h.getCollectionSchema(c, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	if err = validateFieldsData(schema, req.GetFieldsData(), req.GetNumRows(), false); err != nil {
+		return nil, err
+	}
 	return h.proxy.Insert(c, req)
 }
 
+func (h *Handlers) handleUpsert(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedUpsertRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	req, err := wrappedReq.AsUpsertRequest()
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert body to pb failed: %v", errBadRequest, err)
+	}
+	schema, err := h.getCollectionSchema(c, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	if err = validateFieldsData(schema, req.GetFieldsData(), req.GetNumRows(), true); err != nil {
+		return nil, err
+	}
+	return h.proxy.Upsert(c, req)
+}
+
+func (h *Handlers) handleGet(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedGetRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	schema, err := h.getCollectionSchema(c, wrappedReq.DbName, wrappedReq.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	req, err := wrappedReq.AsQueryRequest(schema)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.Query(c, req)
+}
+
 func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DeleteRequest{}
 	err := shouldBind(c, &req)
@@ -344,25 +511,40 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	req := milvuspb.SearchRequest{
-		Base:               wrappedReq.Base,
-		DbName:             wrappedReq.DbName,
-		CollectionName:     wrappedReq.CollectionName,
-		PartitionNames:     wrappedReq.PartitionNames,
-		Dsl:                wrappedReq.Dsl,
-		DslType:            wrappedReq.DslType,
-		OutputFields:       wrappedReq.OutputFields,
-		SearchParams:       wrappedReq.SearchParams,
-		TravelTimestamp:    wrappedReq.TravelTimestamp,
-		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
-		Nq:                 wrappedReq.Nq,
+	return h.proxy.Search(c, wrappedReq.AsSearchRequest())
+}
+
+func (h *Handlers) handleHybridSearch(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedHybridSearchRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	// the sub searches are called on proxy directly, so the search privilege of collection is checked here,
+	// the row policies and field privileges of user are applied by every sub search with the context
+	ctx, err := authorize(c, wrappedReq.DbName, &milvuspb.SearchRequest{DbName: wrappedReq.DbName, CollectionName: wrappedReq.CollectionName})
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.HybridSearch(ctx, wrappedReq.AsHybridSearchRequest())
+}
+
+func (h *Handlers) handleExplain(c *gin.Context) (interface{}, error) {
+	req := types.ExplainRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	if len(wrappedReq.BinaryVectors) > 0 {
-		req.PlaceholderGroup = binaryVector2Bytes(wrappedReq.BinaryVectors)
-	} else {
-		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
+	// explain shows the schema and segments of collection, so it requires the privilege of the plan explained
+	var privilegeReq interface{} = &milvuspb.QueryRequest{DbName: req.DbName, CollectionName: req.CollectionName}
+	if req.AnnsField != "" {
+		privilegeReq = &milvuspb.SearchRequest{DbName: req.DbName, CollectionName: req.CollectionName}
 	}
-	return h.proxy.Search(c, &req)
+	ctx, err := authorize(c, req.DbName, privilegeReq)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.Explain(ctx, &req)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
@@ -383,6 +565,24 @@ func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	return h.proxy.Flush(c, &req)
 }
 
+func (h *Handlers) handleFlushAll(c *gin.Context) (interface{}, error) {
+	req := milvuspb.FlushAllRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.FlushAll(c, &req)
+}
+
+func (h *Handlers) handleGetFlushAllState(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetFlushAllStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetFlushAllState(c, &req)
+}
+
 func (h *Handlers) handleCalcDistance(c *gin.Context) (interface{}, error) {
 	wrappedReq := WrappedCalcDistanceRequest{}
 	err := shouldBind(c, &wrappedReq)
@@ -542,3 +742,286 @@ func (h *Handlers) handleListCredUsers(c *gin.Context) (interface{}, error) {
 	}
 	return h.proxy.ListCredUsers(c, &req)
 }
+
+func (h *Handlers) handleCreateRole(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateRoleRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CreateRole(ctx, &req)
+}
+
+func (h *Handlers) handleDropRole(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropRoleRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropRole(ctx, &req)
+}
+
+func (h *Handlers) handleSelectRole(c *gin.Context) (interface{}, error) {
+	req := milvuspb.SelectRoleRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.SelectRole(ctx, &req)
+}
+
+func (h *Handlers) handleOperateUserRole(c *gin.Context) (interface{}, error) {
+	req := milvuspb.OperateUserRoleRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.OperateUserRole(ctx, &req)
+}
+
+func (h *Handlers) handleSelectUser(c *gin.Context) (interface{}, error) {
+	req := milvuspb.SelectUserRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.SelectUser(ctx, &req)
+}
+
+func (h *Handlers) handleOperatePrivilege(c *gin.Context) (interface{}, error) {
+	req := milvuspb.OperatePrivilegeRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.OperatePrivilege(ctx, &req)
+}
+
+func (h *Handlers) handleSelectGrant(c *gin.Context) (interface{}, error) {
+	req := milvuspb.SelectGrantRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.SelectGrant(ctx, &req)
+}
+
+func (h *Handlers) handleCreateRowPolicy(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateRowPolicyRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, req.GetDbName(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CreateRowPolicy(ctx, &req)
+}
+
+func (h *Handlers) handleDropRowPolicy(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropRowPolicyRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, req.GetDbName(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropRowPolicy(ctx, &req)
+}
+
+func (h *Handlers) handleListRowPolicies(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListRowPoliciesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, req.GetDbName(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.ListRowPolicies(ctx, &req)
+}
+
+func (h *Handlers) handleCreateDatabase(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateDatabaseRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CreateDatabase(ctx, &req)
+}
+
+func (h *Handlers) handleDropDatabase(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropDatabaseRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropDatabase(ctx, &req)
+}
+
+func (h *Handlers) handleListDatabases(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListDatabasesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.ListDatabases(ctx, &req)
+}
+
+func (h *Handlers) handleCreateResourceGroup(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateResourceGroupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.CreateResourceGroup(ctx, &req)
+}
+
+func (h *Handlers) handleDropResourceGroup(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropResourceGroupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DropResourceGroup(ctx, &req)
+}
+
+func (h *Handlers) handleDescribeResourceGroup(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DescribeResourceGroupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.DescribeResourceGroup(ctx, &req)
+}
+
+func (h *Handlers) handleListResourceGroups(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListResourceGroupsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.ListResourceGroups(ctx, &req)
+}
+
+func (h *Handlers) handleTransferNode(c *gin.Context) (interface{}, error) {
+	req := milvuspb.TransferNodeRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.TransferNode(ctx, &req)
+}
+
+func (h *Handlers) handleTransferReplica(c *gin.Context) (interface{}, error) {
+	req := milvuspb.TransferReplicaRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	ctx, err := authorize(c, DefaultDbName, &req)
+	if err != nil {
+		return nil, err
+	}
+	return h.proxy.TransferReplica(ctx, &req)
+}
+
+func (h *Handlers) handleGetVersion(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetVersionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetVersion(c, &req)
+}
+
+func (h *Handlers) handleCheckHealth(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CheckHealthRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CheckHealth(c, &req)
+}
+
+func (h *Handlers) handleGetComponentStates(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetComponentStatesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetComponentStates(c, &req)
+}
+
+func (h *Handlers) handleAllocTimestamp(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AllocTimestampRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AllocTimestamp(c, &req)
+}
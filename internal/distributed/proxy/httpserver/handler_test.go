@@ -17,7 +17,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_WrappedInsertRequest_JSONMarshal_AsInsertRequest(t *testing.T) {
@@ -190,6 +193,45 @@ func (m *mockProxyComponent) Search(ctx context.Context, request *milvuspb.Searc
 	return &searchResult, nil
 }
 
+func (m *mockProxyComponent) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if len(request.Requests) == 0 {
+		return nil, errors.New("body parse err")
+	}
+	return &searchResult, nil
+}
+
+func (m *mockProxyComponent) Explain(ctx context.Context, request *types.ExplainRequest) (*types.ExplainResponse, error) {
+	if request.Expr == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &explainResult, nil
+}
+
+func (m *mockProxyComponent) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return &milvuspb.ListRowPoliciesResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) AddCollectionField(ctx context.Context, req *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DropCollectionField(ctx context.Context, req *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+var explainResult = types.ExplainResponse{
+	PlanType:    "query",
+	Selectivity: 0.5,
+}
+
 var queryResult = milvuspb.QueryResults{
 	CollectionName: "test",
 }
@@ -291,9 +333,122 @@ func (m *mockProxyComponent) ListCredUsers(ctx context.Context, request *milvusp
 	return &milvuspb.ListCredUsersResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) GetLoadingProgress(ctx context.Context, request *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return &milvuspb.GetLoadingProgressResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetLoadState(ctx context.Context, request *milvuspb.GetLoadStateRequest) (*milvuspb.GetLoadStateResponse, error) {
+	return &milvuspb.GetLoadStateResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) DescribeAlias(ctx context.Context, request *milvuspb.DescribeAliasRequest) (*milvuspb.DescribeAliasResponse, error) {
+	return &milvuspb.DescribeAliasResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListAliases(ctx context.Context, request *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
+	return &milvuspb.ListAliasesResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) FlushAll(ctx context.Context, request *milvuspb.FlushAllRequest) (*milvuspb.FlushAllResponse, error) {
+	return &milvuspb.FlushAllResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetFlushAllState(ctx context.Context, request *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+	return &milvuspb.GetFlushAllStateResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) SelectRole(ctx context.Context, request *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return &milvuspb.SelectRoleResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) SelectUser(ctx context.Context, request *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return &milvuspb.SelectUserResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) OperatePrivilege(ctx context.Context, request *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return &milvuspb.SelectGrantResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) CreateResourceGroup(ctx context.Context, request *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DropResourceGroup(ctx context.Context, request *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DescribeResourceGroup(ctx context.Context, request *milvuspb.DescribeResourceGroupRequest) (*milvuspb.DescribeResourceGroupResponse, error) {
+	return &milvuspb.DescribeResourceGroupResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListResourceGroups(ctx context.Context, request *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
+	return &milvuspb.ListResourceGroupsResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) TransferNode(ctx context.Context, request *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) TransferReplica(ctx context.Context, request *milvuspb.TransferReplicaRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) GetVersion(ctx context.Context, request *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
+	return &milvuspb.GetVersionResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return &milvuspb.CheckHealthResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetComponentStates(ctx context.Context, request *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	return &milvuspb.ComponentStates{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) AllocTimestamp(ctx context.Context, request *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
+	return &milvuspb.AllocTimestampResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if request.CollectionName == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &milvuspb.MutationResult{Acknowledged: true}, nil
+}
+
+func mockCollectionSchema(ctx context.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error) {
+	return &schemapb.CollectionSchema{
+		Name: collectionName,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: FieldBookID, IsPrimaryKey: true, DataType: schemapb.DataType_Int64, AutoID: true},
+		},
+	}, nil
+}
+
 func TestHandlers(t *testing.T) {
 	mockProxy := &mockProxyComponent{}
 	h := NewHandlers(mockProxy)
+	h.getCollectionSchema = mockCollectionSchema
 	testEngine := gin.New()
 	h.RegisterRoutesTo(testEngine)
 
@@ -553,6 +708,67 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/credential/users", emptyBody,
 			http.StatusOK, &milvuspb.ListCredUsersResponse{Status: testStatus},
 		},
+		{
+			http.MethodPatch, "/collection", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/rename", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/collection/load/progress", emptyBody,
+			http.StatusOK, &milvuspb.GetLoadingProgressResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/collection/load/state", emptyBody,
+			http.StatusOK, &milvuspb.GetLoadStateResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/alias", emptyBody,
+			http.StatusOK, &milvuspb.DescribeAliasResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/aliases", emptyBody,
+			http.StatusOK, &milvuspb.ListAliasesResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/index/statistics", emptyBody,
+			http.StatusOK, &milvuspb.GetIndexStatisticsResponse{Status: testStatus},
+		},
+		{
+			http.MethodPut, "/entities", &milvuspb.UpsertRequest{CollectionName: "c1"},
+			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
+		},
+		{
+			http.MethodPost, "/entities/get",
+			WrappedGetRequest{CollectionName: "c1", IDs: []json.RawMessage{json.RawMessage("1")}},
+			http.StatusOK, &queryResult,
+		},
+		{
+			http.MethodPost, "/persist/all", emptyBody,
+			http.StatusOK, &milvuspb.FlushAllResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/persist/all/state", emptyBody,
+			http.StatusOK, &milvuspb.GetFlushAllStateResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/version", emptyBody,
+			http.StatusOK, &milvuspb.GetVersionResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/health/check", emptyBody,
+			http.StatusOK, &milvuspb.CheckHealthResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/component-states", emptyBody,
+			http.StatusOK, &milvuspb.ComponentStates{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/timestamp", emptyBody,
+			http.StatusOK, &milvuspb.AllocTimestampResponse{Status: testStatus},
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(fmt.Sprintf("%s %s %d", tt.httpMethod, tt.path, tt.expectedStatus), func(t *testing.T) {
@@ -578,3 +794,46 @@ func TestHandlers(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlers_ValidateBody(t *testing.T) {
+	h := NewHandlers(&mockProxyComponent{})
+	h.getCollectionSchema = mockCollectionSchema
+	testEngine := gin.New()
+	h.RegisterRoutesTo(testEngine)
+
+	t.Run("insert unknown field", func(t *testing.T) {
+		body := []byte(`{"collection_name": "c1", "num_rows": 1, "fields_data": [{"field_name": "unknown", "type": 5, "field": [1]}]}`)
+		req := httptest.NewRequest(http.MethodPost, "/entities", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("insert autoID primary key", func(t *testing.T) {
+		body := []byte(`{"collection_name": "c1", "num_rows": 1, "fields_data": [{"field_name": "book_id", "type": 5, "field": [1]}]}`)
+		req := httptest.NewRequest(http.MethodPost, "/entities", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("get with mismatched id type", func(t *testing.T) {
+		body := []byte(`{"collection_name": "c1", "ids": ["a"]}`)
+		req := httptest.NewRequest(http.MethodPost, "/entities/get", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("schema not found", func(t *testing.T) {
+		h.getCollectionSchema = func(ctx context.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error) {
+			return nil, merr.WrapErrCollectionNotFound(collectionName)
+		}
+		defer func() { h.getCollectionSchema = mockCollectionSchema }()
+		body := []byte(`{"collection_name": "c1", "num_rows": 0}`)
+		req := httptest.NewRequest(http.MethodPut, "/entities", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
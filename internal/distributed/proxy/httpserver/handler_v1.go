@@ -3,9 +3,12 @@ package httpserver
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/golang/protobuf/proto"
@@ -22,6 +25,7 @@ import (
 )
 
 func checkAuthorization(ctx context.Context, c *gin.Context, req interface{}) error {
+	c.Set(ContextRequest, req)
 	if proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
 		username, ok := c.Get(ContextUsername)
 		if !ok || username.(string) == "" {
@@ -37,6 +41,34 @@ func checkAuthorization(ctx context.Context, c *gin.Context, req interface{}) er
 	return nil
 }
 
+// newContextWithMetadata returns the context to call proxy on behalf of the user set by the authenticate middleware,
+// which carries the roles claimed by JWT if the user is authenticated by JWT.
+func newContextWithMetadata(ctx context.Context, c *gin.Context, username string, dbName string) context.Context {
+	ctx = proxy.NewContextWithMetadata(ctx, username, dbName)
+	if roles, ok := c.Get(ContextJWTRoles); ok {
+		ctx = proxy.WithJWTRoles(ctx, roles.([]string))
+	}
+	return ctx
+}
+
+// authorize checks the privilege of the user set by the authenticate middleware for the handlers
+// wrapped by wrapHandler, which write the error response themselves, and returns the context to call proxy with.
+func authorize(c *gin.Context, dbName string, req interface{}) (context.Context, error) {
+	c.Set(ContextRequest, req)
+	username, _ := c.Get(ContextUsername)
+	name, _ := username.(string)
+	ctx := newContextWithMetadata(c, c, name, dbName)
+	if proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		if name == "" {
+			return nil, merr.ErrNeedAuthenticate
+		}
+		if _, err := proxy.PrivilegeInterceptor(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return ctx, nil
+}
+
 func (h *Handlers) checkDatabase(ctx context.Context, c *gin.Context, dbName string) bool {
 	if dbName == DefaultDbName {
 		return true
@@ -109,11 +141,42 @@ func (h *Handlers) RegisterRoutesToV1(router gin.IRouter) {
 	router.GET(VectorCollectionsDescribePath, h.getCollectionDetails)
 	router.POST(VectorCollectionsDropPath, h.dropCollection)
 	router.POST(VectorQueryPath, h.query)
+	router.POST(VectorQueryStreamPath, h.queryStream)
+	router.POST(VectorQueryIteratorPath, h.queryIterator)
 	router.POST(VectorGetPath, h.get)
 	router.POST(VectorDeletePath, h.delete)
 	router.POST(VectorInsertPath, h.insert)
 	router.POST(VectorUpsertPath, h.upsert)
 	router.POST(VectorSearchPath, h.search)
+	router.POST(VectorSearchIteratorPath, h.searchIterator)
+
+	router.POST(HybridSearchPath, wrapHandler(h.handleHybridSearch))
+	router.POST(ExplainPath, wrapHandler(h.handleExplain))
+
+	router.POST(CollectionFieldPath, wrapHandler(h.handleAddCollectionField))
+	router.DELETE(CollectionFieldPath, wrapHandler(h.handleDropCollectionField))
+
+	router.POST(RolePath, wrapHandler(h.handleCreateRole))
+	router.DELETE(RolePath, wrapHandler(h.handleDropRole))
+	router.GET(RolePath, wrapHandler(h.handleSelectRole))
+	router.POST(RoleUserPath, wrapHandler(h.handleOperateUserRole))
+	router.GET(UserPath, wrapHandler(h.handleSelectUser))
+	router.POST(PrivilegePath, wrapHandler(h.handleOperatePrivilege))
+	router.GET(PrivilegePath, wrapHandler(h.handleSelectGrant))
+	router.POST(RowPolicyPath, wrapHandler(h.handleCreateRowPolicy))
+	router.DELETE(RowPolicyPath, wrapHandler(h.handleDropRowPolicy))
+	router.GET(RowPoliciesPath, wrapHandler(h.handleListRowPolicies))
+
+	router.POST(DatabasePath, wrapHandler(h.handleCreateDatabase))
+	router.DELETE(DatabasePath, wrapHandler(h.handleDropDatabase))
+	router.GET(DatabasesPath, wrapHandler(h.handleListDatabases))
+
+	router.POST(ResourceGroupPath, wrapHandler(h.handleCreateResourceGroup))
+	router.DELETE(ResourceGroupPath, wrapHandler(h.handleDropResourceGroup))
+	router.GET(ResourceGroupPath, wrapHandler(h.handleDescribeResourceGroup))
+	router.GET(ResourceGroupsPath, wrapHandler(h.handleListResourceGroups))
+	router.POST(ResourceGroupNodeTransferPath, wrapHandler(h.handleTransferNode))
+	router.POST(ResourceGroupReplicaTransferPath, wrapHandler(h.handleTransferReplica))
 }
 
 func (h *Handlers) listCollections(c *gin.Context) {
@@ -122,7 +185,7 @@ func (h *Handlers) listCollections(c *gin.Context) {
 		DbName: dbName,
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -212,7 +275,7 @@ func (h *Handlers) createCollection(c *gin.Context) {
 		ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -268,7 +331,7 @@ func (h *Handlers) getCollectionDetails(c *gin.Context) {
 	}
 	dbName := c.DefaultQuery(HTTPDbName, DefaultDbName)
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), dbName)
+	ctx := newContextWithMetadata(c, c, username.(string), dbName)
 	if !h.checkDatabase(ctx, c, dbName) {
 		return
 	}
@@ -354,7 +417,7 @@ func (h *Handlers) dropCollection(c *gin.Context) {
 		CollectionName: httpReq.CollectionName,
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -408,6 +471,7 @@ func (h *Handlers) query(c *gin.Context) {
 	req := milvuspb.QueryRequest{
 		DbName:             httpReq.DbName,
 		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
 		Expr:               httpReq.Filter,
 		OutputFields:       httpReq.OutputFields,
 		GuaranteeTimestamp: BoundedTimestamp,
@@ -420,7 +484,7 @@ func (h *Handlers) query(c *gin.Context) {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -448,6 +512,213 @@ func (h *Handlers) query(c *gin.Context) {
 	}
 }
 
+// queryStream writes the query results as newline delimited json rows, or as server-sent events
+// with one batch of rows per event if the client accepts text/event-stream.
+// Each batch is flushed before the next one is fetched, so a slow client throttles the query,
+// and the query is cancelled once the client disconnects.
+// The error before any row is sent is responded as usual, the error after that is written as the last line,
+// or the data of error event, in the form of {"error": {"code": 1100, "message": "..."}}, which is told apart
+// from the rows by the only key error.
+func (h *Handlers) queryStream(c *gin.Context) {
+	httpReq := QueryReq{
+		DbName:       DefaultDbName,
+		OutputFields: []string{DefaultOutputFields},
+	}
+	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of query stream is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, query stream require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+			HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [collectionName]",
+		})
+		return
+	}
+	req := milvuspb.QueryRequest{
+		DbName:             httpReq.DbName,
+		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
+		Expr:               httpReq.Filter,
+		OutputFields:       httpReq.OutputFields,
+		GuaranteeTimestamp: BoundedTimestamp,
+		QueryParams:        []*commonpb.KeyValuePair{},
+	}
+	if httpReq.Offset > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamOffset, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
+	}
+	if httpReq.Limit > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
+	}
+	username, _ := c.Get(ContextUsername)
+	// the request context is cancelled when client disconnects
+	ctx := newContextWithMetadata(c.Request.Context(), c, username.(string), req.DbName)
+	if err := checkAuthorization(ctx, c, &req); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, req.DbName) {
+		return
+	}
+
+	allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+	useSSE := strings.Contains(c.Request.Header.Get(HTTPHeaderAccept), HTTPContentTypeSSE)
+	started := false
+	err := h.proxy.QueryStream(ctx, &req, func(response *milvuspb.QueryResults) error {
+		outputData, err := buildQueryResp(int64(0), response.OutputFields, response.FieldsData, nil, nil, allowJS)
+		if err != nil {
+			log.Warn("high level restful api, fail to deal with query stream result", zap.Error(err))
+			return errors.Wrap(merr.ErrInvalidSearchResult, err.Error())
+		}
+		if !started {
+			started = true
+			if useSSE {
+				c.Header("Content-Type", HTTPContentTypeSSE)
+				c.Header("Cache-Control", "no-cache")
+			} else {
+				c.Header("Content-Type", HTTPContentTypeNDJSON)
+			}
+			c.Status(http.StatusOK)
+		}
+		if useSSE {
+			err = writeSSEEvent(c, SSEEventData, outputData)
+		} else {
+			for _, row := range outputData {
+				if err = writeNDJSONLine(c, row); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if ctx.Err() != nil {
+		log.Info("high level restful api, query stream cancelled by client", zap.Error(ctx.Err()))
+		return
+	}
+	if !started {
+		if err != nil {
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return
+		}
+		// no entity matched, still respond with the stream content type
+		if useSSE {
+			c.Header("Content-Type", HTTPContentTypeSSE)
+		} else {
+			c.Header("Content-Type", HTTPContentTypeNDJSON)
+		}
+		c.Status(http.StatusOK)
+	}
+	// headers have been sent, report the result in the stream
+	if err != nil {
+		log.Warn("high level restful api, query stream failed", zap.Error(err))
+		errBody := gin.H{HTTPReturnStreamError: gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()}}
+		if useSSE {
+			writeSSEEvent(c, SSEEventError, errBody)
+		} else {
+			writeNDJSONLine(c, errBody)
+		}
+	} else if useSSE {
+		writeSSEEvent(c, SSEEventEnd, gin.H{HTTPReturnCode: http.StatusOK})
+	}
+	c.Writer.Flush()
+}
+
+func writeNDJSONLine(c *gin.Context, data interface{}) error {
+	line, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = c.Writer.Write(append(line, '\n'))
+	return err
+}
+
+func writeSSEEvent(c *gin.Context, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+// queryIterator opens a query iterator if no iteratorToken is given, or reads the next page of it.
+// The returned iteratorToken is empty once all entities have been returned.
+func (h *Handlers) queryIterator(c *gin.Context) {
+	httpReq := QueryIteratorReq{
+		DbName:       DefaultDbName,
+		OutputFields: []string{DefaultOutputFields},
+	}
+	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of query iterator is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, query iterator require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+			HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [collectionName]",
+		})
+		return
+	}
+	req := milvuspb.QueryRequest{
+		DbName:             httpReq.DbName,
+		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
+		Expr:               httpReq.Filter,
+		OutputFields:       httpReq.OutputFields,
+		GuaranteeTimestamp: BoundedTimestamp,
+		QueryParams:        []*commonpb.KeyValuePair{},
+	}
+	// the batch size of resumed iterator is kept unless limit is given
+	if httpReq.IteratorToken != "" {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamIteratorToken, Value: httpReq.IteratorToken})
+	} else {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamIterator, Value: "true"})
+		if httpReq.Limit <= 0 {
+			httpReq.Limit = 100
+		}
+	}
+	if httpReq.Limit > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
+	}
+	username, _ := c.Get(ContextUsername)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
+	if err := checkAuthorization(ctx, c, &req); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, req.DbName) {
+		return
+	}
+	response, token, err := h.proxy.QueryIterator(ctx, &req)
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+	outputData, err := buildQueryResp(int64(0), response.OutputFields, response.FieldsData, nil, nil, allowJS)
+	if err != nil {
+		log.Warn("high level restful api, fail to deal with query iterator result", zap.Any("response", response), zap.Error(err))
+		c.JSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+			HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData, HTTPReturnIteratorToken: token})
+}
+
 func (h *Handlers) get(c *gin.Context) {
 	httpReq := GetReq{
 		DbName:       DefaultDbName,
@@ -472,11 +743,12 @@ func (h *Handlers) get(c *gin.Context) {
 	req := milvuspb.QueryRequest{
 		DbName:             httpReq.DbName,
 		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
 		OutputFields:       httpReq.OutputFields,
 		GuaranteeTimestamp: BoundedTimestamp,
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -541,9 +813,10 @@ func (h *Handlers) delete(c *gin.Context) {
 	req := milvuspb.DeleteRequest{
 		DbName:         httpReq.DbName,
 		CollectionName: httpReq.CollectionName,
+		PartitionName:  httpReq.PartitionName,
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -596,6 +869,7 @@ func (h *Handlers) insert(c *gin.Context) {
 		}
 		httpReq.DbName = singleInsertReq.DbName
 		httpReq.CollectionName = singleInsertReq.CollectionName
+		httpReq.PartitionName = singleInsertReq.PartitionName
 		httpReq.Data = []map[string]interface{}{singleInsertReq.Data}
 	}
 	if httpReq.CollectionName == "" || httpReq.Data == nil {
@@ -606,14 +880,17 @@ func (h *Handlers) insert(c *gin.Context) {
 		})
 		return
 	}
+	if httpReq.PartitionName == "" {
+		httpReq.PartitionName = DefaultPartitionName
+	}
 	req := milvuspb.InsertRequest{
 		DbName:         httpReq.DbName,
 		CollectionName: httpReq.CollectionName,
-		PartitionName:  "_default",
+		PartitionName:  httpReq.PartitionName,
 		NumRows:        uint32(len(httpReq.Data)),
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -687,6 +964,7 @@ func (h *Handlers) upsert(c *gin.Context) {
 		}
 		httpReq.DbName = singleUpsertReq.DbName
 		httpReq.CollectionName = singleUpsertReq.CollectionName
+		httpReq.PartitionName = singleUpsertReq.PartitionName
 		httpReq.Data = []map[string]interface{}{singleUpsertReq.Data}
 	}
 	if httpReq.CollectionName == "" || httpReq.Data == nil {
@@ -697,14 +975,17 @@ func (h *Handlers) upsert(c *gin.Context) {
 		})
 		return
 	}
+	if httpReq.PartitionName == "" {
+		httpReq.PartitionName = DefaultPartitionName
+	}
 	req := milvuspb.UpsertRequest{
 		DbName:         httpReq.DbName,
 		CollectionName: httpReq.CollectionName,
-		PartitionName:  "_default",
+		PartitionName:  httpReq.PartitionName,
 		NumRows:        uint32(len(httpReq.Data)),
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -799,6 +1080,7 @@ func (h *Handlers) search(c *gin.Context) {
 	req := milvuspb.SearchRequest{
 		DbName:             httpReq.DbName,
 		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
 		Dsl:                httpReq.Filter,
 		PlaceholderGroup:   vector2PlaceholderGroupBytes(httpReq.Vector),
 		DslType:            commonpb.DslType_BoolExprV1,
@@ -808,7 +1090,7 @@ func (h *Handlers) search(c *gin.Context) {
 		Nq:                 int64(1),
 	}
 	username, _ := c.Get(ContextUsername)
-	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
 	if err := checkAuthorization(ctx, c, &req); err != nil {
 		return
 	}
@@ -839,3 +1121,97 @@ func (h *Handlers) search(c *gin.Context) {
 		}
 	}
 }
+
+// searchIterator opens a search iterator if no iteratorToken is given, or reads the next page of it.
+// Entities are returned from the nearest to the farthest, radius bounds the farthest distance if it's given.
+func (h *Handlers) searchIterator(c *gin.Context) {
+	httpReq := SearchIteratorReq{
+		DbName:     DefaultDbName,
+		MetricType: DefaultMetricType,
+	}
+	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+		log.Warn("high level restful api, the parameter of search iterator is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	// the vector is kept by the iterator once it's opened
+	if httpReq.CollectionName == "" || (httpReq.IteratorToken == "" && httpReq.Vector == nil) {
+		log.Warn("high level restful api, search iterator require parameter: [collectionName, vector], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+			HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [collectionName, vector]",
+		})
+		return
+	}
+	req := milvuspb.SearchRequest{
+		DbName:             httpReq.DbName,
+		CollectionName:     httpReq.CollectionName,
+		PartitionNames:     httpReq.PartitionNames,
+		Dsl:                httpReq.Filter,
+		DslType:            commonpb.DslType_BoolExprV1,
+		OutputFields:       httpReq.OutputFields,
+		GuaranteeTimestamp: BoundedTimestamp,
+		SearchParams:       []*commonpb.KeyValuePair{},
+	}
+	// the batch size of resumed iterator is kept unless limit is given
+	if httpReq.IteratorToken != "" {
+		req.SearchParams = append(req.SearchParams, &commonpb.KeyValuePair{Key: ParamIteratorToken, Value: httpReq.IteratorToken})
+	} else {
+		params := map[string]interface{}{ // auto generated mapping
+			"level": int(commonpb.ConsistencyLevel_Bounded),
+		}
+		if httpReq.Radius != nil {
+			params[ParamRadius] = *httpReq.Radius
+		}
+		if httpReq.RangeFilter != nil {
+			params[ParamRangeFilter] = *httpReq.RangeFilter
+		}
+		bs, _ := json.Marshal(params)
+		if httpReq.Limit <= 0 {
+			httpReq.Limit = 100
+		}
+		req.PlaceholderGroup = vector2PlaceholderGroupBytes(httpReq.Vector)
+		req.Nq = int64(1)
+		req.SearchParams = append(req.SearchParams,
+			&commonpb.KeyValuePair{Key: ParamIterator, Value: "true"},
+			&commonpb.KeyValuePair{Key: Params, Value: string(bs)},
+			&commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: httpReq.MetricType},
+			&commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"},
+		)
+	}
+	if httpReq.Limit > 0 {
+		req.SearchParams = append(req.SearchParams, &commonpb.KeyValuePair{Key: common.TopKKey, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
+	}
+	username, _ := c.Get(ContextUsername)
+	ctx := newContextWithMetadata(c, c, username.(string), req.DbName)
+	if err := checkAuthorization(ctx, c, &req); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, req.DbName) {
+		return
+	}
+	response, token, err := h.proxy.SearchIterator(ctx, &req)
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return
+	}
+	if response.GetResults().GetTopK() == int64(0) {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}, HTTPReturnIteratorToken: token})
+		return
+	}
+	allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+	results := response.GetResults()
+	outputData, err := buildQueryResp(results.TopK, results.OutputFields, results.FieldsData, results.Ids, results.Scores, allowJS)
+	if err != nil {
+		log.Warn("high level restful api, fail to deal with search iterator result", zap.Any("result", results), zap.Error(err))
+		c.JSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+			HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData, HTTPReturnIteratorToken: token})
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -21,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -552,6 +554,254 @@ func genGetRequest() *http.Request {
 	return req
 }
 
+type mockQueryStreamProxy struct {
+	*mocks.MockProxy
+	batches []*milvuspb.QueryResults
+	err     error
+}
+
+func (p *mockQueryStreamProxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error) error {
+	for _, batch := range p.batches {
+		if err := sender(batch); err != nil {
+			return err
+		}
+	}
+	return p.err
+}
+
+func TestQueryStream(t *testing.T) {
+	paramtable.Init()
+	genStreamRequest := func(accept string) *http.Request {
+		jsonBody := []byte(`{"collectionName": "` + DefaultCollectionName + `" , "filter": "book_id in [1,2,3]"}`)
+		req := httptest.NewRequest(http.MethodPost, versional(VectorQueryStreamPath), bytes.NewReader(jsonBody))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		req.Header.Set(HTTPHeaderAllowInt64, "true")
+		if accept != "" {
+			req.Header.Set(HTTPHeaderAccept, accept)
+		}
+		return req
+	}
+	batch := &milvuspb.QueryResults{
+		Status:         &StatusSuccess,
+		FieldsData:     generateFieldData(),
+		CollectionName: DefaultCollectionName,
+		OutputFields:   []string{FieldBookID, FieldWordCount, FieldBookIntro},
+	}
+	rows := "{\"book_id\":1,\"book_intro\":[0.1,0.11],\"word_count\":1000}\n" +
+		"{\"book_id\":2,\"book_intro\":[0.2,0.22],\"word_count\":2000}\n" +
+		"{\"book_id\":3,\"book_intro\":[0.3,0.33],\"word_count\":3000}\n"
+
+	t.Run("missing collection name", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{MockProxy: mocks.NewMockProxy(t)}, true)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, versional(VectorQueryStreamPath), bytes.NewReader([]byte(`{}`)))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		testEngine.ServeHTTP(w, req)
+		assert.True(t, CheckErrCode(w.Body.String(), merr.ErrMissingRequiredParameters))
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{
+			MockProxy: mocks.NewMockProxy(t),
+			batches:   []*milvuspb.QueryResults{batch, batch},
+		}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genStreamRequest(""))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, HTTPContentTypeNDJSON, w.Header().Get("Content-Type"))
+		assert.Equal(t, rows+rows, w.Body.String())
+	})
+
+	t.Run("sse", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{
+			MockProxy: mocks.NewMockProxy(t),
+			batches:   []*milvuspb.QueryResults{batch},
+		}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genStreamRequest(HTTPContentTypeSSE))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, HTTPContentTypeSSE, w.Header().Get("Content-Type"))
+		expected := "event: data\ndata: [{\"book_id\":1,\"book_intro\":[0.1,0.11],\"word_count\":1000},{\"book_id\":2,\"book_intro\":[0.2,0.22],\"word_count\":2000},{\"book_id\":3,\"book_intro\":[0.3,0.33],\"word_count\":3000}]\n\n" +
+			"event: end\ndata: {\"code\":200}\n\n"
+		assert.Equal(t, expected, w.Body.String())
+	})
+
+	t.Run("fail before sending", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{
+			MockProxy: mocks.NewMockProxy(t),
+			err:       ErrDefault,
+		}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genStreamRequest(""))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, PrintErr(ErrDefault), w.Body.String())
+	})
+
+	t.Run("fail after sending", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{
+			MockProxy: mocks.NewMockProxy(t),
+			batches:   []*milvuspb.QueryResults{batch},
+			err:       ErrDefault,
+		}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genStreamRequest(""))
+		assert.Equal(t, http.StatusOK, w.Code)
+		errLine := fmt.Sprintf("{\"error\":%s}\n", PrintErr(ErrDefault))
+		assert.Equal(t, rows+errLine, w.Body.String())
+	})
+
+	t.Run("fail after sending sse", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockQueryStreamProxy{
+			MockProxy: mocks.NewMockProxy(t),
+			batches:   []*milvuspb.QueryResults{batch},
+			err:       ErrDefault,
+		}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genStreamRequest(HTTPContentTypeSSE))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, strings.HasSuffix(w.Body.String(), fmt.Sprintf("event: error\ndata: {\"error\":%s}\n\n", PrintErr(ErrDefault))))
+	})
+}
+
+type mockQueryIteratorProxy struct {
+	*mocks.MockProxy
+	requests []*milvuspb.QueryRequest
+	result   *milvuspb.QueryResults
+	token    string
+	err      error
+}
+
+func (p *mockQueryIteratorProxy) QueryIterator(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error) {
+	p.requests = append(p.requests, request)
+	return p.result, p.token, p.err
+}
+
+func TestQueryIterator(t *testing.T) {
+	paramtable.Init()
+	genIteratorRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, versional(VectorQueryIteratorPath), bytes.NewReader([]byte(body)))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		req.Header.Set(HTTPHeaderAllowInt64, "true")
+		return req
+	}
+	batch := &milvuspb.QueryResults{
+		Status:         &StatusSuccess,
+		FieldsData:     generateFieldData(),
+		CollectionName: DefaultCollectionName,
+		OutputFields:   []string{FieldBookID, FieldWordCount, FieldBookIntro},
+	}
+
+	t.Run("open and resume", func(t *testing.T) {
+		mp := &mockQueryIteratorProxy{MockProxy: mocks.NewMockProxy(t), result: batch, token: "next"}
+		testEngine := initHTTPServer(mp, true)
+
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`", "filter": "book_id > 0", "limit": 3}`))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "{\"code\":200,\"data\":[{\"book_id\":1,\"book_intro\":[0.1,0.11],\"word_count\":1000},{\"book_id\":2,\"book_intro\":[0.2,0.22],\"word_count\":2000},{\"book_id\":3,\"book_intro\":[0.3,0.33],\"word_count\":3000}],\"iteratorToken\":\"next\"}", w.Body.String())
+
+		mp.token = ""
+		w = httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`", "iteratorToken": "next"}`))
+		assert.Equal(t, http.StatusOK, w.Code)
+		resp := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "", resp[HTTPReturnIteratorToken])
+
+		assert.Equal(t, 2, len(mp.requests))
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: ParamLimit, Value: "3"},
+			{Key: ParamIterator, Value: "true"},
+		}, mp.requests[0].GetQueryParams())
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: ParamIteratorToken, Value: "next"},
+		}, mp.requests[1].GetQueryParams())
+	})
+
+	t.Run("query fail", func(t *testing.T) {
+		mp := &mockQueryIteratorProxy{MockProxy: mocks.NewMockProxy(t), err: ErrDefault}
+		testEngine := initHTTPServer(mp, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`"}`))
+		assert.Equal(t, PrintErr(ErrDefault), w.Body.String())
+	})
+}
+
+type mockSearchIteratorProxy struct {
+	*mocks.MockProxy
+	requests []*milvuspb.SearchRequest
+	result   *milvuspb.SearchResults
+	token    string
+	err      error
+}
+
+func (p *mockSearchIteratorProxy) SearchIterator(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error) {
+	p.requests = append(p.requests, request)
+	return p.result, p.token, p.err
+}
+
+func TestSearchIterator(t *testing.T) {
+	paramtable.Init()
+	genIteratorRequest := func(body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, versional(VectorSearchIteratorPath), bytes.NewReader([]byte(body)))
+		req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+		req.Header.Set(HTTPHeaderAllowInt64, "true")
+		return req
+	}
+	result := &milvuspb.SearchResults{
+		Status: &StatusSuccess,
+		Results: &schemapb.SearchResultData{
+			FieldsData: generateFieldData(),
+			Scores:     []float32{0.01, 0.04, 0.09},
+			TopK:       3,
+		},
+	}
+
+	t.Run("missing vector", func(t *testing.T) {
+		testEngine := initHTTPServer(&mockSearchIteratorProxy{MockProxy: mocks.NewMockProxy(t)}, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`"}`))
+		assert.True(t, CheckErrCode(w.Body.String(), merr.ErrMissingRequiredParameters))
+	})
+
+	t.Run("open and resume", func(t *testing.T) {
+		mp := &mockSearchIteratorProxy{MockProxy: mocks.NewMockProxy(t), result: result, token: "next"}
+		testEngine := initHTTPServer(mp, true)
+
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`", "vector": [0.1, 0.2], "limit": 3, "radius": 1.5}`))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "{\"code\":200,\"data\":[{\"book_id\":1,\"book_intro\":[0.1,0.11],\"distance\":0.01,\"word_count\":1000},{\"book_id\":2,\"book_intro\":[0.2,0.22],\"distance\":0.04,\"word_count\":2000},{\"book_id\":3,\"book_intro\":[0.3,0.33],\"distance\":0.09,\"word_count\":3000}],\"iteratorToken\":\"next\"}", w.Body.String())
+
+		mp.token = ""
+		mp.result = &milvuspb.SearchResults{Status: &StatusSuccess, Results: &schemapb.SearchResultData{}}
+		w = httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`", "iteratorToken": "next"}`))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "{\"code\":200,\"data\":[],\"iteratorToken\":\"\"}", w.Body.String())
+
+		assert.Equal(t, 2, len(mp.requests))
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: ParamIterator, Value: "true"},
+			{Key: Params, Value: `{"level":2,"radius":1.5}`},
+			{Key: common.MetricTypeKey, Value: DefaultMetricType},
+			{Key: ParamRoundDecimal, Value: "-1"},
+			{Key: common.TopKKey, Value: "3"},
+		}, mp.requests[0].GetSearchParams())
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: ParamIteratorToken, Value: "next"},
+		}, mp.requests[1].GetSearchParams())
+	})
+
+	t.Run("search fail", func(t *testing.T) {
+		mp := &mockSearchIteratorProxy{MockProxy: mocks.NewMockProxy(t), err: ErrDefault}
+		testEngine := initHTTPServer(mp, true)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, genIteratorRequest(`{"collectionName": "`+DefaultCollectionName+`", "vector": [0.1, 0.2]}`))
+		assert.Equal(t, PrintErr(ErrDefault), w.Body.String())
+	})
+}
+
 func TestDelete(t *testing.T) {
 	paramtable.Init()
 	testCases := []testCase{}
@@ -1563,6 +1813,122 @@ func TestAuthorization(t *testing.T) {
 			})
 		}
 	}
+
+	adminRoutes := []struct {
+		httpMethod string
+		path       string
+	}{
+		{http.MethodPost, CollectionFieldPath},
+		{http.MethodPost, RolePath},
+		{http.MethodPost, RoleUserPath},
+		{http.MethodPost, PrivilegePath},
+		{http.MethodPost, RowPolicyPath},
+		{http.MethodPost, DatabasePath},
+		{http.MethodDelete, DatabasePath},
+		{http.MethodPost, ResourceGroupPath},
+		{http.MethodPost, ResourceGroupNodeTransferPath},
+	}
+	for _, route := range adminRoutes {
+		t.Run("proxy is not ready "+route.httpMethod+" "+route.path, func(t *testing.T) {
+			testEngine := initHTTPServer(&mockProxyComponent{}, true)
+			req := httptest.NewRequest(route.httpMethod, versional(route.path), bytes.NewReader([]byte(`{}`)))
+			req.SetBasicAuth("test", "test")
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		})
+	}
+}
+
+func TestAdminRoutes(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "false")
+	defer paramtable.Get().Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
+
+	testCases := []struct {
+		httpMethod   string
+		path         string
+		expectedBody interface{}
+	}{
+		{http.MethodPost, CollectionFieldPath, testStatus},
+		{http.MethodDelete, CollectionFieldPath, testStatus},
+		{http.MethodPost, RolePath, testStatus},
+		{http.MethodDelete, RolePath, testStatus},
+		{http.MethodGet, RolePath, &milvuspb.SelectRoleResponse{Status: testStatus}},
+		{http.MethodPost, RoleUserPath, testStatus},
+		{http.MethodGet, UserPath, &milvuspb.SelectUserResponse{Status: testStatus}},
+		{http.MethodPost, PrivilegePath, testStatus},
+		{http.MethodGet, PrivilegePath, &milvuspb.SelectGrantResponse{Status: testStatus}},
+		{http.MethodPost, RowPolicyPath, testStatus},
+		{http.MethodDelete, RowPolicyPath, testStatus},
+		{http.MethodGet, RowPoliciesPath, &milvuspb.ListRowPoliciesResponse{Status: testStatus}},
+		{http.MethodPost, DatabasePath, testStatus},
+		{http.MethodDelete, DatabasePath, testStatus},
+		{http.MethodGet, DatabasesPath, &milvuspb.ListDatabasesResponse{Status: testStatus}},
+		{http.MethodPost, ResourceGroupPath, testStatus},
+		{http.MethodDelete, ResourceGroupPath, testStatus},
+		{http.MethodGet, ResourceGroupPath, &milvuspb.DescribeResourceGroupResponse{Status: testStatus}},
+		{http.MethodGet, ResourceGroupsPath, &milvuspb.ListResourceGroupsResponse{Status: testStatus}},
+		{http.MethodPost, ResourceGroupNodeTransferPath, testStatus},
+		{http.MethodPost, ResourceGroupReplicaTransferPath, testStatus},
+	}
+	testEngine := initHTTPServer(&mockProxyComponent{}, true)
+	for _, tt := range testCases {
+		t.Run(tt.httpMethod+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.httpMethod, versional(tt.path), nil)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+			req = httptest.NewRequest(tt.httpMethod, versional(tt.path), nil)
+			req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+			w = httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			bodyBytes, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err)
+			assert.Equal(t, bodyBytes, w.Body.Bytes())
+		})
+	}
+
+	searchRoutes := []struct {
+		path         string
+		body         interface{}
+		expectedBody interface{}
+	}{
+		{HybridSearchPath, WrappedHybridSearchRequest{CollectionName: "test", Requests: []*SearchRequest{{Dsl: "some dsl"}}}, &searchResult},
+		{ExplainPath, types.ExplainRequest{CollectionName: "test", Expr: "some expr"}, &explainResult},
+	}
+	for _, tt := range searchRoutes {
+		t.Run(tt.path, func(t *testing.T) {
+			body, err := json.Marshal(tt.body)
+			assert.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, versional(tt.path), bytes.NewReader(body))
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+			req = httptest.NewRequest(http.MethodPost, versional(tt.path), bytes.NewReader(body))
+			req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+			w = httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			bodyBytes, err := json.Marshal(tt.expectedBody)
+			assert.NoError(t, err)
+			assert.Equal(t, bodyBytes, w.Body.Bytes())
+		})
+	}
+
+	t.Run("not served on the legacy api", func(t *testing.T) {
+		testEngine := gin.New()
+		NewHandlers(&mockProxyComponent{}).RegisterRoutesTo(testEngine)
+		for _, path := range []string{RolePath, RowPolicyPath, HybridSearchPath, ExplainPath} {
+			req := httptest.NewRequest(http.MethodPost, path, nil)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		}
+	})
 }
 
 func TestDatabaseNotFound(t *testing.T) {
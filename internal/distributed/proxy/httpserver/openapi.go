@@ -0,0 +1,426 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// OpenAPIPath is the path the OpenAPI document served at, relative to the RESTful router
+const OpenAPIPath = "/openapi.json"
+
+// routeKey identifies a route by http method and path relative to its router group
+type routeKey struct {
+	method string
+	path   string
+}
+
+// routeSpec describes a route in the OpenAPI document,
+// request and response are the (pointer of) body structures, nil means no body.
+// method is the name of grpc method the route is audited as, it's derived from the request by default, see auditMethod
+type routeSpec struct {
+	summary  string
+	method   string
+	query    []string
+	request  interface{}
+	response interface{}
+}
+
+// restfulRouteSpecs describes the routes registered by RegisterRoutesTo,
+// every route must have an entry here, see TestOpenAPIDocument
+var restfulRouteSpecs = map[routeKey]routeSpec{
+	{http.MethodGet, "/health"}:                   {summary: "Health"},
+	{http.MethodPost, "/dummy"}:                   {summary: "Dummy", request: &milvuspb.DummyRequest{}, response: &milvuspb.DummyResponse{}},
+	{http.MethodPost, "/collection"}:              {summary: "Create collection", request: &WrappedCreateCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/collection"}:            {summary: "Drop collection", request: &milvuspb.DropCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/collection/existence"}:     {summary: "Has collection", request: &milvuspb.HasCollectionRequest{}, response: &milvuspb.BoolResponse{}},
+	{http.MethodGet, "/collection"}:               {summary: "Describe collection", request: &milvuspb.DescribeCollectionRequest{}, response: &milvuspb.DescribeCollectionResponse{}},
+	{http.MethodPost, "/collection/load"}:         {summary: "Load collection", request: &milvuspb.LoadCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/collection/load"}:       {summary: "Release collection", request: &milvuspb.ReleaseCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/collection/statistics"}:    {summary: "Get collection statistics", request: &milvuspb.GetCollectionStatisticsRequest{}, response: &milvuspb.GetCollectionStatisticsResponse{}},
+	{http.MethodGet, "/collections"}:              {summary: "Show collections", request: &milvuspb.ShowCollectionsRequest{}, response: &milvuspb.ShowCollectionsResponse{}},
+	{http.MethodPatch, "/collection"}:             {summary: "Alter collection", request: &milvuspb.AlterCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodPost, "/collection/rename"}:       {summary: "Rename collection", request: &milvuspb.RenameCollectionRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/collection/load/progress"}: {summary: "Get loading progress", request: &milvuspb.GetLoadingProgressRequest{}, response: &milvuspb.GetLoadingProgressResponse{}},
+	{http.MethodGet, "/collection/load/state"}:    {summary: "Get load state", request: &milvuspb.GetLoadStateRequest{}, response: &milvuspb.GetLoadStateResponse{}},
+	{http.MethodPost, "/partition"}:               {summary: "Create partition", request: &milvuspb.CreatePartitionRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/partition"}:             {summary: "Drop partition", request: &milvuspb.DropPartitionRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/partition/existence"}:      {summary: "Has partition", request: &milvuspb.HasPartitionRequest{}, response: &milvuspb.BoolResponse{}},
+	{http.MethodPost, "/partitions/load"}:         {summary: "Load partitions", request: &milvuspb.LoadPartitionsRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/partitions/load"}:       {summary: "Release partitions", request: &milvuspb.ReleasePartitionsRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/partition/statistics"}:     {summary: "Get partition statistics", request: &milvuspb.GetPartitionStatisticsRequest{}, response: &milvuspb.GetPartitionStatisticsResponse{}},
+	{http.MethodGet, "/partitions"}:               {summary: "Show partitions", request: &milvuspb.ShowPartitionsRequest{}, response: &milvuspb.ShowPartitionsResponse{}},
+	{http.MethodPost, "/alias"}:                   {summary: "Create alias", request: &milvuspb.CreateAliasRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/alias"}:                 {summary: "Drop alias", request: &milvuspb.DropAliasRequest{}, response: &commonpb.Status{}},
+	{http.MethodPatch, "/alias"}:                  {summary: "Alter alias", request: &milvuspb.AlterAliasRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/alias"}:                    {summary: "Describe alias", request: &milvuspb.DescribeAliasRequest{}, response: &milvuspb.DescribeAliasResponse{}},
+	{http.MethodGet, "/aliases"}:                  {summary: "List aliases", request: &milvuspb.ListAliasesRequest{}, response: &milvuspb.ListAliasesResponse{}},
+	{http.MethodPost, "/index"}:                   {summary: "Create index", request: &milvuspb.CreateIndexRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/index"}:                    {summary: "Describe index", request: &milvuspb.DescribeIndexRequest{}, response: &milvuspb.DescribeIndexResponse{}},
+	{http.MethodGet, "/index/state"}:              {summary: "Get index state", request: &milvuspb.GetIndexStateRequest{}, response: &milvuspb.GetIndexStateResponse{}},
+	{http.MethodGet, "/index/progress"}:           {summary: "Get index build progress", request: &milvuspb.GetIndexBuildProgressRequest{}, response: &milvuspb.GetIndexBuildProgressResponse{}},
+	{http.MethodGet, "/index/statistics"}:         {summary: "Get index statistics", request: &milvuspb.GetIndexStatisticsRequest{}, response: &milvuspb.GetIndexStatisticsResponse{}},
+	{http.MethodDelete, "/index"}:                 {summary: "Drop index", request: &milvuspb.DropIndexRequest{}, response: &commonpb.Status{}},
+	{http.MethodPost, "/entities"}:                {summary: "Insert", request: &WrappedInsertRequest{}, response: &milvuspb.MutationResult{}},
+	{http.MethodPut, "/entities"}:                 {summary: "Upsert", request: &WrappedUpsertRequest{}, response: &milvuspb.MutationResult{}},
+	{http.MethodDelete, "/entities"}:              {summary: "Delete", request: &milvuspb.DeleteRequest{}, response: &milvuspb.MutationResult{}},
+	{http.MethodPost, "/entities/get"}:            {summary: "Get entities by primary keys", request: &WrappedGetRequest{}, response: &milvuspb.QueryResults{}},
+	{http.MethodPost, "/search"}:                  {summary: "Search", request: &SearchRequest{}, response: &milvuspb.SearchResults{}},
+	{http.MethodPost, "/query"}:                   {summary: "Query", request: &milvuspb.QueryRequest{}, response: &milvuspb.QueryResults{}},
+	{http.MethodPost, "/persist"}:                 {summary: "Flush", request: &milvuspb.FlushRequest{}, response: &milvuspb.FlushResponse{}},
+	{http.MethodPost, "/persist/all"}:             {summary: "Flush all", request: &milvuspb.FlushAllRequest{}, response: &milvuspb.FlushAllResponse{}},
+	{http.MethodGet, "/persist/all/state"}:        {summary: "Get flush all state", request: &milvuspb.GetFlushAllStateRequest{}, response: &milvuspb.GetFlushAllStateResponse{}},
+	{http.MethodGet, "/distance"}:                 {summary: "Calc distance", request: &WrappedCalcDistanceRequest{}, response: &milvuspb.CalcDistanceResults{}},
+	{http.MethodGet, "/persist/state"}:            {summary: "Get flush state", request: &milvuspb.GetFlushStateRequest{}, response: &milvuspb.GetFlushStateResponse{}},
+	{http.MethodGet, "/persist/segment-info"}:     {summary: "Get persistent segment info", request: &milvuspb.GetPersistentSegmentInfoRequest{}, response: &milvuspb.GetPersistentSegmentInfoResponse{}},
+	{http.MethodGet, "/query-segment-info"}:       {summary: "Get query segment info", request: &milvuspb.GetQuerySegmentInfoRequest{}, response: &milvuspb.GetQuerySegmentInfoResponse{}},
+	{http.MethodGet, "/replicas"}:                 {summary: "Get replicas", request: &milvuspb.GetReplicasRequest{}, response: &milvuspb.GetReplicasResponse{}},
+	{http.MethodGet, "/metrics"}:                  {summary: "Get metrics", request: &milvuspb.GetMetricsRequest{}, response: &milvuspb.GetMetricsResponse{}},
+	{http.MethodPost, "/load-balance"}:            {summary: "Load balance", request: &milvuspb.LoadBalanceRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/compaction/state"}:         {summary: "Get compaction state", request: &milvuspb.GetCompactionStateRequest{}, response: &milvuspb.GetCompactionStateResponse{}},
+	{http.MethodGet, "/compaction/plans"}:         {summary: "Get compaction state with plans", request: &milvuspb.GetCompactionPlansRequest{}, response: &milvuspb.GetCompactionPlansResponse{}},
+	{http.MethodPost, "/compaction"}:              {summary: "Manual compaction", request: &milvuspb.ManualCompactionRequest{}, response: &milvuspb.ManualCompactionResponse{}},
+	{http.MethodPost, "/import"}:                  {summary: "Import", request: &milvuspb.ImportRequest{}, response: &milvuspb.ImportResponse{}},
+	{http.MethodGet, "/import/state"}:             {summary: "Get import state", request: &milvuspb.GetImportStateRequest{}, response: &milvuspb.GetImportStateResponse{}},
+	{http.MethodGet, "/import/tasks"}:             {summary: "List import tasks", request: &milvuspb.ListImportTasksRequest{}, response: &milvuspb.ListImportTasksResponse{}},
+	{http.MethodPost, "/credential"}:              {summary: "Create credential", request: &milvuspb.CreateCredentialRequest{}, response: &commonpb.Status{}},
+	{http.MethodPatch, "/credential"}:             {summary: "Update credential", request: &milvuspb.UpdateCredentialRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, "/credential"}:            {summary: "Delete credential", request: &milvuspb.DeleteCredentialRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, "/credential/users"}:         {summary: "List cred users", request: &milvuspb.ListCredUsersRequest{}, response: &milvuspb.ListCredUsersResponse{}},
+	{http.MethodGet, "/version"}:                  {summary: "Get version", request: &milvuspb.GetVersionRequest{}, response: &milvuspb.GetVersionResponse{}},
+	{http.MethodGet, "/health/check"}:             {summary: "Check health", request: &milvuspb.CheckHealthRequest{}, response: &milvuspb.CheckHealthResponse{}},
+	{http.MethodGet, "/component-states"}:         {summary: "Get component states", request: &milvuspb.GetComponentStatesRequest{}, response: &milvuspb.ComponentStates{}},
+	{http.MethodGet, "/timestamp"}:                {summary: "Alloc timestamp", request: &milvuspb.AllocTimestampRequest{}, response: &milvuspb.AllocTimestampResponse{}},
+}
+
+// vectorRouteSpecs describes the routes registered by RegisterRoutesToV1,
+// the responses are wrapped as {"code": xx, "message": xx, "data": xx}
+var vectorRouteSpecs = map[routeKey]routeSpec{
+	{http.MethodGet, VectorCollectionsPath}:         {summary: "List collections", method: "ShowCollections", query: []string{HTTPDbName}},
+	{http.MethodPost, VectorCollectionsCreatePath}:  {summary: "Create collection", request: &CreateCollectionReq{}},
+	{http.MethodGet, VectorCollectionsDescribePath}: {summary: "Describe collection", method: "DescribeCollection", query: []string{HTTPDbName, HTTPCollectionName}},
+	{http.MethodPost, VectorCollectionsDropPath}:    {summary: "Drop collection", request: &DropCollectionReq{}},
+	{http.MethodPost, VectorQueryPath}:              {summary: "Query", request: &QueryReq{}},
+	{http.MethodPost, VectorQueryIteratorPath}:      {summary: "Query page by page with iterator token", request: &QueryIteratorReq{}},
+	{http.MethodPost, VectorQueryStreamPath}:        {summary: "Query and stream results as NDJSON rows or server-sent events, an error after streaming started is sent as an object whose only key is error", request: &QueryReq{}},
+	{http.MethodPost, VectorGetPath}:                {summary: "Get entities by primary keys", request: &GetReq{}},
+	{http.MethodPost, VectorDeletePath}:             {summary: "Delete", request: &DeleteReq{}},
+	{http.MethodPost, VectorInsertPath}:             {summary: "Insert", request: &InsertReq{}},
+	{http.MethodPost, VectorUpsertPath}:             {summary: "Upsert", request: &UpsertReq{}},
+	{http.MethodPost, VectorSearchPath}:             {summary: "Search", request: &SearchReq{}},
+	{http.MethodPost, VectorSearchIteratorPath}:     {summary: "Search page by page with iterator token", request: &SearchIteratorReq{}},
+	{http.MethodGet, OpenAPIPath}:                   {summary: "OpenAPI document"},
+}
+
+// adminRouteSpecs describes the hybrid search, explain, schema, RBAC, database and resource group routes registered by RegisterRoutesToV1,
+// they are served behind authentication and respond the proxy responses as RegisterRoutesTo does
+var adminRouteSpecs = map[routeKey]routeSpec{
+	{http.MethodPost, HybridSearchPath}:                 {summary: "Hybrid search over multiple vector fields", request: &WrappedHybridSearchRequest{}, response: &milvuspb.SearchResults{}},
+	{http.MethodPost, ExplainPath}:                      {summary: "Explain the plan of filter expression without executing it", request: &types.ExplainRequest{}, response: &types.ExplainResponse{}},
+	{http.MethodPost, CollectionFieldPath}:              {summary: "Add collection field", request: &WrappedAddCollectionFieldRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, CollectionFieldPath}:            {summary: "Drop collection field", request: &rootcoordpb.DropCollectionFieldRequest{}, response: &commonpb.Status{}},
+	{http.MethodPost, RolePath}:                         {summary: "Create role", request: &milvuspb.CreateRoleRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, RolePath}:                       {summary: "Drop role", request: &milvuspb.DropRoleRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, RolePath}:                          {summary: "Select role", request: &milvuspb.SelectRoleRequest{}, response: &milvuspb.SelectRoleResponse{}},
+	{http.MethodPost, RoleUserPath}:                     {summary: "Operate user role", request: &milvuspb.OperateUserRoleRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, UserPath}:                          {summary: "Select user", request: &milvuspb.SelectUserRequest{}, response: &milvuspb.SelectUserResponse{}},
+	{http.MethodPost, PrivilegePath}:                    {summary: "Operate privilege", request: &milvuspb.OperatePrivilegeRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, PrivilegePath}:                     {summary: "Select grant", request: &milvuspb.SelectGrantRequest{}, response: &milvuspb.SelectGrantResponse{}},
+	{http.MethodPost, RowPolicyPath}:                    {summary: "Create row policy", request: &milvuspb.CreateRowPolicyRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, RowPolicyPath}:                  {summary: "Drop row policy", request: &milvuspb.DropRowPolicyRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, RowPoliciesPath}:                   {summary: "List row policies", request: &milvuspb.ListRowPoliciesRequest{}, response: &milvuspb.ListRowPoliciesResponse{}},
+	{http.MethodPost, DatabasePath}:                     {summary: "Create database", request: &milvuspb.CreateDatabaseRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, DatabasePath}:                   {summary: "Drop database", request: &milvuspb.DropDatabaseRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, DatabasesPath}:                     {summary: "List databases", request: &milvuspb.ListDatabasesRequest{}, response: &milvuspb.ListDatabasesResponse{}},
+	{http.MethodPost, ResourceGroupPath}:                {summary: "Create resource group", request: &milvuspb.CreateResourceGroupRequest{}, response: &commonpb.Status{}},
+	{http.MethodDelete, ResourceGroupPath}:              {summary: "Drop resource group", request: &milvuspb.DropResourceGroupRequest{}, response: &commonpb.Status{}},
+	{http.MethodGet, ResourceGroupPath}:                 {summary: "Describe resource group", request: &milvuspb.DescribeResourceGroupRequest{}, response: &milvuspb.DescribeResourceGroupResponse{}},
+	{http.MethodGet, ResourceGroupsPath}:                {summary: "List resource groups", request: &milvuspb.ListResourceGroupsRequest{}, response: &milvuspb.ListResourceGroupsResponse{}},
+	{http.MethodPost, ResourceGroupNodeTransferPath}:    {summary: "Transfer node", request: &milvuspb.TransferNodeRequest{}, response: &commonpb.Status{}},
+	{http.MethodPost, ResourceGroupReplicaTransferPath}: {summary: "Transfer replica", request: &milvuspb.TransferReplicaRequest{}, response: &commonpb.Status{}},
+}
+
+// OpenAPIDocument is the OpenAPI 3 document of RESTful API
+type OpenAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       OpenAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*OpenAPIOperation `json:"paths"`
+	Components OpenAPIComponents                       `json:"components"`
+}
+
+// OpenAPIInfo is the metadata of OpenAPI document
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIComponents holds the reusable schemas referred by operations
+type OpenAPIComponents struct {
+	Schemas map[string]*OpenAPISchema `json:"schemas"`
+}
+
+// OpenAPIOperation describes a single API operation on a path
+type OpenAPIOperation struct {
+	Summary     string                      `json:"summary,omitempty"`
+	OperationID string                      `json:"operationId"`
+	Parameters  []*OpenAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter describes a query parameter
+type OpenAPIParameter struct {
+	Name   string         `json:"name"`
+	In     string         `json:"in"`
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIRequestBody describes a request body
+type OpenAPIRequestBody struct {
+	Content map[string]*OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse describes a response
+type OpenAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*OpenAPIMediaType `json:"content,omitempty"`
+}
+
+// OpenAPIMediaType describes the schema of a media type
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema is the subset of JSON schema used by OpenAPI document
+type OpenAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Items                *OpenAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenAPISchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+}
+
+// GenerateOpenAPIDocument generates the OpenAPI document from the routes registered by RegisterRoutesTo and RegisterRoutesToV1,
+// apiPrefix and vectorPrefix are the path prefixes of router groups they registered to.
+// An error is returned if any registered route has no spec.
+func (h *Handlers) GenerateOpenAPIDocument(apiPrefix, vectorPrefix string) (*OpenAPIDocument, error) {
+	engine := gin.New()
+	h.RegisterRoutesTo(engine.Group(apiPrefix))
+	h.RegisterRoutesToV1(engine.Group(vectorPrefix))
+	engine.GET(vectorPrefix+OpenAPIPath, func(c *gin.Context) {})
+
+	gen := &openAPIGenerator{
+		schemas: make(map[string]*OpenAPISchema),
+	}
+	doc := &OpenAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: OpenAPIInfo{
+			Title:   "Milvus RESTful API",
+			Version: "v1",
+		},
+		Paths: make(map[string]map[string]*OpenAPIOperation),
+	}
+	for _, route := range engine.Routes() {
+		var spec routeSpec
+		var ok, wrapped bool
+		switch {
+		case strings.HasPrefix(route.Path, vectorPrefix+"/"):
+			key := routeKey{route.Method, strings.TrimPrefix(route.Path, vectorPrefix)}
+			if spec, ok = vectorRouteSpecs[key]; ok {
+				wrapped = true
+			} else {
+				spec, ok = adminRouteSpecs[key]
+			}
+		case strings.HasPrefix(route.Path, apiPrefix+"/"):
+			spec, ok = restfulRouteSpecs[routeKey{route.Method, strings.TrimPrefix(route.Path, apiPrefix)}]
+		}
+		if !ok {
+			return nil, errors.Newf("no OpenAPI spec for route %s %s", route.Method, route.Path)
+		}
+		if doc.Paths[route.Path] == nil {
+			doc.Paths[route.Path] = make(map[string]*OpenAPIOperation)
+		}
+		doc.Paths[route.Path][strings.ToLower(route.Method)] = gen.operation(route, spec, wrapped)
+	}
+	doc.Components.Schemas = gen.schemas
+	return doc, nil
+}
+
+// OpenAPIHandler serves the OpenAPI document, the document is generated once on the first request
+func (h *Handlers) OpenAPIHandler(apiPrefix, vectorPrefix string) gin.HandlerFunc {
+	var once sync.Once
+	var doc *OpenAPIDocument
+	var err error
+	return func(c *gin.Context) {
+		once.Do(func() {
+			doc, err = h.GenerateOpenAPIDocument(apiPrefix, vectorPrefix)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{HTTPReturnCode: http.StatusInternalServerError, HTTPReturnMessage: err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, doc)
+	}
+}
+
+type openAPIGenerator struct {
+	schemas map[string]*OpenAPISchema
+}
+
+func (g *openAPIGenerator) operation(route gin.RouteInfo, spec routeSpec, wrapped bool) *OpenAPIOperation {
+	op := &OpenAPIOperation{
+		Summary:     spec.summary,
+		OperationID: operationID(route.Method, route.Path),
+		Responses:   make(map[string]*OpenAPIResponse),
+	}
+	for _, name := range spec.query {
+		op.Parameters = append(op.Parameters, &OpenAPIParameter{
+			Name:   name,
+			In:     "query",
+			Schema: &OpenAPISchema{Type: "string"},
+		})
+	}
+	if spec.request != nil {
+		op.RequestBody = &OpenAPIRequestBody{
+			Content: map[string]*OpenAPIMediaType{
+				"application/json": {Schema: g.schemaOf(reflect.TypeOf(spec.request))},
+			},
+		}
+	}
+	var respSchema *OpenAPISchema
+	if spec.response != nil {
+		respSchema = g.schemaOf(reflect.TypeOf(spec.response))
+	} else {
+		respSchema = &OpenAPISchema{Type: "object"}
+	}
+	if wrapped {
+		respSchema = &OpenAPISchema{
+			Type: "object",
+			Properties: map[string]*OpenAPISchema{
+				HTTPReturnCode:    {Type: "integer", Format: "int32"},
+				HTTPReturnMessage: {Type: "string"},
+				HTTPReturnData:    {},
+			},
+		}
+	}
+	op.Responses["200"] = &OpenAPIResponse{
+		Description: "OK",
+		Content: map[string]*OpenAPIMediaType{
+			"application/json": {Schema: respSchema},
+		},
+	}
+	if !wrapped {
+		op.Responses["default"] = &OpenAPIResponse{
+			Description: "Error",
+			Content: map[string]*OpenAPIMediaType{
+				"application/json": {Schema: g.schemaOf(reflect.TypeOf(&commonpb.Status{}))},
+			},
+		}
+	}
+	return op
+}
+
+// schemaOf converts go type to OpenAPI schema following encoding/json rules,
+// named structs are put into components and referred by $ref
+func (g *openAPIGenerator) schemaOf(t reflect.Type) *OpenAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &OpenAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &OpenAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &OpenAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes []byte as base64 string, json.RawMessage is kept as is
+			if t.Name() == "RawMessage" {
+				return &OpenAPISchema{}
+			}
+			return &OpenAPISchema{Type: "string", Format: "byte"}
+		}
+		return &OpenAPISchema{Type: "array", Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &OpenAPISchema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			// placeholder to stop recursion of self-referencing messages
+			g.schemas[name] = &OpenAPISchema{Type: "object"}
+			g.schemas[name] = g.structSchema(t)
+		}
+		return &OpenAPISchema{Ref: "#/components/schemas/" + name}
+	default:
+		// interface{} and proto oneof wrappers accept any value
+		return &OpenAPISchema{}
+	}
+}
+
+func (g *openAPIGenerator) structSchema(t reflect.Type) *OpenAPISchema {
+	schema := &OpenAPISchema{
+		Type:       "object",
+		Properties: make(map[string]*OpenAPISchema),
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+				name = tagName
+			}
+		}
+		schema.Properties[name] = g.schemaOf(field.Type)
+		if strings.Contains(field.Tag.Get("validate"), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	sort.Strings(schema.Required)
+	return schema
+}
+
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if idx := strings.LastIndex(pkg, "/"); idx >= 0 {
+		pkg = pkg[idx+1:]
+	}
+	return fmt.Sprintf("%s.%s", pkg, t.Name())
+}
+
+func operationID(method, path string) string {
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(method))
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == '-' || r == '.' }) {
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	h := NewHandlers(&mockProxyComponent{})
+
+	t.Run("all routes have spec", func(t *testing.T) {
+		doc, err := h.GenerateOpenAPIDocument("/api/v1", "/v1")
+		assert.NoError(t, err)
+		operations := 0
+		for _, ops := range doc.Paths {
+			operations += len(ops)
+		}
+		assert.Equal(t, len(restfulRouteSpecs)+len(vectorRouteSpecs)+len(adminRouteSpecs), operations)
+
+		insert := doc.Paths["/api/v1/entities"]["post"]
+		assert.NotNil(t, insert)
+		assert.Equal(t, "#/components/schemas/httpserver.WrappedInsertRequest", insert.RequestBody.Content["application/json"].Schema.Ref)
+		assert.Contains(t, doc.Components.Schemas, "httpserver.FieldData")
+		assert.Contains(t, doc.Components.Schemas, "milvuspb.MutationResult")
+
+		query := doc.Paths["/v1"+VectorQueryPath]["post"]
+		assert.NotNil(t, query)
+		queryReq := doc.Components.Schemas["httpserver.QueryReq"]
+		assert.ElementsMatch(t, []string{"collectionName", "filter"}, queryReq.Required)
+
+		assert.Nil(t, doc.Paths["/api/v1"+RolePath])
+		createRole := doc.Paths["/v1"+RolePath]["post"]
+		assert.NotNil(t, createRole)
+		assert.Equal(t, "#/components/schemas/milvuspb.CreateRoleRequest", createRole.RequestBody.Content["application/json"].Schema.Ref)
+
+		_, err = json.Marshal(doc)
+		assert.NoError(t, err)
+	})
+
+	t.Run("route without spec", func(t *testing.T) {
+		key := routeKey{http.MethodPost, VectorSearchPath}
+		spec := vectorRouteSpecs[key]
+		delete(vectorRouteSpecs, key)
+		defer func() { vectorRouteSpecs[key] = spec }()
+
+		_, err := h.GenerateOpenAPIDocument("/api/v1", "/v1")
+		assert.Error(t, err)
+	})
+
+	t.Run("serve document", func(t *testing.T) {
+		testEngine := gin.New()
+		testEngine.GET("/v1"+OpenAPIPath, h.OpenAPIHandler("/api/v1", "/v1"))
+		req := httptest.NewRequest(http.MethodGet, "/v1"+OpenAPIPath, nil)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		doc := OpenAPIDocument{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		assert.Equal(t, "3.0.3", doc.OpenAPI)
+	})
+}
@@ -18,15 +18,27 @@ type DropCollectionReq struct {
 type QueryReq struct {
 	DbName         string   `json:"dbName"`
 	CollectionName string   `json:"collectionName" validate:"required"`
+	PartitionNames []string `json:"partitionNames"`
 	OutputFields   []string `json:"outputFields"`
 	Filter         string   `json:"filter" validate:"required"`
 	Limit          int32    `json:"limit"`
 	Offset         int32    `json:"offset"`
 }
 
+type QueryIteratorReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	PartitionNames []string `json:"partitionNames"`
+	OutputFields   []string `json:"outputFields"`
+	Filter         string   `json:"filter"`
+	Limit          int32    `json:"limit"`
+	IteratorToken  string   `json:"iteratorToken"`
+}
+
 type GetReq struct {
 	DbName         string      `json:"dbName"`
 	CollectionName string      `json:"collectionName" validate:"required"`
+	PartitionNames []string    `json:"partitionNames"`
 	OutputFields   []string    `json:"outputFields"`
 	ID             interface{} `json:"id" validate:"required"`
 }
@@ -34,6 +46,7 @@ type GetReq struct {
 type DeleteReq struct {
 	DbName         string      `json:"dbName"`
 	CollectionName string      `json:"collectionName" validate:"required"`
+	PartitionName  string      `json:"partitionName"`
 	ID             interface{} `json:"id"`
 	Filter         string      `json:"filter"`
 }
@@ -41,30 +54,49 @@ type DeleteReq struct {
 type InsertReq struct {
 	DbName         string                   `json:"dbName"`
 	CollectionName string                   `json:"collectionName" validate:"required"`
+	PartitionName  string                   `json:"partitionName"`
 	Data           []map[string]interface{} `json:"data" validate:"required"`
 }
 
 type SingleInsertReq struct {
 	DbName         string                 `json:"dbName"`
 	CollectionName string                 `json:"collectionName" validate:"required"`
+	PartitionName  string                 `json:"partitionName"`
 	Data           map[string]interface{} `json:"data" validate:"required"`
 }
 
 type UpsertReq struct {
 	DbName         string                   `json:"dbName"`
 	CollectionName string                   `json:"collectionName" validate:"required"`
+	PartitionName  string                   `json:"partitionName"`
 	Data           []map[string]interface{} `json:"data" validate:"required"`
 }
 
 type SingleUpsertReq struct {
 	DbName         string                 `json:"dbName"`
 	CollectionName string                 `json:"collectionName" validate:"required"`
+	PartitionName  string                 `json:"partitionName"`
 	Data           map[string]interface{} `json:"data" validate:"required"`
 }
 
+type SearchIteratorReq struct {
+	DbName         string    `json:"dbName"`
+	CollectionName string    `json:"collectionName" validate:"required"`
+	PartitionNames []string  `json:"partitionNames"`
+	Filter         string    `json:"filter"`
+	Limit          int32     `json:"limit"`
+	OutputFields   []string  `json:"outputFields"`
+	Vector         []float32 `json:"vector"`
+	MetricType     string    `json:"metricType"`
+	Radius         *float64  `json:"radius"`
+	RangeFilter    *float64  `json:"rangeFilter"`
+	IteratorToken  string    `json:"iteratorToken"`
+}
+
 type SearchReq struct {
 	DbName         string    `json:"dbName"`
 	CollectionName string    `json:"collectionName" validate:"required"`
+	PartitionNames []string  `json:"partitionNames"`
 	Filter         string    `json:"filter"`
 	Limit          int32     `json:"limit"`
 	Offset         int32     `json:"offset"`
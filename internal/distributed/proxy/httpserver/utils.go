@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/parameterutil.go"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func ParseUsernamePassword(c *gin.Context) (string, string, bool) {
@@ -133,7 +134,7 @@ func printFields(fields []*schemapb.FieldSchema) []gin.H {
 			HTTPReturnFieldAutoID:     field.AutoID,
 			HTTPReturnDescription:     field.Description,
 		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) && !typeutil.IsSparseFloatVectorType(field.DataType) {
 			dim, _ := getDim(field)
 			fieldDetail[HTTPReturnFieldType] = field.DataType.String() + "(" + strconv.FormatInt(dim, 10) + ")"
 		} else if field.DataType == schemapb.DataType_VarChar {
@@ -214,6 +215,22 @@ func checkAndSetData(body string, collDescResp *milvuspb.DescribeCollectionRespo
 						binaryArray = append(binaryArray, cast.ToUint8(vector.Num))
 					}
 					reallyData[fieldName] = binaryArray
+				case typeutil.BFloat16Vector:
+					var bf16Array []float32
+					for _, vector := range gjson.Get(data.Raw, fieldName).Array() {
+						bf16Array = append(bf16Array, cast.ToFloat32(vector.Num))
+					}
+					reallyData[fieldName] = bf16Array
+				case typeutil.Int8Vector:
+					var int8Array []int8
+					for _, vector := range gjson.Get(data.Raw, fieldName).Array() {
+						result, err := cast.ToInt8E(vector.Raw)
+						if err != nil {
+							return merr.WrapErrParameterInvalid(schemapb.DataType_name[int32(fieldType)], vector.Raw, err.Error()), reallyDataArray
+						}
+						int8Array = append(int8Array, result)
+					}
+					reallyData[fieldName] = int8Array
 				case schemapb.DataType_Bool:
 					result, err := cast.ToBoolE(dataString)
 					if err != nil {
@@ -302,6 +319,68 @@ func checkAndSetData(body string, collDescResp *milvuspb.DescribeCollectionRespo
 	return nil, reallyDataArray
 }
 
+// validateFieldsData checks the columns of insert/upsert body against the collection schema,
+// so that malformed requests are rejected before reaching the proxy tasks
+func validateFieldsData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, numRows uint32, isUpsert bool) error {
+	fieldSchemas := make(map[string]*schemapb.FieldSchema, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldSchemas[field.GetName()] = field
+	}
+	passed := make(map[string]struct{}, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		name := fieldData.GetFieldName()
+		if _, ok := passed[name]; ok {
+			return merr.WrapErrParameterInvalidMsg("duplicated field %s in request", name)
+		}
+		passed[name] = struct{}{}
+		field, ok := fieldSchemas[name]
+		if !ok {
+			if name == common.MetaFieldName && schema.GetEnableDynamicField() {
+				continue
+			}
+			return merr.WrapErrFieldNotFound(name, "field not exist in collection "+schema.GetName())
+		}
+		if field.GetDataType() != fieldData.GetType() {
+			return merr.WrapErrParameterInvalid(field.GetDataType().String(), fieldData.GetType().String(),
+				fmt.Sprintf("data type of field %s mismatch", name))
+		}
+		if field.GetIsPrimaryKey() && field.GetAutoID() && !isUpsert {
+			return merr.WrapErrParameterInvalidMsg("no need to pass primary key %s for autoID collection", name)
+		}
+		// sparse float vector has no dim in schema, every row has its own length
+		if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+			dim, err := getDim(field)
+			if err != nil {
+				return merr.WrapErrParameterInvalidMsg("failed to get dim of field %s: %s", name, err.Error())
+			}
+			if dim != fieldData.GetVectors().GetDim() {
+				return merr.WrapErrParameterInvalid(dim, fieldData.GetVectors().GetDim(), fmt.Sprintf("dim of field %s mismatch", name))
+			}
+		}
+		rows, err := funcutil.GetNumRowOfFieldData(fieldData)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("invalid data of field %s: %s", name, err.Error())
+		}
+		if rows != uint64(numRows) {
+			return merr.WrapErrParameterInvalid(numRows, rows, fmt.Sprintf("row num of field %s mismatch", name))
+		}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := passed[field.GetName()]; ok || field.GetIsDynamic() || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		if field.GetIsPrimaryKey() && field.GetAutoID() && !isUpsert {
+			continue
+		}
+		// omitted nullable or defaulted fields are filled by the proxy
+		if typeutil.IsFieldNullable(field) || field.GetDefaultValue() != nil {
+			continue
+		}
+		return merr.WrapErrParameterInvalidMsg("missing field %s in request", field.GetName())
+	}
+	return nil
+}
+
 func containsString(arr []string, s string) bool {
 	for _, str := range arr {
 		if str == s {
@@ -350,6 +429,17 @@ func convertBinaryVectorToArray(vector [][]byte, dim int64) ([]byte, error) {
 	return binaryArray, nil
 }
 
+func convertInt8VectorToArray(vector [][]int8, dim int64) ([]int8, error) {
+	int8Array := make([]int8, 0, int64(len(vector))*dim)
+	for _, arr := range vector {
+		if int64(len(arr)) != dim {
+			return nil, errors.New("vector length diff from dimension")
+		}
+		int8Array = append(int8Array, arr...)
+	}
+	return int8Array, nil
+}
+
 type fieldCandi struct {
 	name    string
 	v       reflect.Value
@@ -437,6 +527,12 @@ func anyToColumns(rows []map[string]interface{}, sch *schemapb.CollectionSchema)
 		case schemapb.DataType_BinaryVector:
 			data = make([][]byte, 0, rowsLen)
 			dim, _ = getDim(field)
+		case typeutil.BFloat16Vector:
+			data = make([][]float32, 0, rowsLen)
+			dim, _ = getDim(field)
+		case typeutil.Int8Vector:
+			data = make([][]int8, 0, rowsLen)
+			dim, _ = getDim(field)
 		default:
 			return nil, fmt.Errorf("the type(%v) of field(%v) is not supported, use other sdk please", field.DataType, field.Name)
 		}
@@ -499,6 +595,10 @@ func anyToColumns(rows []map[string]interface{}, sch *schemapb.CollectionSchema)
 				nameColumns[field.Name] = append(nameColumns[field.Name].([][]float32), candi.v.Interface().([]float32))
 			case schemapb.DataType_BinaryVector:
 				nameColumns[field.Name] = append(nameColumns[field.Name].([][]byte), candi.v.Interface().([]byte))
+			case typeutil.BFloat16Vector:
+				nameColumns[field.Name] = append(nameColumns[field.Name].([][]float32), candi.v.Interface().([]float32))
+			case typeutil.Int8Vector:
+				nameColumns[field.Name] = append(nameColumns[field.Name].([][]int8), candi.v.Interface().([]int8))
 			default:
 				return nil, fmt.Errorf("the type(%v) of field(%v) is not supported, use other sdk please", field.DataType, field.Name)
 			}
@@ -650,6 +750,18 @@ func anyToColumns(rows []map[string]interface{}, sch *schemapb.CollectionSchema)
 					},
 				},
 			}
+		case typeutil.BFloat16Vector:
+			arr, err := convertFloatVectorToArray(column.([][]float32), dim)
+			if err != nil {
+				return nil, err
+			}
+			colData.Field = typeutil.NewBytesVectorFieldData(colData.Type, name, dim, typeutil.Float32VectorToBFloat16Bytes(arr)).GetField()
+		case typeutil.Int8Vector:
+			arr, err := convertInt8VectorToArray(column.([][]int8), dim)
+			if err != nil {
+				return nil, err
+			}
+			colData.Field = typeutil.NewBytesVectorFieldData(colData.Type, name, dim, typeutil.Int8VectorToBytes(arr)).GetField()
 		default:
 			return nil, fmt.Errorf("the type(%v) of field(%v) is not supported, use other sdk please", colData.Type, name)
 		}
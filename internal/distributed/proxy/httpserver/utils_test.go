@@ -12,6 +12,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
@@ -843,3 +844,83 @@ func TestBuildQueryResps(t *testing.T) {
 	_, err = buildQueryResp(int64(0), outputFields, newFieldData(generateFieldData(), schemapb.DataType_None), generateIds(schemapb.DataType_Int64, 3), []float32{0.01, 0.04}, true)
 	assert.Equal(t, nil, err)
 }
+
+func TestValidateFieldsData(t *testing.T) {
+	schema := generateCollectionSchema(schemapb.DataType_Int64, false)
+	fieldsData := generateFieldData()
+	assert.NoError(t, validateFieldsData(schema, fieldsData, 3, false))
+
+	// word_count is omitted
+	omitted := []*schemapb.FieldData{fieldsData[0], fieldsData[2]}
+	err := validateFieldsData(schema, omitted, 3, false)
+	assert.ErrorContains(t, err, "missing field "+FieldWordCount)
+
+	wordCount := schema.GetFields()[1]
+	wordCount.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	assert.NoError(t, validateFieldsData(schema, omitted, 3, false))
+
+	wordCount.TypeParams = nil
+	wordCount.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}}
+	assert.NoError(t, validateFieldsData(schema, omitted, 3, false))
+
+	// sparse float vector has no dim
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 200, Name: "sparse", DataType: typeutil.SparseFloatVector})
+	sparse := typeutil.NewSparseFloatVectorFieldData("sparse", [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1}, []float32{0.1}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{0.2}),
+		typeutil.CreateSparseFloatRow([]uint32{3}, []float32{0.3}),
+	})
+	assert.NoError(t, validateFieldsData(schema, append(fieldsData, sparse), 3, false))
+	fieldsData = append(fieldsData, sparse)
+
+	// bfloat16 and int8 vectors are checked against the dim in schema
+	dimParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 201, Name: "bf16", DataType: typeutil.BFloat16Vector, TypeParams: dimParams},
+		&schemapb.FieldSchema{FieldID: 202, Name: "int8", DataType: typeutil.Int8Vector, TypeParams: dimParams})
+	bf16 := typeutil.NewBytesVectorFieldData(typeutil.BFloat16Vector, "bf16", 2, make([]byte, 12))
+	i8 := typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "int8", 2, make([]byte, 6))
+	assert.NoError(t, validateFieldsData(schema, append(fieldsData, bf16, i8), 3, false))
+
+	i8 = typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "int8", 3, make([]byte, 9))
+	err = validateFieldsData(schema, append(fieldsData, bf16, i8), 3, false)
+	assert.ErrorContains(t, err, "dim of field int8 mismatch")
+
+	i8 = typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "int8", 2, make([]byte, 4))
+	err = validateFieldsData(schema, append(fieldsData, bf16, i8), 3, false)
+	assert.ErrorContains(t, err, "row num of field int8 mismatch")
+}
+
+func TestInsertBytesVectors(t *testing.T) {
+	coll := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "bf16", DataType: typeutil.BFloat16Vector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+			{FieldID: 102, Name: "int8", DataType: typeutil.Int8Vector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}},
+		},
+	}
+	body := `{"data": [{"id": 1, "bf16": [1.0, -2.5], "int8": [-128, 127]}, {"id": 2, "bf16": [0.5, 2], "int8": [0, 1]}]}`
+	err, data := checkAndSetData(body, &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema: coll,
+	})
+	assert.NoError(t, err)
+	fieldsData, err := anyToColumns(data, coll)
+	assert.NoError(t, err)
+	assert.NoError(t, validateFieldsData(coll, fieldsData, 2, false))
+	for _, fieldData := range fieldsData {
+		switch fieldData.GetFieldName() {
+		case "bf16":
+			assert.Equal(t, []float32{1.0, -2.5, 0.5, 2}, typeutil.BFloat16BytesToFloat32Vector(fieldData.GetVectors().GetBfloat16Vector()))
+		case "int8":
+			assert.Equal(t, []int8{-128, 127, 0, 1}, typeutil.BytesToInt8Vector(fieldData.GetVectors().GetInt8Vector()))
+		}
+	}
+
+	err, _ = checkAndSetData(`{"data": [{"id": 1, "bf16": [1.0, -2.5], "int8": ["a", 1]}]}`, &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Schema: coll,
+	})
+	assert.Error(t, err)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -12,6 +14,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // We wrap original protobuf structure for 2 reasons:
@@ -41,6 +45,14 @@ type WrappedCreateCollectionRequest struct {
 	Properties       []*commonpb.KeyValuePair  `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"`
 }
 
+// WrappedAddCollectionFieldRequest wraps AddCollectionFieldRequest, the field is a struct instead of
+// the serialized `schema.FieldSchema`.
+type WrappedAddCollectionFieldRequest struct {
+	DbName         string               `json:"db_name,omitempty"`
+	CollectionName string               `json:"collection_name,omitempty"`
+	Field          schemapb.FieldSchema `json:"field,omitempty"`
+}
+
 // WrappedInsertRequest is the InsertRequest wrapped for RESTful request
 type WrappedInsertRequest struct {
 	Base           *commonpb.MsgBase `json:"base,omitempty"`
@@ -68,6 +80,86 @@ func (w *WrappedInsertRequest) AsInsertRequest() (*milvuspb.InsertRequest, error
 	}, nil
 }
 
+// WrappedUpsertRequest is the UpsertRequest wrapped for RESTful request
+type WrappedUpsertRequest struct {
+	Base           *commonpb.MsgBase `json:"base,omitempty"`
+	DbName         string            `json:"db_name,omitempty"`
+	CollectionName string            `json:"collection_name,omitempty"`
+	PartitionName  string            `json:"partition_name,omitempty"`
+	FieldsData     []*FieldData      `json:"fields_data,omitempty"`
+	HashKeys       []uint32          `json:"hash_keys,omitempty"`
+	NumRows        uint32            `json:"num_rows,omitempty"`
+}
+
+func (w *WrappedUpsertRequest) AsUpsertRequest() (*milvuspb.UpsertRequest, error) {
+	fieldData, err := convertFieldDataArray(w.FieldsData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert field data failed: %v", errBadRequest, err)
+	}
+	return &milvuspb.UpsertRequest{
+		Base:           w.Base,
+		DbName:         w.DbName,
+		CollectionName: w.CollectionName,
+		PartitionName:  w.PartitionName,
+		FieldsData:     fieldData,
+		HashKeys:       w.HashKeys,
+		NumRows:        w.NumRows,
+	}, nil
+}
+
+// WrappedGetRequest is the RESTful request body for getting entities by primary keys,
+// it's converted to a QueryRequest with a `pk in [...]` expression
+type WrappedGetRequest struct {
+	Base               *commonpb.MsgBase         `json:"base,omitempty"`
+	DbName             string                    `json:"db_name,omitempty"`
+	CollectionName     string                    `json:"collection_name,omitempty"`
+	PartitionNames     []string                  `json:"partition_names,omitempty"`
+	IDs                []json.RawMessage         `json:"ids,omitempty"`
+	OutputFields       []string                  `json:"output_fields,omitempty"`
+	GuaranteeTimestamp uint64                    `json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel   commonpb.ConsistencyLevel `json:"consistency_level,omitempty"`
+}
+
+// AsQueryRequest converts the WrappedGetRequest to a QueryRequest, the type of ids is checked against the primary key
+func (w *WrappedGetRequest) AsQueryRequest(schema *schemapb.CollectionSchema) (*milvuspb.QueryRequest, error) {
+	if len(w.IDs) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("ids should not be empty")
+	}
+	primaryField, ok := getPrimaryField(schema)
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("collection %s has no primary key", w.CollectionName)
+	}
+	ids := make([]string, 0, len(w.IDs))
+	for _, raw := range w.IDs {
+		switch primaryField.GetDataType() {
+		case schemapb.DataType_Int64:
+			var id int64
+			if err := json.Unmarshal(raw, &id); err != nil {
+				return nil, merr.WrapErrParameterInvalid("int64", string(raw), "primary key type mismatch")
+			}
+			ids = append(ids, strconv.FormatInt(id, 10))
+		case schemapb.DataType_VarChar:
+			var id string
+			if err := json.Unmarshal(raw, &id); err != nil {
+				return nil, merr.WrapErrParameterInvalid("string", string(raw), "primary key type mismatch")
+			}
+			ids = append(ids, strconv.Quote(id))
+		default:
+			return nil, merr.WrapErrParameterInvalid("Int64 or VarChar", primaryField.GetDataType().String(), "unsupported primary key type")
+		}
+	}
+	return &milvuspb.QueryRequest{
+		Base:               w.Base,
+		DbName:             w.DbName,
+		CollectionName:     w.CollectionName,
+		PartitionNames:     w.PartitionNames,
+		Expr:               fmt.Sprintf("%s in [%s]", primaryField.GetName(), strings.Join(ids, ",")),
+		OutputFields:       w.OutputFields,
+		GuaranteeTimestamp: w.GuaranteeTimestamp,
+		ConsistencyLevel:   w.ConsistencyLevel,
+	}, nil
+}
+
 // FieldData is the field data in RESTful request that can be convertd to schemapb.FieldData
 type FieldData struct {
 	Type      schemapb.DataType `json:"type,omitempty"`
@@ -179,6 +271,52 @@ func (f *FieldData) AsSchemapb() (*schemapb.FieldData, error) {
 			},
 		}
 
+	case schemapb.DataType_JSON:
+		wrappedData := []json.RawMessage{}
+		err := json.Unmarshal(raw, &wrappedData)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		data := make([][]byte, 0, len(wrappedData))
+		for _, row := range wrappedData {
+			data = append(data, row)
+		}
+		ret.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{
+					JsonData: &schemapb.JSONArray{
+						Data: data,
+					},
+				},
+			},
+		}
+
+	case schemapb.DataType_BinaryVector:
+		wrappedData := [][]byte{}
+		err := json.Unmarshal(raw, &wrappedData)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		if len(wrappedData) < 1 {
+			return nil, errors.New("at least one row for insert")
+		}
+		bytesPerRow := len(wrappedData[0])
+		if bytesPerRow < 1 {
+			return nil, errors.New("dim must >= 8")
+		}
+		data := make([]byte, 0, len(wrappedData)*bytesPerRow)
+		for _, dataArray := range wrappedData {
+			data = append(data, dataArray...)
+		}
+		ret.Field = &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim: int64(bytesPerRow * 8),
+				Data: &schemapb.VectorField_BinaryVector{
+					BinaryVector: data,
+				},
+			},
+		}
+
 	case schemapb.DataType_FloatVector:
 		wrappedData := [][]float32{}
 		err := json.Unmarshal(raw, &wrappedData)
@@ -212,6 +350,63 @@ func (f *FieldData) AsSchemapb() (*schemapb.FieldData, error) {
 				},
 			},
 		}
+
+	case typeutil.BFloat16Vector:
+		wrappedData := [][]float32{}
+		err := json.Unmarshal(raw, &wrappedData)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		if len(wrappedData) < 1 {
+			return nil, errors.New("at least one row for insert")
+		}
+		dim := len(wrappedData[0])
+		if dim < 1 {
+			return nil, errors.New("dim must >= 1")
+		}
+		data := make([]float32, 0, len(wrappedData)*dim)
+		for _, dataArray := range wrappedData {
+			data = append(data, dataArray...)
+		}
+		ret.Field = typeutil.NewBytesVectorFieldData(f.Type, f.FieldName, int64(dim), typeutil.Float32VectorToBFloat16Bytes(data)).GetField()
+
+	case typeutil.Int8Vector:
+		wrappedData := [][]int8{}
+		err := json.Unmarshal(raw, &wrappedData)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		if len(wrappedData) < 1 {
+			return nil, errors.New("at least one row for insert")
+		}
+		dim := len(wrappedData[0])
+		if dim < 1 {
+			return nil, errors.New("dim must >= 1")
+		}
+		data := make([]int8, 0, len(wrappedData)*dim)
+		for _, dataArray := range wrappedData {
+			data = append(data, dataArray...)
+		}
+		ret.Field = typeutil.NewBytesVectorFieldData(f.Type, f.FieldName, int64(dim), typeutil.Int8VectorToBytes(data)).GetField()
+
+	case typeutil.SparseFloatVector:
+		wrappedData := []map[string]interface{}{}
+		err := json.Unmarshal(raw, &wrappedData)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		if len(wrappedData) < 1 {
+			return nil, errors.New("at least one row for insert")
+		}
+		rows := make([][]byte, 0, len(wrappedData))
+		for _, obj := range wrappedData {
+			row, err := typeutil.ParseSparseFloatRow(obj)
+			if err != nil {
+				return nil, newFieldDataError(f.FieldName, err)
+			}
+			rows = append(rows, row)
+		}
+		ret.Field = typeutil.NewSparseFloatVectorFieldData(f.FieldName, rows).GetField()
 	default:
 		return nil, errors.New("unsupported data type")
 	}
@@ -251,6 +446,58 @@ type SearchRequest struct {
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
 }
 
+// AsSearchRequest returns a milvuspb.SearchRequest
+func (r *SearchRequest) AsSearchRequest() *milvuspb.SearchRequest {
+	req := &milvuspb.SearchRequest{
+		Base:               r.Base,
+		DbName:             r.DbName,
+		CollectionName:     r.CollectionName,
+		PartitionNames:     r.PartitionNames,
+		Dsl:                r.Dsl,
+		DslType:            r.DslType,
+		OutputFields:       r.OutputFields,
+		SearchParams:       r.SearchParams,
+		TravelTimestamp:    r.TravelTimestamp,
+		GuaranteeTimestamp: r.GuaranteeTimestamp,
+		Nq:                 r.Nq,
+	}
+	if len(r.BinaryVectors) > 0 {
+		req.PlaceholderGroup = binaryVector2Bytes(r.BinaryVectors)
+	} else {
+		req.PlaceholderGroup = vector2Bytes(r.Vectors)
+	}
+	return req
+}
+
+// WrappedHybridSearchRequest is the RESTful request body for hybrid search,
+// every element of requests is the search of a vector field with its own params and filter.
+type WrappedHybridSearchRequest struct {
+	DbName             string                   `json:"db_name,omitempty"`
+	CollectionName     string                   `json:"collection_name,omitempty"`
+	PartitionNames     []string                 `json:"partition_names,omitempty"`
+	Requests           []*SearchRequest         `json:"requests,omitempty"`
+	RankParams         []*commonpb.KeyValuePair `json:"rank_params,omitempty"`
+	OutputFields       []string                 `json:"output_fields,omitempty"`
+	GuaranteeTimestamp uint64                   `json:"guarantee_timestamp,omitempty"`
+}
+
+// AsHybridSearchRequest returns a milvuspb.HybridSearchRequest
+func (r *WrappedHybridSearchRequest) AsHybridSearchRequest() *milvuspb.HybridSearchRequest {
+	req := &milvuspb.HybridSearchRequest{
+		DbName:             r.DbName,
+		CollectionName:     r.CollectionName,
+		PartitionNames:     r.PartitionNames,
+		Requests:           make([]*milvuspb.SearchRequest, 0, len(r.Requests)),
+		RankParams:         r.RankParams,
+		OutputFields:       r.OutputFields,
+		GuaranteeTimestamp: r.GuaranteeTimestamp,
+	}
+	for _, subReq := range r.Requests {
+		req.Requests = append(req.Requests, subReq.AsSearchRequest())
+	}
+	return req
+}
+
 func binaryVector2Bytes(vectors [][]byte) []byte {
 	ph := &commonpb.PlaceholderValue{
 		Tag:    "$0",
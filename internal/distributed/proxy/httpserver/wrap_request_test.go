@@ -6,8 +6,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func TestFieldData_AsSchemapb(t *testing.T) {
@@ -219,6 +221,74 @@ func TestFieldData_AsSchemapb(t *testing.T) {
 		_, err := fieldData.AsSchemapb()
 		assert.Error(t, err)
 	})
+
+	t.Run("sparsefloatvector_ok", func(t *testing.T) {
+		fieldData := FieldData{
+			Type:      typeutil.SparseFloatVector,
+			FieldName: "sparse",
+			Field: []byte(`[
+				{"1": 0.1, "100": 0.2},
+				{"indices": [3], "values": [0.3]}
+			]`),
+		}
+		raw, _ := json.Marshal(fieldData)
+		json.Unmarshal(raw, &fieldData)
+		ret, err := fieldData.AsSchemapb()
+		assert.NoError(t, err)
+		assert.Equal(t, typeutil.SparseFloatVector, ret.GetType())
+		rows := typeutil.GetSparseFloatVectorRows(ret)
+		assert.Equal(t, 2, len(rows))
+		assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{3}, []float32{0.3}), rows[1])
+	})
+	t.Run("sparsefloatvector_error", func(t *testing.T) {
+		for _, field := range []string{`[]`, `[{"a": 0.1}]`, `[[0.1]]`} {
+			fieldData := FieldData{
+				Type:  typeutil.SparseFloatVector,
+				Field: []byte(field),
+			}
+			_, err := fieldData.AsSchemapb()
+			assert.Error(t, err, field)
+		}
+	})
+
+	t.Run("bfloat16vector_ok", func(t *testing.T) {
+		fieldData := FieldData{
+			Type:  typeutil.BFloat16Vector,
+			Field: []byte(`[[1.0, -2.5], [0.5, 2]]`),
+		}
+		ret, err := fieldData.AsSchemapb()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), ret.GetVectors().GetDim())
+		assert.Equal(t, []float32{1.0, -2.5, 0.5, 2}, typeutil.BFloat16BytesToFloat32Vector(ret.GetVectors().GetBfloat16Vector()))
+	})
+	t.Run("int8vector_ok", func(t *testing.T) {
+		fieldData := FieldData{
+			Type:  typeutil.Int8Vector,
+			Field: []byte(`[[-128, 1, 127], [0, 0, 0]]`),
+		}
+		ret, err := fieldData.AsSchemapb()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), ret.GetVectors().GetDim())
+		assert.Equal(t, []int8{-128, 1, 127, 0, 0, 0}, typeutil.BytesToInt8Vector(ret.GetVectors().GetInt8Vector()))
+	})
+	t.Run("bytesvector_error", func(t *testing.T) {
+		for _, dataType := range []schemapb.DataType{typeutil.BFloat16Vector, typeutil.Int8Vector} {
+			for _, field := range []string{`[]`, `[[]]`, `["a"]`} {
+				fieldData := FieldData{
+					Type:  dataType,
+					Field: []byte(field),
+				}
+				_, err := fieldData.AsSchemapb()
+				assert.Error(t, err, field)
+			}
+		}
+		fieldData := FieldData{
+			Type:  typeutil.Int8Vector,
+			Field: []byte(`[[128]]`),
+		}
+		_, err := fieldData.AsSchemapb()
+		assert.Error(t, err)
+	})
 }
 
 func Test_vector2Bytes(t *testing.T) {
@@ -233,6 +303,28 @@ func Test_binaryVector2Bytes(t *testing.T) {
 	assert.NotEmpty(t, ret)
 }
 
+func TestWrappedHybridSearchRequest_AsHybridSearchRequest(t *testing.T) {
+	wrappedReq := &WrappedHybridSearchRequest{
+		DbName:         "db",
+		CollectionName: "c1",
+		Requests: []*SearchRequest{
+			{Dsl: "a > 1", Vectors: [][]float32{{1.1, 1.2}}, Nq: 1},
+			{Dsl: "b > 1", BinaryVectors: [][]byte{[]byte("somebytes")}, Nq: 1},
+		},
+		RankParams:   []*commonpb.KeyValuePair{{Key: "strategy", Value: "rrf"}},
+		OutputFields: []string{"a"},
+	}
+	req := wrappedReq.AsHybridSearchRequest()
+	assert.Equal(t, "db", req.DbName)
+	assert.Equal(t, "c1", req.CollectionName)
+	assert.Equal(t, []string{"a"}, req.OutputFields)
+	assert.Equal(t, wrappedReq.RankParams, req.RankParams)
+	assert.Len(t, req.Requests, 2)
+	assert.Equal(t, "a > 1", req.Requests[0].GetDsl())
+	assert.Equal(t, vector2Bytes(wrappedReq.Requests[0].Vectors), req.Requests[0].GetPlaceholderGroup())
+	assert.Equal(t, binaryVector2Bytes(wrappedReq.Requests[1].BinaryVectors), req.Requests[1].GetPlaceholderGroup())
+}
+
 func TestVectorsArray_AsPbVectorArray(t *testing.T) {
 	dim := int64(1)
 	t.Run("vector_ok", func(t *testing.T) {
@@ -1,6 +1,7 @@
 package httpserver
 
 import (
+	"context"
 	"io"
 	"net/http"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin/binding"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 var errBadRequest = errors.New("bad request")
@@ -40,15 +42,89 @@ func wrapHandler(handle handlerFunc) gin.HandlerFunc {
 				c.Negotiate(http.StatusBadRequest, bodyFormatNegotiate)
 				return
 			default:
+				status := merr.Status(err)
 				bodyFormatNegotiate.Data = ErrResponse{
 					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Code:      status.GetCode(),
 					Reason:    err.Error(),
+					Retriable: status.GetRetriable(),
 				}
-				c.Negotiate(http.StatusInternalServerError, bodyFormatNegotiate)
+				c.Negotiate(httpStatusFromError(err), bodyFormatNegotiate)
 				return
 			}
 		}
-		c.Negotiate(http.StatusOK, bodyFormatNegotiate)
+		// the proxy reports most failures through the status in response,
+		// map them to http status code so that clients don't have to peek the body
+		c.Negotiate(httpStatusFromError(responseError(data)), bodyFormatNegotiate)
+	}
+}
+
+// responseError returns the error carried by the status of proxy response, if any
+func responseError(data interface{}) error {
+	switch resp := data.(type) {
+	case interface{ GetStatus() *commonpb.Status }:
+		return merr.Error(resp.GetStatus())
+	case *commonpb.Status:
+		return merr.Error(resp)
+	}
+	return nil
+}
+
+// httpStatusFromError maps the merr error code to http status code,
+// returns http.StatusOK if err is nil
+func httpStatusFromError(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	switch {
+	case errors.Is(err, errBadRequest),
+		errors.Is(err, merr.ErrParameterInvalid),
+		errors.Is(err, merr.ErrIncorrectParameterFormat),
+		errors.Is(err, merr.ErrMissingRequiredParameters),
+		errors.Is(err, merr.ErrInvalidInsertData),
+		errors.Is(err, merr.ErrCheckPrimaryKey),
+		errors.Is(err, merr.ErrFieldInvalidName),
+		errors.Is(err, merr.ErrDatabaseInvalidName):
+		return http.StatusBadRequest
+	case errors.Is(err, merr.ErrNeedAuthenticate),
+		errors.Is(err, merr.ErrPrivilegeNotAuthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, merr.ErrPrivilegeNotPermitted),
+		errors.Is(err, merr.ErrServiceForceDeny):
+		return http.StatusForbidden
+	case errors.Is(err, merr.ErrCollectionNotFound),
+		errors.Is(err, merr.ErrPartitionNotFound),
+		errors.Is(err, merr.ErrDatabaseNotFound),
+		errors.Is(err, merr.ErrAliasNotFound),
+		errors.Is(err, merr.ErrIndexNotFound),
+		errors.Is(err, merr.ErrFieldNotFound),
+		errors.Is(err, merr.ErrResourceGroupNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, merr.ErrAliasAlreadyExist),
+		errors.Is(err, merr.ErrAliasCollectionNameConfilct),
+		errors.Is(err, merr.ErrIndexDuplicate):
+		return http.StatusConflict
+	case errors.Is(err, merr.ErrCollectionNotLoaded),
+		errors.Is(err, merr.ErrPartitionNotLoaded):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, merr.ErrServiceRateLimit),
+		errors.Is(err, merr.ErrServiceRequestLimitExceeded),
+		errors.Is(err, merr.ErrCollectionNumLimitExceeded),
+		errors.Is(err, merr.ErrDatabaseNumLimitExceeded):
+		return http.StatusTooManyRequests
+	case errors.Is(err, merr.ErrServiceUnimplemented):
+		return http.StatusNotImplemented
+	case errors.Is(err, merr.ErrServiceNotReady),
+		errors.Is(err, merr.ErrServiceUnavailable),
+		errors.Is(err, merr.ErrServiceMemoryLimitExceeded),
+		errors.Is(err, merr.ErrServiceDiskLimitExceeded),
+		errors.Is(err, merr.ErrCollectionNotFullyLoaded),
+		errors.Is(err, merr.ErrPartitionNotFullyLoaded):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
 	}
 }
 
@@ -8,6 +8,8 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestWrapHandler(t *testing.T) {
@@ -20,6 +22,10 @@ func TestWrapHandler(t *testing.T) {
 			return nil, errBadRequest
 		case "2":
 			return nil, errors.New("internal err")
+		case "3":
+			return nil, merr.WrapErrCollectionNotFound("c1")
+		case "4":
+			return merr.Status(merr.WrapErrServiceRateLimit(1)), nil
 		}
 		panic("shall not reach")
 	}
@@ -54,4 +60,31 @@ func TestWrapHandler(t *testing.T) {
 		testEngine.ServeHTTP(w, req)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+	t.Run("err collection not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/test/3", nil)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("failed status in response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/test/4", nil)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+}
+
+func TestHTTPStatusFromError(t *testing.T) {
+	assert.Equal(t, http.StatusOK, httpStatusFromError(nil))
+	assert.Equal(t, http.StatusBadRequest, httpStatusFromError(merr.WrapErrParameterInvalidMsg("bad")))
+	assert.Equal(t, http.StatusUnauthorized, httpStatusFromError(merr.ErrNeedAuthenticate))
+	assert.Equal(t, http.StatusForbidden, httpStatusFromError(merr.WrapErrPrivilegeNotPermitted("no")))
+	assert.Equal(t, http.StatusNotFound, httpStatusFromError(merr.WrapErrDatabaseNotFound("db")))
+	assert.Equal(t, http.StatusConflict, httpStatusFromError(merr.WrapErrAliasAlreadyExist("db", "alias")))
+	assert.Equal(t, http.StatusTooManyRequests, httpStatusFromError(merr.WrapErrServiceRateLimit(1)))
+	assert.Equal(t, http.StatusServiceUnavailable, httpStatusFromError(merr.WrapErrServiceNotReady("proxy", 1, "Initializing")))
+	// status from remote keeps the error code
+	assert.Equal(t, http.StatusNotFound, httpStatusFromError(merr.Error(merr.Status(merr.WrapErrCollectionNotFound("c1")))))
+	assert.Equal(t, http.StatusInternalServerError, httpStatusFromError(errors.New("unknown")))
 }
@@ -132,7 +132,16 @@ func authenticate(c *gin.Context) {
 		}
 	}
 	rawToken := httpserver.GetAuthorization(c)
-	if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
+	if proxy.IsJWT(rawToken) {
+		// verified by the same authenticator of grpc requests, the roles claimed are checked besides the roles of user
+		user, roles, err := proxy.VerifyJWT(c, rawToken)
+		if err == nil {
+			c.Set(httpserver.ContextUsername, user)
+			c.Set(httpserver.ContextJWTRoles, roles)
+			return
+		}
+		log.Warn("fail to verify jwt", zap.Error(err))
+	} else if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
 		user, err := proxy.VerifyAPIKey(rawToken)
 		if err == nil {
 			c.Set(httpserver.ContextUsername, user)
@@ -156,7 +165,7 @@ func (s *Server) registerHTTPServer() {
 		gin.SetMode(gin.ReleaseMode)
 	}
 	metricsGinHandler := gin.Default()
-	apiv1 := metricsGinHandler.Group(apiPathPrefix)
+	apiv1 := metricsGinHandler.Group(apiPathPrefix, httpserver.NewAuditLogHandler(apiPathPrefix, "/v1"))
 	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
 	management.Register(&management.Handler{
 		Path:        "/",
@@ -187,9 +196,11 @@ func (s *Server) startHTTPServer(errChan chan error) {
 			return
 		}
 		c.Next()
-	}, authenticate, proxy.HTTPTraceLog)
+	}, httpserver.NewAuditLogHandler(apiPathPrefix, "/v1"), authenticate, proxy.HTTPTraceLog)
 	app := ginHandler.Group("/v1")
-	httpserver.NewHandlers(s.proxy).RegisterRoutesToV1(app)
+	handlers := httpserver.NewHandlers(s.proxy)
+	handlers.RegisterRoutesToV1(app)
+	app.GET(httpserver.OpenAPIPath, handlers.OpenAPIHandler(apiPathPrefix, "/v1"))
 	s.httpServer = &http.Server{Handler: ginHandler, ReadHeaderTimeout: time.Second}
 	errChan <- nil
 	if err := s.httpServer.Serve(s.httpListener); err != nil && err != cmux.ErrServerClosed {
@@ -239,8 +250,10 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			otelgrpc.UnaryServerInterceptor(opts...),
+			accesslog.UnaryAuditLogInterceptor,
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
+			accesslog.UnaryAuditContextInterceptor,
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
@@ -741,6 +754,11 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 	return s.proxy.AlterCollection(ctx, request)
 }
 
+// AddCollectionField notifies Proxy to add a field to collection
+func (s *Server) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, request)
+}
+
 // CreatePartition notifies Proxy to create a partition
 func (s *Server) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreatePartition(ctx, request)
@@ -794,6 +812,11 @@ func (s *Server) DropIndex(ctx context.Context, request *milvuspb.DropIndexReque
 	return s.proxy.DropIndex(ctx, request)
 }
 
+// AlterIndex notifies Proxy to alter index
+func (s *Server) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterIndex(ctx, request)
+}
+
 // DescribeIndex notifies Proxy to get index describe
 func (s *Server) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return s.proxy.DescribeIndex(ctx, request)
@@ -833,6 +856,10 @@ func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*
 	return s.proxy.Search(ctx, request)
 }
 
+func (s *Server) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return s.proxy.HybridSearch(ctx, request)
+}
+
 func (s *Server) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return s.proxy.Flush(ctx, request)
 }
@@ -1079,6 +1106,10 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *milvuspb.Descri
 	return s.proxy.DescribeResourceGroup(ctx, req)
 }
 
+func (s *Server) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateResourceGroups(ctx, req)
+}
+
 func (s *Server) TransferNode(ctx context.Context, req *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
 	return s.proxy.TransferNode(ctx, req)
 }
@@ -1130,3 +1161,71 @@ func (s *Server) AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestam
 func (s *Server) ReplicateMessage(ctx context.Context, req *milvuspb.ReplicateMessageRequest) (*milvuspb.ReplicateMessageResponse, error) {
 	return s.proxy.ReplicateMessage(ctx, req)
 }
+
+func (s *Server) AlterCollectionField(ctx context.Context, req *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollectionField(ctx, req)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterDatabase(ctx, req)
+}
+
+func (s *Server) DescribeDatabase(ctx context.Context, req *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error) {
+	return s.proxy.DescribeDatabase(ctx, req)
+}
+
+func (s *Server) BackupRBAC(ctx context.Context, req *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
+	return s.proxy.BackupRBAC(ctx, req)
+}
+
+func (s *Server) RestoreRBAC(ctx context.Context, req *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
+	return s.proxy.RestoreRBAC(ctx, req)
+}
+
+func (s *Server) CreatePrivilegeGroup(ctx context.Context, req *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return s.proxy.CreatePrivilegeGroup(ctx, req)
+}
+
+func (s *Server) DropPrivilegeGroup(ctx context.Context, req *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error) {
+	return s.proxy.DropPrivilegeGroup(ctx, req)
+}
+
+func (s *Server) ListPrivilegeGroups(ctx context.Context, req *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error) {
+	return s.proxy.ListPrivilegeGroups(ctx, req)
+}
+
+func (s *Server) OperatePrivilegeGroup(ctx context.Context, req *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return s.proxy.OperatePrivilegeGroup(ctx, req)
+}
+
+func (s *Server) RunAnalyzer(ctx context.Context, req *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
+	return s.proxy.RunAnalyzer(ctx, req)
+}
+
+func (s *Server) AddUserTags(ctx context.Context, req *milvuspb.AddUserTagsRequest) (*commonpb.Status, error) {
+	return s.proxy.AddUserTags(ctx, req)
+}
+
+func (s *Server) DeleteUserTags(ctx context.Context, req *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteUserTags(ctx, req)
+}
+
+func (s *Server) GetUserTags(ctx context.Context, req *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error) {
+	return s.proxy.GetUserTags(ctx, req)
+}
+
+func (s *Server) ListUsersWithTag(ctx context.Context, req *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error) {
+	return s.proxy.ListUsersWithTag(ctx, req)
+}
+
+func (s *Server) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRowPolicy(ctx, req)
+}
+
+func (s *Server) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRowPolicy(ctx, req)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return s.proxy.ListRowPolicies(ctx, req)
+}
@@ -18,10 +18,15 @@ package grpcproxy
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"net/http"
 	"net/http/httptest"
 	"os"
 	"strconv"
@@ -30,6 +35,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -46,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	milvusmock "github.com/milvus-io/milvus/internal/util/mock"
@@ -219,6 +226,14 @@ func (m *MockProxy) AlterCollection(ctx context.Context, request *milvuspb.Alter
 	return nil, nil
 }
 
+func (m *MockProxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -263,6 +278,10 @@ func (m *MockProxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexRe
 	return nil, nil
 }
 
+func (m *MockProxy) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	return nil, nil
 }
@@ -295,6 +314,14 @@ func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) SearchIterator(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error) {
+	return nil, "", nil
+}
+
+func (m *MockProxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*milvuspb.FlushResponse, error) {
 	return nil, nil
 }
@@ -303,6 +330,18 @@ func (m *MockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (
 	return nil, nil
 }
 
+func (m *MockProxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error) error {
+	return nil
+}
+
+func (m *MockProxy) QueryIterator(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error) {
+	return nil, "", nil
+}
+
+func (m *MockProxy) Explain(ctx context.Context, request *types.ExplainRequest) (*types.ExplainResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return nil, nil
 }
@@ -480,6 +519,10 @@ func (m *MockProxy) DropResourceGroup(ctx context.Context, req *milvuspb.DropRes
 	return nil, nil
 }
 
+func (m *MockProxy) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) DescribeResourceGroup(ctx context.Context, req *milvuspb.DescribeResourceGroupRequest) (*milvuspb.DescribeResourceGroupResponse, error) {
 	return nil, nil
 }
@@ -512,6 +555,74 @@ func (m *MockProxy) ReplicateMessage(ctx context.Context, req *milvuspb.Replicat
 	return nil, nil
 }
 
+func (m *MockProxy) AlterCollectionField(ctx context.Context, req *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterDatabase(ctx context.Context, req *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DescribeDatabase(ctx context.Context, req *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) BackupRBAC(ctx context.Context, req *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreRBAC(ctx context.Context, req *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreatePrivilegeGroup(ctx context.Context, req *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropPrivilegeGroup(ctx context.Context, req *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListPrivilegeGroups(ctx context.Context, req *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperatePrivilegeGroup(ctx context.Context, req *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RunAnalyzer(ctx context.Context, req *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddUserTags(ctx context.Context, req *milvuspb.AddUserTagsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DeleteUserTags(ctx context.Context, req *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetUserTags(ctx context.Context, req *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListUsersWithTag(ctx context.Context, req *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return nil, nil
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 type WaitOption struct {
@@ -703,6 +814,11 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("AddCollectionField", func(t *testing.T) {
+		_, err := server.AddCollectionField(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("CreatePartition", func(t *testing.T) {
 		_, err := server.CreatePartition(ctx, nil)
 		assert.NoError(t, err)
@@ -753,6 +869,11 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("AlterIndex", func(t *testing.T) {
+		_, err := server.AlterIndex(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("DescribeIndex", func(t *testing.T) {
 		_, err := server.DescribeIndex(ctx, nil)
 		assert.NoError(t, err)
@@ -793,6 +914,11 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("HybridSearch", func(t *testing.T) {
+		_, err := server.HybridSearch(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("Flush", func(t *testing.T) {
 		_, err := server.Flush(ctx, nil)
 		assert.NoError(t, err)
@@ -963,6 +1089,11 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("UpdateResourceGroups", func(t *testing.T) {
+		_, err := server.UpdateResourceGroups(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("TransferNode", func(t *testing.T) {
 		_, err := server.TransferNode(ctx, nil)
 		assert.NoError(t, err)
@@ -1012,6 +1143,91 @@ func Test_NewServer(t *testing.T) {
 		_, err := server.AllocTimestamp(ctx, nil)
 		assert.Nil(t, err)
 	})
+
+	t.Run("AlterCollectionField", func(t *testing.T) {
+		_, err := server.AlterCollectionField(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlterDatabase", func(t *testing.T) {
+		_, err := server.AlterDatabase(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DescribeDatabase", func(t *testing.T) {
+		_, err := server.DescribeDatabase(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BackupRBAC", func(t *testing.T) {
+		_, err := server.BackupRBAC(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RestoreRBAC", func(t *testing.T) {
+		_, err := server.RestoreRBAC(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("CreatePrivilegeGroup", func(t *testing.T) {
+		_, err := server.CreatePrivilegeGroup(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DropPrivilegeGroup", func(t *testing.T) {
+		_, err := server.DropPrivilegeGroup(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListPrivilegeGroups", func(t *testing.T) {
+		_, err := server.ListPrivilegeGroups(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("OperatePrivilegeGroup", func(t *testing.T) {
+		_, err := server.OperatePrivilegeGroup(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RunAnalyzer", func(t *testing.T) {
+		_, err := server.RunAnalyzer(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AddUserTags", func(t *testing.T) {
+		_, err := server.AddUserTags(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeleteUserTags", func(t *testing.T) {
+		_, err := server.DeleteUserTags(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetUserTags", func(t *testing.T) {
+		_, err := server.GetUserTags(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListUsersWithTag", func(t *testing.T) {
+		_, err := server.ListUsersWithTag(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("CreateRowPolicy", func(t *testing.T) {
+		_, err := server.CreateRowPolicy(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DropRowPolicy", func(t *testing.T) {
+		_, err := server.DropRowPolicy(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListRowPolicies", func(t *testing.T) {
+		_, err := server.ListRowPolicies(ctx, nil)
+		assert.NoError(t, err)
+	})
 	err = server.Stop()
 	assert.NoError(t, err)
 
@@ -1380,3 +1596,54 @@ func TestHttpAuthenticate(t *testing.T) {
 		assert.Equal(t, "foo", ctxName)
 	}
 }
+
+func TestHttpAuthenticateJWT(t *testing.T) {
+	params := paramtable.Get()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA", "kid": "rsa1", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	}))
+	defer jwks.Close()
+	params.Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	params.Save(params.ProxyCfg.JWTEnabled.Key, "true")
+	params.Save(params.ProxyCfg.JWTJWKSURL.Key, jwks.URL)
+	defer func() {
+		params.Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
+		params.Reset(params.ProxyCfg.JWTEnabled.Key)
+		params.Reset(params.ProxyCfg.JWTJWKSURL.Key)
+	}()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub":   "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"reader"},
+	})
+	token.Header["kid"] = "rsa1"
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest("GET", "/test", nil)
+	ctx.Request.Header.Set("Authorization", "Bearer "+signed)
+	authenticate(ctx)
+	assert.False(t, ctx.IsAborted())
+	ctxName, _ := ctx.Get(httpserver.ContextUsername)
+	assert.Equal(t, "alice", ctxName)
+	roles, _ := ctx.Get(httpserver.ContextJWTRoles)
+	assert.Equal(t, []string{"reader"}, roles)
+
+	// invalid JWT is rejected rather than verified as api key
+	w = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest("GET", "/test", nil)
+	ctx.Request.Header.Set("Authorization", "Bearer "+signed[:len(signed)-4])
+	authenticate(ctx)
+	assert.True(t, ctx.IsAborted())
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -220,6 +220,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListPolicy(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.OperateRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.AddCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.ShowConfigurations(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -438,6 +450,18 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListPolicy(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.OperateRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.AddCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CheckHealth(shortCtx, nil)
 		retCheck(rTimeout, err)
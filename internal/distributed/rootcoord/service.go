@@ -513,6 +513,10 @@ func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyR
 	return s.rootCoord.ListPolicy(ctx, request)
 }
 
+func (s *Server) OperateRowPolicy(ctx context.Context, request *rootcoordpb.OperateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateRowPolicy(ctx, request)
+}
+
 func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.AlterCollection(ctx, request)
 }
@@ -520,3 +524,11 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) AddCollectionField(ctx context.Context, request *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+func (s *Server) DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropCollectionField(ctx, request)
+}
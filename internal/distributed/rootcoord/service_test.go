@@ -32,6 +32,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
@@ -64,6 +65,14 @@ func (m *mockCore) RenameCollection(ctx context.Context, request *milvuspb.Renam
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockCore) AddCollectionField(ctx context.Context, request *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (m *mockCore) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -213,6 +222,16 @@ func TestRun(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("AddCollectionField", func(t *testing.T) {
+			_, err := svr.AddCollectionField(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("DropCollectionField", func(t *testing.T) {
+			_, err := svr.DropCollectionField(ctx, nil)
+			assert.NoError(t, err)
+		})
+
 		t.Run("CreateDatabase", func(t *testing.T) {
 			ret, err := svr.CreateDatabase(ctx, nil)
 			assert.Nil(t, err)
@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -78,6 +79,12 @@ type RootCoordCatalog interface {
 	// List all user role pair in string for the tenant
 	// For example []string{"user1/role1"}
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
+	// SaveRowPolicy creates or replaces the row policy identified by its role, db, collection and policy name for the tenant.
+	SaveRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error
+	// DropRowPolicy removes the row policy identified by its role, db, collection and policy name for the tenant.
+	DropRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error
+	// ListRowPolicy lists all row policies for the tenant.
+	ListRowPolicy(ctx context.Context, tenant string) ([]*internalpb.RowPolicy, error)
 
 	Close()
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -337,7 +338,12 @@ func (kc *Catalog) listFieldsAfter210(ctx context.Context, collectionID typeutil
 		if err != nil {
 			return nil, err
 		}
-		fields = append(fields, model.UnmarshalFieldModel(partitionMeta))
+		field := model.UnmarshalFieldModel(partitionMeta)
+		// the dropped fields are kept to avoid reusing their ids
+		if !field.Available() {
+			continue
+		}
+		fields = append(fields, field)
 	}
 	return fields, nil
 }
@@ -455,6 +461,8 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.SchemaVersion = newColl.SchemaVersion
+	oldCollClone.MaxFieldID = newColl.MaxFieldID
 
 	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
 	newKey := BuildCollectionKey(newColl.DBID, oldColl.CollectionID)
@@ -463,12 +471,53 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 		return err
 	}
 	saves := map[string]string{newKey: string(value)}
+	fieldSaves, err := buildAlteredFieldKvs(oldColl, newColl)
+	if err != nil {
+		return err
+	}
+	for k, v := range fieldSaves {
+		saves[k] = v
+	}
 	if oldKey == newKey {
-		return kc.Snapshot.Save(newKey, string(value), ts)
+		if len(saves) == 1 {
+			return kc.Snapshot.Save(newKey, string(value), ts)
+		}
+		return kc.Snapshot.MultiSave(saves, ts)
 	}
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(saves, []string{oldKey}, ts)
 }
 
+// buildAlteredFieldKvs returns the kvs of the fields added or dropped by altering the collection,
+// the dropped fields are saved in dropped state, so that their ids are never reused.
+func buildAlteredFieldKvs(oldColl *model.Collection, newColl *model.Collection) (map[string]string, error) {
+	kvs := make(map[string]string)
+	oldFields := lo.SliceToMap(oldColl.Fields, func(field *model.Field) (int64, *model.Field) { return field.FieldID, field })
+	newFields := lo.SliceToMap(newColl.Fields, func(field *model.Field) (int64, *model.Field) { return field.FieldID, field })
+	for fieldID, field := range newFields {
+		if oldField, ok := oldFields[fieldID]; ok && oldField.Equal(*field) {
+			continue
+		}
+		v, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return nil, err
+		}
+		kvs[BuildFieldKey(newColl.CollectionID, fieldID)] = string(v)
+	}
+	for fieldID, field := range oldFields {
+		if _, ok := newFields[fieldID]; ok {
+			continue
+		}
+		droppedField := field.Clone()
+		droppedField.State = schemapb.FieldState_FieldDropped
+		v, err := proto.Marshal(model.MarshalFieldModel(droppedField))
+		if err != nil {
+			return nil, err
+		}
+		kvs[BuildFieldKey(newColl.CollectionID, fieldID)] = string(v)
+	}
+	return kvs, nil
+}
+
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
 	if alterType == metastore.MODIFY {
 		return kc.alterModifyCollection(oldColl, newColl, ts)
@@ -1167,6 +1216,56 @@ func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, e
 	return userRoles, nil
 }
 
+func rowPolicyKey(tenant string, policy *internalpb.RowPolicy) string {
+	return funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant,
+		fmt.Sprintf("%s/%s/%s", policy.GetRole(), funcutil.CombineObjectName(policy.GetDbName(), policy.GetCollectionName()), policy.GetPolicyName()))
+}
+
+func (kc *Catalog) SaveRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	k := rowPolicyKey(tenant, policy)
+	v, err := proto.Marshal(policy)
+	if err != nil {
+		log.Error("fail to marshal the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	if err = kc.Txn.Save(k, string(v)); err != nil {
+		log.Error("fail to save the row policy", zap.String("key", k), zap.Error(err))
+	}
+	return err
+}
+
+func (kc *Catalog) DropRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	k := rowPolicyKey(tenant, policy)
+	if _, err := kc.Txn.Load(k); err != nil {
+		log.Debug("the row policy isn't existed", zap.String("key", k), zap.Error(err))
+		return common.NewIgnorableError(fmt.Errorf("the row policy isn't existed, key: %s", k))
+	}
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Error("fail to remove the row policy", zap.String("key", k), zap.Error(err))
+	}
+	return err
+}
+
+func (kc *Catalog) ListRowPolicy(ctx context.Context, tenant string) ([]*internalpb.RowPolicy, error) {
+	k := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, "")
+	_, values, err := kc.Txn.LoadWithPrefix(k)
+	if err != nil {
+		log.Error("fail to load all row policies", zap.String("key", k), zap.Error(err))
+		return nil, err
+	}
+	policies := make([]*internalpb.RowPolicy, 0, len(values))
+	for _, value := range values {
+		policy := &internalpb.RowPolicy{}
+		if err := proto.Unmarshal([]byte(value), policy); err != nil {
+			log.Error("fail to unmarshal the row policy", zap.String("key", k), zap.Error(err))
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
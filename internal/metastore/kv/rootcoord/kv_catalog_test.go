@@ -20,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -1040,6 +1041,63 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
 		assert.NoError(t, err)
 	})
+
+	t.Run("modify fields", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for k, v := range saves {
+				kvs[k] = v
+			}
+			return nil
+		}
+
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{
+			CollectionID: collectionID,
+			State:        pb.CollectionState_CollectionCreated,
+			Fields:       []*model.Field{{FieldID: 100, Name: "pk"}, {FieldID: 101, Name: "dropped"}},
+		}
+		newC := &model.Collection{
+			CollectionID:  collectionID,
+			State:         pb.CollectionState_CollectionCreated,
+			Fields:        []*model.Field{{FieldID: 100, Name: "pk"}, {FieldID: 102, Name: "added"}},
+			SchemaVersion: 2,
+			MaxFieldID:    102,
+		}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.Len(t, kvs, 3)
+
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(kvs[BuildCollectionKey(0, collectionID)]), &collPb)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), collPb.GetSchemaVersion())
+		assert.Equal(t, int64(102), collPb.GetMaxFieldId())
+
+		var fieldPb schemapb.FieldSchema
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 102)]), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "added", fieldPb.GetName())
+		assert.Equal(t, schemapb.FieldState_FieldCreated, fieldPb.GetState())
+
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 101)]), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "dropped", fieldPb.GetName())
+		assert.Equal(t, schemapb.FieldState_FieldDropped, fieldPb.GetState())
+
+		// the dropped field is not listed
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return []string{BuildFieldKey(collectionID, 101), BuildFieldKey(collectionID, 102)},
+				[]string{kvs[BuildFieldKey(collectionID, 101)], kvs[BuildFieldKey(collectionID, 102)]}, nil
+		}
+		fields, err := kc.listFieldsAfter210(ctx, collectionID, 0)
+		assert.NoError(t, err)
+		assert.Len(t, fields, 1)
+		assert.Equal(t, int64(102), fields[0].FieldID)
+	})
 }
 
 func TestCatalog_AlterPartition(t *testing.T) {
@@ -2487,3 +2545,43 @@ func TestRBAC_Grant(t *testing.T) {
 		}
 	})
 }
+
+func TestRBAC_RowPolicy(t *testing.T) {
+	var (
+		tenant = "default"
+		ctx    = context.TODO()
+		c      = &Catalog{Txn: memkv.NewMemoryKV()}
+	)
+
+	policy1 := &internalpb.RowPolicy{Role: "tenant1", DbName: "default", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 1"}
+	policy2 := &internalpb.RowPolicy{Role: "tenant2", DbName: "default", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 2"}
+	assert.NoError(t, c.SaveRowPolicy(ctx, tenant, policy1))
+	assert.NoError(t, c.SaveRowPolicy(ctx, tenant, policy2))
+
+	// replace the expr of existed policy
+	policy1.Expr = "tenant_id in [1, 3]"
+	assert.NoError(t, c.SaveRowPolicy(ctx, tenant, policy1))
+	policies, err := c.ListRowPolicy(ctx, tenant)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(policies))
+	for _, policy := range policies {
+		if policy.GetRole() == policy1.GetRole() {
+			assert.True(t, proto.Equal(policy1, policy))
+		} else {
+			assert.True(t, proto.Equal(policy2, policy))
+		}
+	}
+
+	assert.NoError(t, c.DropRowPolicy(ctx, tenant, policy1))
+	err = c.DropRowPolicy(ctx, tenant, policy1)
+	assert.True(t, common.IsIgnorableError(err))
+	policies, err = c.ListRowPolicy(ctx, tenant)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policies))
+	assert.Equal(t, "tenant_id == 2", policies[0].GetExpr())
+
+	// other tenants are isolated
+	policies, err = c.ListRowPolicy(ctx, "tenant")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(policies))
+}
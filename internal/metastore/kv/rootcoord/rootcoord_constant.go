@@ -49,6 +49,9 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// RowPolicyPrefix prefix for row policies of role
+	RowPolicyPrefix = ComponentPrefix + CommonCredentialPrefix + "/row-policies"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	metastore "github.com/milvus-io/milvus/internal/metastore"
 
@@ -948,6 +950,50 @@ func (_c *RootCoordCatalog_DropRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: ctx, tenant, policy
+func (_m *RootCoordCatalog) DropRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	ret := _m.Called(ctx, tenant, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *internalpb.RowPolicy) error); ok {
+		r0 = rf(ctx, tenant, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type RootCoordCatalog_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - policy *internalpb.RowPolicy
+func (_e *RootCoordCatalog_Expecter) DropRowPolicy(ctx interface{}, tenant interface{}, policy interface{}) *RootCoordCatalog_DropRowPolicy_Call {
+	return &RootCoordCatalog_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", ctx, tenant, policy)}
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) Run(run func(ctx context.Context, tenant string, policy *internalpb.RowPolicy)) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) Return(_a0 error) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) RunAndReturn(run func(context.Context, string, *internalpb.RowPolicy) error) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbID, ts, collectionID
 func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, dbID int64, ts uint64, collectionID int64) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts, collectionID)
@@ -1506,6 +1552,61 @@ func (_c *RootCoordCatalog_ListRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListRowPolicy provides a mock function with given fields: ctx, tenant
+func (_m *RootCoordCatalog) ListRowPolicy(ctx context.Context, tenant string) ([]*internalpb.RowPolicy, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 []*internalpb.RowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*internalpb.RowPolicy, error)); ok {
+		return rf(ctx, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*internalpb.RowPolicy); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicy'
+type RootCoordCatalog_ListRowPolicy_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+func (_e *RootCoordCatalog_Expecter) ListRowPolicy(ctx interface{}, tenant interface{}) *RootCoordCatalog_ListRowPolicy_Call {
+	return &RootCoordCatalog_ListRowPolicy_Call{Call: _e.mock.On("ListRowPolicy", ctx, tenant)}
+}
+
+func (_c *RootCoordCatalog_ListRowPolicy_Call) Run(run func(ctx context.Context, tenant string)) *RootCoordCatalog_ListRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRowPolicy_Call) Return(_a0 []*internalpb.RowPolicy, _a1 error) *RootCoordCatalog_ListRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRowPolicy_Call) RunAndReturn(run func(context.Context, string) ([]*internalpb.RowPolicy, error)) *RootCoordCatalog_ListRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUser provides a mock function with given fields: ctx, tenant, entity, includeRoleInfo
 func (_m *RootCoordCatalog) ListUser(ctx context.Context, tenant string, entity *milvuspb.UserEntity, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
 	ret := _m.Called(ctx, tenant, entity, includeRoleInfo)
@@ -1618,6 +1719,50 @@ func (_c *RootCoordCatalog_ListUserRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// SaveRowPolicy provides a mock function with given fields: ctx, tenant, policy
+func (_m *RootCoordCatalog) SaveRowPolicy(ctx context.Context, tenant string, policy *internalpb.RowPolicy) error {
+	ret := _m.Called(ctx, tenant, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *internalpb.RowPolicy) error); ok {
+		r0 = rf(ctx, tenant, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRowPolicy'
+type RootCoordCatalog_SaveRowPolicy_Call struct {
+	*mock.Call
+}
+
+// SaveRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - policy *internalpb.RowPolicy
+func (_e *RootCoordCatalog_Expecter) SaveRowPolicy(ctx interface{}, tenant interface{}, policy interface{}) *RootCoordCatalog_SaveRowPolicy_Call {
+	return &RootCoordCatalog_SaveRowPolicy_Call{Call: _e.mock.On("SaveRowPolicy", ctx, tenant, policy)}
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) Run(run func(ctx context.Context, tenant string, policy *internalpb.RowPolicy)) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) Return(_a0 error) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) RunAndReturn(run func(context.Context, string, *internalpb.RowPolicy) error) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
@@ -28,6 +28,8 @@ type Collection struct {
 	Properties           []*commonpb.KeyValuePair
 	State                pb.CollectionState
 	EnableDynamicField   bool
+	SchemaVersion        int32 // bumped every time a field is added or dropped
+	MaxFieldID           int64 // the max id of fields ever added, dropped field ids are never reused
 }
 
 func (c *Collection) Available() bool {
@@ -54,6 +56,8 @@ func (c *Collection) Clone() *Collection {
 		Properties:           common.CloneKeyValuePairs(c.Properties),
 		State:                c.State,
 		EnableDynamicField:   c.EnableDynamicField,
+		SchemaVersion:        c.SchemaVersion,
+		MaxFieldID:           c.MaxFieldID,
 	}
 }
 
@@ -64,6 +68,17 @@ func (c *Collection) GetPartitionNum(filterUnavailable bool) int {
 	return lo.CountBy(c.Partitions, func(p *Partition) bool { return p.Available() })
 }
 
+// NextFieldID returns the id of the next added field, the ids of dropped fields are never reused.
+func (c *Collection) NextFieldID() int64 {
+	maxFieldID := c.MaxFieldID
+	for _, field := range c.Fields {
+		if field.FieldID > maxFieldID {
+			maxFieldID = field.FieldID
+		}
+	}
+	return maxFieldID + 1
+}
+
 func (c *Collection) Equal(other Collection) bool {
 	return c.TenantID == other.TenantID &&
 		c.DBID == other.DBID &&
@@ -110,6 +125,8 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		State:                coll.State,
 		Properties:           coll.Properties,
 		EnableDynamicField:   coll.Schema.EnableDynamicField,
+		SchemaVersion:        coll.SchemaVersion,
+		MaxFieldID:           coll.MaxFieldId,
 	}
 }
 
@@ -171,6 +188,8 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
+		MaxFieldId:           coll.MaxFieldID,
 	}
 
 	if c.withPartitions {
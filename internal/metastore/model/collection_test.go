@@ -119,6 +119,20 @@ func TestCollection_GetPartitionNum(t *testing.T) {
 	assert.Equal(t, 6, coll.GetPartitionNum(false))
 }
 
+func TestCollection_NextFieldID(t *testing.T) {
+	coll := &Collection{
+		Fields: []*Field{{FieldID: 0}, {FieldID: 1}, {FieldID: 100}, {FieldID: 101}},
+	}
+	assert.Equal(t, int64(102), coll.NextFieldID())
+
+	// the id of the dropped field 102 is not reused
+	coll.MaxFieldID = 102
+	assert.Equal(t, int64(103), coll.NextFieldID())
+
+	clone := coll.Clone()
+	assert.Equal(t, int64(102), clone.MaxFieldID)
+}
+
 func TestCollection_Equal(t *testing.T) {
 	equal := func(a, b Collection) bool {
 		return a.Equal(b)
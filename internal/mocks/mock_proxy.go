@@ -18,6 +18,8 @@ import (
 
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
 
+	rootcoordpb "github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+
 	types "github.com/milvus-io/milvus/internal/types"
 )
 
@@ -34,6 +36,116 @@ func (_m *MockProxy) EXPECT() *MockProxy_Expecter {
 	return &MockProxy_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionField(_a0 context.Context, _a1 *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxy_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AddCollectionFieldRequest
+func (_e *MockProxy_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionField_Call {
+	return &MockProxy_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AddCollectionFieldRequest)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Context, *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddUserTags provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddUserTags(_a0 context.Context, _a1 *milvuspb.AddUserTagsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AddUserTagsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AddUserTagsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AddUserTagsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserTags'
+type MockProxy_AddUserTags_Call struct {
+	*mock.Call
+}
+
+// AddUserTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AddUserTagsRequest
+func (_e *MockProxy_Expecter) AddUserTags(_a0 interface{}, _a1 interface{}) *MockProxy_AddUserTags_Call {
+	return &MockProxy_AddUserTags_Call{Call: _e.mock.On("AddUserTags", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddUserTags_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AddUserTagsRequest)) *MockProxy_AddUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AddUserTagsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddUserTags_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddUserTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddUserTags_Call) RunAndReturn(run func(context.Context, *milvuspb.AddUserTagsRequest) (*commonpb.Status, error)) *MockProxy_AddUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -199,6 +311,226 @@ func (_c *MockProxy_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterCollectionField(_a0 context.Context, _a1 *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionField'
+type MockProxy_AlterCollectionField_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AlterCollectionFieldRequest
+func (_e *MockProxy_Expecter) AlterCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AlterCollectionField_Call {
+	return &MockProxy_AlterCollectionField_Call{Call: _e.mock.On("AlterCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterCollectionField_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AlterCollectionFieldRequest)) *MockProxy_AlterCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterCollectionField_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AlterCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterDatabase(_a0 context.Context, _a1 *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxy_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AlterDatabaseRequest
+func (_e *MockProxy_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_AlterDatabase_Call {
+	return &MockProxy_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AlterDatabaseRequest)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterIndex provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterIndex(_a0 context.Context, _a1 *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterIndexRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterIndexRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterIndexRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterIndex'
+type MockProxy_AlterIndex_Call struct {
+	*mock.Call
+}
+
+// AlterIndex is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AlterIndexRequest
+func (_e *MockProxy_Expecter) AlterIndex(_a0 interface{}, _a1 interface{}) *MockProxy_AlterIndex_Call {
+	return &MockProxy_AlterIndex_Call{Call: _e.mock.On("AlterIndex", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterIndex_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AlterIndexRequest)) *MockProxy_AlterIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterIndexRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterIndex_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterIndexRequest) (*commonpb.Status, error)) *MockProxy_AlterIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackupRBAC provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) BackupRBAC(_a0 context.Context, _a1 *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.BackupRBACMetaResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.BackupRBACMetaRequest) *milvuspb.BackupRBACMetaResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BackupRBACMetaResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.BackupRBACMetaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_BackupRBAC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupRBAC'
+type MockProxy_BackupRBAC_Call struct {
+	*mock.Call
+}
+
+// BackupRBAC is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.BackupRBACMetaRequest
+func (_e *MockProxy_Expecter) BackupRBAC(_a0 interface{}, _a1 interface{}) *MockProxy_BackupRBAC_Call {
+	return &MockProxy_BackupRBAC_Call{Call: _e.mock.On("BackupRBAC", _a0, _a1)}
+}
+
+func (_c *MockProxy_BackupRBAC_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.BackupRBACMetaRequest)) *MockProxy_BackupRBAC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.BackupRBACMetaRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_BackupRBAC_Call) Return(_a0 *milvuspb.BackupRBACMetaResponse, _a1 error) *MockProxy_BackupRBAC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_BackupRBAC_Call) RunAndReturn(run func(context.Context, *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error)) *MockProxy_BackupRBAC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -694,6 +1026,61 @@ func (_c *MockProxy_CreatePartition_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreatePrivilegeGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreatePrivilegeGroup(_a0 context.Context, _a1 *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePrivilegeGroupRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreatePrivilegeGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreatePrivilegeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePrivilegeGroup'
+type MockProxy_CreatePrivilegeGroup_Call struct {
+	*mock.Call
+}
+
+// CreatePrivilegeGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.CreatePrivilegeGroupRequest
+func (_e *MockProxy_Expecter) CreatePrivilegeGroup(_a0 interface{}, _a1 interface{}) *MockProxy_CreatePrivilegeGroup_Call {
+	return &MockProxy_CreatePrivilegeGroup_Call{Call: _e.mock.On("CreatePrivilegeGroup", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreatePrivilegeGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreatePrivilegeGroupRequest)) *MockProxy_CreatePrivilegeGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CreatePrivilegeGroupRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreatePrivilegeGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreatePrivilegeGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreatePrivilegeGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error)) *MockProxy_CreatePrivilegeGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -734,31 +1121,86 @@ func (_e *MockProxy_Expecter) CreateResourceGroup(_a0 interface{}, _a1 interface
 
 func (_c *MockProxy_CreateResourceGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest)) *MockProxy_CreateResourceGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateResourceGroupRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateResourceGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)) *MockProxy_CreateResourceGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRole provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateRole(_a0 context.Context, _a1 *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateRoleRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockProxy_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.CreateRoleRequest
+func (_e *MockProxy_Expecter) CreateRole(_a0 interface{}, _a1 interface{}) *MockProxy_CreateRole_Call {
+	return &MockProxy_CreateRole_Call{Call: _e.mock.On("CreateRole", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateRole_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateRoleRequest)) *MockProxy_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateRoleRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_CreateResourceGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateResourceGroup_Call {
+func (_c *MockProxy_CreateRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_CreateResourceGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error)) *MockProxy_CreateResourceGroup_Call {
+func (_c *MockProxy_CreateRole_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)) *MockProxy_CreateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRole provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) CreateRole(_a0 context.Context, _a1 *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+// CreateRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateRowPolicy(_a0 context.Context, _a1 *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRowPolicyRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -766,7 +1208,7 @@ func (_m *MockProxy) CreateRole(_a0 context.Context, _a1 *milvuspb.CreateRoleReq
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateRoleRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateRowPolicyRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -775,31 +1217,31 @@ func (_m *MockProxy) CreateRole(_a0 context.Context, _a1 *milvuspb.CreateRoleReq
 	return r0, r1
 }
 
-// MockProxy_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
-type MockProxy_CreateRole_Call struct {
+// MockProxy_CreateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRowPolicy'
+type MockProxy_CreateRowPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateRole is a helper method to define mock.On call
+// CreateRowPolicy is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.CreateRoleRequest
-func (_e *MockProxy_Expecter) CreateRole(_a0 interface{}, _a1 interface{}) *MockProxy_CreateRole_Call {
-	return &MockProxy_CreateRole_Call{Call: _e.mock.On("CreateRole", _a0, _a1)}
+//   - _a1 *milvuspb.CreateRowPolicyRequest
+func (_e *MockProxy_Expecter) CreateRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_CreateRowPolicy_Call {
+	return &MockProxy_CreateRowPolicy_Call{Call: _e.mock.On("CreateRowPolicy", _a0, _a1)}
 }
 
-func (_c *MockProxy_CreateRole_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateRoleRequest)) *MockProxy_CreateRole_Call {
+func (_c *MockProxy_CreateRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CreateRowPolicyRequest)) *MockProxy_CreateRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateRoleRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateRowPolicyRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_CreateRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateRole_Call {
+func (_c *MockProxy_CreateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_CreateRole_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)) *MockProxy_CreateRole_Call {
+func (_c *MockProxy_CreateRowPolicy_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_CreateRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -914,6 +1356,61 @@ func (_c *MockProxy_DeleteCredential_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DeleteUserTags provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DeleteUserTags(_a0 context.Context, _a1 *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteUserTagsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DeleteUserTagsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DeleteUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserTags'
+type MockProxy_DeleteUserTags_Call struct {
+	*mock.Call
+}
+
+// DeleteUserTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DeleteUserTagsRequest
+func (_e *MockProxy_Expecter) DeleteUserTags(_a0 interface{}, _a1 interface{}) *MockProxy_DeleteUserTags_Call {
+	return &MockProxy_DeleteUserTags_Call{Call: _e.mock.On("DeleteUserTags", _a0, _a1)}
+}
+
+func (_c *MockProxy_DeleteUserTags_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DeleteUserTagsRequest)) *MockProxy_DeleteUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DeleteUserTagsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DeleteUserTags_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DeleteUserTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DeleteUserTags_Call) RunAndReturn(run func(context.Context, *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error)) *MockProxy_DeleteUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeAlias provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeAlias(_a0 context.Context, _a1 *milvuspb.DescribeAliasRequest) (*milvuspb.DescribeAliasResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1024,6 +1521,61 @@ func (_c *MockProxy_DescribeCollection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DescribeDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeDatabase(_a0 context.Context, _a1 *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeDatabaseRequest) *milvuspb.DescribeDatabaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DescribeDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type MockProxy_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DescribeDatabaseRequest
+func (_e *MockProxy_Expecter) DescribeDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeDatabase_Call {
+	return &MockProxy_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DescribeDatabaseRequest)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DescribeDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Return(_a0 *milvuspb.DescribeDatabaseResponse, _a1 error) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeIndex(_a0 context.Context, _a1 *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1299,6 +1851,61 @@ func (_c *MockProxy_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionField provides a mock function with given fields: ctx, request
+func (_m *MockProxy) DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type MockProxy_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *rootcoordpb.DropCollectionFieldRequest
+func (_e *MockProxy_Expecter) DropCollectionField(ctx interface{}, request interface{}) *MockProxy_DropCollectionField_Call {
+	return &MockProxy_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", ctx, request)}
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Run(run func(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1464,6 +2071,61 @@ func (_c *MockProxy_DropPartition_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DropPrivilegeGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropPrivilegeGroup(_a0 context.Context, _a1 *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPrivilegeGroupRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropPrivilegeGroupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropPrivilegeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropPrivilegeGroup'
+type MockProxy_DropPrivilegeGroup_Call struct {
+	*mock.Call
+}
+
+// DropPrivilegeGroup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropPrivilegeGroupRequest
+func (_e *MockProxy_Expecter) DropPrivilegeGroup(_a0 interface{}, _a1 interface{}) *MockProxy_DropPrivilegeGroup_Call {
+	return &MockProxy_DropPrivilegeGroup_Call{Call: _e.mock.On("DropPrivilegeGroup", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropPrivilegeGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropPrivilegeGroupRequest)) *MockProxy_DropPrivilegeGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropPrivilegeGroupRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropPrivilegeGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropPrivilegeGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropPrivilegeGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error)) *MockProxy_DropPrivilegeGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropResourceGroup(_a0 context.Context, _a1 *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1574,6 +2236,61 @@ func (_c *MockProxy_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropRowPolicy(_a0 context.Context, _a1 *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type MockProxy_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropRowPolicyRequest
+func (_e *MockProxy_Expecter) DropRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_DropRowPolicy_Call {
+	return &MockProxy_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropRowPolicyRequest)) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropRowPolicy_Call) RunAndReturn(run func(context.Context, *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dummy provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Dummy(_a0 context.Context, _a1 *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1605,26 +2322,81 @@ type MockProxy_Dummy_Call struct {
 	*mock.Call
 }
 
-// Dummy is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *milvuspb.DummyRequest
-func (_e *MockProxy_Expecter) Dummy(_a0 interface{}, _a1 interface{}) *MockProxy_Dummy_Call {
-	return &MockProxy_Dummy_Call{Call: _e.mock.On("Dummy", _a0, _a1)}
+// Dummy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DummyRequest
+func (_e *MockProxy_Expecter) Dummy(_a0 interface{}, _a1 interface{}) *MockProxy_Dummy_Call {
+	return &MockProxy_Dummy_Call{Call: _e.mock.On("Dummy", _a0, _a1)}
+}
+
+func (_c *MockProxy_Dummy_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DummyRequest)) *MockProxy_Dummy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DummyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_Dummy_Call) Return(_a0 *milvuspb.DummyResponse, _a1 error) *MockProxy_Dummy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_Dummy_Call) RunAndReturn(run func(context.Context, *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error)) *MockProxy_Dummy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Explain provides a mock function with given fields: ctx, request
+func (_m *MockProxy) Explain(ctx context.Context, request *types.ExplainRequest) (*types.ExplainResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *types.ExplainResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ExplainRequest) (*types.ExplainResponse, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ExplainRequest) *types.ExplainResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ExplainResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ExplainRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_Explain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Explain'
+type MockProxy_Explain_Call struct {
+	*mock.Call
+}
+
+// Explain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *types.ExplainRequest
+func (_e *MockProxy_Expecter) Explain(ctx interface{}, request interface{}) *MockProxy_Explain_Call {
+	return &MockProxy_Explain_Call{Call: _e.mock.On("Explain", ctx, request)}
 }
 
-func (_c *MockProxy_Dummy_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DummyRequest)) *MockProxy_Dummy_Call {
+func (_c *MockProxy_Explain_Call) Run(run func(ctx context.Context, request *types.ExplainRequest)) *MockProxy_Explain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.DummyRequest))
+		run(args[0].(context.Context), args[1].(*types.ExplainRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_Dummy_Call) Return(_a0 *milvuspb.DummyResponse, _a1 error) *MockProxy_Dummy_Call {
+func (_c *MockProxy_Explain_Call) Return(_a0 *types.ExplainResponse, _a1 error) *MockProxy_Explain_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_Dummy_Call) RunAndReturn(run func(context.Context, *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error)) *MockProxy_Dummy_Call {
+func (_c *MockProxy_Explain_Call) RunAndReturn(run func(context.Context, *types.ExplainRequest) (*types.ExplainResponse, error)) *MockProxy_Explain_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2933,6 +3705,61 @@ func (_c *MockProxy_GetStatisticsChannel_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetUserTags provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetUserTags(_a0 context.Context, _a1 *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetUserTagsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetUserTagsRequest) *milvuspb.GetUserTagsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetUserTagsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetUserTagsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetUserTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTags'
+type MockProxy_GetUserTags_Call struct {
+	*mock.Call
+}
+
+// GetUserTags is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetUserTagsRequest
+func (_e *MockProxy_Expecter) GetUserTags(_a0 interface{}, _a1 interface{}) *MockProxy_GetUserTags_Call {
+	return &MockProxy_GetUserTags_Call{Call: _e.mock.On("GetUserTags", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetUserTags_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetUserTagsRequest)) *MockProxy_GetUserTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetUserTagsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetUserTags_Call) Return(_a0 *milvuspb.GetUserTagsResponse, _a1 error) *MockProxy_GetUserTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetUserTags_Call) RunAndReturn(run func(context.Context, *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error)) *MockProxy_GetUserTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetVersion provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetVersion(_a0 context.Context, _a1 *milvuspb.GetVersionRequest) (*milvuspb.GetVersionResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3098,6 +3925,61 @@ func (_c *MockProxy_HasPartition_Call) RunAndReturn(run func(context.Context, *m
 	return _c
 }
 
+// HybridSearch provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) HybridSearch(_a0 context.Context, _a1 *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.SearchResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HybridSearchRequest) *milvuspb.SearchResults); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.SearchResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HybridSearchRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_HybridSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HybridSearch'
+type MockProxy_HybridSearch_Call struct {
+	*mock.Call
+}
+
+// HybridSearch is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.HybridSearchRequest
+func (_e *MockProxy_Expecter) HybridSearch(_a0 interface{}, _a1 interface{}) *MockProxy_HybridSearch_Call {
+	return &MockProxy_HybridSearch_Call{Call: _e.mock.On("HybridSearch", _a0, _a1)}
+}
+
+func (_c *MockProxy_HybridSearch_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.HybridSearchRequest)) *MockProxy_HybridSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.HybridSearchRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_HybridSearch_Call) Return(_a0 *milvuspb.SearchResults, _a1 error) *MockProxy_HybridSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_HybridSearch_Call) RunAndReturn(run func(context.Context, *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error)) *MockProxy_HybridSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Import provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Import(_a0 context.Context, _a1 *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3689,6 +4571,61 @@ func (_c *MockProxy_ListIndexedSegment_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListPrivilegeGroups provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListPrivilegeGroups(_a0 context.Context, _a1 *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ListPrivilegeGroupsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListPrivilegeGroupsRequest) *milvuspb.ListPrivilegeGroupsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListPrivilegeGroupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListPrivilegeGroupsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListPrivilegeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPrivilegeGroups'
+type MockProxy_ListPrivilegeGroups_Call struct {
+	*mock.Call
+}
+
+// ListPrivilegeGroups is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.ListPrivilegeGroupsRequest
+func (_e *MockProxy_Expecter) ListPrivilegeGroups(_a0 interface{}, _a1 interface{}) *MockProxy_ListPrivilegeGroups_Call {
+	return &MockProxy_ListPrivilegeGroups_Call{Call: _e.mock.On("ListPrivilegeGroups", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ListPrivilegeGroupsRequest)) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListPrivilegeGroupsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) Return(_a0 *milvuspb.ListPrivilegeGroupsResponse, _a1 error) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListPrivilegeGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error)) *MockProxy_ListPrivilegeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListResourceGroups provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListResourceGroups(_a0 context.Context, _a1 *milvuspb.ListResourceGroupsRequest) (*milvuspb.ListResourceGroupsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3744,6 +4681,116 @@ func (_c *MockProxy_ListResourceGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListRowPolicies(_a0 context.Context, _a1 *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListRowPoliciesRequest) *milvuspb.ListRowPoliciesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListRowPoliciesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockProxy_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.ListRowPoliciesRequest
+func (_e *MockProxy_Expecter) ListRowPolicies(_a0 interface{}, _a1 interface{}) *MockProxy_ListRowPolicies_Call {
+	return &MockProxy_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ListRowPoliciesRequest)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListRowPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Return(_a0 *milvuspb.ListRowPoliciesResponse, _a1 error) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersWithTag provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListUsersWithTag(_a0 context.Context, _a1 *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ListUsersWithTagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListUsersWithTagRequest) *milvuspb.ListUsersWithTagResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListUsersWithTagResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListUsersWithTagRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListUsersWithTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersWithTag'
+type MockProxy_ListUsersWithTag_Call struct {
+	*mock.Call
+}
+
+// ListUsersWithTag is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.ListUsersWithTagRequest
+func (_e *MockProxy_Expecter) ListUsersWithTag(_a0 interface{}, _a1 interface{}) *MockProxy_ListUsersWithTag_Call {
+	return &MockProxy_ListUsersWithTag_Call{Call: _e.mock.On("ListUsersWithTag", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListUsersWithTag_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ListUsersWithTagRequest)) *MockProxy_ListUsersWithTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ListUsersWithTagRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListUsersWithTag_Call) Return(_a0 *milvuspb.ListUsersWithTagResponse, _a1 error) *MockProxy_ListUsersWithTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListUsersWithTag_Call) RunAndReturn(run func(context.Context, *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error)) *MockProxy_ListUsersWithTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBalance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3922,11 +4969,66 @@ func (_m *MockProxy) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualC
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
+type MockProxy_ManualCompaction_Call struct {
+	*mock.Call
+}
+
+// ManualCompaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.ManualCompactionRequest
+func (_e *MockProxy_Expecter) ManualCompaction(_a0 interface{}, _a1 interface{}) *MockProxy_ManualCompaction_Call {
+	return &MockProxy_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", _a0, _a1)}
+}
+
+func (_c *MockProxy_ManualCompaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest)) *MockProxy_ManualCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockProxy_ManualCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockProxy_ManualCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OperatePrivilege provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -3935,45 +5037,45 @@ func (_m *MockProxy) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualC
 	return r0, r1
 }
 
-// MockProxy_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
-type MockProxy_ManualCompaction_Call struct {
+// MockProxy_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
+type MockProxy_OperatePrivilege_Call struct {
 	*mock.Call
 }
 
-// ManualCompaction is a helper method to define mock.On call
+// OperatePrivilege is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.ManualCompactionRequest
-func (_e *MockProxy_Expecter) ManualCompaction(_a0 interface{}, _a1 interface{}) *MockProxy_ManualCompaction_Call {
-	return &MockProxy_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", _a0, _a1)}
+//   - _a1 *milvuspb.OperatePrivilegeRequest
+func (_e *MockProxy_Expecter) OperatePrivilege(_a0 interface{}, _a1 interface{}) *MockProxy_OperatePrivilege_Call {
+	return &MockProxy_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege", _a0, _a1)}
 }
 
-func (_c *MockProxy_ManualCompaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest)) *MockProxy_ManualCompaction_Call {
+func (_c *MockProxy_OperatePrivilege_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest)) *MockProxy_OperatePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockProxy_ManualCompaction_Call {
+func (_c *MockProxy_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperatePrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockProxy_ManualCompaction_Call {
+func (_c *MockProxy_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)) *MockProxy_OperatePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperatePrivilege provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+// OperatePrivilegeGroup provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) OperatePrivilegeGroup(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -3981,7 +5083,7 @@ func (_m *MockProxy) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.Operate
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeGroupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -3990,31 +5092,31 @@ func (_m *MockProxy) OperatePrivilege(_a0 context.Context, _a1 *milvuspb.Operate
 	return r0, r1
 }
 
-// MockProxy_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
-type MockProxy_OperatePrivilege_Call struct {
+// MockProxy_OperatePrivilegeGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilegeGroup'
+type MockProxy_OperatePrivilegeGroup_Call struct {
 	*mock.Call
 }
 
-// OperatePrivilege is a helper method to define mock.On call
+// OperatePrivilegeGroup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.OperatePrivilegeRequest
-func (_e *MockProxy_Expecter) OperatePrivilege(_a0 interface{}, _a1 interface{}) *MockProxy_OperatePrivilege_Call {
-	return &MockProxy_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege", _a0, _a1)}
+//   - _a1 *milvuspb.OperatePrivilegeGroupRequest
+func (_e *MockProxy_Expecter) OperatePrivilegeGroup(_a0 interface{}, _a1 interface{}) *MockProxy_OperatePrivilegeGroup_Call {
+	return &MockProxy_OperatePrivilegeGroup_Call{Call: _e.mock.On("OperatePrivilegeGroup", _a0, _a1)}
 }
 
-func (_c *MockProxy_OperatePrivilege_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeRequest)) *MockProxy_OperatePrivilege_Call {
+func (_c *MockProxy_OperatePrivilegeGroup_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.OperatePrivilegeGroupRequest)) *MockProxy_OperatePrivilegeGroup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeGroupRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperatePrivilege_Call {
+func (_c *MockProxy_OperatePrivilegeGroup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperatePrivilegeGroup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)) *MockProxy_OperatePrivilege_Call {
+func (_c *MockProxy_OperatePrivilegeGroup_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error)) *MockProxy_OperatePrivilegeGroup_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4129,6 +5231,112 @@ func (_c *MockProxy_Query_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// QueryIterator provides a mock function with given fields: ctx, request
+func (_m *MockProxy) QueryIterator(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *milvuspb.QueryResults
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) *milvuspb.QueryResults); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.QueryResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.QueryRequest) string); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *milvuspb.QueryRequest) error); ok {
+		r2 = rf(ctx, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProxy_QueryIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryIterator'
+type MockProxy_QueryIterator_Call struct {
+	*mock.Call
+}
+
+// QueryIterator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *milvuspb.QueryRequest
+func (_e *MockProxy_Expecter) QueryIterator(ctx interface{}, request interface{}) *MockProxy_QueryIterator_Call {
+	return &MockProxy_QueryIterator_Call{Call: _e.mock.On("QueryIterator", ctx, request)}
+}
+
+func (_c *MockProxy_QueryIterator_Call) Run(run func(ctx context.Context, request *milvuspb.QueryRequest)) *MockProxy_QueryIterator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.QueryRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_QueryIterator_Call) Return(_a0 *milvuspb.QueryResults, _a1 string, _a2 error) *MockProxy_QueryIterator_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProxy_QueryIterator_Call) RunAndReturn(run func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error)) *MockProxy_QueryIterator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryStream provides a mock function with given fields: ctx, request, sender
+func (_m *MockProxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error) error {
+	ret := _m.Called(ctx, request, sender)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest, func(*milvuspb.QueryResults) error) error); ok {
+		r0 = rf(ctx, request, sender)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_QueryStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryStream'
+type MockProxy_QueryStream_Call struct {
+	*mock.Call
+}
+
+// QueryStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *milvuspb.QueryRequest
+//   - sender func(*milvuspb.QueryResults) error
+func (_e *MockProxy_Expecter) QueryStream(ctx interface{}, request interface{}, sender interface{}) *MockProxy_QueryStream_Call {
+	return &MockProxy_QueryStream_Call{Call: _e.mock.On("QueryStream", ctx, request, sender)}
+}
+
+func (_c *MockProxy_QueryStream_Call) Run(run func(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error)) *MockProxy_QueryStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.QueryRequest), args[2].(func(*milvuspb.QueryResults) error))
+	})
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) Return(_a0 error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) RunAndReturn(run func(context.Context, *milvuspb.QueryRequest, func(*milvuspb.QueryResults) error) error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) RefreshPolicyInfoCache(_a0 context.Context, _a1 *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4500,6 +5708,116 @@ func (_c *MockProxy_ReplicateMessage_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RestoreRBAC provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RestoreRBAC(_a0 context.Context, _a1 *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RestoreRBACMetaRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RestoreRBACMetaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RestoreRBAC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRBAC'
+type MockProxy_RestoreRBAC_Call struct {
+	*mock.Call
+}
+
+// RestoreRBAC is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.RestoreRBACMetaRequest
+func (_e *MockProxy_Expecter) RestoreRBAC(_a0 interface{}, _a1 interface{}) *MockProxy_RestoreRBAC_Call {
+	return &MockProxy_RestoreRBAC_Call{Call: _e.mock.On("RestoreRBAC", _a0, _a1)}
+}
+
+func (_c *MockProxy_RestoreRBAC_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.RestoreRBACMetaRequest)) *MockProxy_RestoreRBAC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.RestoreRBACMetaRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RestoreRBAC_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RestoreRBAC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RestoreRBAC_Call) RunAndReturn(run func(context.Context, *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error)) *MockProxy_RestoreRBAC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunAnalyzer provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RunAnalyzer(_a0 context.Context, _a1 *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.RunAnalyzerResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RunAnalyzerRequest) *milvuspb.RunAnalyzerResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.RunAnalyzerResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RunAnalyzerRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RunAnalyzer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunAnalyzer'
+type MockProxy_RunAnalyzer_Call struct {
+	*mock.Call
+}
+
+// RunAnalyzer is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.RunAnalyzerRequest
+func (_e *MockProxy_Expecter) RunAnalyzer(_a0 interface{}, _a1 interface{}) *MockProxy_RunAnalyzer_Call {
+	return &MockProxy_RunAnalyzer_Call{Call: _e.mock.On("RunAnalyzer", _a0, _a1)}
+}
+
+func (_c *MockProxy_RunAnalyzer_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.RunAnalyzerRequest)) *MockProxy_RunAnalyzer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.RunAnalyzerRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RunAnalyzer_Call) Return(_a0 *milvuspb.RunAnalyzerResponse, _a1 error) *MockProxy_RunAnalyzer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RunAnalyzer_Call) RunAndReturn(run func(context.Context, *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error)) *MockProxy_RunAnalyzer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Search(_a0 context.Context, _a1 *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4555,6 +5873,68 @@ func (_c *MockProxy_Search_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// SearchIterator provides a mock function with given fields: ctx, request
+func (_m *MockProxy) SearchIterator(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *milvuspb.SearchResults
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error)); ok {
+		return rf(ctx, request)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.SearchRequest) *milvuspb.SearchResults); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.SearchResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.SearchRequest) string); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *milvuspb.SearchRequest) error); ok {
+		r2 = rf(ctx, request)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockProxy_SearchIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchIterator'
+type MockProxy_SearchIterator_Call struct {
+	*mock.Call
+}
+
+// SearchIterator is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *milvuspb.SearchRequest
+func (_e *MockProxy_Expecter) SearchIterator(ctx interface{}, request interface{}) *MockProxy_SearchIterator_Call {
+	return &MockProxy_SearchIterator_Call{Call: _e.mock.On("SearchIterator", ctx, request)}
+}
+
+func (_c *MockProxy_SearchIterator_Call) Run(run func(ctx context.Context, request *milvuspb.SearchRequest)) *MockProxy_SearchIterator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.SearchRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SearchIterator_Call) Return(_a0 *milvuspb.SearchResults, _a1 string, _a2 error) *MockProxy_SearchIterator_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockProxy_SearchIterator_Call) RunAndReturn(run func(context.Context, *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error)) *MockProxy_SearchIterator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5385,6 +6765,61 @@ func (_c *MockProxy_UpdateCredentialCache_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UpdateResourceGroups provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) UpdateResourceGroups(_a0 context.Context, _a1 *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.UpdateResourceGroupsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.UpdateResourceGroupsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_UpdateResourceGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResourceGroups'
+type MockProxy_UpdateResourceGroups_Call struct {
+	*mock.Call
+}
+
+// UpdateResourceGroups is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.UpdateResourceGroupsRequest
+func (_e *MockProxy_Expecter) UpdateResourceGroups(_a0 interface{}, _a1 interface{}) *MockProxy_UpdateResourceGroups_Call {
+	return &MockProxy_UpdateResourceGroups_Call{Call: _e.mock.On("UpdateResourceGroups", _a0, _a1)}
+}
+
+func (_c *MockProxy_UpdateResourceGroups_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.UpdateResourceGroupsRequest)) *MockProxy_UpdateResourceGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.UpdateResourceGroupsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_UpdateResourceGroups_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_UpdateResourceGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_UpdateResourceGroups_Call) RunAndReturn(run func(context.Context, *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error)) *MockProxy_UpdateResourceGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockProxy) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
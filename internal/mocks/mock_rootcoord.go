@@ -36,6 +36,61 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AddCollectionField(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -916,6 +971,61 @@ func (_c *RootCoord_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropCollectionField(_a0 context.Context, _a1 *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionField'
+type RootCoord_DropCollectionField_Call struct {
+	*mock.Call
+}
+
+// DropCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.DropCollectionFieldRequest
+func (_e *RootCoord_Expecter) DropCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_DropCollectionField_Call {
+	return &RootCoord_DropCollectionField_Call{Call: _e.mock.On("DropCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.DropCollectionFieldRequest)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionField_Call) RunAndReturn(run func(context.Context, *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_DropCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1947,6 +2057,61 @@ func (_c *RootCoord_OperatePrivilege_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// OperateRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) OperateRowPolicy(_a0 context.Context, _a1 *rootcoordpb.OperateRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.OperateRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.OperateRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_OperateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRowPolicy'
+type RootCoord_OperateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// OperateRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.OperateRowPolicyRequest
+func (_e *RootCoord_Expecter) OperateRowPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_OperateRowPolicy_Call {
+	return &RootCoord_OperateRowPolicy_Call{Call: _e.mock.On("OperateRowPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_OperateRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.OperateRowPolicyRequest)) *RootCoord_OperateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.OperateRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_OperateRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_OperateRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_OperateRowPolicy_Call) RunAndReturn(run func(context.Context, *rootcoordpb.OperateRowPolicyRequest) (*commonpb.Status, error)) *RootCoord_OperateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) OperateUserRole(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -7,12 +7,13 @@ expr:
 	| StringLiteral											                     # String
 	| Identifier											                     # Identifier
 	| JSONIdentifier                                                             # JSONIdentifier
+	| CAST '(' expr AS Identifier ')'                                            # Cast
+	| Identifier '(' (expr (',' expr)* ','?)? ')'                                # Call
 	| '(' expr ')'											                     # Parens
 	| '[' expr (',' expr)* ','? ']'                                              # Array
 	| expr LIKE StringLiteral                                                    # Like
 	| expr POW expr											                     # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                     # Unary
-//	| '(' typeName ')' expr									                     # Cast
 	| expr op = (MUL | DIV | MOD) expr						                     # MulDivMod
 	| expr op = (ADD | SUB) expr							                     # AddSub
 	| expr op = (SHL | SHR) expr							                     # Shift
@@ -22,6 +23,8 @@ expr:
 	| (JSONContainsAll | ArrayContainsAll)'('expr',' expr')'                     # JSONContainsAll
 	| (JSONContainsAny | ArrayContainsAny)'('expr',' expr')'                     # JSONContainsAny
 	| ArrayLength'('(Identifier | JSONIdentifier)')'                             # ArrayLength
+	| RegexMatch'('expr',' StringLiteral')'                                      # RegexMatch
+	| TextMatch'('expr',' StringLiteral')'                                       # TextMatch
 	| expr op1 = (LT | LE) (Identifier | JSONIdentifier) op2 = (LT | LE) expr	 # Range
 	| expr op1 = (GT | GE) (Identifier | JSONIdentifier) op2 = (GT | GE) expr    # ReverseRange
 	| expr op = (LT | LE | GT | GE) expr					                     # Relational
@@ -33,16 +36,6 @@ expr:
 	| expr OR expr											                     # LogicalOr
 	| EXISTS expr                                                                # Exists;
 
-// typeName: ty = (BOOL | INT8 | INT16 | INT32 | INT64 | FLOAT | DOUBLE);
-
-// BOOL: 'bool';
-// INT8: 'int8';
-// INT16: 'int16';
-// INT32: 'int32';
-// INT64: 'int64';
-// FLOAT: 'float';
-// DOUBLE: 'double';
-
 LT: '<';
 LE: '<=';
 GT: '>';
@@ -52,6 +45,8 @@ NE: '!=';
 
 LIKE: 'like' | 'LIKE';
 EXISTS: 'exists' | 'EXISTS';
+CAST: 'cast' | 'CAST';
+AS: 'as' | 'AS';
 
 ADD: '+';
 SUB: '-';
@@ -84,6 +79,9 @@ ArrayContainsAll: 'array_contains_all' | 'ARRAY_CONTAINS_ALL';
 ArrayContainsAny: 'array_contains_any' | 'ARRAY_CONTAINS_ANY';
 ArrayLength: 'array_length' | 'ARRAY_LENGTH';
 
+RegexMatch: 'regex_match' | 'REGEX_MATCH';
+TextMatch: 'text_match' | 'TEXT_MATCH';
+
 BooleanConstant: 'true' | 'True' | 'TRUE' | 'false' | 'False' | 'FALSE';
 
 IntegerConstant:
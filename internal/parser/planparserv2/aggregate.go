@@ -0,0 +1,153 @@
+package planparserv2
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var (
+	aggregatePattern = regexp.MustCompile(`(?i)^\s*(count|sum|min|max|avg)\s*\(\s*(.*?)\s*\)\s*$`)
+	aggregateOps     = map[string]planpb.Aggregate_Op{
+		"count": planpb.Aggregate_Count,
+		"sum":   planpb.Aggregate_Sum,
+		"min":   planpb.Aggregate_Min,
+		"max":   planpb.Aggregate_Max,
+		"avg":   planpb.Aggregate_Avg,
+	}
+)
+
+// IsAggregate returns whether the output field is an aggregate, like count(*), sum(price) or max(meta["score"]).
+func IsAggregate(outputField string) bool {
+	return aggregatePattern.MatchString(outputField)
+}
+
+// IsAggregatePlan returns whether the retrieve plan computes aggregates instead of returning entities.
+func IsAggregatePlan(plan *planpb.PlanNode) bool {
+	query := plan.GetQuery()
+	return len(query.GetAggregates()) > 0 || len(query.GetGroupBy()) > 0
+}
+
+func parseColumn(schema *typeutil.SchemaHelper, identifier string) (*planpb.ColumnInfo, error) {
+	var column *planpb.ColumnInfo
+	err := ParseIdentifier(schema, identifier, func(expr *planpb.Expr) error {
+		column = expr.GetColumnExpr().GetInfo()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// ParseAggregate parses the aggregate output field, the column of numeric aggregates must be
+// a numeric field or a path of json field.
+func ParseAggregate(schema *typeutil.SchemaHelper, outputField string) (*planpb.Aggregate, error) {
+	matches := aggregatePattern.FindStringSubmatch(outputField)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid aggregate: %s", outputField)
+	}
+	aggregate := &planpb.Aggregate{Op: aggregateOps[strings.ToLower(matches[1])]}
+	if matches[2] == "*" {
+		if aggregate.Op != planpb.Aggregate_Count {
+			return nil, fmt.Errorf("only count supports *: %s", outputField)
+		}
+		return aggregate, nil
+	}
+
+	column, err := parseColumn(schema, matches[2])
+	if err != nil {
+		return nil, err
+	}
+	dataType := column.GetDataType()
+	switch {
+	case typeutil.IsVectorType(dataType) || typeutil.IsArrayType(dataType):
+		return nil, fmt.Errorf("aggregate on %s field is not supported: %s", dataType.String(), outputField)
+	case aggregate.Op == planpb.Aggregate_Count:
+	case typeutil.IsIntegerType(dataType), typeutil.IsFloatingType(dataType), typeutil.IsJSONType(dataType):
+	default:
+		return nil, fmt.Errorf("%s of %s field is not supported: %s", matches[1], dataType.String(), outputField)
+	}
+	aggregate.Column = column
+	return aggregate, nil
+}
+
+// ParseGroupBy parses the group by field, it must be a bool, integer or string field, or a path of json field.
+func ParseGroupBy(schema *typeutil.SchemaHelper, field string) (*planpb.ColumnInfo, error) {
+	column, err := parseColumn(schema, field)
+	if err != nil {
+		return nil, err
+	}
+	dataType := column.GetDataType()
+	if !typeutil.IsBoolType(dataType) && !typeutil.IsIntegerType(dataType) &&
+		!typeutil.IsStringType(dataType) && !typeutil.IsJSONType(dataType) {
+		return nil, fmt.Errorf("group by %s field is not supported: %s", dataType.String(), field)
+	}
+	return column, nil
+}
+
+func sameColumn(a, b *planpb.ColumnInfo) bool {
+	if a.GetFieldId() != b.GetFieldId() || len(a.GetNestedPath()) != len(b.GetNestedPath()) {
+		return false
+	}
+	for i := range a.GetNestedPath() {
+		if a.GetNestedPath()[i] != b.GetNestedPath()[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateAggregatePlan creates the retrieve plan which computes the aggregates of output fields for every
+// group of groupBy fields. Output fields which are not aggregates must be one of the group by fields.
+func CreateAggregatePlan(schemaPb *schemapb.CollectionSchema, exprStr string, outputFields []string, groupBy []string) (*planpb.PlanNode, error) {
+	schema, err := typeutil.CreateSchemaHelper(schemaPb)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := CreateRetrievePlan(schemaPb, exprStr)
+	if err != nil {
+		return nil, err
+	}
+	query := plan.GetQuery()
+	for _, field := range groupBy {
+		column, err := ParseGroupBy(schema, field)
+		if err != nil {
+			return nil, err
+		}
+		query.GroupBy = append(query.GroupBy, column)
+	}
+	for _, outputField := range outputFields {
+		if IsAggregate(outputField) {
+			aggregate, err := ParseAggregate(schema, outputField)
+			if err != nil {
+				return nil, err
+			}
+			query.Aggregates = append(query.Aggregates, aggregate)
+			continue
+		}
+		column, err := parseColumn(schema, outputField)
+		if err != nil {
+			return nil, err
+		}
+		grouped := false
+		for _, groupByColumn := range query.GroupBy {
+			if sameColumn(column, groupByColumn) {
+				grouped = true
+				break
+			}
+		}
+		if !grouped {
+			return nil, fmt.Errorf("output field %s must be an aggregate or a group by field", outputField)
+		}
+	}
+	if !IsAggregatePlan(plan) {
+		return nil, fmt.Errorf("neither aggregate nor group by field is given")
+	}
+	return plan, nil
+}
@@ -0,0 +1,76 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func TestIsAggregate(t *testing.T) {
+	assert.True(t, IsAggregate("count(*)"))
+	assert.True(t, IsAggregate(" SUM( Int64Field ) "))
+	assert.True(t, IsAggregate(`max(JSONField["a"])`))
+	assert.False(t, IsAggregate("Int64Field"))
+	assert.False(t, IsAggregate("median(Int64Field)"))
+}
+
+func TestCreateAggregatePlan(t *testing.T) {
+	schema := newTestSchema()
+
+	t.Run("group by", func(t *testing.T) {
+		plan, err := CreateAggregatePlan(schema, "Int64Field > 0",
+			[]string{"VarCharField", "count(*)", "sum(Int64Field)", `avg(JSONField["a"])`, "max(B)"},
+			[]string{"VarCharField", `JSONField["b"]`})
+		assert.NoError(t, err)
+		assert.True(t, IsAggregatePlan(plan))
+		assert.False(t, IsAlwaysTruePlan(plan))
+
+		query := plan.GetQuery()
+		assert.NotNil(t, query.GetPredicates())
+		assert.Equal(t, 2, len(query.GetGroupBy()))
+		assert.Equal(t, schemapb.DataType_VarChar, query.GetGroupBy()[0].GetDataType())
+		assert.Equal(t, []string{"b"}, query.GetGroupBy()[1].GetNestedPath())
+
+		aggregates := query.GetAggregates()
+		assert.Equal(t, 4, len(aggregates))
+		assert.Equal(t, planpb.Aggregate_Count, aggregates[0].GetOp())
+		assert.Nil(t, aggregates[0].GetColumn())
+		assert.Equal(t, planpb.Aggregate_Sum, aggregates[1].GetOp())
+		assert.Equal(t, schemapb.DataType_Int64, aggregates[1].GetColumn().GetDataType())
+		assert.Equal(t, planpb.Aggregate_Avg, aggregates[2].GetOp())
+		assert.Equal(t, []string{"a"}, aggregates[2].GetColumn().GetNestedPath())
+		assert.Equal(t, planpb.Aggregate_Max, aggregates[3].GetOp())
+		assert.Equal(t, []string{"B"}, aggregates[3].GetColumn().GetNestedPath())
+	})
+
+	t.Run("aggregate without group by", func(t *testing.T) {
+		plan, err := CreateAggregatePlan(schema, "", []string{"min(DoubleField)", "count(BoolField)"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(plan.GetQuery().GetGroupBy()))
+		assert.Equal(t, 2, len(plan.GetQuery().GetAggregates()))
+		assert.False(t, IsAlwaysTruePlan(plan))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalids := []struct {
+			outputFields []string
+			groupBy      []string
+		}{
+			{[]string{"sum(*)"}, nil},
+			{[]string{"sum(VarCharField)"}, nil},
+			{[]string{"count(FloatVectorField)"}, nil},
+			{[]string{"avg(ArrayField)"}, nil},
+			{[]string{"Int64Field", "count(*)"}, []string{"VarCharField"}},
+			{[]string{"count(*)"}, []string{"FloatField"}},
+			{[]string{"count(*)"}, []string{"Int64Field + 1"}},
+			{[]string{"VarCharField"}, nil},
+		}
+		for _, c := range invalids {
+			_, err := CreateAggregatePlan(schema, "", c.outputFields, c.groupBy)
+			assert.Error(t, err, c.outputFields)
+		}
+	})
+}
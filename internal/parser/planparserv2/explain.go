@@ -0,0 +1,150 @@
+package planparserv2
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// The default selectivity of predicates, there is no statistics of field values yet,
+// so they are estimated by the kind of predicates like most planners do without histograms.
+const (
+	equalSelectivity   = 0.005
+	rangeSelectivity   = 1.0 / 3
+	betweenSelectivity = 1.0 / 4
+	matchSelectivity   = 0.1
+	existsSelectivity  = 0.9
+	defaultSelectivity = 0.5
+)
+
+// Predicate is the leaf of the boolean expression, like a > 1 or json_contains(b, 2).
+type Predicate struct {
+	Expr *planpb.Expr
+	// Columns are the fields the predicate filters
+	Columns []*planpb.ColumnInfo
+	// Selectivity is the estimated fraction of rows passing the predicate
+	Selectivity float64
+}
+
+// ShowExprTree returns the expr tree as json object, see ShowExpr.
+func ShowExprTree(expr *planpb.Expr) interface{} {
+	return NewShowExprVisitor().VisitExpr(expr)
+}
+
+// ExtractPredicates returns the leaf predicates of expr from left to right.
+func ExtractPredicates(expr *planpb.Expr) []*Predicate {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		return ExtractPredicates(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		return append(ExtractPredicates(e.BinaryExpr.GetLeft()), ExtractPredicates(e.BinaryExpr.GetRight())...)
+	case *planpb.Expr_AlwaysTrueExpr, nil:
+		return nil
+	default:
+		return []*Predicate{{
+			Expr:        expr,
+			Columns:     predicateColumns(expr),
+			Selectivity: EstimateSelectivity(expr),
+		}}
+	}
+}
+
+// ExprColumns returns all the columns expr refers to, including the ones in arithmetic, cast and function calls.
+func ExprColumns(expr *planpb.Expr) []*planpb.ColumnInfo {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		return ExprColumns(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		return append(ExprColumns(e.BinaryExpr.GetLeft()), ExprColumns(e.BinaryExpr.GetRight())...)
+	case *planpb.Expr_BinaryArithExpr:
+		return append(ExprColumns(e.BinaryArithExpr.GetLeft()), ExprColumns(e.BinaryArithExpr.GetRight())...)
+	case *planpb.Expr_ComputedCompareExpr:
+		return append(ExprColumns(e.ComputedCompareExpr.GetLeft()), ExprColumns(e.ComputedCompareExpr.GetRight())...)
+	case *planpb.Expr_CastExpr:
+		return ExprColumns(e.CastExpr.GetChild())
+	case *planpb.Expr_CallExpr:
+		columns := make([]*planpb.ColumnInfo, 0)
+		for _, param := range e.CallExpr.GetFunctionParameters() {
+			columns = append(columns, ExprColumns(param)...)
+		}
+		return columns
+	default:
+		return predicateColumns(expr)
+	}
+}
+
+func predicateColumns(expr *planpb.Expr) []*planpb.ColumnInfo {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		return []*planpb.ColumnInfo{e.TermExpr.GetColumnInfo()}
+	case *planpb.Expr_UnaryRangeExpr:
+		return []*planpb.ColumnInfo{e.UnaryRangeExpr.GetColumnInfo()}
+	case *planpb.Expr_BinaryRangeExpr:
+		return []*planpb.ColumnInfo{e.BinaryRangeExpr.GetColumnInfo()}
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		return []*planpb.ColumnInfo{e.BinaryArithOpEvalRangeExpr.GetColumnInfo()}
+	case *planpb.Expr_CompareExpr:
+		return []*planpb.ColumnInfo{e.CompareExpr.GetLeftColumnInfo(), e.CompareExpr.GetRightColumnInfo()}
+	case *planpb.Expr_ExistsExpr:
+		return []*planpb.ColumnInfo{e.ExistsExpr.GetInfo()}
+	case *planpb.Expr_JsonContainsExpr:
+		return []*planpb.ColumnInfo{e.JsonContainsExpr.GetColumnInfo()}
+	case *planpb.Expr_ColumnExpr:
+		return []*planpb.ColumnInfo{e.ColumnExpr.GetInfo()}
+	default:
+		return nil
+	}
+}
+
+func opSelectivity(op planpb.OpType) float64 {
+	switch op {
+	case planpb.OpType_Equal:
+		return equalSelectivity
+	case planpb.OpType_NotEqual:
+		return 1 - equalSelectivity
+	case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan, planpb.OpType_LessEqual:
+		return rangeSelectivity
+	case planpb.OpType_PrefixMatch, planpb.OpType_PostfixMatch, planpb.OpType_Match,
+		planpb.OpType_RegexMatch, planpb.OpType_TextMatch:
+		return matchSelectivity
+	default:
+		return defaultSelectivity
+	}
+}
+
+// EstimateSelectivity estimates the fraction of rows passing expr, the predicates are assumed independent.
+func EstimateSelectivity(expr *planpb.Expr) float64 {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_AlwaysTrueExpr, nil:
+		return 1
+	case *planpb.Expr_UnaryExpr:
+		return 1 - EstimateSelectivity(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		left, right := EstimateSelectivity(e.BinaryExpr.GetLeft()), EstimateSelectivity(e.BinaryExpr.GetRight())
+		if e.BinaryExpr.GetOp() == planpb.BinaryExpr_LogicalOr {
+			return left + right - left*right
+		}
+		return left * right
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetIsInField() {
+			return matchSelectivity
+		}
+		s := equalSelectivity * float64(len(e.TermExpr.GetValues()))
+		if s > 1 {
+			return 1
+		}
+		return s
+	case *planpb.Expr_UnaryRangeExpr:
+		return opSelectivity(e.UnaryRangeExpr.GetOp())
+	case *planpb.Expr_BinaryRangeExpr:
+		return betweenSelectivity
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		return opSelectivity(e.BinaryArithOpEvalRangeExpr.GetOp())
+	case *planpb.Expr_CompareExpr:
+		return opSelectivity(e.CompareExpr.GetOp())
+	case *planpb.Expr_ExistsExpr:
+		return existsSelectivity
+	case *planpb.Expr_JsonContainsExpr:
+		return matchSelectivity
+	default:
+		return defaultSelectivity
+	}
+}
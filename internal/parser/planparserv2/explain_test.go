@@ -0,0 +1,81 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestExtractPredicates(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `Int64Field in [1, 2] && (VarCharField like "a%" || not (FloatField > 1.0))`)
+	assert.NoError(t, err)
+
+	predicates := ExtractPredicates(expr)
+	assert.Equal(t, 3, len(predicates))
+	assert.NotNil(t, predicates[0].Expr.GetTermExpr())
+	assert.Equal(t, "Int64Field", mustFieldName(t, helper, predicates[0].Columns[0]))
+	assert.Equal(t, planpb.OpType_PrefixMatch, predicates[1].Expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "VarCharField", mustFieldName(t, helper, predicates[1].Columns[0]))
+	assert.Equal(t, "FloatField", mustFieldName(t, helper, predicates[2].Columns[0]))
+	assert.InDelta(t, 2*equalSelectivity, predicates[0].Selectivity, 1e-9)
+	assert.InDelta(t, rangeSelectivity, predicates[2].Selectivity, 1e-9)
+
+	// a and (b or not c)
+	notC := 1 - rangeSelectivity
+	expected := 2 * equalSelectivity * (matchSelectivity + notC - matchSelectivity*notC)
+	assert.InDelta(t, expected, EstimateSelectivity(expr), 1e-9)
+
+	expr, err = ParseExpr(helper, `Int64Field > Int32Field`)
+	assert.NoError(t, err)
+	predicates = ExtractPredicates(expr)
+	assert.Equal(t, 1, len(predicates))
+	assert.Equal(t, 2, len(predicates[0].Columns))
+
+	assert.Empty(t, ExtractPredicates(alwaysTrueExpr()))
+	assert.Equal(t, float64(1), EstimateSelectivity(alwaysTrueExpr()))
+}
+
+func TestExprColumns(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `cast(Int8Field as int64) > 1 && (floor(Int64Field) >= round(2) || not (FloatField > Int32Field))`)
+	assert.NoError(t, err)
+	names := make([]string, 0)
+	for _, column := range ExprColumns(expr) {
+		names = append(names, mustFieldName(t, helper, column))
+	}
+	assert.ElementsMatch(t, []string{"Int8Field", "Int64Field", "FloatField", "Int32Field"}, names)
+}
+
+func TestShowExprTree(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `exists $meta["A"] && json_contains(JSONField["B"], 1)`)
+	assert.NoError(t, err)
+	tree, ok := ShowExprTree(expr).(map[string]interface{})
+	assert.True(t, ok)
+	binary := tree["expr"].(map[string]interface{})
+	assert.Equal(t, "LogicalAnd", binary["expr_type"])
+	left := binary["left_child"].(map[string]interface{})["expr"].(map[string]interface{})
+	assert.Equal(t, "exists", left["expr_type"])
+	right := binary["right_child"].(map[string]interface{})["expr"].(map[string]interface{})
+	assert.Equal(t, "json_contains", right["expr_type"])
+	assert.Equal(t, []string{"B"}, right["column_info"].(map[string]interface{})["nested_path"])
+}
+
+func mustFieldName(t *testing.T, helper *typeutil.SchemaHelper, column *planpb.ColumnInfo) string {
+	field, err := helper.GetFieldFromID(column.GetFieldId())
+	assert.NoError(t, err)
+	return field.GetName()
+}
@@ -0,0 +1,298 @@
+package planparserv2
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// castTypes are the data types which can be used in cast(expr as type).
+var castTypes = map[string]schemapb.DataType{
+	"bool":    schemapb.DataType_Bool,
+	"int8":    schemapb.DataType_Int8,
+	"int16":   schemapb.DataType_Int16,
+	"int32":   schemapb.DataType_Int32,
+	"int64":   schemapb.DataType_Int64,
+	"float":   schemapb.DataType_Float,
+	"double":  schemapb.DataType_Double,
+	"varchar": schemapb.DataType_VarChar,
+}
+
+func castTypeNames() string {
+	names := make([]string, 0, len(castTypes))
+	for name := range castTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func integerInRange(value int64, dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8:
+		return value >= math.MinInt8 && value <= math.MaxInt8
+	case schemapb.DataType_Int16:
+		return value >= math.MinInt16 && value <= math.MaxInt16
+	case schemapb.DataType_Int32:
+		return value >= math.MinInt32 && value <= math.MaxInt32
+	default:
+		return true
+	}
+}
+
+// castConstant casts the constant value to dataType, integers must be in the range of dataType
+// and floating values are truncated when they're cast to integers.
+func castConstant(value *planpb.GenericValue, dataType schemapb.DataType) (*planpb.GenericValue, error) {
+	invalid := fmt.Errorf("cannot cast %s to %s", value, dataType.String())
+	switch {
+	case typeutil.IsBoolType(dataType):
+		switch {
+		case IsBool(value):
+			return value, nil
+		case IsInteger(value):
+			return NewBool(value.GetInt64Val() != 0), nil
+		case IsString(value):
+			b, err := strconv.ParseBool(strings.TrimSpace(value.GetStringVal()))
+			if err != nil {
+				return nil, invalid
+			}
+			return NewBool(b), nil
+		}
+	case typeutil.IsIntegerType(dataType):
+		var i int64
+		switch {
+		case IsBool(value):
+			if value.GetBoolVal() {
+				i = 1
+			}
+		case IsInteger(value):
+			i = value.GetInt64Val()
+		case IsFloating(value):
+			f := math.Trunc(value.GetFloatVal())
+			if math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return nil, fmt.Errorf("%v is out of range of %s", value.GetFloatVal(), dataType.String())
+			}
+			i = int64(f)
+		case IsString(value):
+			var err error
+			i, err = strconv.ParseInt(strings.TrimSpace(value.GetStringVal()), 0, 64)
+			if err != nil {
+				return nil, invalid
+			}
+		default:
+			return nil, invalid
+		}
+		if !integerInRange(i, dataType) {
+			return nil, fmt.Errorf("%d is out of range of %s", i, dataType.String())
+		}
+		return NewInt(i), nil
+	case typeutil.IsFloatingType(dataType):
+		var f float64
+		switch {
+		case IsBool(value):
+			if value.GetBoolVal() {
+				f = 1
+			}
+		case IsInteger(value):
+			f = float64(value.GetInt64Val())
+		case IsFloating(value):
+			f = value.GetFloatVal()
+		case IsString(value):
+			var err error
+			f, err = strconv.ParseFloat(strings.TrimSpace(value.GetStringVal()), 64)
+			if err != nil {
+				return nil, invalid
+			}
+		default:
+			return nil, invalid
+		}
+		if dataType == schemapb.DataType_Float {
+			f = float64(float32(f))
+		}
+		return NewFloat(f), nil
+	case typeutil.IsStringType(dataType):
+		switch {
+		case IsBool(value):
+			return NewString(strconv.FormatBool(value.GetBoolVal())), nil
+		case IsInteger(value):
+			return NewString(strconv.FormatInt(value.GetInt64Val(), 10)), nil
+		case IsFloating(value):
+			return NewString(strconv.FormatFloat(value.GetFloatVal(), 'g', -1, 64)), nil
+		case IsString(value):
+			return value, nil
+		}
+	}
+	return nil, invalid
+}
+
+// isLosslessCast returns whether every value of from can be represented by to without change,
+// the cast of fields is dropped in this case.
+func isLosslessCast(from, to schemapb.DataType) bool {
+	if from == to {
+		return true
+	}
+	if typeutil.IsIntegerType(from) && typeutil.IsIntegerType(to) {
+		return integerBits[from] <= integerBits[to]
+	}
+	return false
+}
+
+var integerBits = map[schemapb.DataType]int{
+	schemapb.DataType_Int8:  8,
+	schemapb.DataType_Int16: 16,
+	schemapb.DataType_Int32: 32,
+	schemapb.DataType_Int64: 64,
+}
+
+// function is a builtin function of expressions, it's evaluated by parser if all the parameters are constants.
+type function struct {
+	// params validate the data type of every parameter
+	params []func(dataType schemapb.DataType) bool
+	// returnType returns the data type of result by the data type of the first parameter
+	returnType func(dataType schemapb.DataType) schemapb.DataType
+	eval       func(values []*planpb.GenericValue) *planpb.GenericValue
+	// rewrite translates the call into the expression which can be executed by segcore, nil if it can't
+	rewrite func(params []*ExprWithType) *ExprWithType
+}
+
+func isStringParam(dataType schemapb.DataType) bool {
+	return typeutil.IsStringType(dataType) || typeutil.IsJSONType(dataType)
+}
+
+func isNumberParam(dataType schemapb.DataType) bool {
+	return typeutil.IsArithmetic(dataType) || typeutil.IsJSONType(dataType)
+}
+
+func isLengthParam(dataType schemapb.DataType) bool {
+	return isStringParam(dataType) || typeutil.IsArrayType(dataType)
+}
+
+func returns(dataType schemapb.DataType) func(schemapb.DataType) schemapb.DataType {
+	return func(schemapb.DataType) schemapb.DataType {
+		return dataType
+	}
+}
+
+func numberReturnType(dataType schemapb.DataType) schemapb.DataType {
+	if typeutil.IsIntegerType(dataType) {
+		return schemapb.DataType_Int64
+	}
+	return schemapb.DataType_Double
+}
+
+func stringFunction(fn func(string) string) *function {
+	return &function{
+		params:     []func(schemapb.DataType) bool{isStringParam},
+		returnType: returns(schemapb.DataType_VarChar),
+		eval: func(values []*planpb.GenericValue) *planpb.GenericValue {
+			return NewString(fn(values[0].GetStringVal()))
+		},
+	}
+}
+
+func matchFunction(fn func(string, string) bool, op planpb.OpType) *function {
+	return &function{
+		params:     []func(schemapb.DataType) bool{isStringParam, typeutil.IsStringType},
+		returnType: returns(schemapb.DataType_Bool),
+		eval: func(values []*planpb.GenericValue) *planpb.GenericValue {
+			return NewBool(fn(values[0].GetStringVal(), values[1].GetStringVal()))
+		},
+		rewrite: func(params []*ExprWithType) *ExprWithType {
+			column, value := toColumnInfo(params[0]), params[1].expr.GetValueExpr()
+			if column == nil || value == nil || op == planpb.OpType_Invalid {
+				return nil
+			}
+			return &ExprWithType{
+				expr: &planpb.Expr{
+					Expr: &planpb.Expr_UnaryRangeExpr{
+						UnaryRangeExpr: &planpb.UnaryRangeExpr{
+							ColumnInfo: column,
+							Op:         op,
+							Value:      value.GetValue(),
+						},
+					},
+				},
+				dataType: schemapb.DataType_Bool,
+			}
+		},
+	}
+}
+
+func roundFunction(fn func(float64) float64) *function {
+	return &function{
+		params:     []func(schemapb.DataType) bool{isNumberParam},
+		returnType: numberReturnType,
+		eval: func(values []*planpb.GenericValue) *planpb.GenericValue {
+			if IsInteger(values[0]) {
+				return values[0]
+			}
+			return NewFloat(fn(values[0].GetFloatVal()))
+		},
+		rewrite: func(params []*ExprWithType) *ExprWithType {
+			// rounding integers changes nothing
+			if params[0].expr.GetColumnExpr() != nil && typeutil.IsIntegerType(params[0].dataType) {
+				return params[0]
+			}
+			return nil
+		},
+	}
+}
+
+var functions = map[string]*function{
+	"lower": stringFunction(strings.ToLower),
+	"upper": stringFunction(strings.ToUpper),
+	"trim":  stringFunction(strings.TrimSpace),
+	"length": {
+		params:     []func(schemapb.DataType) bool{isLengthParam},
+		returnType: returns(schemapb.DataType_Int64),
+		eval: func(values []*planpb.GenericValue) *planpb.GenericValue {
+			if IsArray(values[0]) {
+				return NewInt(int64(len(values[0].GetArrayVal().GetArray())))
+			}
+			return NewInt(int64(utf8.RuneCountInString(values[0].GetStringVal())))
+		},
+		rewrite: func(params []*ExprWithType) *ExprWithType {
+			// length of array and json is the number of elements, like array_length
+			column := toColumnInfo(params[0])
+			if column == nil || len(column.GetNestedPath()) > 0 && typeutil.IsArrayType(column.GetDataType()) ||
+				(!typeutil.IsArrayType(column.GetDataType()) && !typeutil.IsJSONType(column.GetDataType())) {
+				return nil
+			}
+			return newArrayLengthExpr(column)
+		},
+	},
+	// ends_with can't be pushed down until segcore supports PostfixMatch
+	"starts_with": matchFunction(strings.HasPrefix, planpb.OpType_PrefixMatch),
+	"ends_with":   matchFunction(strings.HasSuffix, planpb.OpType_Invalid),
+	"abs": {
+		params:     []func(schemapb.DataType) bool{isNumberParam},
+		returnType: numberReturnType,
+		eval: func(values []*planpb.GenericValue) *planpb.GenericValue {
+			if IsInteger(values[0]) {
+				if values[0].GetInt64Val() < 0 {
+					return NewInt(-values[0].GetInt64Val())
+				}
+				return values[0]
+			}
+			return NewFloat(math.Abs(values[0].GetFloatVal()))
+		},
+	},
+	"ceil":  roundFunction(math.Ceil),
+	"floor": roundFunction(math.Floor),
+	"round": roundFunction(math.Round),
+}
+
+// elementDataType returns the element type if expr is an element of array field.
+func elementDataType(expr *ExprWithType) schemapb.DataType {
+	if column := toColumnInfo(expr); column != nil && typeutil.IsArrayType(column.GetDataType()) && len(column.GetNestedPath()) > 0 {
+		return column.GetElementType()
+	}
+	return expr.dataType
+}
@@ -0,0 +1,121 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestCastConstant(t *testing.T) {
+	v, err := castConstant(NewString("0x10"), schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), v.GetInt64Val())
+
+	v, err = castConstant(NewFloat(-2.7), schemapb.DataType_Int32)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-2), v.GetInt64Val())
+
+	v, err = castConstant(NewInt(3), schemapb.DataType_VarChar)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", v.GetStringVal())
+
+	v, err = castConstant(NewString("true"), schemapb.DataType_Bool)
+	assert.NoError(t, err)
+	assert.True(t, v.GetBoolVal())
+
+	v, err = castConstant(NewBool(true), schemapb.DataType_Double)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), v.GetFloatVal())
+
+	_, err = castConstant(NewInt(128), schemapb.DataType_Int8)
+	assert.Error(t, err)
+	_, err = castConstant(NewString("abc"), schemapb.DataType_Int64)
+	assert.Error(t, err)
+	_, err = castConstant(NewFloat(1.5), schemapb.DataType_Bool)
+	assert.Error(t, err)
+}
+
+func TestExpr_Functions(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`Int64Field > cast("3" as int64)`,
+		`Int64Field > CAST(3.9 AS int8)`,
+		`cast(Int8Field as int64) > 1`,
+		`cast(Int32Field as Int32) in [1, 2]`,
+		`VarCharField == lower("ABC")`,
+		`VarCharField == trim(upper(" abc "))`,
+		`Int64Field == length("abc")`,
+		`length(ArrayField) == 3`,
+		`length(A) != 2`,
+		`FloatField < abs(-1.5)`,
+		`floor(Int64Field) >= round(2)`,
+		`starts_with(VarCharField, "prefix")`,
+		`not starts_with(A, "prefix") && Int64Field > 0`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`Int64Field + Int32Field > FloatField`,
+		`Int64Field + Int32Field == 3`,
+		`Int64Field * 2 > Int32Field`,
+		`lower(VarCharField) == "a"`,
+		`ends_with(VarCharField, "a")`,
+		`cast(FloatField as int64) > 1`,
+		`cast(Int64Field as varchar) == "1"`,
+		`cast(Int64Field as decimal) > 1`,
+		`cast(ArrayField as int64) > 1`,
+		`cast("abc" as int64) > Int64Field`,
+		`unknown(Int64Field) > 1`,
+		`lower(VarCharField, "a") == "a"`,
+		`abs(VarCharField) > 1`,
+		`starts_with(Int64Field, "a")`,
+		`length(Int64Field) > 1`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_FunctionsRewrite(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `Int64Field > cast("3" as int64)`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, int64(3), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	expr, err = ParseExpr(helper, `VarCharField == lower("ABC")`)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	expr, err = ParseExpr(helper, `starts_with(VarCharField, "pre")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_PrefixMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "pre", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	expr, err = ParseExpr(helper, `length(ArrayField) == 3`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.ArithOpType_ArrayLength, expr.GetBinaryArithOpEvalRangeExpr().GetArithOp())
+
+	expr, err = ParseExpr(helper, `cast(Int8Field as int64) > 1`)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int8, expr.GetUnaryRangeExpr().GetColumnInfo().GetDataType())
+
+	_, err = ParseExpr(helper, `Int64Field + Int32Field > FloatField`)
+	assert.ErrorContains(t, err, "comparison between arithmetic of multiple fields is not supported")
+	_, err = ParseExpr(helper, `lower(VarCharField) == "a"`)
+	assert.ErrorContains(t, err, "function lower on fields is not supported")
+	_, err = ParseExpr(helper, `cast(FloatField as int64) > 1`)
+	assert.ErrorContains(t, err, "cast of fields to Int64 is not supported")
+}
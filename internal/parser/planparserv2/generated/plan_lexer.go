@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 48, 754,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 52, 824,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -28,335 +28,366 @@ var serializedLexerAtn = []uint16{
 	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
 	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
 	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
-	70, 4, 71, 9, 71, 4, 72, 9, 72, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3,
-	5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 180, 10, 13, 3, 14, 3, 14, 3,
-	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14,
-	194, 10, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3,
-	19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22,
-	3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3,
-	26, 5, 26, 226, 10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 232, 10, 27,
-	3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 240, 10, 29, 3, 30, 3,
-	30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32,
-	3, 32, 7, 32, 255, 10, 32, 12, 32, 14, 32, 258, 11, 32, 3, 32, 3, 32, 3,
-	33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33,
-	3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3,
-	33, 3, 33, 3, 33, 3, 33, 3, 33, 5, 33, 288, 10, 33, 3, 34, 3, 34, 3, 34,
-	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3,
-	34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
-	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 5,
-	34, 324, 10, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
+	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
+	4, 76, 9, 76, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3,
+	6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 11,
+	3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3,
+	13, 3, 13, 3, 13, 5, 13, 188, 10, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
+	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 202, 10, 14, 3,
+	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 212, 10, 15,
+	3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 218, 10, 16, 3, 17, 3, 17, 3, 18, 3,
+	18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 23,
+	3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3,
+	27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 250, 10, 28, 3, 29, 3, 29,
+	3, 29, 3, 29, 5, 29, 256, 10, 29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3,
+	31, 5, 31, 264, 10, 31, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 7, 34, 279, 10, 34, 12, 34, 14,
+	34, 282, 11, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
 	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3,
-	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
-	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 5, 35, 360, 10, 35, 3, 36, 3, 36, 3,
+	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 5, 35,
+	312, 10, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
 	36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
 	3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 3, 36, 5, 36, 390, 10, 36, 3, 37, 3, 37, 3, 37,
+	36, 3, 36, 3, 36, 3, 36, 3, 36, 5, 36, 348, 10, 36, 3, 37, 3, 37, 3, 37,
 	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
 	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37,
-	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
-	37, 3, 37, 5, 37, 428, 10, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
+	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 5,
+	37, 384, 10, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
 	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3,
-	38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
-	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 5, 38, 466,
-	10, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39,
+	38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 5, 38,
+	414, 10, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3,
+	39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39,
 	3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 492, 10, 39, 3, 40, 3, 40, 3, 40,
+	39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 452, 10, 39, 3, 40,
 	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3,
 	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40,
-	3, 40, 3, 40, 3, 40, 5, 40, 521, 10, 40, 3, 41, 3, 41, 3, 41, 3, 41, 5,
-	41, 527, 10, 41, 3, 42, 3, 42, 5, 42, 531, 10, 42, 3, 43, 3, 43, 3, 43,
-	7, 43, 536, 10, 43, 12, 43, 14, 43, 539, 11, 43, 3, 43, 3, 43, 3, 43, 3,
-	43, 3, 43, 5, 43, 546, 10, 43, 3, 44, 5, 44, 549, 10, 44, 3, 44, 3, 44,
-	5, 44, 553, 10, 44, 3, 44, 3, 44, 3, 44, 5, 44, 558, 10, 44, 3, 44, 5,
-	44, 561, 10, 44, 3, 45, 3, 45, 3, 45, 3, 45, 5, 45, 567, 10, 45, 3, 45,
-	3, 45, 6, 45, 571, 10, 45, 13, 45, 14, 45, 572, 3, 46, 3, 46, 3, 46, 5,
-	46, 578, 10, 46, 3, 47, 6, 47, 581, 10, 47, 13, 47, 14, 47, 582, 3, 48,
-	6, 48, 586, 10, 48, 13, 48, 14, 48, 587, 3, 49, 3, 49, 3, 49, 3, 49, 3,
-	49, 3, 49, 3, 49, 5, 49, 597, 10, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50,
-	3, 50, 3, 50, 5, 50, 606, 10, 50, 3, 51, 3, 51, 3, 52, 3, 52, 3, 53, 3,
-	53, 3, 53, 6, 53, 615, 10, 53, 13, 53, 14, 53, 616, 3, 54, 3, 54, 7, 54,
-	621, 10, 54, 12, 54, 14, 54, 624, 11, 54, 3, 54, 5, 54, 627, 10, 54, 3,
-	55, 3, 55, 7, 55, 631, 10, 55, 12, 55, 14, 55, 634, 11, 55, 3, 56, 3, 56,
-	3, 56, 3, 56, 3, 57, 3, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 60, 3, 60, 3,
-	60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61,
-	3, 61, 3, 61, 5, 61, 661, 10, 61, 3, 62, 3, 62, 5, 62, 665, 10, 62, 3,
-	62, 3, 62, 3, 62, 5, 62, 670, 10, 62, 3, 63, 3, 63, 3, 63, 3, 63, 5, 63,
-	676, 10, 63, 3, 63, 3, 63, 3, 64, 5, 64, 681, 10, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 64, 5, 64, 688, 10, 64, 3, 65, 3, 65, 5, 65, 692, 10, 65,
-	3, 65, 3, 65, 3, 66, 6, 66, 697, 10, 66, 13, 66, 14, 66, 698, 3, 67, 5,
-	67, 702, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 709, 10, 67,
-	3, 68, 6, 68, 712, 10, 68, 13, 68, 14, 68, 713, 3, 69, 3, 69, 5, 69, 718,
-	10, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 5, 70, 727, 10,
-	70, 3, 70, 5, 70, 730, 10, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 5, 70,
-	737, 10, 70, 3, 71, 6, 71, 740, 10, 71, 13, 71, 14, 71, 741, 3, 71, 3,
-	71, 3, 72, 3, 72, 5, 72, 748, 10, 72, 3, 72, 5, 72, 751, 10, 72, 3, 72,
-	3, 72, 2, 2, 73, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19,
-	11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37,
-	20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55,
-	29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73,
-	38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91,
-	2, 93, 2, 95, 2, 97, 2, 99, 2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2,
-	111, 2, 113, 2, 115, 2, 117, 2, 119, 2, 121, 2, 123, 2, 125, 2, 127, 2,
-	129, 2, 131, 2, 133, 2, 135, 2, 137, 2, 139, 2, 141, 47, 143, 48, 3, 2,
-	18, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94,
-	6, 2, 12, 12, 15, 15, 41, 41, 94, 94, 5, 2, 67, 92, 97, 97, 99, 124, 3,
-	2, 50, 59, 4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2, 90, 90, 122, 122,
-	3, 2, 51, 59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71,
-	103, 103, 4, 2, 45, 45, 47, 47, 4, 2, 82, 82, 114, 114, 12, 2, 36, 36,
-	41, 41, 65, 65, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118,
-	120, 120, 4, 2, 11, 11, 34, 34, 2, 793, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2,
-	2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2,
-	2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3,
-	2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29,
-	3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2,
-	37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2,
-	2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2,
-	2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2,
-	2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3,
-	2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75,
-	3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2,
-	83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2,
-	2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 3, 145, 3, 2, 2, 2, 5, 147, 3,
-	2, 2, 2, 7, 149, 3, 2, 2, 2, 9, 151, 3, 2, 2, 2, 11, 153, 3, 2, 2, 2, 13,
-	155, 3, 2, 2, 2, 15, 157, 3, 2, 2, 2, 17, 160, 3, 2, 2, 2, 19, 162, 3,
-	2, 2, 2, 21, 165, 3, 2, 2, 2, 23, 168, 3, 2, 2, 2, 25, 179, 3, 2, 2, 2,
-	27, 193, 3, 2, 2, 2, 29, 195, 3, 2, 2, 2, 31, 197, 3, 2, 2, 2, 33, 199,
-	3, 2, 2, 2, 35, 201, 3, 2, 2, 2, 37, 203, 3, 2, 2, 2, 39, 205, 3, 2, 2,
-	2, 41, 208, 3, 2, 2, 2, 43, 211, 3, 2, 2, 2, 45, 214, 3, 2, 2, 2, 47, 216,
-	3, 2, 2, 2, 49, 218, 3, 2, 2, 2, 51, 225, 3, 2, 2, 2, 53, 231, 3, 2, 2,
-	2, 55, 233, 3, 2, 2, 2, 57, 239, 3, 2, 2, 2, 59, 241, 3, 2, 2, 2, 61, 244,
-	3, 2, 2, 2, 63, 251, 3, 2, 2, 2, 65, 287, 3, 2, 2, 2, 67, 323, 3, 2, 2,
-	2, 69, 359, 3, 2, 2, 2, 71, 389, 3, 2, 2, 2, 73, 427, 3, 2, 2, 2, 75, 465,
-	3, 2, 2, 2, 77, 491, 3, 2, 2, 2, 79, 520, 3, 2, 2, 2, 81, 526, 3, 2, 2,
-	2, 83, 530, 3, 2, 2, 2, 85, 545, 3, 2, 2, 2, 87, 548, 3, 2, 2, 2, 89, 562,
-	3, 2, 2, 2, 91, 577, 3, 2, 2, 2, 93, 580, 3, 2, 2, 2, 95, 585, 3, 2, 2,
-	2, 97, 596, 3, 2, 2, 2, 99, 605, 3, 2, 2, 2, 101, 607, 3, 2, 2, 2, 103,
-	609, 3, 2, 2, 2, 105, 611, 3, 2, 2, 2, 107, 626, 3, 2, 2, 2, 109, 628,
-	3, 2, 2, 2, 111, 635, 3, 2, 2, 2, 113, 639, 3, 2, 2, 2, 115, 641, 3, 2,
-	2, 2, 117, 643, 3, 2, 2, 2, 119, 645, 3, 2, 2, 2, 121, 660, 3, 2, 2, 2,
-	123, 669, 3, 2, 2, 2, 125, 671, 3, 2, 2, 2, 127, 687, 3, 2, 2, 2, 129,
-	689, 3, 2, 2, 2, 131, 696, 3, 2, 2, 2, 133, 708, 3, 2, 2, 2, 135, 711,
-	3, 2, 2, 2, 137, 715, 3, 2, 2, 2, 139, 736, 3, 2, 2, 2, 141, 739, 3, 2,
-	2, 2, 143, 750, 3, 2, 2, 2, 145, 146, 7, 42, 2, 2, 146, 4, 3, 2, 2, 2,
-	147, 148, 7, 43, 2, 2, 148, 6, 3, 2, 2, 2, 149, 150, 7, 93, 2, 2, 150,
-	8, 3, 2, 2, 2, 151, 152, 7, 46, 2, 2, 152, 10, 3, 2, 2, 2, 153, 154, 7,
-	95, 2, 2, 154, 12, 3, 2, 2, 2, 155, 156, 7, 62, 2, 2, 156, 14, 3, 2, 2,
-	2, 157, 158, 7, 62, 2, 2, 158, 159, 7, 63, 2, 2, 159, 16, 3, 2, 2, 2, 160,
-	161, 7, 64, 2, 2, 161, 18, 3, 2, 2, 2, 162, 163, 7, 64, 2, 2, 163, 164,
-	7, 63, 2, 2, 164, 20, 3, 2, 2, 2, 165, 166, 7, 63, 2, 2, 166, 167, 7, 63,
-	2, 2, 167, 22, 3, 2, 2, 2, 168, 169, 7, 35, 2, 2, 169, 170, 7, 63, 2, 2,
-	170, 24, 3, 2, 2, 2, 171, 172, 7, 110, 2, 2, 172, 173, 7, 107, 2, 2, 173,
-	174, 7, 109, 2, 2, 174, 180, 7, 103, 2, 2, 175, 176, 7, 78, 2, 2, 176,
-	177, 7, 75, 2, 2, 177, 178, 7, 77, 2, 2, 178, 180, 7, 71, 2, 2, 179, 171,
-	3, 2, 2, 2, 179, 175, 3, 2, 2, 2, 180, 26, 3, 2, 2, 2, 181, 182, 7, 103,
-	2, 2, 182, 183, 7, 122, 2, 2, 183, 184, 7, 107, 2, 2, 184, 185, 7, 117,
-	2, 2, 185, 186, 7, 118, 2, 2, 186, 194, 7, 117, 2, 2, 187, 188, 7, 71,
-	2, 2, 188, 189, 7, 90, 2, 2, 189, 190, 7, 75, 2, 2, 190, 191, 7, 85, 2,
-	2, 191, 192, 7, 86, 2, 2, 192, 194, 7, 85, 2, 2, 193, 181, 3, 2, 2, 2,
-	193, 187, 3, 2, 2, 2, 194, 28, 3, 2, 2, 2, 195, 196, 7, 45, 2, 2, 196,
-	30, 3, 2, 2, 2, 197, 198, 7, 47, 2, 2, 198, 32, 3, 2, 2, 2, 199, 200, 7,
-	44, 2, 2, 200, 34, 3, 2, 2, 2, 201, 202, 7, 49, 2, 2, 202, 36, 3, 2, 2,
-	2, 203, 204, 7, 39, 2, 2, 204, 38, 3, 2, 2, 2, 205, 206, 7, 44, 2, 2, 206,
-	207, 7, 44, 2, 2, 207, 40, 3, 2, 2, 2, 208, 209, 7, 62, 2, 2, 209, 210,
-	7, 62, 2, 2, 210, 42, 3, 2, 2, 2, 211, 212, 7, 64, 2, 2, 212, 213, 7, 64,
-	2, 2, 213, 44, 3, 2, 2, 2, 214, 215, 7, 40, 2, 2, 215, 46, 3, 2, 2, 2,
-	216, 217, 7, 126, 2, 2, 217, 48, 3, 2, 2, 2, 218, 219, 7, 96, 2, 2, 219,
-	50, 3, 2, 2, 2, 220, 221, 7, 40, 2, 2, 221, 226, 7, 40, 2, 2, 222, 223,
-	7, 99, 2, 2, 223, 224, 7, 112, 2, 2, 224, 226, 7, 102, 2, 2, 225, 220,
-	3, 2, 2, 2, 225, 222, 3, 2, 2, 2, 226, 52, 3, 2, 2, 2, 227, 228, 7, 126,
-	2, 2, 228, 232, 7, 126, 2, 2, 229, 230, 7, 113, 2, 2, 230, 232, 7, 116,
-	2, 2, 231, 227, 3, 2, 2, 2, 231, 229, 3, 2, 2, 2, 232, 54, 3, 2, 2, 2,
-	233, 234, 7, 128, 2, 2, 234, 56, 3, 2, 2, 2, 235, 240, 7, 35, 2, 2, 236,
-	237, 7, 112, 2, 2, 237, 238, 7, 113, 2, 2, 238, 240, 7, 118, 2, 2, 239,
-	235, 3, 2, 2, 2, 239, 236, 3, 2, 2, 2, 240, 58, 3, 2, 2, 2, 241, 242, 7,
-	107, 2, 2, 242, 243, 7, 112, 2, 2, 243, 60, 3, 2, 2, 2, 244, 245, 7, 112,
-	2, 2, 245, 246, 7, 113, 2, 2, 246, 247, 7, 118, 2, 2, 247, 248, 7, 34,
-	2, 2, 248, 249, 7, 107, 2, 2, 249, 250, 7, 112, 2, 2, 250, 62, 3, 2, 2,
-	2, 251, 256, 7, 93, 2, 2, 252, 255, 5, 141, 71, 2, 253, 255, 5, 143, 72,
-	2, 254, 252, 3, 2, 2, 2, 254, 253, 3, 2, 2, 2, 255, 258, 3, 2, 2, 2, 256,
-	254, 3, 2, 2, 2, 256, 257, 3, 2, 2, 2, 257, 259, 3, 2, 2, 2, 258, 256,
-	3, 2, 2, 2, 259, 260, 7, 95, 2, 2, 260, 64, 3, 2, 2, 2, 261, 262, 7, 108,
-	2, 2, 262, 263, 7, 117, 2, 2, 263, 264, 7, 113, 2, 2, 264, 265, 7, 112,
-	2, 2, 265, 266, 7, 97, 2, 2, 266, 267, 7, 101, 2, 2, 267, 268, 7, 113,
-	2, 2, 268, 269, 7, 112, 2, 2, 269, 270, 7, 118, 2, 2, 270, 271, 7, 99,
-	2, 2, 271, 272, 7, 107, 2, 2, 272, 273, 7, 112, 2, 2, 273, 288, 7, 117,
-	2, 2, 274, 275, 7, 76, 2, 2, 275, 276, 7, 85, 2, 2, 276, 277, 7, 81, 2,
-	2, 277, 278, 7, 80, 2, 2, 278, 279, 7, 97, 2, 2, 279, 280, 7, 69, 2, 2,
-	280, 281, 7, 81, 2, 2, 281, 282, 7, 80, 2, 2, 282, 283, 7, 86, 2, 2, 283,
-	284, 7, 67, 2, 2, 284, 285, 7, 75, 2, 2, 285, 286, 7, 80, 2, 2, 286, 288,
-	7, 85, 2, 2, 287, 261, 3, 2, 2, 2, 287, 274, 3, 2, 2, 2, 288, 66, 3, 2,
-	2, 2, 289, 290, 7, 108, 2, 2, 290, 291, 7, 117, 2, 2, 291, 292, 7, 113,
-	2, 2, 292, 293, 7, 112, 2, 2, 293, 294, 7, 97, 2, 2, 294, 295, 7, 101,
-	2, 2, 295, 296, 7, 113, 2, 2, 296, 297, 7, 112, 2, 2, 297, 298, 7, 118,
-	2, 2, 298, 299, 7, 99, 2, 2, 299, 300, 7, 107, 2, 2, 300, 301, 7, 112,
-	2, 2, 301, 302, 7, 117, 2, 2, 302, 303, 7, 97, 2, 2, 303, 304, 7, 99, 2,
-	2, 304, 305, 7, 110, 2, 2, 305, 324, 7, 110, 2, 2, 306, 307, 7, 76, 2,
-	2, 307, 308, 7, 85, 2, 2, 308, 309, 7, 81, 2, 2, 309, 310, 7, 80, 2, 2,
-	310, 311, 7, 97, 2, 2, 311, 312, 7, 69, 2, 2, 312, 313, 7, 81, 2, 2, 313,
-	314, 7, 80, 2, 2, 314, 315, 7, 86, 2, 2, 315, 316, 7, 67, 2, 2, 316, 317,
-	7, 75, 2, 2, 317, 318, 7, 80, 2, 2, 318, 319, 7, 85, 2, 2, 319, 320, 7,
-	97, 2, 2, 320, 321, 7, 67, 2, 2, 321, 322, 7, 78, 2, 2, 322, 324, 7, 78,
-	2, 2, 323, 289, 3, 2, 2, 2, 323, 306, 3, 2, 2, 2, 324, 68, 3, 2, 2, 2,
-	325, 326, 7, 108, 2, 2, 326, 327, 7, 117, 2, 2, 327, 328, 7, 113, 2, 2,
-	328, 329, 7, 112, 2, 2, 329, 330, 7, 97, 2, 2, 330, 331, 7, 101, 2, 2,
-	331, 332, 7, 113, 2, 2, 332, 333, 7, 112, 2, 2, 333, 334, 7, 118, 2, 2,
-	334, 335, 7, 99, 2, 2, 335, 336, 7, 107, 2, 2, 336, 337, 7, 112, 2, 2,
-	337, 338, 7, 117, 2, 2, 338, 339, 7, 97, 2, 2, 339, 340, 7, 99, 2, 2, 340,
-	341, 7, 112, 2, 2, 341, 360, 7, 123, 2, 2, 342, 343, 7, 76, 2, 2, 343,
-	344, 7, 85, 2, 2, 344, 345, 7, 81, 2, 2, 345, 346, 7, 80, 2, 2, 346, 347,
-	7, 97, 2, 2, 347, 348, 7, 69, 2, 2, 348, 349, 7, 81, 2, 2, 349, 350, 7,
-	80, 2, 2, 350, 351, 7, 86, 2, 2, 351, 352, 7, 67, 2, 2, 352, 353, 7, 75,
-	2, 2, 353, 354, 7, 80, 2, 2, 354, 355, 7, 85, 2, 2, 355, 356, 7, 97, 2,
-	2, 356, 357, 7, 67, 2, 2, 357, 358, 7, 80, 2, 2, 358, 360, 7, 91, 2, 2,
-	359, 325, 3, 2, 2, 2, 359, 342, 3, 2, 2, 2, 360, 70, 3, 2, 2, 2, 361, 362,
-	7, 99, 2, 2, 362, 363, 7, 116, 2, 2, 363, 364, 7, 116, 2, 2, 364, 365,
-	7, 99, 2, 2, 365, 366, 7, 123, 2, 2, 366, 367, 7, 97, 2, 2, 367, 368, 7,
-	101, 2, 2, 368, 369, 7, 113, 2, 2, 369, 370, 7, 112, 2, 2, 370, 371, 7,
-	118, 2, 2, 371, 372, 7, 99, 2, 2, 372, 373, 7, 107, 2, 2, 373, 374, 7,
-	112, 2, 2, 374, 390, 7, 117, 2, 2, 375, 376, 7, 67, 2, 2, 376, 377, 7,
-	84, 2, 2, 377, 378, 7, 84, 2, 2, 378, 379, 7, 67, 2, 2, 379, 380, 7, 91,
-	2, 2, 380, 381, 7, 97, 2, 2, 381, 382, 7, 69, 2, 2, 382, 383, 7, 81, 2,
-	2, 383, 384, 7, 80, 2, 2, 384, 385, 7, 86, 2, 2, 385, 386, 7, 67, 2, 2,
-	386, 387, 7, 75, 2, 2, 387, 388, 7, 80, 2, 2, 388, 390, 7, 85, 2, 2, 389,
-	361, 3, 2, 2, 2, 389, 375, 3, 2, 2, 2, 390, 72, 3, 2, 2, 2, 391, 392, 7,
-	99, 2, 2, 392, 393, 7, 116, 2, 2, 393, 394, 7, 116, 2, 2, 394, 395, 7,
-	99, 2, 2, 395, 396, 7, 123, 2, 2, 396, 397, 7, 97, 2, 2, 397, 398, 7, 101,
-	2, 2, 398, 399, 7, 113, 2, 2, 399, 400, 7, 112, 2, 2, 400, 401, 7, 118,
-	2, 2, 401, 402, 7, 99, 2, 2, 402, 403, 7, 107, 2, 2, 403, 404, 7, 112,
-	2, 2, 404, 405, 7, 117, 2, 2, 405, 406, 7, 97, 2, 2, 406, 407, 7, 99, 2,
-	2, 407, 408, 7, 110, 2, 2, 408, 428, 7, 110, 2, 2, 409, 410, 7, 67, 2,
-	2, 410, 411, 7, 84, 2, 2, 411, 412, 7, 84, 2, 2, 412, 413, 7, 67, 2, 2,
-	413, 414, 7, 91, 2, 2, 414, 415, 7, 97, 2, 2, 415, 416, 7, 69, 2, 2, 416,
-	417, 7, 81, 2, 2, 417, 418, 7, 80, 2, 2, 418, 419, 7, 86, 2, 2, 419, 420,
-	7, 67, 2, 2, 420, 421, 7, 75, 2, 2, 421, 422, 7, 80, 2, 2, 422, 423, 7,
-	85, 2, 2, 423, 424, 7, 97, 2, 2, 424, 425, 7, 67, 2, 2, 425, 426, 7, 78,
-	2, 2, 426, 428, 7, 78, 2, 2, 427, 391, 3, 2, 2, 2, 427, 409, 3, 2, 2, 2,
-	428, 74, 3, 2, 2, 2, 429, 430, 7, 99, 2, 2, 430, 431, 7, 116, 2, 2, 431,
-	432, 7, 116, 2, 2, 432, 433, 7, 99, 2, 2, 433, 434, 7, 123, 2, 2, 434,
-	435, 7, 97, 2, 2, 435, 436, 7, 101, 2, 2, 436, 437, 7, 113, 2, 2, 437,
-	438, 7, 112, 2, 2, 438, 439, 7, 118, 2, 2, 439, 440, 7, 99, 2, 2, 440,
-	441, 7, 107, 2, 2, 441, 442, 7, 112, 2, 2, 442, 443, 7, 117, 2, 2, 443,
-	444, 7, 97, 2, 2, 444, 445, 7, 99, 2, 2, 445, 446, 7, 112, 2, 2, 446, 466,
-	7, 123, 2, 2, 447, 448, 7, 67, 2, 2, 448, 449, 7, 84, 2, 2, 449, 450, 7,
-	84, 2, 2, 450, 451, 7, 67, 2, 2, 451, 452, 7, 91, 2, 2, 452, 453, 7, 97,
-	2, 2, 453, 454, 7, 69, 2, 2, 454, 455, 7, 81, 2, 2, 455, 456, 7, 80, 2,
-	2, 456, 457, 7, 86, 2, 2, 457, 458, 7, 67, 2, 2, 458, 459, 7, 75, 2, 2,
-	459, 460, 7, 80, 2, 2, 460, 461, 7, 85, 2, 2, 461, 462, 7, 97, 2, 2, 462,
-	463, 7, 67, 2, 2, 463, 464, 7, 80, 2, 2, 464, 466, 7, 91, 2, 2, 465, 429,
-	3, 2, 2, 2, 465, 447, 3, 2, 2, 2, 466, 76, 3, 2, 2, 2, 467, 468, 7, 99,
-	2, 2, 468, 469, 7, 116, 2, 2, 469, 470, 7, 116, 2, 2, 470, 471, 7, 99,
-	2, 2, 471, 472, 7, 123, 2, 2, 472, 473, 7, 97, 2, 2, 473, 474, 7, 110,
-	2, 2, 474, 475, 7, 103, 2, 2, 475, 476, 7, 112, 2, 2, 476, 477, 7, 105,
-	2, 2, 477, 478, 7, 118, 2, 2, 478, 492, 7, 106, 2, 2, 479, 480, 7, 67,
-	2, 2, 480, 481, 7, 84, 2, 2, 481, 482, 7, 84, 2, 2, 482, 483, 7, 67, 2,
-	2, 483, 484, 7, 91, 2, 2, 484, 485, 7, 97, 2, 2, 485, 486, 7, 78, 2, 2,
-	486, 487, 7, 71, 2, 2, 487, 488, 7, 80, 2, 2, 488, 489, 7, 73, 2, 2, 489,
-	490, 7, 86, 2, 2, 490, 492, 7, 74, 2, 2, 491, 467, 3, 2, 2, 2, 491, 479,
-	3, 2, 2, 2, 492, 78, 3, 2, 2, 2, 493, 494, 7, 118, 2, 2, 494, 495, 7, 116,
-	2, 2, 495, 496, 7, 119, 2, 2, 496, 521, 7, 103, 2, 2, 497, 498, 7, 86,
-	2, 2, 498, 499, 7, 116, 2, 2, 499, 500, 7, 119, 2, 2, 500, 521, 7, 103,
-	2, 2, 501, 502, 7, 86, 2, 2, 502, 503, 7, 84, 2, 2, 503, 504, 7, 87, 2,
-	2, 504, 521, 7, 71, 2, 2, 505, 506, 7, 104, 2, 2, 506, 507, 7, 99, 2, 2,
-	507, 508, 7, 110, 2, 2, 508, 509, 7, 117, 2, 2, 509, 521, 7, 103, 2, 2,
-	510, 511, 7, 72, 2, 2, 511, 512, 7, 99, 2, 2, 512, 513, 7, 110, 2, 2, 513,
-	514, 7, 117, 2, 2, 514, 521, 7, 103, 2, 2, 515, 516, 7, 72, 2, 2, 516,
-	517, 7, 67, 2, 2, 517, 518, 7, 78, 2, 2, 518, 519, 7, 85, 2, 2, 519, 521,
-	7, 71, 2, 2, 520, 493, 3, 2, 2, 2, 520, 497, 3, 2, 2, 2, 520, 501, 3, 2,
-	2, 2, 520, 505, 3, 2, 2, 2, 520, 510, 3, 2, 2, 2, 520, 515, 3, 2, 2, 2,
-	521, 80, 3, 2, 2, 2, 522, 527, 5, 107, 54, 2, 523, 527, 5, 109, 55, 2,
-	524, 527, 5, 111, 56, 2, 525, 527, 5, 105, 53, 2, 526, 522, 3, 2, 2, 2,
-	526, 523, 3, 2, 2, 2, 526, 524, 3, 2, 2, 2, 526, 525, 3, 2, 2, 2, 527,
-	82, 3, 2, 2, 2, 528, 531, 5, 123, 62, 2, 529, 531, 5, 125, 63, 2, 530,
-	528, 3, 2, 2, 2, 530, 529, 3, 2, 2, 2, 531, 84, 3, 2, 2, 2, 532, 537, 5,
-	101, 51, 2, 533, 536, 5, 101, 51, 2, 534, 536, 5, 103, 52, 2, 535, 533,
-	3, 2, 2, 2, 535, 534, 3, 2, 2, 2, 536, 539, 3, 2, 2, 2, 537, 535, 3, 2,
-	2, 2, 537, 538, 3, 2, 2, 2, 538, 546, 3, 2, 2, 2, 539, 537, 3, 2, 2, 2,
-	540, 541, 7, 38, 2, 2, 541, 542, 7, 111, 2, 2, 542, 543, 7, 103, 2, 2,
-	543, 544, 7, 118, 2, 2, 544, 546, 7, 99, 2, 2, 545, 532, 3, 2, 2, 2, 545,
-	540, 3, 2, 2, 2, 546, 86, 3, 2, 2, 2, 547, 549, 5, 91, 46, 2, 548, 547,
-	3, 2, 2, 2, 548, 549, 3, 2, 2, 2, 549, 560, 3, 2, 2, 2, 550, 552, 7, 36,
-	2, 2, 551, 553, 5, 93, 47, 2, 552, 551, 3, 2, 2, 2, 552, 553, 3, 2, 2,
-	2, 553, 554, 3, 2, 2, 2, 554, 561, 7, 36, 2, 2, 555, 557, 7, 41, 2, 2,
-	556, 558, 5, 95, 48, 2, 557, 556, 3, 2, 2, 2, 557, 558, 3, 2, 2, 2, 558,
-	559, 3, 2, 2, 2, 559, 561, 7, 41, 2, 2, 560, 550, 3, 2, 2, 2, 560, 555,
-	3, 2, 2, 2, 561, 88, 3, 2, 2, 2, 562, 570, 5, 85, 43, 2, 563, 566, 7, 93,
-	2, 2, 564, 567, 5, 87, 44, 2, 565, 567, 5, 107, 54, 2, 566, 564, 3, 2,
-	2, 2, 566, 565, 3, 2, 2, 2, 567, 568, 3, 2, 2, 2, 568, 569, 7, 95, 2, 2,
-	569, 571, 3, 2, 2, 2, 570, 563, 3, 2, 2, 2, 571, 572, 3, 2, 2, 2, 572,
-	570, 3, 2, 2, 2, 572, 573, 3, 2, 2, 2, 573, 90, 3, 2, 2, 2, 574, 575, 7,
-	119, 2, 2, 575, 578, 7, 58, 2, 2, 576, 578, 9, 2, 2, 2, 577, 574, 3, 2,
-	2, 2, 577, 576, 3, 2, 2, 2, 578, 92, 3, 2, 2, 2, 579, 581, 5, 97, 49, 2,
-	580, 579, 3, 2, 2, 2, 581, 582, 3, 2, 2, 2, 582, 580, 3, 2, 2, 2, 582,
-	583, 3, 2, 2, 2, 583, 94, 3, 2, 2, 2, 584, 586, 5, 99, 50, 2, 585, 584,
-	3, 2, 2, 2, 586, 587, 3, 2, 2, 2, 587, 585, 3, 2, 2, 2, 587, 588, 3, 2,
-	2, 2, 588, 96, 3, 2, 2, 2, 589, 597, 10, 3, 2, 2, 590, 597, 5, 139, 70,
-	2, 591, 592, 7, 94, 2, 2, 592, 597, 7, 12, 2, 2, 593, 594, 7, 94, 2, 2,
-	594, 595, 7, 15, 2, 2, 595, 597, 7, 12, 2, 2, 596, 589, 3, 2, 2, 2, 596,
-	590, 3, 2, 2, 2, 596, 591, 3, 2, 2, 2, 596, 593, 3, 2, 2, 2, 597, 98, 3,
-	2, 2, 2, 598, 606, 10, 4, 2, 2, 599, 606, 5, 139, 70, 2, 600, 601, 7, 94,
-	2, 2, 601, 606, 7, 12, 2, 2, 602, 603, 7, 94, 2, 2, 603, 604, 7, 15, 2,
-	2, 604, 606, 7, 12, 2, 2, 605, 598, 3, 2, 2, 2, 605, 599, 3, 2, 2, 2, 605,
-	600, 3, 2, 2, 2, 605, 602, 3, 2, 2, 2, 606, 100, 3, 2, 2, 2, 607, 608,
-	9, 5, 2, 2, 608, 102, 3, 2, 2, 2, 609, 610, 9, 6, 2, 2, 610, 104, 3, 2,
-	2, 2, 611, 612, 7, 50, 2, 2, 612, 614, 9, 7, 2, 2, 613, 615, 9, 8, 2, 2,
-	614, 613, 3, 2, 2, 2, 615, 616, 3, 2, 2, 2, 616, 614, 3, 2, 2, 2, 616,
-	617, 3, 2, 2, 2, 617, 106, 3, 2, 2, 2, 618, 622, 5, 113, 57, 2, 619, 621,
-	5, 103, 52, 2, 620, 619, 3, 2, 2, 2, 621, 624, 3, 2, 2, 2, 622, 620, 3,
-	2, 2, 2, 622, 623, 3, 2, 2, 2, 623, 627, 3, 2, 2, 2, 624, 622, 3, 2, 2,
-	2, 625, 627, 7, 50, 2, 2, 626, 618, 3, 2, 2, 2, 626, 625, 3, 2, 2, 2, 627,
-	108, 3, 2, 2, 2, 628, 632, 7, 50, 2, 2, 629, 631, 5, 115, 58, 2, 630, 629,
-	3, 2, 2, 2, 631, 634, 3, 2, 2, 2, 632, 630, 3, 2, 2, 2, 632, 633, 3, 2,
-	2, 2, 633, 110, 3, 2, 2, 2, 634, 632, 3, 2, 2, 2, 635, 636, 7, 50, 2, 2,
-	636, 637, 9, 9, 2, 2, 637, 638, 5, 135, 68, 2, 638, 112, 3, 2, 2, 2, 639,
-	640, 9, 10, 2, 2, 640, 114, 3, 2, 2, 2, 641, 642, 9, 11, 2, 2, 642, 116,
-	3, 2, 2, 2, 643, 644, 9, 12, 2, 2, 644, 118, 3, 2, 2, 2, 645, 646, 5, 117,
-	59, 2, 646, 647, 5, 117, 59, 2, 647, 648, 5, 117, 59, 2, 648, 649, 5, 117,
-	59, 2, 649, 120, 3, 2, 2, 2, 650, 651, 7, 94, 2, 2, 651, 652, 7, 119, 2,
-	2, 652, 653, 3, 2, 2, 2, 653, 661, 5, 119, 60, 2, 654, 655, 7, 94, 2, 2,
-	655, 656, 7, 87, 2, 2, 656, 657, 3, 2, 2, 2, 657, 658, 5, 119, 60, 2, 658,
-	659, 5, 119, 60, 2, 659, 661, 3, 2, 2, 2, 660, 650, 3, 2, 2, 2, 660, 654,
-	3, 2, 2, 2, 661, 122, 3, 2, 2, 2, 662, 664, 5, 127, 64, 2, 663, 665, 5,
-	129, 65, 2, 664, 663, 3, 2, 2, 2, 664, 665, 3, 2, 2, 2, 665, 670, 3, 2,
-	2, 2, 666, 667, 5, 131, 66, 2, 667, 668, 5, 129, 65, 2, 668, 670, 3, 2,
-	2, 2, 669, 662, 3, 2, 2, 2, 669, 666, 3, 2, 2, 2, 670, 124, 3, 2, 2, 2,
-	671, 672, 7, 50, 2, 2, 672, 675, 9, 9, 2, 2, 673, 676, 5, 133, 67, 2, 674,
-	676, 5, 135, 68, 2, 675, 673, 3, 2, 2, 2, 675, 674, 3, 2, 2, 2, 676, 677,
-	3, 2, 2, 2, 677, 678, 5, 137, 69, 2, 678, 126, 3, 2, 2, 2, 679, 681, 5,
-	131, 66, 2, 680, 679, 3, 2, 2, 2, 680, 681, 3, 2, 2, 2, 681, 682, 3, 2,
-	2, 2, 682, 683, 7, 48, 2, 2, 683, 688, 5, 131, 66, 2, 684, 685, 5, 131,
-	66, 2, 685, 686, 7, 48, 2, 2, 686, 688, 3, 2, 2, 2, 687, 680, 3, 2, 2,
-	2, 687, 684, 3, 2, 2, 2, 688, 128, 3, 2, 2, 2, 689, 691, 9, 13, 2, 2, 690,
-	692, 9, 14, 2, 2, 691, 690, 3, 2, 2, 2, 691, 692, 3, 2, 2, 2, 692, 693,
-	3, 2, 2, 2, 693, 694, 5, 131, 66, 2, 694, 130, 3, 2, 2, 2, 695, 697, 5,
-	103, 52, 2, 696, 695, 3, 2, 2, 2, 697, 698, 3, 2, 2, 2, 698, 696, 3, 2,
-	2, 2, 698, 699, 3, 2, 2, 2, 699, 132, 3, 2, 2, 2, 700, 702, 5, 135, 68,
-	2, 701, 700, 3, 2, 2, 2, 701, 702, 3, 2, 2, 2, 702, 703, 3, 2, 2, 2, 703,
-	704, 7, 48, 2, 2, 704, 709, 5, 135, 68, 2, 705, 706, 5, 135, 68, 2, 706,
-	707, 7, 48, 2, 2, 707, 709, 3, 2, 2, 2, 708, 701, 3, 2, 2, 2, 708, 705,
-	3, 2, 2, 2, 709, 134, 3, 2, 2, 2, 710, 712, 5, 117, 59, 2, 711, 710, 3,
-	2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 711, 3, 2, 2, 2, 713, 714, 3, 2, 2,
-	2, 714, 136, 3, 2, 2, 2, 715, 717, 9, 15, 2, 2, 716, 718, 9, 14, 2, 2,
-	717, 716, 3, 2, 2, 2, 717, 718, 3, 2, 2, 2, 718, 719, 3, 2, 2, 2, 719,
-	720, 5, 131, 66, 2, 720, 138, 3, 2, 2, 2, 721, 722, 7, 94, 2, 2, 722, 737,
-	9, 16, 2, 2, 723, 724, 7, 94, 2, 2, 724, 726, 5, 115, 58, 2, 725, 727,
-	5, 115, 58, 2, 726, 725, 3, 2, 2, 2, 726, 727, 3, 2, 2, 2, 727, 729, 3,
-	2, 2, 2, 728, 730, 5, 115, 58, 2, 729, 728, 3, 2, 2, 2, 729, 730, 3, 2,
-	2, 2, 730, 737, 3, 2, 2, 2, 731, 732, 7, 94, 2, 2, 732, 733, 7, 122, 2,
-	2, 733, 734, 3, 2, 2, 2, 734, 737, 5, 135, 68, 2, 735, 737, 5, 121, 61,
-	2, 736, 721, 3, 2, 2, 2, 736, 723, 3, 2, 2, 2, 736, 731, 3, 2, 2, 2, 736,
-	735, 3, 2, 2, 2, 737, 140, 3, 2, 2, 2, 738, 740, 9, 17, 2, 2, 739, 738,
-	3, 2, 2, 2, 740, 741, 3, 2, 2, 2, 741, 739, 3, 2, 2, 2, 741, 742, 3, 2,
-	2, 2, 742, 743, 3, 2, 2, 2, 743, 744, 8, 71, 2, 2, 744, 142, 3, 2, 2, 2,
-	745, 747, 7, 15, 2, 2, 746, 748, 7, 12, 2, 2, 747, 746, 3, 2, 2, 2, 747,
-	748, 3, 2, 2, 2, 748, 751, 3, 2, 2, 2, 749, 751, 7, 12, 2, 2, 750, 745,
-	3, 2, 2, 2, 750, 749, 3, 2, 2, 2, 751, 752, 3, 2, 2, 2, 752, 753, 8, 72,
-	2, 2, 753, 144, 3, 2, 2, 2, 56, 2, 179, 193, 225, 231, 239, 254, 256, 287,
-	323, 359, 389, 427, 465, 491, 520, 526, 530, 535, 537, 545, 548, 552, 557,
-	560, 566, 572, 577, 582, 587, 596, 605, 616, 622, 626, 632, 660, 664, 669,
-	675, 680, 687, 691, 698, 701, 708, 713, 717, 726, 729, 736, 741, 747, 750,
-	3, 8, 2, 2,
+	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3,
+	40, 3, 40, 3, 40, 3, 40, 5, 40, 490, 10, 40, 3, 41, 3, 41, 3, 41, 3, 41,
+	3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
+	41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41,
+	516, 10, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3,
+	42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42,
+	3, 42, 3, 42, 3, 42, 5, 42, 540, 10, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3,
+	43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43,
+	3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 5, 43, 562, 10, 43, 3, 44, 3, 44, 3,
+	44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3,
+	44, 3, 44, 3, 44, 3, 44, 5, 44, 591, 10, 44, 3, 45, 3, 45, 3, 45, 3, 45,
+	5, 45, 597, 10, 45, 3, 46, 3, 46, 5, 46, 601, 10, 46, 3, 47, 3, 47, 3,
+	47, 7, 47, 606, 10, 47, 12, 47, 14, 47, 609, 11, 47, 3, 47, 3, 47, 3, 47,
+	3, 47, 3, 47, 5, 47, 616, 10, 47, 3, 48, 5, 48, 619, 10, 48, 3, 48, 3,
+	48, 5, 48, 623, 10, 48, 3, 48, 3, 48, 3, 48, 5, 48, 628, 10, 48, 3, 48,
+	5, 48, 631, 10, 48, 3, 49, 3, 49, 3, 49, 3, 49, 5, 49, 637, 10, 49, 3,
+	49, 3, 49, 6, 49, 641, 10, 49, 13, 49, 14, 49, 642, 3, 50, 3, 50, 3, 50,
+	5, 50, 648, 10, 50, 3, 51, 6, 51, 651, 10, 51, 13, 51, 14, 51, 652, 3,
+	52, 6, 52, 656, 10, 52, 13, 52, 14, 52, 657, 3, 53, 3, 53, 3, 53, 3, 53,
+	3, 53, 3, 53, 3, 53, 5, 53, 667, 10, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3,
+	54, 3, 54, 3, 54, 5, 54, 676, 10, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 57,
+	3, 57, 3, 57, 6, 57, 685, 10, 57, 13, 57, 14, 57, 686, 3, 58, 3, 58, 7,
+	58, 691, 10, 58, 12, 58, 14, 58, 694, 11, 58, 3, 58, 5, 58, 697, 10, 58,
+	3, 59, 3, 59, 7, 59, 701, 10, 59, 12, 59, 14, 59, 704, 11, 59, 3, 60, 3,
+	60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 62, 3, 62, 3, 63, 3, 63, 3, 64, 3, 64,
+	3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 65, 3, 65, 5, 65, 731, 10, 65, 3, 66, 3, 66, 5, 66, 735, 10, 66,
+	3, 66, 3, 66, 3, 66, 5, 66, 740, 10, 66, 3, 67, 3, 67, 3, 67, 3, 67, 5,
+	67, 746, 10, 67, 3, 67, 3, 67, 3, 68, 5, 68, 751, 10, 68, 3, 68, 3, 68,
+	3, 68, 3, 68, 3, 68, 5, 68, 758, 10, 68, 3, 69, 3, 69, 5, 69, 762, 10,
+	69, 3, 69, 3, 69, 3, 70, 6, 70, 767, 10, 70, 13, 70, 14, 70, 768, 3, 71,
+	5, 71, 772, 10, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 5, 71, 779, 10,
+	71, 3, 72, 6, 72, 782, 10, 72, 13, 72, 14, 72, 783, 3, 73, 3, 73, 5, 73,
+	788, 10, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 5, 74, 797,
+	10, 74, 3, 74, 5, 74, 800, 10, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 5,
+	74, 807, 10, 74, 3, 75, 6, 75, 810, 10, 75, 13, 75, 14, 75, 811, 3, 75,
+	3, 75, 3, 76, 3, 76, 5, 76, 818, 10, 76, 3, 76, 5, 76, 821, 10, 76, 3,
+	76, 3, 76, 2, 2, 77, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10,
+	19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19,
+	37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28,
+	55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37,
+	73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46,
+	91, 47, 93, 48, 95, 49, 97, 50, 99, 2, 101, 2, 103, 2, 105, 2, 107, 2,
+	109, 2, 111, 2, 113, 2, 115, 2, 117, 2, 119, 2, 121, 2, 123, 2, 125, 2,
+	127, 2, 129, 2, 131, 2, 133, 2, 135, 2, 137, 2, 139, 2, 141, 2, 143, 2,
+	145, 2, 147, 2, 149, 51, 151, 52, 3, 2, 18, 5, 2, 78, 78, 87, 87, 119,
+	119, 6, 2, 12, 12, 15, 15, 36, 36, 94, 94, 6, 2, 12, 12, 15, 15, 41, 41,
+	94, 94, 5, 2, 67, 92, 97, 97, 99, 124, 3, 2, 50, 59, 4, 2, 68, 68, 100,
+	100, 3, 2, 50, 51, 4, 2, 90, 90, 122, 122, 3, 2, 51, 59, 3, 2, 50, 57,
+	5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47,
+	47, 4, 2, 82, 82, 114, 114, 12, 2, 36, 36, 41, 41, 65, 65, 94, 94, 99,
+	100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120, 4, 2, 11, 11, 34,
+	34, 2, 867, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9,
+	3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2,
+	17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2,
+	2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2,
+	2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2,
+	2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3,
+	2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55,
+	3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2,
+	63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2,
+	2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2,
+	2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2,
+	2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3,
+	2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151,
+	3, 2, 2, 2, 3, 153, 3, 2, 2, 2, 5, 155, 3, 2, 2, 2, 7, 157, 3, 2, 2, 2,
+	9, 159, 3, 2, 2, 2, 11, 161, 3, 2, 2, 2, 13, 163, 3, 2, 2, 2, 15, 165,
+	3, 2, 2, 2, 17, 168, 3, 2, 2, 2, 19, 170, 3, 2, 2, 2, 21, 173, 3, 2, 2,
+	2, 23, 176, 3, 2, 2, 2, 25, 187, 3, 2, 2, 2, 27, 201, 3, 2, 2, 2, 29, 211,
+	3, 2, 2, 2, 31, 217, 3, 2, 2, 2, 33, 219, 3, 2, 2, 2, 35, 221, 3, 2, 2,
+	2, 37, 223, 3, 2, 2, 2, 39, 225, 3, 2, 2, 2, 41, 227, 3, 2, 2, 2, 43, 229,
+	3, 2, 2, 2, 45, 232, 3, 2, 2, 2, 47, 235, 3, 2, 2, 2, 49, 238, 3, 2, 2,
+	2, 51, 240, 3, 2, 2, 2, 53, 242, 3, 2, 2, 2, 55, 249, 3, 2, 2, 2, 57, 255,
+	3, 2, 2, 2, 59, 257, 3, 2, 2, 2, 61, 263, 3, 2, 2, 2, 63, 265, 3, 2, 2,
+	2, 65, 268, 3, 2, 2, 2, 67, 275, 3, 2, 2, 2, 69, 311, 3, 2, 2, 2, 71, 347,
+	3, 2, 2, 2, 73, 383, 3, 2, 2, 2, 75, 413, 3, 2, 2, 2, 77, 451, 3, 2, 2,
+	2, 79, 489, 3, 2, 2, 2, 81, 515, 3, 2, 2, 2, 83, 539, 3, 2, 2, 2, 85, 561,
+	3, 2, 2, 2, 87, 590, 3, 2, 2, 2, 89, 596, 3, 2, 2, 2, 91, 600, 3, 2, 2,
+	2, 93, 615, 3, 2, 2, 2, 95, 618, 3, 2, 2, 2, 97, 632, 3, 2, 2, 2, 99, 647,
+	3, 2, 2, 2, 101, 650, 3, 2, 2, 2, 103, 655, 3, 2, 2, 2, 105, 666, 3, 2,
+	2, 2, 107, 675, 3, 2, 2, 2, 109, 677, 3, 2, 2, 2, 111, 679, 3, 2, 2, 2,
+	113, 681, 3, 2, 2, 2, 115, 696, 3, 2, 2, 2, 117, 698, 3, 2, 2, 2, 119,
+	705, 3, 2, 2, 2, 121, 709, 3, 2, 2, 2, 123, 711, 3, 2, 2, 2, 125, 713,
+	3, 2, 2, 2, 127, 715, 3, 2, 2, 2, 129, 730, 3, 2, 2, 2, 131, 739, 3, 2,
+	2, 2, 133, 741, 3, 2, 2, 2, 135, 757, 3, 2, 2, 2, 137, 759, 3, 2, 2, 2,
+	139, 766, 3, 2, 2, 2, 141, 778, 3, 2, 2, 2, 143, 781, 3, 2, 2, 2, 145,
+	785, 3, 2, 2, 2, 147, 806, 3, 2, 2, 2, 149, 809, 3, 2, 2, 2, 151, 820,
+	3, 2, 2, 2, 153, 154, 7, 42, 2, 2, 154, 4, 3, 2, 2, 2, 155, 156, 7, 43,
+	2, 2, 156, 6, 3, 2, 2, 2, 157, 158, 7, 46, 2, 2, 158, 8, 3, 2, 2, 2, 159,
+	160, 7, 93, 2, 2, 160, 10, 3, 2, 2, 2, 161, 162, 7, 95, 2, 2, 162, 12,
+	3, 2, 2, 2, 163, 164, 7, 62, 2, 2, 164, 14, 3, 2, 2, 2, 165, 166, 7, 62,
+	2, 2, 166, 167, 7, 63, 2, 2, 167, 16, 3, 2, 2, 2, 168, 169, 7, 64, 2, 2,
+	169, 18, 3, 2, 2, 2, 170, 171, 7, 64, 2, 2, 171, 172, 7, 63, 2, 2, 172,
+	20, 3, 2, 2, 2, 173, 174, 7, 63, 2, 2, 174, 175, 7, 63, 2, 2, 175, 22,
+	3, 2, 2, 2, 176, 177, 7, 35, 2, 2, 177, 178, 7, 63, 2, 2, 178, 24, 3, 2,
+	2, 2, 179, 180, 7, 110, 2, 2, 180, 181, 7, 107, 2, 2, 181, 182, 7, 109,
+	2, 2, 182, 188, 7, 103, 2, 2, 183, 184, 7, 78, 2, 2, 184, 185, 7, 75, 2,
+	2, 185, 186, 7, 77, 2, 2, 186, 188, 7, 71, 2, 2, 187, 179, 3, 2, 2, 2,
+	187, 183, 3, 2, 2, 2, 188, 26, 3, 2, 2, 2, 189, 190, 7, 103, 2, 2, 190,
+	191, 7, 122, 2, 2, 191, 192, 7, 107, 2, 2, 192, 193, 7, 117, 2, 2, 193,
+	194, 7, 118, 2, 2, 194, 202, 7, 117, 2, 2, 195, 196, 7, 71, 2, 2, 196,
+	197, 7, 90, 2, 2, 197, 198, 7, 75, 2, 2, 198, 199, 7, 85, 2, 2, 199, 200,
+	7, 86, 2, 2, 200, 202, 7, 85, 2, 2, 201, 189, 3, 2, 2, 2, 201, 195, 3,
+	2, 2, 2, 202, 28, 3, 2, 2, 2, 203, 204, 7, 101, 2, 2, 204, 205, 7, 99,
+	2, 2, 205, 206, 7, 117, 2, 2, 206, 212, 7, 118, 2, 2, 207, 208, 7, 69,
+	2, 2, 208, 209, 7, 67, 2, 2, 209, 210, 7, 85, 2, 2, 210, 212, 7, 86, 2,
+	2, 211, 203, 3, 2, 2, 2, 211, 207, 3, 2, 2, 2, 212, 30, 3, 2, 2, 2, 213,
+	214, 7, 99, 2, 2, 214, 218, 7, 117, 2, 2, 215, 216, 7, 67, 2, 2, 216, 218,
+	7, 85, 2, 2, 217, 213, 3, 2, 2, 2, 217, 215, 3, 2, 2, 2, 218, 32, 3, 2,
+	2, 2, 219, 220, 7, 45, 2, 2, 220, 34, 3, 2, 2, 2, 221, 222, 7, 47, 2, 2,
+	222, 36, 3, 2, 2, 2, 223, 224, 7, 44, 2, 2, 224, 38, 3, 2, 2, 2, 225, 226,
+	7, 49, 2, 2, 226, 40, 3, 2, 2, 2, 227, 228, 7, 39, 2, 2, 228, 42, 3, 2,
+	2, 2, 229, 230, 7, 44, 2, 2, 230, 231, 7, 44, 2, 2, 231, 44, 3, 2, 2, 2,
+	232, 233, 7, 62, 2, 2, 233, 234, 7, 62, 2, 2, 234, 46, 3, 2, 2, 2, 235,
+	236, 7, 64, 2, 2, 236, 237, 7, 64, 2, 2, 237, 48, 3, 2, 2, 2, 238, 239,
+	7, 40, 2, 2, 239, 50, 3, 2, 2, 2, 240, 241, 7, 126, 2, 2, 241, 52, 3, 2,
+	2, 2, 242, 243, 7, 96, 2, 2, 243, 54, 3, 2, 2, 2, 244, 245, 7, 40, 2, 2,
+	245, 250, 7, 40, 2, 2, 246, 247, 7, 99, 2, 2, 247, 248, 7, 112, 2, 2, 248,
+	250, 7, 102, 2, 2, 249, 244, 3, 2, 2, 2, 249, 246, 3, 2, 2, 2, 250, 56,
+	3, 2, 2, 2, 251, 252, 7, 126, 2, 2, 252, 256, 7, 126, 2, 2, 253, 254, 7,
+	113, 2, 2, 254, 256, 7, 116, 2, 2, 255, 251, 3, 2, 2, 2, 255, 253, 3, 2,
+	2, 2, 256, 58, 3, 2, 2, 2, 257, 258, 7, 128, 2, 2, 258, 60, 3, 2, 2, 2,
+	259, 264, 7, 35, 2, 2, 260, 261, 7, 112, 2, 2, 261, 262, 7, 113, 2, 2,
+	262, 264, 7, 118, 2, 2, 263, 259, 3, 2, 2, 2, 263, 260, 3, 2, 2, 2, 264,
+	62, 3, 2, 2, 2, 265, 266, 7, 107, 2, 2, 266, 267, 7, 112, 2, 2, 267, 64,
+	3, 2, 2, 2, 268, 269, 7, 112, 2, 2, 269, 270, 7, 113, 2, 2, 270, 271, 7,
+	118, 2, 2, 271, 272, 7, 34, 2, 2, 272, 273, 7, 107, 2, 2, 273, 274, 7,
+	112, 2, 2, 274, 66, 3, 2, 2, 2, 275, 280, 7, 93, 2, 2, 276, 279, 5, 149,
+	75, 2, 277, 279, 5, 151, 76, 2, 278, 276, 3, 2, 2, 2, 278, 277, 3, 2, 2,
+	2, 279, 282, 3, 2, 2, 2, 280, 278, 3, 2, 2, 2, 280, 281, 3, 2, 2, 2, 281,
+	283, 3, 2, 2, 2, 282, 280, 3, 2, 2, 2, 283, 284, 7, 95, 2, 2, 284, 68,
+	3, 2, 2, 2, 285, 286, 7, 108, 2, 2, 286, 287, 7, 117, 2, 2, 287, 288, 7,
+	113, 2, 2, 288, 289, 7, 112, 2, 2, 289, 290, 7, 97, 2, 2, 290, 291, 7,
+	101, 2, 2, 291, 292, 7, 113, 2, 2, 292, 293, 7, 112, 2, 2, 293, 294, 7,
+	118, 2, 2, 294, 295, 7, 99, 2, 2, 295, 296, 7, 107, 2, 2, 296, 297, 7,
+	112, 2, 2, 297, 312, 7, 117, 2, 2, 298, 299, 7, 76, 2, 2, 299, 300, 7,
+	85, 2, 2, 300, 301, 7, 81, 2, 2, 301, 302, 7, 80, 2, 2, 302, 303, 7, 97,
+	2, 2, 303, 304, 7, 69, 2, 2, 304, 305, 7, 81, 2, 2, 305, 306, 7, 80, 2,
+	2, 306, 307, 7, 86, 2, 2, 307, 308, 7, 67, 2, 2, 308, 309, 7, 75, 2, 2,
+	309, 310, 7, 80, 2, 2, 310, 312, 7, 85, 2, 2, 311, 285, 3, 2, 2, 2, 311,
+	298, 3, 2, 2, 2, 312, 70, 3, 2, 2, 2, 313, 314, 7, 108, 2, 2, 314, 315,
+	7, 117, 2, 2, 315, 316, 7, 113, 2, 2, 316, 317, 7, 112, 2, 2, 317, 318,
+	7, 97, 2, 2, 318, 319, 7, 101, 2, 2, 319, 320, 7, 113, 2, 2, 320, 321,
+	7, 112, 2, 2, 321, 322, 7, 118, 2, 2, 322, 323, 7, 99, 2, 2, 323, 324,
+	7, 107, 2, 2, 324, 325, 7, 112, 2, 2, 325, 326, 7, 117, 2, 2, 326, 327,
+	7, 97, 2, 2, 327, 328, 7, 99, 2, 2, 328, 329, 7, 110, 2, 2, 329, 348, 7,
+	110, 2, 2, 330, 331, 7, 76, 2, 2, 331, 332, 7, 85, 2, 2, 332, 333, 7, 81,
+	2, 2, 333, 334, 7, 80, 2, 2, 334, 335, 7, 97, 2, 2, 335, 336, 7, 69, 2,
+	2, 336, 337, 7, 81, 2, 2, 337, 338, 7, 80, 2, 2, 338, 339, 7, 86, 2, 2,
+	339, 340, 7, 67, 2, 2, 340, 341, 7, 75, 2, 2, 341, 342, 7, 80, 2, 2, 342,
+	343, 7, 85, 2, 2, 343, 344, 7, 97, 2, 2, 344, 345, 7, 67, 2, 2, 345, 346,
+	7, 78, 2, 2, 346, 348, 7, 78, 2, 2, 347, 313, 3, 2, 2, 2, 347, 330, 3,
+	2, 2, 2, 348, 72, 3, 2, 2, 2, 349, 350, 7, 108, 2, 2, 350, 351, 7, 117,
+	2, 2, 351, 352, 7, 113, 2, 2, 352, 353, 7, 112, 2, 2, 353, 354, 7, 97,
+	2, 2, 354, 355, 7, 101, 2, 2, 355, 356, 7, 113, 2, 2, 356, 357, 7, 112,
+	2, 2, 357, 358, 7, 118, 2, 2, 358, 359, 7, 99, 2, 2, 359, 360, 7, 107,
+	2, 2, 360, 361, 7, 112, 2, 2, 361, 362, 7, 117, 2, 2, 362, 363, 7, 97,
+	2, 2, 363, 364, 7, 99, 2, 2, 364, 365, 7, 112, 2, 2, 365, 384, 7, 123,
+	2, 2, 366, 367, 7, 76, 2, 2, 367, 368, 7, 85, 2, 2, 368, 369, 7, 81, 2,
+	2, 369, 370, 7, 80, 2, 2, 370, 371, 7, 97, 2, 2, 371, 372, 7, 69, 2, 2,
+	372, 373, 7, 81, 2, 2, 373, 374, 7, 80, 2, 2, 374, 375, 7, 86, 2, 2, 375,
+	376, 7, 67, 2, 2, 376, 377, 7, 75, 2, 2, 377, 378, 7, 80, 2, 2, 378, 379,
+	7, 85, 2, 2, 379, 380, 7, 97, 2, 2, 380, 381, 7, 67, 2, 2, 381, 382, 7,
+	80, 2, 2, 382, 384, 7, 91, 2, 2, 383, 349, 3, 2, 2, 2, 383, 366, 3, 2,
+	2, 2, 384, 74, 3, 2, 2, 2, 385, 386, 7, 99, 2, 2, 386, 387, 7, 116, 2,
+	2, 387, 388, 7, 116, 2, 2, 388, 389, 7, 99, 2, 2, 389, 390, 7, 123, 2,
+	2, 390, 391, 7, 97, 2, 2, 391, 392, 7, 101, 2, 2, 392, 393, 7, 113, 2,
+	2, 393, 394, 7, 112, 2, 2, 394, 395, 7, 118, 2, 2, 395, 396, 7, 99, 2,
+	2, 396, 397, 7, 107, 2, 2, 397, 398, 7, 112, 2, 2, 398, 414, 7, 117, 2,
+	2, 399, 400, 7, 67, 2, 2, 400, 401, 7, 84, 2, 2, 401, 402, 7, 84, 2, 2,
+	402, 403, 7, 67, 2, 2, 403, 404, 7, 91, 2, 2, 404, 405, 7, 97, 2, 2, 405,
+	406, 7, 69, 2, 2, 406, 407, 7, 81, 2, 2, 407, 408, 7, 80, 2, 2, 408, 409,
+	7, 86, 2, 2, 409, 410, 7, 67, 2, 2, 410, 411, 7, 75, 2, 2, 411, 412, 7,
+	80, 2, 2, 412, 414, 7, 85, 2, 2, 413, 385, 3, 2, 2, 2, 413, 399, 3, 2,
+	2, 2, 414, 76, 3, 2, 2, 2, 415, 416, 7, 99, 2, 2, 416, 417, 7, 116, 2,
+	2, 417, 418, 7, 116, 2, 2, 418, 419, 7, 99, 2, 2, 419, 420, 7, 123, 2,
+	2, 420, 421, 7, 97, 2, 2, 421, 422, 7, 101, 2, 2, 422, 423, 7, 113, 2,
+	2, 423, 424, 7, 112, 2, 2, 424, 425, 7, 118, 2, 2, 425, 426, 7, 99, 2,
+	2, 426, 427, 7, 107, 2, 2, 427, 428, 7, 112, 2, 2, 428, 429, 7, 117, 2,
+	2, 429, 430, 7, 97, 2, 2, 430, 431, 7, 99, 2, 2, 431, 432, 7, 110, 2, 2,
+	432, 452, 7, 110, 2, 2, 433, 434, 7, 67, 2, 2, 434, 435, 7, 84, 2, 2, 435,
+	436, 7, 84, 2, 2, 436, 437, 7, 67, 2, 2, 437, 438, 7, 91, 2, 2, 438, 439,
+	7, 97, 2, 2, 439, 440, 7, 69, 2, 2, 440, 441, 7, 81, 2, 2, 441, 442, 7,
+	80, 2, 2, 442, 443, 7, 86, 2, 2, 443, 444, 7, 67, 2, 2, 444, 445, 7, 75,
+	2, 2, 445, 446, 7, 80, 2, 2, 446, 447, 7, 85, 2, 2, 447, 448, 7, 97, 2,
+	2, 448, 449, 7, 67, 2, 2, 449, 450, 7, 78, 2, 2, 450, 452, 7, 78, 2, 2,
+	451, 415, 3, 2, 2, 2, 451, 433, 3, 2, 2, 2, 452, 78, 3, 2, 2, 2, 453, 454,
+	7, 99, 2, 2, 454, 455, 7, 116, 2, 2, 455, 456, 7, 116, 2, 2, 456, 457,
+	7, 99, 2, 2, 457, 458, 7, 123, 2, 2, 458, 459, 7, 97, 2, 2, 459, 460, 7,
+	101, 2, 2, 460, 461, 7, 113, 2, 2, 461, 462, 7, 112, 2, 2, 462, 463, 7,
+	118, 2, 2, 463, 464, 7, 99, 2, 2, 464, 465, 7, 107, 2, 2, 465, 466, 7,
+	112, 2, 2, 466, 467, 7, 117, 2, 2, 467, 468, 7, 97, 2, 2, 468, 469, 7,
+	99, 2, 2, 469, 470, 7, 112, 2, 2, 470, 490, 7, 123, 2, 2, 471, 472, 7,
+	67, 2, 2, 472, 473, 7, 84, 2, 2, 473, 474, 7, 84, 2, 2, 474, 475, 7, 67,
+	2, 2, 475, 476, 7, 91, 2, 2, 476, 477, 7, 97, 2, 2, 477, 478, 7, 69, 2,
+	2, 478, 479, 7, 81, 2, 2, 479, 480, 7, 80, 2, 2, 480, 481, 7, 86, 2, 2,
+	481, 482, 7, 67, 2, 2, 482, 483, 7, 75, 2, 2, 483, 484, 7, 80, 2, 2, 484,
+	485, 7, 85, 2, 2, 485, 486, 7, 97, 2, 2, 486, 487, 7, 67, 2, 2, 487, 488,
+	7, 80, 2, 2, 488, 490, 7, 91, 2, 2, 489, 453, 3, 2, 2, 2, 489, 471, 3,
+	2, 2, 2, 490, 80, 3, 2, 2, 2, 491, 492, 7, 99, 2, 2, 492, 493, 7, 116,
+	2, 2, 493, 494, 7, 116, 2, 2, 494, 495, 7, 99, 2, 2, 495, 496, 7, 123,
+	2, 2, 496, 497, 7, 97, 2, 2, 497, 498, 7, 110, 2, 2, 498, 499, 7, 103,
+	2, 2, 499, 500, 7, 112, 2, 2, 500, 501, 7, 105, 2, 2, 501, 502, 7, 118,
+	2, 2, 502, 516, 7, 106, 2, 2, 503, 504, 7, 67, 2, 2, 504, 505, 7, 84, 2,
+	2, 505, 506, 7, 84, 2, 2, 506, 507, 7, 67, 2, 2, 507, 508, 7, 91, 2, 2,
+	508, 509, 7, 97, 2, 2, 509, 510, 7, 78, 2, 2, 510, 511, 7, 71, 2, 2, 511,
+	512, 7, 80, 2, 2, 512, 513, 7, 73, 2, 2, 513, 514, 7, 86, 2, 2, 514, 516,
+	7, 74, 2, 2, 515, 491, 3, 2, 2, 2, 515, 503, 3, 2, 2, 2, 516, 82, 3, 2,
+	2, 2, 517, 518, 7, 116, 2, 2, 518, 519, 7, 103, 2, 2, 519, 520, 7, 105,
+	2, 2, 520, 521, 7, 103, 2, 2, 521, 522, 7, 122, 2, 2, 522, 523, 7, 97,
+	2, 2, 523, 524, 7, 111, 2, 2, 524, 525, 7, 99, 2, 2, 525, 526, 7, 118,
+	2, 2, 526, 527, 7, 101, 2, 2, 527, 540, 7, 106, 2, 2, 528, 529, 7, 84,
+	2, 2, 529, 530, 7, 71, 2, 2, 530, 531, 7, 73, 2, 2, 531, 532, 7, 71, 2,
+	2, 532, 533, 7, 90, 2, 2, 533, 534, 7, 97, 2, 2, 534, 535, 7, 79, 2, 2,
+	535, 536, 7, 67, 2, 2, 536, 537, 7, 86, 2, 2, 537, 538, 7, 69, 2, 2, 538,
+	540, 7, 74, 2, 2, 539, 517, 3, 2, 2, 2, 539, 528, 3, 2, 2, 2, 540, 84,
+	3, 2, 2, 2, 541, 542, 7, 118, 2, 2, 542, 543, 7, 103, 2, 2, 543, 544, 7,
+	122, 2, 2, 544, 545, 7, 118, 2, 2, 545, 546, 7, 97, 2, 2, 546, 547, 7,
+	111, 2, 2, 547, 548, 7, 99, 2, 2, 548, 549, 7, 118, 2, 2, 549, 550, 7,
+	101, 2, 2, 550, 562, 7, 106, 2, 2, 551, 552, 7, 86, 2, 2, 552, 553, 7,
+	71, 2, 2, 553, 554, 7, 90, 2, 2, 554, 555, 7, 86, 2, 2, 555, 556, 7, 97,
+	2, 2, 556, 557, 7, 79, 2, 2, 557, 558, 7, 67, 2, 2, 558, 559, 7, 86, 2,
+	2, 559, 560, 7, 69, 2, 2, 560, 562, 7, 74, 2, 2, 561, 541, 3, 2, 2, 2,
+	561, 551, 3, 2, 2, 2, 562, 86, 3, 2, 2, 2, 563, 564, 7, 118, 2, 2, 564,
+	565, 7, 116, 2, 2, 565, 566, 7, 119, 2, 2, 566, 591, 7, 103, 2, 2, 567,
+	568, 7, 86, 2, 2, 568, 569, 7, 116, 2, 2, 569, 570, 7, 119, 2, 2, 570,
+	591, 7, 103, 2, 2, 571, 572, 7, 86, 2, 2, 572, 573, 7, 84, 2, 2, 573, 574,
+	7, 87, 2, 2, 574, 591, 7, 71, 2, 2, 575, 576, 7, 104, 2, 2, 576, 577, 7,
+	99, 2, 2, 577, 578, 7, 110, 2, 2, 578, 579, 7, 117, 2, 2, 579, 591, 7,
+	103, 2, 2, 580, 581, 7, 72, 2, 2, 581, 582, 7, 99, 2, 2, 582, 583, 7, 110,
+	2, 2, 583, 584, 7, 117, 2, 2, 584, 591, 7, 103, 2, 2, 585, 586, 7, 72,
+	2, 2, 586, 587, 7, 67, 2, 2, 587, 588, 7, 78, 2, 2, 588, 589, 7, 85, 2,
+	2, 589, 591, 7, 71, 2, 2, 590, 563, 3, 2, 2, 2, 590, 567, 3, 2, 2, 2, 590,
+	571, 3, 2, 2, 2, 590, 575, 3, 2, 2, 2, 590, 580, 3, 2, 2, 2, 590, 585,
+	3, 2, 2, 2, 591, 88, 3, 2, 2, 2, 592, 597, 5, 115, 58, 2, 593, 597, 5,
+	117, 59, 2, 594, 597, 5, 119, 60, 2, 595, 597, 5, 113, 57, 2, 596, 592,
+	3, 2, 2, 2, 596, 593, 3, 2, 2, 2, 596, 594, 3, 2, 2, 2, 596, 595, 3, 2,
+	2, 2, 597, 90, 3, 2, 2, 2, 598, 601, 5, 131, 66, 2, 599, 601, 5, 133, 67,
+	2, 600, 598, 3, 2, 2, 2, 600, 599, 3, 2, 2, 2, 601, 92, 3, 2, 2, 2, 602,
+	607, 5, 109, 55, 2, 603, 606, 5, 109, 55, 2, 604, 606, 5, 111, 56, 2, 605,
+	603, 3, 2, 2, 2, 605, 604, 3, 2, 2, 2, 606, 609, 3, 2, 2, 2, 607, 605,
+	3, 2, 2, 2, 607, 608, 3, 2, 2, 2, 608, 616, 3, 2, 2, 2, 609, 607, 3, 2,
+	2, 2, 610, 611, 7, 38, 2, 2, 611, 612, 7, 111, 2, 2, 612, 613, 7, 103,
+	2, 2, 613, 614, 7, 118, 2, 2, 614, 616, 7, 99, 2, 2, 615, 602, 3, 2, 2,
+	2, 615, 610, 3, 2, 2, 2, 616, 94, 3, 2, 2, 2, 617, 619, 5, 99, 50, 2, 618,
+	617, 3, 2, 2, 2, 618, 619, 3, 2, 2, 2, 619, 630, 3, 2, 2, 2, 620, 622,
+	7, 36, 2, 2, 621, 623, 5, 101, 51, 2, 622, 621, 3, 2, 2, 2, 622, 623, 3,
+	2, 2, 2, 623, 624, 3, 2, 2, 2, 624, 631, 7, 36, 2, 2, 625, 627, 7, 41,
+	2, 2, 626, 628, 5, 103, 52, 2, 627, 626, 3, 2, 2, 2, 627, 628, 3, 2, 2,
+	2, 628, 629, 3, 2, 2, 2, 629, 631, 7, 41, 2, 2, 630, 620, 3, 2, 2, 2, 630,
+	625, 3, 2, 2, 2, 631, 96, 3, 2, 2, 2, 632, 640, 5, 93, 47, 2, 633, 636,
+	7, 93, 2, 2, 634, 637, 5, 95, 48, 2, 635, 637, 5, 115, 58, 2, 636, 634,
+	3, 2, 2, 2, 636, 635, 3, 2, 2, 2, 637, 638, 3, 2, 2, 2, 638, 639, 7, 95,
+	2, 2, 639, 641, 3, 2, 2, 2, 640, 633, 3, 2, 2, 2, 641, 642, 3, 2, 2, 2,
+	642, 640, 3, 2, 2, 2, 642, 643, 3, 2, 2, 2, 643, 98, 3, 2, 2, 2, 644, 645,
+	7, 119, 2, 2, 645, 648, 7, 58, 2, 2, 646, 648, 9, 2, 2, 2, 647, 644, 3,
+	2, 2, 2, 647, 646, 3, 2, 2, 2, 648, 100, 3, 2, 2, 2, 649, 651, 5, 105,
+	53, 2, 650, 649, 3, 2, 2, 2, 651, 652, 3, 2, 2, 2, 652, 650, 3, 2, 2, 2,
+	652, 653, 3, 2, 2, 2, 653, 102, 3, 2, 2, 2, 654, 656, 5, 107, 54, 2, 655,
+	654, 3, 2, 2, 2, 656, 657, 3, 2, 2, 2, 657, 655, 3, 2, 2, 2, 657, 658,
+	3, 2, 2, 2, 658, 104, 3, 2, 2, 2, 659, 667, 10, 3, 2, 2, 660, 667, 5, 147,
+	74, 2, 661, 662, 7, 94, 2, 2, 662, 667, 7, 12, 2, 2, 663, 664, 7, 94, 2,
+	2, 664, 665, 7, 15, 2, 2, 665, 667, 7, 12, 2, 2, 666, 659, 3, 2, 2, 2,
+	666, 660, 3, 2, 2, 2, 666, 661, 3, 2, 2, 2, 666, 663, 3, 2, 2, 2, 667,
+	106, 3, 2, 2, 2, 668, 676, 10, 4, 2, 2, 669, 676, 5, 147, 74, 2, 670, 671,
+	7, 94, 2, 2, 671, 676, 7, 12, 2, 2, 672, 673, 7, 94, 2, 2, 673, 674, 7,
+	15, 2, 2, 674, 676, 7, 12, 2, 2, 675, 668, 3, 2, 2, 2, 675, 669, 3, 2,
+	2, 2, 675, 670, 3, 2, 2, 2, 675, 672, 3, 2, 2, 2, 676, 108, 3, 2, 2, 2,
+	677, 678, 9, 5, 2, 2, 678, 110, 3, 2, 2, 2, 679, 680, 9, 6, 2, 2, 680,
+	112, 3, 2, 2, 2, 681, 682, 7, 50, 2, 2, 682, 684, 9, 7, 2, 2, 683, 685,
+	9, 8, 2, 2, 684, 683, 3, 2, 2, 2, 685, 686, 3, 2, 2, 2, 686, 684, 3, 2,
+	2, 2, 686, 687, 3, 2, 2, 2, 687, 114, 3, 2, 2, 2, 688, 692, 5, 121, 61,
+	2, 689, 691, 5, 111, 56, 2, 690, 689, 3, 2, 2, 2, 691, 694, 3, 2, 2, 2,
+	692, 690, 3, 2, 2, 2, 692, 693, 3, 2, 2, 2, 693, 697, 3, 2, 2, 2, 694,
+	692, 3, 2, 2, 2, 695, 697, 7, 50, 2, 2, 696, 688, 3, 2, 2, 2, 696, 695,
+	3, 2, 2, 2, 697, 116, 3, 2, 2, 2, 698, 702, 7, 50, 2, 2, 699, 701, 5, 123,
+	62, 2, 700, 699, 3, 2, 2, 2, 701, 704, 3, 2, 2, 2, 702, 700, 3, 2, 2, 2,
+	702, 703, 3, 2, 2, 2, 703, 118, 3, 2, 2, 2, 704, 702, 3, 2, 2, 2, 705,
+	706, 7, 50, 2, 2, 706, 707, 9, 9, 2, 2, 707, 708, 5, 143, 72, 2, 708, 120,
+	3, 2, 2, 2, 709, 710, 9, 10, 2, 2, 710, 122, 3, 2, 2, 2, 711, 712, 9, 11,
+	2, 2, 712, 124, 3, 2, 2, 2, 713, 714, 9, 12, 2, 2, 714, 126, 3, 2, 2, 2,
+	715, 716, 5, 125, 63, 2, 716, 717, 5, 125, 63, 2, 717, 718, 5, 125, 63,
+	2, 718, 719, 5, 125, 63, 2, 719, 128, 3, 2, 2, 2, 720, 721, 7, 94, 2, 2,
+	721, 722, 7, 119, 2, 2, 722, 723, 3, 2, 2, 2, 723, 731, 5, 127, 64, 2,
+	724, 725, 7, 94, 2, 2, 725, 726, 7, 87, 2, 2, 726, 727, 3, 2, 2, 2, 727,
+	728, 5, 127, 64, 2, 728, 729, 5, 127, 64, 2, 729, 731, 3, 2, 2, 2, 730,
+	720, 3, 2, 2, 2, 730, 724, 3, 2, 2, 2, 731, 130, 3, 2, 2, 2, 732, 734,
+	5, 135, 68, 2, 733, 735, 5, 137, 69, 2, 734, 733, 3, 2, 2, 2, 734, 735,
+	3, 2, 2, 2, 735, 740, 3, 2, 2, 2, 736, 737, 5, 139, 70, 2, 737, 738, 5,
+	137, 69, 2, 738, 740, 3, 2, 2, 2, 739, 732, 3, 2, 2, 2, 739, 736, 3, 2,
+	2, 2, 740, 132, 3, 2, 2, 2, 741, 742, 7, 50, 2, 2, 742, 745, 9, 9, 2, 2,
+	743, 746, 5, 141, 71, 2, 744, 746, 5, 143, 72, 2, 745, 743, 3, 2, 2, 2,
+	745, 744, 3, 2, 2, 2, 746, 747, 3, 2, 2, 2, 747, 748, 5, 145, 73, 2, 748,
+	134, 3, 2, 2, 2, 749, 751, 5, 139, 70, 2, 750, 749, 3, 2, 2, 2, 750, 751,
+	3, 2, 2, 2, 751, 752, 3, 2, 2, 2, 752, 753, 7, 48, 2, 2, 753, 758, 5, 139,
+	70, 2, 754, 755, 5, 139, 70, 2, 755, 756, 7, 48, 2, 2, 756, 758, 3, 2,
+	2, 2, 757, 750, 3, 2, 2, 2, 757, 754, 3, 2, 2, 2, 758, 136, 3, 2, 2, 2,
+	759, 761, 9, 13, 2, 2, 760, 762, 9, 14, 2, 2, 761, 760, 3, 2, 2, 2, 761,
+	762, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 764, 5, 139, 70, 2, 764, 138,
+	3, 2, 2, 2, 765, 767, 5, 111, 56, 2, 766, 765, 3, 2, 2, 2, 767, 768, 3,
+	2, 2, 2, 768, 766, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 140, 3, 2, 2,
+	2, 770, 772, 5, 143, 72, 2, 771, 770, 3, 2, 2, 2, 771, 772, 3, 2, 2, 2,
+	772, 773, 3, 2, 2, 2, 773, 774, 7, 48, 2, 2, 774, 779, 5, 143, 72, 2, 775,
+	776, 5, 143, 72, 2, 776, 777, 7, 48, 2, 2, 777, 779, 3, 2, 2, 2, 778, 771,
+	3, 2, 2, 2, 778, 775, 3, 2, 2, 2, 779, 142, 3, 2, 2, 2, 780, 782, 5, 125,
+	63, 2, 781, 780, 3, 2, 2, 2, 782, 783, 3, 2, 2, 2, 783, 781, 3, 2, 2, 2,
+	783, 784, 3, 2, 2, 2, 784, 144, 3, 2, 2, 2, 785, 787, 9, 15, 2, 2, 786,
+	788, 9, 14, 2, 2, 787, 786, 3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 788, 789,
+	3, 2, 2, 2, 789, 790, 5, 139, 70, 2, 790, 146, 3, 2, 2, 2, 791, 792, 7,
+	94, 2, 2, 792, 807, 9, 16, 2, 2, 793, 794, 7, 94, 2, 2, 794, 796, 5, 123,
+	62, 2, 795, 797, 5, 123, 62, 2, 796, 795, 3, 2, 2, 2, 796, 797, 3, 2, 2,
+	2, 797, 799, 3, 2, 2, 2, 798, 800, 5, 123, 62, 2, 799, 798, 3, 2, 2, 2,
+	799, 800, 3, 2, 2, 2, 800, 807, 3, 2, 2, 2, 801, 802, 7, 94, 2, 2, 802,
+	803, 7, 122, 2, 2, 803, 804, 3, 2, 2, 2, 804, 807, 5, 143, 72, 2, 805,
+	807, 5, 129, 65, 2, 806, 791, 3, 2, 2, 2, 806, 793, 3, 2, 2, 2, 806, 801,
+	3, 2, 2, 2, 806, 805, 3, 2, 2, 2, 807, 148, 3, 2, 2, 2, 808, 810, 9, 17,
+	2, 2, 809, 808, 3, 2, 2, 2, 810, 811, 3, 2, 2, 2, 811, 809, 3, 2, 2, 2,
+	811, 812, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813, 814, 8, 75, 2, 2, 814,
+	150, 3, 2, 2, 2, 815, 817, 7, 15, 2, 2, 816, 818, 7, 12, 2, 2, 817, 816,
+	3, 2, 2, 2, 817, 818, 3, 2, 2, 2, 818, 821, 3, 2, 2, 2, 819, 821, 7, 12,
+	2, 2, 820, 815, 3, 2, 2, 2, 820, 819, 3, 2, 2, 2, 821, 822, 3, 2, 2, 2,
+	822, 823, 8, 76, 2, 2, 823, 152, 3, 2, 2, 2, 60, 2, 187, 201, 211, 217,
+	249, 255, 263, 278, 280, 311, 347, 383, 413, 451, 489, 515, 539, 561, 590,
+	596, 600, 605, 607, 615, 618, 622, 627, 630, 636, 642, 647, 652, 657, 666,
+	675, 686, 692, 696, 702, 730, 734, 739, 745, 750, 757, 761, 768, 771, 778,
+	783, 787, 796, 799, 806, 811, 817, 820, 3, 8, 2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -368,35 +399,35 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
-	"'!='", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'",
-	"'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
+	"", "'('", "')'", "','", "'['", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
+	"'!='", "", "", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'",
+	"'>>'", "'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "EXISTS",
-	"ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR",
-	"BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "JSONContains",
+	"CAST", "AS", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND",
+	"BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "JSONContains",
 	"JSONContainsAll", "JSONContainsAny", "ArrayContains", "ArrayContainsAll",
-	"ArrayContainsAny", "ArrayLength", "BooleanConstant", "IntegerConstant",
-	"FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier", "Whitespace",
-	"Newline",
+	"ArrayContainsAny", "ArrayLength", "RegexMatch", "TextMatch", "BooleanConstant",
+	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier",
+	"Whitespace", "Newline",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "LT", "LE", "GT", "GE", "EQ", "NE",
-	"LIKE", "EXISTS", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR",
-	"BAND", "BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm",
-	"JSONContains", "JSONContainsAll", "JSONContainsAny", "ArrayContains",
-	"ArrayContainsAll", "ArrayContainsAny", "ArrayLength", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier",
-	"EncodingPrefix", "DoubleSCharSequence", "SingleSCharSequence", "DoubleSChar",
-	"SingleSChar", "Nondigit", "Digit", "BinaryConstant", "DecimalConstant",
-	"OctalConstant", "HexadecimalConstant", "NonzeroDigit", "OctalDigit", "HexadecimalDigit",
-	"HexQuad", "UniversalCharacterName", "DecimalFloatingConstant", "HexadecimalFloatingConstant",
-	"FractionalConstant", "ExponentPart", "DigitSequence", "HexadecimalFractionalConstant",
-	"HexadecimalDigitSequence", "BinaryExponentPart", "EscapeSequence", "Whitespace",
-	"Newline",
+	"LIKE", "EXISTS", "CAST", "AS", "ADD", "SUB", "MUL", "DIV", "MOD", "POW",
+	"SHL", "SHR", "BAND", "BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN",
+	"NIN", "EmptyTerm", "JSONContains", "JSONContainsAll", "JSONContainsAny",
+	"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+	"RegexMatch", "TextMatch", "BooleanConstant", "IntegerConstant", "FloatingConstant",
+	"Identifier", "StringLiteral", "JSONIdentifier", "EncodingPrefix", "DoubleSCharSequence",
+	"SingleSCharSequence", "DoubleSChar", "SingleSChar", "Nondigit", "Digit",
+	"BinaryConstant", "DecimalConstant", "OctalConstant", "HexadecimalConstant",
+	"NonzeroDigit", "OctalDigit", "HexadecimalDigit", "HexQuad", "UniversalCharacterName",
+	"DecimalFloatingConstant", "HexadecimalFloatingConstant", "FractionalConstant",
+	"ExponentPart", "DigitSequence", "HexadecimalFractionalConstant", "HexadecimalDigitSequence",
+	"BinaryExponentPart", "EscapeSequence", "Whitespace", "Newline",
 }
 
 type PlanLexer struct {
@@ -449,37 +480,41 @@ const (
 	PlanLexerNE               = 11
 	PlanLexerLIKE             = 12
 	PlanLexerEXISTS           = 13
-	PlanLexerADD              = 14
-	PlanLexerSUB              = 15
-	PlanLexerMUL              = 16
-	PlanLexerDIV              = 17
-	PlanLexerMOD              = 18
-	PlanLexerPOW              = 19
-	PlanLexerSHL              = 20
-	PlanLexerSHR              = 21
-	PlanLexerBAND             = 22
-	PlanLexerBOR              = 23
-	PlanLexerBXOR             = 24
-	PlanLexerAND              = 25
-	PlanLexerOR               = 26
-	PlanLexerBNOT             = 27
-	PlanLexerNOT              = 28
-	PlanLexerIN               = 29
-	PlanLexerNIN              = 30
-	PlanLexerEmptyTerm        = 31
-	PlanLexerJSONContains     = 32
-	PlanLexerJSONContainsAll  = 33
-	PlanLexerJSONContainsAny  = 34
-	PlanLexerArrayContains    = 35
-	PlanLexerArrayContainsAll = 36
-	PlanLexerArrayContainsAny = 37
-	PlanLexerArrayLength      = 38
-	PlanLexerBooleanConstant  = 39
-	PlanLexerIntegerConstant  = 40
-	PlanLexerFloatingConstant = 41
-	PlanLexerIdentifier       = 42
-	PlanLexerStringLiteral    = 43
-	PlanLexerJSONIdentifier   = 44
-	PlanLexerWhitespace       = 45
-	PlanLexerNewline          = 46
+	PlanLexerCAST             = 14
+	PlanLexerAS               = 15
+	PlanLexerADD              = 16
+	PlanLexerSUB              = 17
+	PlanLexerMUL              = 18
+	PlanLexerDIV              = 19
+	PlanLexerMOD              = 20
+	PlanLexerPOW              = 21
+	PlanLexerSHL              = 22
+	PlanLexerSHR              = 23
+	PlanLexerBAND             = 24
+	PlanLexerBOR              = 25
+	PlanLexerBXOR             = 26
+	PlanLexerAND              = 27
+	PlanLexerOR               = 28
+	PlanLexerBNOT             = 29
+	PlanLexerNOT              = 30
+	PlanLexerIN               = 31
+	PlanLexerNIN              = 32
+	PlanLexerEmptyTerm        = 33
+	PlanLexerJSONContains     = 34
+	PlanLexerJSONContainsAll  = 35
+	PlanLexerJSONContainsAny  = 36
+	PlanLexerArrayContains    = 37
+	PlanLexerArrayContainsAll = 38
+	PlanLexerArrayContainsAny = 39
+	PlanLexerArrayLength      = 40
+	PlanLexerRegexMatch       = 41
+	PlanLexerTextMatch        = 42
+	PlanLexerBooleanConstant  = 43
+	PlanLexerIntegerConstant  = 44
+	PlanLexerFloatingConstant = 45
+	PlanLexerIdentifier       = 46
+	PlanLexerStringLiteral    = 47
+	PlanLexerJSONIdentifier   = 48
+	PlanLexerWhitespace       = 49
+	PlanLexerNewline          = 50
 )
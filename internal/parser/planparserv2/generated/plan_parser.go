@@ -15,83 +15,100 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 48, 131,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 52, 168,
 	4, 2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 20, 10, 2, 12, 2, 14, 2, 23, 11, 2,
-	3, 2, 5, 2, 26, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 24, 10, 2,
+	12, 2, 14, 2, 27, 11, 2, 3, 2, 5, 2, 30, 10, 2, 5, 2, 32, 10, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 43, 10, 2, 12, 2,
+	14, 2, 46, 11, 2, 3, 2, 5, 2, 49, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2,
-	59, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 96, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
 	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 113, 10, 2, 12, 2, 14, 2, 116,
-	11, 2, 3, 2, 5, 2, 119, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 126,
-	10, 2, 12, 2, 14, 2, 129, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 15, 4, 2, 16,
-	17, 29, 30, 4, 2, 34, 34, 37, 37, 4, 2, 35, 35, 38, 38, 4, 2, 36, 36, 39,
-	39, 4, 2, 44, 44, 46, 46, 3, 2, 18, 20, 3, 2, 16, 17, 3, 2, 22, 23, 3,
-	2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 31, 32, 2, 162,
-	2, 58, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 59, 7, 42, 2, 2, 6, 59, 7, 43,
-	2, 2, 7, 59, 7, 41, 2, 2, 8, 59, 7, 45, 2, 2, 9, 59, 7, 44, 2, 2, 10, 59,
-	7, 46, 2, 2, 11, 12, 7, 3, 2, 2, 12, 13, 5, 2, 2, 2, 13, 14, 7, 4, 2, 2,
-	14, 59, 3, 2, 2, 2, 15, 16, 7, 5, 2, 2, 16, 21, 5, 2, 2, 2, 17, 18, 7,
-	6, 2, 2, 18, 20, 5, 2, 2, 2, 19, 17, 3, 2, 2, 2, 20, 23, 3, 2, 2, 2, 21,
-	19, 3, 2, 2, 2, 21, 22, 3, 2, 2, 2, 22, 25, 3, 2, 2, 2, 23, 21, 3, 2, 2,
-	2, 24, 26, 7, 6, 2, 2, 25, 24, 3, 2, 2, 2, 25, 26, 3, 2, 2, 2, 26, 27,
-	3, 2, 2, 2, 27, 28, 7, 7, 2, 2, 28, 59, 3, 2, 2, 2, 29, 30, 9, 2, 2, 2,
-	30, 59, 5, 2, 2, 22, 31, 32, 9, 3, 2, 2, 32, 33, 7, 3, 2, 2, 33, 34, 5,
-	2, 2, 2, 34, 35, 7, 6, 2, 2, 35, 36, 5, 2, 2, 2, 36, 37, 7, 4, 2, 2, 37,
-	59, 3, 2, 2, 2, 38, 39, 9, 4, 2, 2, 39, 40, 7, 3, 2, 2, 40, 41, 5, 2, 2,
-	2, 41, 42, 7, 6, 2, 2, 42, 43, 5, 2, 2, 2, 43, 44, 7, 4, 2, 2, 44, 59,
-	3, 2, 2, 2, 45, 46, 9, 5, 2, 2, 46, 47, 7, 3, 2, 2, 47, 48, 5, 2, 2, 2,
-	48, 49, 7, 6, 2, 2, 49, 50, 5, 2, 2, 2, 50, 51, 7, 4, 2, 2, 51, 59, 3,
-	2, 2, 2, 52, 53, 7, 40, 2, 2, 53, 54, 7, 3, 2, 2, 54, 55, 9, 6, 2, 2, 55,
-	59, 7, 4, 2, 2, 56, 57, 7, 15, 2, 2, 57, 59, 5, 2, 2, 3, 58, 4, 3, 2, 2,
-	2, 58, 6, 3, 2, 2, 2, 58, 7, 3, 2, 2, 2, 58, 8, 3, 2, 2, 2, 58, 9, 3, 2,
-	2, 2, 58, 10, 3, 2, 2, 2, 58, 11, 3, 2, 2, 2, 58, 15, 3, 2, 2, 2, 58, 29,
-	3, 2, 2, 2, 58, 31, 3, 2, 2, 2, 58, 38, 3, 2, 2, 2, 58, 45, 3, 2, 2, 2,
-	58, 52, 3, 2, 2, 2, 58, 56, 3, 2, 2, 2, 59, 127, 3, 2, 2, 2, 60, 61, 12,
-	23, 2, 2, 61, 62, 7, 21, 2, 2, 62, 126, 5, 2, 2, 24, 63, 64, 12, 21, 2,
-	2, 64, 65, 9, 7, 2, 2, 65, 126, 5, 2, 2, 22, 66, 67, 12, 20, 2, 2, 67,
-	68, 9, 8, 2, 2, 68, 126, 5, 2, 2, 21, 69, 70, 12, 19, 2, 2, 70, 71, 9,
-	9, 2, 2, 71, 126, 5, 2, 2, 20, 72, 73, 12, 12, 2, 2, 73, 74, 9, 10, 2,
-	2, 74, 75, 9, 6, 2, 2, 75, 76, 9, 10, 2, 2, 76, 126, 5, 2, 2, 13, 77, 78,
-	12, 11, 2, 2, 78, 79, 9, 11, 2, 2, 79, 80, 9, 6, 2, 2, 80, 81, 9, 11, 2,
-	2, 81, 126, 5, 2, 2, 12, 82, 83, 12, 10, 2, 2, 83, 84, 9, 12, 2, 2, 84,
-	126, 5, 2, 2, 11, 85, 86, 12, 9, 2, 2, 86, 87, 9, 13, 2, 2, 87, 126, 5,
-	2, 2, 10, 88, 89, 12, 8, 2, 2, 89, 90, 7, 24, 2, 2, 90, 126, 5, 2, 2, 9,
-	91, 92, 12, 7, 2, 2, 92, 93, 7, 26, 2, 2, 93, 126, 5, 2, 2, 8, 94, 95,
-	12, 6, 2, 2, 95, 96, 7, 25, 2, 2, 96, 126, 5, 2, 2, 7, 97, 98, 12, 5, 2,
-	2, 98, 99, 7, 27, 2, 2, 99, 126, 5, 2, 2, 6, 100, 101, 12, 4, 2, 2, 101,
-	102, 7, 28, 2, 2, 102, 126, 5, 2, 2, 5, 103, 104, 12, 24, 2, 2, 104, 105,
-	7, 14, 2, 2, 105, 126, 7, 45, 2, 2, 106, 107, 12, 18, 2, 2, 107, 108, 9,
-	14, 2, 2, 108, 109, 7, 5, 2, 2, 109, 114, 5, 2, 2, 2, 110, 111, 7, 6, 2,
-	2, 111, 113, 5, 2, 2, 2, 112, 110, 3, 2, 2, 2, 113, 116, 3, 2, 2, 2, 114,
-	112, 3, 2, 2, 2, 114, 115, 3, 2, 2, 2, 115, 118, 3, 2, 2, 2, 116, 114,
-	3, 2, 2, 2, 117, 119, 7, 6, 2, 2, 118, 117, 3, 2, 2, 2, 118, 119, 3, 2,
-	2, 2, 119, 120, 3, 2, 2, 2, 120, 121, 7, 7, 2, 2, 121, 126, 3, 2, 2, 2,
-	122, 123, 12, 17, 2, 2, 123, 124, 9, 14, 2, 2, 124, 126, 7, 33, 2, 2, 125,
-	60, 3, 2, 2, 2, 125, 63, 3, 2, 2, 2, 125, 66, 3, 2, 2, 2, 125, 69, 3, 2,
-	2, 2, 125, 72, 3, 2, 2, 2, 125, 77, 3, 2, 2, 2, 125, 82, 3, 2, 2, 2, 125,
-	85, 3, 2, 2, 2, 125, 88, 3, 2, 2, 2, 125, 91, 3, 2, 2, 2, 125, 94, 3, 2,
-	2, 2, 125, 97, 3, 2, 2, 2, 125, 100, 3, 2, 2, 2, 125, 103, 3, 2, 2, 2,
-	125, 106, 3, 2, 2, 2, 125, 122, 3, 2, 2, 2, 126, 129, 3, 2, 2, 2, 127,
-	125, 3, 2, 2, 2, 127, 128, 3, 2, 2, 2, 128, 3, 3, 2, 2, 2, 129, 127, 3,
-	2, 2, 2, 9, 21, 25, 58, 114, 118, 125, 127,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2,
+	150, 10, 2, 12, 2, 14, 2, 153, 11, 2, 3, 2, 5, 2, 156, 10, 2, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 7, 2, 163, 10, 2, 12, 2, 14, 2, 166, 11, 2, 3, 2,
+	2, 3, 2, 3, 2, 2, 15, 4, 2, 18, 19, 31, 32, 4, 2, 36, 36, 39, 39, 4, 2,
+	37, 37, 40, 40, 4, 2, 38, 38, 41, 41, 4, 2, 48, 48, 50, 50, 3, 2, 20, 22,
+	3, 2, 18, 19, 3, 2, 24, 25, 3, 2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2,
+	12, 13, 3, 2, 33, 34, 2, 206, 2, 95, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 96,
+	7, 46, 2, 2, 6, 96, 7, 47, 2, 2, 7, 96, 7, 45, 2, 2, 8, 96, 7, 49, 2, 2,
+	9, 96, 7, 48, 2, 2, 10, 96, 7, 50, 2, 2, 11, 12, 7, 16, 2, 2, 12, 13, 7,
+	3, 2, 2, 13, 14, 5, 2, 2, 2, 14, 15, 7, 17, 2, 2, 15, 16, 7, 48, 2, 2,
+	16, 17, 7, 4, 2, 2, 17, 96, 3, 2, 2, 2, 18, 19, 7, 48, 2, 2, 19, 31, 7,
+	3, 2, 2, 20, 25, 5, 2, 2, 2, 21, 22, 7, 5, 2, 2, 22, 24, 5, 2, 2, 2, 23,
+	21, 3, 2, 2, 2, 24, 27, 3, 2, 2, 2, 25, 23, 3, 2, 2, 2, 25, 26, 3, 2, 2,
+	2, 26, 29, 3, 2, 2, 2, 27, 25, 3, 2, 2, 2, 28, 30, 7, 5, 2, 2, 29, 28,
+	3, 2, 2, 2, 29, 30, 3, 2, 2, 2, 30, 32, 3, 2, 2, 2, 31, 20, 3, 2, 2, 2,
+	31, 32, 3, 2, 2, 2, 32, 33, 3, 2, 2, 2, 33, 96, 7, 4, 2, 2, 34, 35, 7,
+	3, 2, 2, 35, 36, 5, 2, 2, 2, 36, 37, 7, 4, 2, 2, 37, 96, 3, 2, 2, 2, 38,
+	39, 7, 6, 2, 2, 39, 44, 5, 2, 2, 2, 40, 41, 7, 5, 2, 2, 41, 43, 5, 2, 2,
+	2, 42, 40, 3, 2, 2, 2, 43, 46, 3, 2, 2, 2, 44, 42, 3, 2, 2, 2, 44, 45,
+	3, 2, 2, 2, 45, 48, 3, 2, 2, 2, 46, 44, 3, 2, 2, 2, 47, 49, 7, 5, 2, 2,
+	48, 47, 3, 2, 2, 2, 48, 49, 3, 2, 2, 2, 49, 50, 3, 2, 2, 2, 50, 51, 7,
+	7, 2, 2, 51, 96, 3, 2, 2, 2, 52, 53, 9, 2, 2, 2, 53, 96, 5, 2, 2, 24, 54,
+	55, 9, 3, 2, 2, 55, 56, 7, 3, 2, 2, 56, 57, 5, 2, 2, 2, 57, 58, 7, 5, 2,
+	2, 58, 59, 5, 2, 2, 2, 59, 60, 7, 4, 2, 2, 60, 96, 3, 2, 2, 2, 61, 62,
+	9, 4, 2, 2, 62, 63, 7, 3, 2, 2, 63, 64, 5, 2, 2, 2, 64, 65, 7, 5, 2, 2,
+	65, 66, 5, 2, 2, 2, 66, 67, 7, 4, 2, 2, 67, 96, 3, 2, 2, 2, 68, 69, 9,
+	5, 2, 2, 69, 70, 7, 3, 2, 2, 70, 71, 5, 2, 2, 2, 71, 72, 7, 5, 2, 2, 72,
+	73, 5, 2, 2, 2, 73, 74, 7, 4, 2, 2, 74, 96, 3, 2, 2, 2, 75, 76, 7, 42,
+	2, 2, 76, 77, 7, 3, 2, 2, 77, 78, 9, 6, 2, 2, 78, 96, 7, 4, 2, 2, 79, 80,
+	7, 43, 2, 2, 80, 81, 7, 3, 2, 2, 81, 82, 5, 2, 2, 2, 82, 83, 7, 5, 2, 2,
+	83, 84, 7, 49, 2, 2, 84, 85, 7, 4, 2, 2, 85, 96, 3, 2, 2, 2, 86, 87, 7,
+	44, 2, 2, 87, 88, 7, 3, 2, 2, 88, 89, 5, 2, 2, 2, 89, 90, 7, 5, 2, 2, 90,
+	91, 7, 49, 2, 2, 91, 92, 7, 4, 2, 2, 92, 96, 3, 2, 2, 2, 93, 94, 7, 15,
+	2, 2, 94, 96, 5, 2, 2, 3, 95, 4, 3, 2, 2, 2, 95, 6, 3, 2, 2, 2, 95, 7,
+	3, 2, 2, 2, 95, 8, 3, 2, 2, 2, 95, 9, 3, 2, 2, 2, 95, 10, 3, 2, 2, 2, 95,
+	11, 3, 2, 2, 2, 95, 18, 3, 2, 2, 2, 95, 34, 3, 2, 2, 2, 95, 38, 3, 2, 2,
+	2, 95, 52, 3, 2, 2, 2, 95, 54, 3, 2, 2, 2, 95, 61, 3, 2, 2, 2, 95, 68,
+	3, 2, 2, 2, 95, 75, 3, 2, 2, 2, 95, 79, 3, 2, 2, 2, 95, 86, 3, 2, 2, 2,
+	95, 93, 3, 2, 2, 2, 96, 164, 3, 2, 2, 2, 97, 98, 12, 25, 2, 2, 98, 99,
+	7, 23, 2, 2, 99, 163, 5, 2, 2, 26, 100, 101, 12, 23, 2, 2, 101, 102, 9,
+	7, 2, 2, 102, 163, 5, 2, 2, 24, 103, 104, 12, 22, 2, 2, 104, 105, 9, 8,
+	2, 2, 105, 163, 5, 2, 2, 23, 106, 107, 12, 21, 2, 2, 107, 108, 9, 9, 2,
+	2, 108, 163, 5, 2, 2, 22, 109, 110, 12, 12, 2, 2, 110, 111, 9, 10, 2, 2,
+	111, 112, 9, 6, 2, 2, 112, 113, 9, 10, 2, 2, 113, 163, 5, 2, 2, 13, 114,
+	115, 12, 11, 2, 2, 115, 116, 9, 11, 2, 2, 116, 117, 9, 6, 2, 2, 117, 118,
+	9, 11, 2, 2, 118, 163, 5, 2, 2, 12, 119, 120, 12, 10, 2, 2, 120, 121, 9,
+	12, 2, 2, 121, 163, 5, 2, 2, 11, 122, 123, 12, 9, 2, 2, 123, 124, 9, 13,
+	2, 2, 124, 163, 5, 2, 2, 10, 125, 126, 12, 8, 2, 2, 126, 127, 7, 26, 2,
+	2, 127, 163, 5, 2, 2, 9, 128, 129, 12, 7, 2, 2, 129, 130, 7, 28, 2, 2,
+	130, 163, 5, 2, 2, 8, 131, 132, 12, 6, 2, 2, 132, 133, 7, 27, 2, 2, 133,
+	163, 5, 2, 2, 7, 134, 135, 12, 5, 2, 2, 135, 136, 7, 29, 2, 2, 136, 163,
+	5, 2, 2, 6, 137, 138, 12, 4, 2, 2, 138, 139, 7, 30, 2, 2, 139, 163, 5,
+	2, 2, 5, 140, 141, 12, 26, 2, 2, 141, 142, 7, 14, 2, 2, 142, 163, 7, 49,
+	2, 2, 143, 144, 12, 20, 2, 2, 144, 145, 9, 14, 2, 2, 145, 146, 7, 6, 2,
+	2, 146, 151, 5, 2, 2, 2, 147, 148, 7, 5, 2, 2, 148, 150, 5, 2, 2, 2, 149,
+	147, 3, 2, 2, 2, 150, 153, 3, 2, 2, 2, 151, 149, 3, 2, 2, 2, 151, 152,
+	3, 2, 2, 2, 152, 155, 3, 2, 2, 2, 153, 151, 3, 2, 2, 2, 154, 156, 7, 5,
+	2, 2, 155, 154, 3, 2, 2, 2, 155, 156, 3, 2, 2, 2, 156, 157, 3, 2, 2, 2,
+	157, 158, 7, 7, 2, 2, 158, 163, 3, 2, 2, 2, 159, 160, 12, 19, 2, 2, 160,
+	161, 9, 14, 2, 2, 161, 163, 7, 35, 2, 2, 162, 97, 3, 2, 2, 2, 162, 100,
+	3, 2, 2, 2, 162, 103, 3, 2, 2, 2, 162, 106, 3, 2, 2, 2, 162, 109, 3, 2,
+	2, 2, 162, 114, 3, 2, 2, 2, 162, 119, 3, 2, 2, 2, 162, 122, 3, 2, 2, 2,
+	162, 125, 3, 2, 2, 2, 162, 128, 3, 2, 2, 2, 162, 131, 3, 2, 2, 2, 162,
+	134, 3, 2, 2, 2, 162, 137, 3, 2, 2, 2, 162, 140, 3, 2, 2, 2, 162, 143,
+	3, 2, 2, 2, 162, 159, 3, 2, 2, 2, 163, 166, 3, 2, 2, 2, 164, 162, 3, 2,
+	2, 2, 164, 165, 3, 2, 2, 2, 165, 3, 3, 2, 2, 2, 166, 164, 3, 2, 2, 2, 12,
+	25, 29, 31, 44, 48, 95, 151, 155, 162, 164,
 }
 var literalNames = []string{
-	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
-	"'!='", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'", "'>>'",
-	"'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
+	"", "'('", "')'", "','", "'['", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
+	"'!='", "", "", "", "", "'+'", "'-'", "'*'", "'/'", "'%'", "'**'", "'<<'",
+	"'>>'", "'&'", "'|'", "'^'", "", "", "'~'", "", "'in'", "'not in'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "EXISTS",
-	"ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR",
-	"BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "JSONContains",
+	"CAST", "AS", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND",
+	"BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "JSONContains",
 	"JSONContainsAll", "JSONContainsAny", "ArrayContains", "ArrayContainsAll",
-	"ArrayContainsAny", "ArrayLength", "BooleanConstant", "IntegerConstant",
-	"FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier", "Whitespace",
-	"Newline",
+	"ArrayContainsAny", "ArrayLength", "RegexMatch", "TextMatch", "BooleanConstant",
+	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "JSONIdentifier",
+	"Whitespace", "Newline",
 }
 
 var ruleNames = []string{
@@ -143,39 +160,43 @@ const (
 	PlanParserNE               = 11
 	PlanParserLIKE             = 12
 	PlanParserEXISTS           = 13
-	PlanParserADD              = 14
-	PlanParserSUB              = 15
-	PlanParserMUL              = 16
-	PlanParserDIV              = 17
-	PlanParserMOD              = 18
-	PlanParserPOW              = 19
-	PlanParserSHL              = 20
-	PlanParserSHR              = 21
-	PlanParserBAND             = 22
-	PlanParserBOR              = 23
-	PlanParserBXOR             = 24
-	PlanParserAND              = 25
-	PlanParserOR               = 26
-	PlanParserBNOT             = 27
-	PlanParserNOT              = 28
-	PlanParserIN               = 29
-	PlanParserNIN              = 30
-	PlanParserEmptyTerm        = 31
-	PlanParserJSONContains     = 32
-	PlanParserJSONContainsAll  = 33
-	PlanParserJSONContainsAny  = 34
-	PlanParserArrayContains    = 35
-	PlanParserArrayContainsAll = 36
-	PlanParserArrayContainsAny = 37
-	PlanParserArrayLength      = 38
-	PlanParserBooleanConstant  = 39
-	PlanParserIntegerConstant  = 40
-	PlanParserFloatingConstant = 41
-	PlanParserIdentifier       = 42
-	PlanParserStringLiteral    = 43
-	PlanParserJSONIdentifier   = 44
-	PlanParserWhitespace       = 45
-	PlanParserNewline          = 46
+	PlanParserCAST             = 14
+	PlanParserAS               = 15
+	PlanParserADD              = 16
+	PlanParserSUB              = 17
+	PlanParserMUL              = 18
+	PlanParserDIV              = 19
+	PlanParserMOD              = 20
+	PlanParserPOW              = 21
+	PlanParserSHL              = 22
+	PlanParserSHR              = 23
+	PlanParserBAND             = 24
+	PlanParserBOR              = 25
+	PlanParserBXOR             = 26
+	PlanParserAND              = 27
+	PlanParserOR               = 28
+	PlanParserBNOT             = 29
+	PlanParserNOT              = 30
+	PlanParserIN               = 31
+	PlanParserNIN              = 32
+	PlanParserEmptyTerm        = 33
+	PlanParserJSONContains     = 34
+	PlanParserJSONContainsAll  = 35
+	PlanParserJSONContainsAny  = 36
+	PlanParserArrayContains    = 37
+	PlanParserArrayContainsAll = 38
+	PlanParserArrayContainsAny = 39
+	PlanParserArrayLength      = 40
+	PlanParserRegexMatch       = 41
+	PlanParserTextMatch        = 42
+	PlanParserBooleanConstant  = 43
+	PlanParserIntegerConstant  = 44
+	PlanParserFloatingConstant = 45
+	PlanParserIdentifier       = 46
+	PlanParserStringLiteral    = 47
+	PlanParserJSONIdentifier   = 48
+	PlanParserWhitespace       = 49
+	PlanParserNewline          = 50
 )
 
 // PlanParserRULE_expr is the PlanParser rule.
@@ -231,6 +252,56 @@ func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) s
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
+type CastContext struct {
+	*ExprContext
+}
+
+func NewCastContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CastContext {
+	var p = new(CastContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CastContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CastContext) CAST() antlr.TerminalNode {
+	return s.GetToken(PlanParserCAST, 0)
+}
+
+func (s *CastContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CastContext) AS() antlr.TerminalNode {
+	return s.GetToken(PlanParserAS, 0)
+}
+
+func (s *CastContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *CastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitCast(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type JSONIdentifierContext struct {
 	*ExprContext
 }
@@ -840,6 +911,61 @@ func (s *ShiftContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type CallContext struct {
+	*ExprContext
+}
+
+func NewCallContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallContext {
+	var p = new(CallContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *CallContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *CallContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *CallContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *CallContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitCall(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type ReverseRangeContext struct {
 	*ExprContext
 	op1 antlr.Token
@@ -1156,6 +1282,52 @@ func (s *ArrayLengthContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 	}
 }
 
+type TextMatchContext struct {
+	*ExprContext
+}
+
+func NewTextMatchContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TextMatchContext {
+	var p = new(TextMatchContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *TextMatchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TextMatchContext) TextMatch() antlr.TerminalNode {
+	return s.GetToken(PlanParserTextMatch, 0)
+}
+
+func (s *TextMatchContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *TextMatchContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *TextMatchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitTextMatch(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type TermContext struct {
 	*ExprContext
 	op antlr.Token
@@ -1772,6 +1944,52 @@ func (s *EmptyTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
+type RegexMatchContext struct {
+	*ExprContext
+}
+
+func NewRegexMatchContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RegexMatchContext {
+	var p = new(RegexMatchContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *RegexMatchContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RegexMatchContext) RegexMatch() antlr.TerminalNode {
+	return s.GetToken(PlanParserRegexMatch, 0)
+}
+
+func (s *RegexMatchContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *RegexMatchContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *RegexMatchContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitRegexMatch(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type PowerContext struct {
 	*ExprContext
 }
@@ -1860,11 +2078,10 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(56)
+	p.SetState(93)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case PlanParserIntegerConstant:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	case 1:
 		localctx = NewIntegerContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1874,7 +2091,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserIntegerConstant)
 		}
 
-	case PlanParserFloatingConstant:
+	case 2:
 		localctx = NewFloatingContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1883,7 +2100,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserFloatingConstant)
 		}
 
-	case PlanParserBooleanConstant:
+	case 3:
 		localctx = NewBooleanContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1892,7 +2109,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserBooleanConstant)
 		}
 
-	case PlanParserStringLiteral:
+	case 4:
 		localctx = NewStringContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1901,7 +2118,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserStringLiteral)
 		}
 
-	case PlanParserIdentifier:
+	case 5:
 		localctx = NewIdentifierContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1910,7 +2127,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserIdentifier)
 		}
 
-	case PlanParserJSONIdentifier:
+	case 6:
 		localctx = NewJSONIdentifierContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
@@ -1919,77 +2136,165 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			p.Match(PlanParserJSONIdentifier)
 		}
 
-	case PlanParserT__0:
-		localctx = NewParensContext(p, localctx)
+	case 7:
+		localctx = NewCastContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
 			p.SetState(9)
-			p.Match(PlanParserT__0)
+			p.Match(PlanParserCAST)
 		}
 		{
 			p.SetState(10)
-			p.expr(0)
+			p.Match(PlanParserT__0)
 		}
 		{
 			p.SetState(11)
+			p.expr(0)
+		}
+		{
+			p.SetState(12)
+			p.Match(PlanParserAS)
+		}
+		{
+			p.SetState(13)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(14)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserT__2:
+	case 8:
+		localctx = NewCallContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(16)
+			p.Match(PlanParserIdentifier)
+		}
+		{
+			p.SetState(17)
+			p.Match(PlanParserT__0)
+		}
+		p.SetState(29)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<PlanParserT__0)|(1<<PlanParserT__3)|(1<<PlanParserEXISTS)|(1<<PlanParserCAST)|(1<<PlanParserADD)|(1<<PlanParserSUB)|(1<<PlanParserBNOT)|(1<<PlanParserNOT))) != 0) || (((_la-34)&-(0x1f+1)) == 0 && ((1<<uint((_la-34)))&((1<<(PlanParserJSONContains-34))|(1<<(PlanParserJSONContainsAll-34))|(1<<(PlanParserJSONContainsAny-34))|(1<<(PlanParserArrayContains-34))|(1<<(PlanParserArrayContainsAll-34))|(1<<(PlanParserArrayContainsAny-34))|(1<<(PlanParserArrayLength-34))|(1<<(PlanParserRegexMatch-34))|(1<<(PlanParserTextMatch-34))|(1<<(PlanParserBooleanConstant-34))|(1<<(PlanParserIntegerConstant-34))|(1<<(PlanParserFloatingConstant-34))|(1<<(PlanParserIdentifier-34))|(1<<(PlanParserStringLiteral-34))|(1<<(PlanParserJSONIdentifier-34)))) != 0) {
+			{
+				p.SetState(18)
+				p.expr(0)
+			}
+			p.SetState(23)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(19)
+						p.Match(PlanParserT__2)
+					}
+					{
+						p.SetState(20)
+						p.expr(0)
+					}
+
+				}
+				p.SetState(25)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
+			}
+			p.SetState(27)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PlanParserT__2 {
+				{
+					p.SetState(26)
+					p.Match(PlanParserT__2)
+				}
+
+			}
+
+		}
+		{
+			p.SetState(31)
+			p.Match(PlanParserT__1)
+		}
+
+	case 9:
+		localctx = NewParensContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(32)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(33)
+			p.expr(0)
+		}
+		{
+			p.SetState(34)
+			p.Match(PlanParserT__1)
+		}
+
+	case 10:
 		localctx = NewArrayContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(13)
-			p.Match(PlanParserT__2)
+			p.SetState(36)
+			p.Match(PlanParserT__3)
 		}
 		{
-			p.SetState(14)
+			p.SetState(37)
 			p.expr(0)
 		}
-		p.SetState(19)
+		p.SetState(42)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(15)
-					p.Match(PlanParserT__3)
+					p.SetState(38)
+					p.Match(PlanParserT__2)
 				}
 				{
-					p.SetState(16)
+					p.SetState(39)
 					p.expr(0)
 				}
 
 			}
-			p.SetState(21)
+			p.SetState(44)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 		}
-		p.SetState(23)
+		p.SetState(46)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == PlanParserT__3 {
+		if _la == PlanParserT__2 {
 			{
-				p.SetState(22)
-				p.Match(PlanParserT__3)
+				p.SetState(45)
+				p.Match(PlanParserT__2)
 			}
 
 		}
 		{
-			p.SetState(25)
+			p.SetState(48)
 			p.Match(PlanParserT__4)
 		}
 
-	case PlanParserADD, PlanParserSUB, PlanParserBNOT, PlanParserNOT:
+	case 11:
 		localctx = NewUnaryContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(27)
+			p.SetState(50)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -2007,16 +2312,16 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(28)
-			p.expr(20)
+			p.SetState(51)
+			p.expr(22)
 		}
 
-	case PlanParserJSONContains, PlanParserArrayContains:
+	case 12:
 		localctx = NewJSONContainsContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(29)
+			p.SetState(52)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContains || _la == PlanParserArrayContains) {
@@ -2027,32 +2332,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(30)
+			p.SetState(53)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(31)
+			p.SetState(54)
 			p.expr(0)
 		}
 		{
-			p.SetState(32)
-			p.Match(PlanParserT__3)
+			p.SetState(55)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(33)
+			p.SetState(56)
 			p.expr(0)
 		}
 		{
-			p.SetState(34)
+			p.SetState(57)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserJSONContainsAll, PlanParserArrayContainsAll:
+	case 13:
 		localctx = NewJSONContainsAllContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(36)
+			p.SetState(59)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContainsAll || _la == PlanParserArrayContainsAll) {
@@ -2063,32 +2368,32 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(37)
+			p.SetState(60)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(38)
+			p.SetState(61)
 			p.expr(0)
 		}
 		{
-			p.SetState(39)
-			p.Match(PlanParserT__3)
+			p.SetState(62)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(40)
+			p.SetState(63)
 			p.expr(0)
 		}
 		{
-			p.SetState(41)
+			p.SetState(64)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserJSONContainsAny, PlanParserArrayContainsAny:
+	case 14:
 		localctx = NewJSONContainsAnyContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(43)
+			p.SetState(66)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserJSONContainsAny || _la == PlanParserArrayContainsAny) {
@@ -2099,40 +2404,40 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(44)
+			p.SetState(67)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(45)
+			p.SetState(68)
 			p.expr(0)
 		}
 		{
-			p.SetState(46)
-			p.Match(PlanParserT__3)
+			p.SetState(69)
+			p.Match(PlanParserT__2)
 		}
 		{
-			p.SetState(47)
+			p.SetState(70)
 			p.expr(0)
 		}
 		{
-			p.SetState(48)
+			p.SetState(71)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserArrayLength:
+	case 15:
 		localctx = NewArrayLengthContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(50)
+			p.SetState(73)
 			p.Match(PlanParserArrayLength)
 		}
 		{
-			p.SetState(51)
+			p.SetState(74)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(52)
+			p.SetState(75)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2143,30 +2448,86 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 			}
 		}
 		{
-			p.SetState(53)
+			p.SetState(76)
+			p.Match(PlanParserT__1)
+		}
+
+	case 16:
+		localctx = NewRegexMatchContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(77)
+			p.Match(PlanParserRegexMatch)
+		}
+		{
+			p.SetState(78)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(79)
+			p.expr(0)
+		}
+		{
+			p.SetState(80)
+			p.Match(PlanParserT__2)
+		}
+		{
+			p.SetState(81)
+			p.Match(PlanParserStringLiteral)
+		}
+		{
+			p.SetState(82)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserEXISTS:
+	case 17:
+		localctx = NewTextMatchContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(84)
+			p.Match(PlanParserTextMatch)
+		}
+		{
+			p.SetState(85)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(86)
+			p.expr(0)
+		}
+		{
+			p.SetState(87)
+			p.Match(PlanParserT__2)
+		}
+		{
+			p.SetState(88)
+			p.Match(PlanParserStringLiteral)
+		}
+		{
+			p.SetState(89)
+			p.Match(PlanParserT__1)
+		}
+
+	case 18:
 		localctx = NewExistsContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(54)
+			p.SetState(91)
 			p.Match(PlanParserEXISTS)
 		}
 		{
-			p.SetState(55)
+			p.SetState(92)
 			p.expr(1)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(125)
+	p.SetState(162)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -2174,36 +2535,36 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(123)
+			p.SetState(160)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewPowerContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(58)
+				p.SetState(95)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
 				}
 				{
-					p.SetState(59)
+					p.SetState(96)
 					p.Match(PlanParserPOW)
 				}
 				{
-					p.SetState(60)
-					p.expr(22)
+					p.SetState(97)
+					p.expr(24)
 				}
 
 			case 2:
 				localctx = NewMulDivModContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(61)
+				p.SetState(98)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 				}
 				{
-					p.SetState(62)
+					p.SetState(99)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2221,20 +2582,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(63)
-					p.expr(20)
+					p.SetState(100)
+					p.expr(22)
 				}
 
 			case 3:
 				localctx = NewAddSubContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(64)
+				p.SetState(101)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(65)
+					p.SetState(102)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2252,20 +2613,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(66)
-					p.expr(19)
+					p.SetState(103)
+					p.expr(21)
 				}
 
 			case 4:
 				localctx = NewShiftContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(67)
+				p.SetState(104)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(68)
+					p.SetState(105)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2283,20 +2644,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(69)
-					p.expr(18)
+					p.SetState(106)
+					p.expr(20)
 				}
 
 			case 5:
 				localctx = NewRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(70)
+				p.SetState(107)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(71)
+					p.SetState(108)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2314,7 +2675,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(72)
+					p.SetState(109)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2325,7 +2686,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(73)
+					p.SetState(110)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2343,20 +2704,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(74)
+					p.SetState(111)
 					p.expr(11)
 				}
 
 			case 6:
 				localctx = NewReverseRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(75)
+				p.SetState(112)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(76)
+					p.SetState(113)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2374,7 +2735,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(77)
+					p.SetState(114)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
@@ -2385,7 +2746,7 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(78)
+					p.SetState(115)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2403,20 +2764,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(79)
+					p.SetState(116)
 					p.expr(10)
 				}
 
 			case 7:
 				localctx = NewRelationalContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(80)
+				p.SetState(117)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(81)
+					p.SetState(118)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2434,20 +2795,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(82)
+					p.SetState(119)
 					p.expr(9)
 				}
 
 			case 8:
 				localctx = NewEqualityContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(83)
+				p.SetState(120)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(84)
+					p.SetState(121)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2465,122 +2826,122 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(85)
+					p.SetState(122)
 					p.expr(8)
 				}
 
 			case 9:
 				localctx = NewBitAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(86)
+				p.SetState(123)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(87)
+					p.SetState(124)
 					p.Match(PlanParserBAND)
 				}
 				{
-					p.SetState(88)
+					p.SetState(125)
 					p.expr(7)
 				}
 
 			case 10:
 				localctx = NewBitXorContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(89)
+				p.SetState(126)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(90)
+					p.SetState(127)
 					p.Match(PlanParserBXOR)
 				}
 				{
-					p.SetState(91)
+					p.SetState(128)
 					p.expr(6)
 				}
 
 			case 11:
 				localctx = NewBitOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(92)
+				p.SetState(129)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(93)
+					p.SetState(130)
 					p.Match(PlanParserBOR)
 				}
 				{
-					p.SetState(94)
+					p.SetState(131)
 					p.expr(5)
 				}
 
 			case 12:
 				localctx = NewLogicalAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(95)
+				p.SetState(132)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(96)
+					p.SetState(133)
 					p.Match(PlanParserAND)
 				}
 				{
-					p.SetState(97)
+					p.SetState(134)
 					p.expr(4)
 				}
 
 			case 13:
 				localctx = NewLogicalOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(98)
+				p.SetState(135)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(99)
+					p.SetState(136)
 					p.Match(PlanParserOR)
 				}
 				{
-					p.SetState(100)
+					p.SetState(137)
 					p.expr(3)
 				}
 
 			case 14:
 				localctx = NewLikeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(101)
+				p.SetState(138)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 24)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 24)", ""))
 				}
 				{
-					p.SetState(102)
+					p.SetState(139)
 					p.Match(PlanParserLIKE)
 				}
 				{
-					p.SetState(103)
+					p.SetState(140)
 					p.Match(PlanParserStringLiteral)
 				}
 
 			case 15:
 				localctx = NewTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(104)
+				p.SetState(141)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
 				}
 				{
-					p.SetState(105)
+					p.SetState(142)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2599,59 +2960,59 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 
 				{
-					p.SetState(106)
-					p.Match(PlanParserT__2)
+					p.SetState(143)
+					p.Match(PlanParserT__3)
 				}
 				{
-					p.SetState(107)
+					p.SetState(144)
 					p.expr(0)
 				}
-				p.SetState(112)
+				p.SetState(149)
 				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 					if _alt == 1 {
 						{
-							p.SetState(108)
-							p.Match(PlanParserT__3)
+							p.SetState(145)
+							p.Match(PlanParserT__2)
 						}
 						{
-							p.SetState(109)
+							p.SetState(146)
 							p.expr(0)
 						}
 
 					}
-					p.SetState(114)
+					p.SetState(151)
 					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 				}
-				p.SetState(116)
+				p.SetState(153)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
-				if _la == PlanParserT__3 {
+				if _la == PlanParserT__2 {
 					{
-						p.SetState(115)
-						p.Match(PlanParserT__3)
+						p.SetState(152)
+						p.Match(PlanParserT__2)
 					}
 
 				}
 				{
-					p.SetState(118)
+					p.SetState(155)
 					p.Match(PlanParserT__4)
 				}
 
 			case 16:
 				localctx = NewEmptyTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(120)
+				p.SetState(157)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
 				}
 				{
-					p.SetState(121)
+					p.SetState(158)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2669,16 +3030,16 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(122)
+					p.SetState(159)
 					p.Match(PlanParserEmptyTerm)
 				}
 
 			}
 
 		}
-		p.SetState(127)
+		p.SetState(164)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -2701,16 +3062,16 @@ func (p *PlanParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 21)
+		return p.Precpred(p.GetParserRuleContext(), 23)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 19)
+		return p.Precpred(p.GetParserRuleContext(), 21)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 18)
+		return p.Precpred(p.GetParserRuleContext(), 20)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 17)
+		return p.Precpred(p.GetParserRuleContext(), 19)
 
 	case 4:
 		return p.Precpred(p.GetParserRuleContext(), 10)
@@ -2740,13 +3101,13 @@ func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) boo
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
 	case 13:
-		return p.Precpred(p.GetParserRuleContext(), 22)
+		return p.Precpred(p.GetParserRuleContext(), 24)
 
 	case 14:
-		return p.Precpred(p.GetParserRuleContext(), 16)
+		return p.Precpred(p.GetParserRuleContext(), 18)
 
 	case 15:
-		return p.Precpred(p.GetParserRuleContext(), 15)
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
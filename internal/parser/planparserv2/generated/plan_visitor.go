@@ -7,6 +7,9 @@ import "github.com/antlr/antlr4/runtime/Go/antlr"
 type PlanVisitor interface {
 	antlr.ParseTreeVisitor
 
+	// Visit a parse tree produced by PlanParser#Cast.
+	VisitCast(ctx *CastContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#JSONIdentifier.
 	VisitJSONIdentifier(ctx *JSONIdentifierContext) interface{}
 
@@ -46,6 +49,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Shift.
 	VisitShift(ctx *ShiftContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#Call.
+	VisitCall(ctx *CallContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#ReverseRange.
 	VisitReverseRange(ctx *ReverseRangeContext) interface{}
 
@@ -61,6 +67,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#ArrayLength.
 	VisitArrayLength(ctx *ArrayLengthContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#TextMatch.
+	VisitTextMatch(ctx *TextMatchContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#Term.
 	VisitTerm(ctx *TermContext) interface{}
 
@@ -94,6 +103,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#EmptyTerm.
 	VisitEmptyTerm(ctx *EmptyTermContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#RegexMatch.
+	VisitRegexMatch(ctx *RegexMatchContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#Power.
 	VisitPower(ctx *PowerContext) interface{}
 }
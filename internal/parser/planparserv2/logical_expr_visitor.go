@@ -14,4 +14,10 @@ type LogicalExprVisitor interface {
 	VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) interface{}
 	VisitValueExpr(expr *planpb.ValueExpr) interface{}
 	VisitColumnExpr(expr *planpb.ColumnExpr) interface{}
+	VisitExistsExpr(expr *planpb.ExistsExpr) interface{}
+	VisitJSONContainsExpr(expr *planpb.JSONContainsExpr) interface{}
+	VisitAlwaysTrueExpr(expr *planpb.AlwaysTrueExpr) interface{}
+	VisitCastExpr(expr *planpb.CastExpr) interface{}
+	VisitCallExpr(expr *planpb.CallExpr) interface{}
+	VisitComputedCompareExpr(expr *planpb.ComputedCompareExpr) interface{}
 }
@@ -0,0 +1,39 @@
+package planparserv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ParseOrderBy parses the order by item, like "price" or "price desc". The field must be
+// a bool, numeric or string field, entities are in ascending order if it's not specified.
+func ParseOrderBy(schema *typeutil.SchemaHelper, orderBy string) (*planpb.OrderBy, error) {
+	items := strings.Fields(orderBy)
+	if len(items) == 0 || len(items) > 2 {
+		return nil, fmt.Errorf("invalid order by: %s", orderBy)
+	}
+	ascending := true
+	if len(items) == 2 {
+		switch strings.ToLower(items[1]) {
+		case "asc":
+		case "desc":
+			ascending = false
+		default:
+			return nil, fmt.Errorf("order should be asc or desc: %s", orderBy)
+		}
+	}
+
+	column, err := parseColumn(schema, items[0])
+	if err != nil {
+		return nil, err
+	}
+	dataType := column.GetDataType()
+	if len(column.GetNestedPath()) > 0 || (!typeutil.IsBoolType(dataType) && !typeutil.IsIntegerType(dataType) &&
+		!typeutil.IsFloatingType(dataType) && !typeutil.IsStringType(dataType)) {
+		return nil, fmt.Errorf("order by %s field is not supported: %s", dataType.String(), orderBy)
+	}
+	return &planpb.OrderBy{Column: column, Ascending: ascending}, nil
+}
@@ -0,0 +1,45 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	schema, err := typeutil.CreateSchemaHelper(newTestSchema())
+	assert.NoError(t, err)
+
+	orderBy, err := ParseOrderBy(schema, "Int64Field")
+	assert.NoError(t, err)
+	assert.True(t, orderBy.GetAscending())
+	assert.Equal(t, schemapb.DataType_Int64, orderBy.GetColumn().GetDataType())
+
+	orderBy, err = ParseOrderBy(schema, " VarCharField  DESC ")
+	assert.NoError(t, err)
+	assert.False(t, orderBy.GetAscending())
+	assert.Equal(t, schemapb.DataType_VarChar, orderBy.GetColumn().GetDataType())
+
+	orderBy, err = ParseOrderBy(schema, "DoubleField asc")
+	assert.NoError(t, err)
+	assert.True(t, orderBy.GetAscending())
+
+	invalids := []string{
+		"",
+		"Int64Field up",
+		"Int64Field desc nulls",
+		"NotExistField",
+		"FloatVectorField",
+		"ArrayField",
+		"JSONField",
+		`JSONField["a"]`,
+		"B",
+	}
+	for _, invalid := range invalids {
+		_, err := ParseOrderBy(schema, invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -478,6 +478,87 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 	}
 }
 
+// getMatchColumn returns the string column of regex_match and text_match.
+func (v *ParserVisitor) getMatchColumn(name string, child antlr.ParserRuleContext) (*planpb.ColumnInfo, error) {
+	left := child.Accept(v)
+	if err := getError(left); err != nil {
+		return nil, err
+	}
+	leftExpr := getExpr(left)
+	if leftExpr == nil {
+		return nil, fmt.Errorf("the first parameter of %s is invalid", name)
+	}
+	column := toColumnInfo(leftExpr)
+	if column == nil {
+		return nil, fmt.Errorf("%s on complicated expr is unsupported", name)
+	}
+	if !typeutil.IsStringType(leftExpr.dataType) && !typeutil.IsJSONType(leftExpr.dataType) &&
+		!(typeutil.IsArrayType(leftExpr.dataType) && len(column.GetNestedPath()) > 0 && typeutil.IsStringType(column.GetElementType())) {
+		return nil, fmt.Errorf("%s on non-string or non-json field is unsupported", name)
+	}
+	return column, nil
+}
+
+// VisitRegexMatch translates regex_match(field, pattern), the row matches if any part of it matches the pattern.
+func (v *ParserVisitor) VisitRegexMatch(ctx *parser.RegexMatchContext) interface{} {
+	column, err := v.getMatchColumn("regex_match", ctx.Expr())
+	if err != nil {
+		return err
+	}
+
+	pattern, err := convertEscapeSingle(ctx.StringLiteral().GetText())
+	if err != nil {
+		return err
+	}
+	if _, err := getRegex(pattern); err != nil {
+		return fmt.Errorf("invalid regex pattern %s: %s", pattern, err)
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: column,
+					Op:         planpb.OpType_RegexMatch,
+					Value:      NewString(pattern),
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+// VisitTextMatch translates text_match(field, query), the row matches if it contains any token of query.
+// The tokens are lower case words, they're joined by single space in the plan.
+func (v *ParserVisitor) VisitTextMatch(ctx *parser.TextMatchContext) interface{} {
+	column, err := v.getMatchColumn("text_match", ctx.Expr())
+	if err != nil {
+		return err
+	}
+
+	query, err := convertEscapeSingle(ctx.StringLiteral().GetText())
+	if err != nil {
+		return err
+	}
+	tokens, err := getTextTokens(query)
+	if err != nil {
+		return err
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: column,
+					Op:         planpb.OpType_TextMatch,
+					Value:      NewString(strings.Join(tokens, " ")),
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 // VisitTerm translates expr to term plan.
 func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 	child := ctx.Expr(0).Accept(v)
@@ -1306,6 +1387,11 @@ func (v *ParserVisitor) VisitArrayLength(ctx *parser.ArrayLengthContext) interfa
 			"array_length operation are only supported on json or array fields now, got: %s", ctx.GetText())
 	}
 
+	return newArrayLengthExpr(columnInfo)
+}
+
+// newArrayLengthExpr returns the number of elements of the array or json column.
+func newArrayLengthExpr(columnInfo *planpb.ColumnInfo) *ExprWithType {
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_BinaryArithExpr{
 			BinaryArithExpr: &planpb.BinaryArithExpr{
@@ -1327,3 +1413,111 @@ func (v *ParserVisitor) VisitArrayLength(ctx *parser.ArrayLengthContext) interfa
 		nodeDependent: true,
 	}
 }
+
+// VisitCast translates cast(expr as type), the cast of constant is evaluated and the lossless cast
+// of field is dropped.
+func (v *ParserVisitor) VisitCast(ctx *parser.CastContext) interface{} {
+	child := ctx.Expr().Accept(v)
+	if err := getError(child); err != nil {
+		return err
+	}
+
+	typeName := ctx.Identifier().GetText()
+	dataType, ok := castTypes[strings.ToLower(typeName)]
+	if !ok {
+		return fmt.Errorf("cannot cast to unknown type %s, supported types: %s", typeName, castTypeNames())
+	}
+
+	if value := getGenericValue(child); value != nil {
+		castedValue, err := castConstant(value, dataType)
+		if err != nil {
+			return err
+		}
+		return toValueExpr(castedValue)
+	}
+
+	childExpr := getExpr(child)
+	if childExpr == nil {
+		return fmt.Errorf("invalid cast expression: %s", ctx.GetText())
+	}
+	childType := elementDataType(childExpr)
+	if typeutil.IsVectorType(childType) || typeutil.IsArrayType(childType) {
+		return fmt.Errorf("cannot cast %s to %s", childType.String(), dataType.String())
+	}
+	if isLosslessCast(childType, dataType) {
+		return childExpr
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_CastExpr{
+				CastExpr: &planpb.CastExpr{
+					Child:    childExpr.expr,
+					DataType: dataType,
+				},
+			},
+		},
+		dataType:      dataType,
+		nodeDependent: true,
+	}
+}
+
+// VisitCall translates the call of builtin functions, the call is evaluated if all the parameters
+// are constants, or rewritten into the expression segcore supports if possible.
+func (v *ParserVisitor) VisitCall(ctx *parser.CallContext) interface{} {
+	name := strings.ToLower(ctx.Identifier().GetText())
+	fn, ok := functions[name]
+	if !ok {
+		return fmt.Errorf("unknown function: %s", ctx.Identifier().GetText())
+	}
+	if len(ctx.AllExpr()) != len(fn.params) {
+		return fmt.Errorf("function %s expects %d parameters, got %d", name, len(fn.params), len(ctx.AllExpr()))
+	}
+
+	params := make([]*ExprWithType, 0, len(fn.params))
+	values := make([]*planpb.GenericValue, 0, len(fn.params))
+	for i := range fn.params {
+		child := ctx.Expr(i).Accept(v)
+		if err := getError(child); err != nil {
+			return err
+		}
+		param := getExpr(child)
+		if param == nil {
+			return fmt.Errorf("invalid parameter of function %s: %s", name, ctx.Expr(i).GetText())
+		}
+		if !fn.params[i](elementDataType(param)) {
+			return fmt.Errorf("function %s doesn't support parameter of %s type: %s",
+				name, elementDataType(param).String(), ctx.Expr(i).GetText())
+		}
+		params = append(params, param)
+		if value := getGenericValue(child); value != nil {
+			values = append(values, value)
+		}
+	}
+
+	returnType := fn.returnType(elementDataType(params[0]))
+	if len(values) == len(params) {
+		return toValueExpr(fn.eval(values))
+	}
+	if fn.rewrite != nil {
+		if expr := fn.rewrite(params); expr != nil {
+			return expr
+		}
+	}
+
+	parameters := make([]*planpb.Expr, 0, len(params))
+	for _, param := range params {
+		parameters = append(parameters, param.expr)
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_CallExpr{
+				CallExpr: &planpb.CallExpr{
+					FunctionName:       name,
+					FunctionParameters: parameters,
+				},
+			},
+		},
+		dataType:      returnType,
+		nodeDependent: !typeutil.IsBoolType(returnType),
+	}
+}
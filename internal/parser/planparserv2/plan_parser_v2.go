@@ -66,6 +66,9 @@ func ParseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, err
 	if !canBeExecuted(predicate) {
 		return nil, fmt.Errorf("predicate is not a boolean expression: %s, data type: %s", exprStr, predicate.dataType)
 	}
+	if err := checkPushdown(predicate.expr); err != nil {
+		return nil, fmt.Errorf("cannot parse expression: %s, error: %s", exprStr, err)
+	}
 
 	return predicate.expr, nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -165,6 +166,94 @@ func TestExpr_Like(t *testing.T) {
 	}
 }
 
+func TestExpr_RegexMatch(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`regex_match(VarCharField, "^error: [0-9]+")`,
+		`REGEX_MATCH(StringField, 'time(out|d out)')`,
+		`regex_match(JSONField["A"], "\\d+")`,
+		`regex_match($meta["A"], "a.*b")`,
+		`regex_match(StringArrayField[0], "^a")`,
+		`not regex_match(VarCharField, "warn") && Int64Field > 1`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`regex_match(VarCharField, "(error")`,
+		`regex_match(Int64Field, "1")`,
+		`regex_match(StringArrayField, "a")`,
+		`regex_match(VarCharField, VarCharField)`,
+		`regex_match("abc", "a")`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `regex_match(VarCharField, "^error")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_RegexMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "^error", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+}
+
+func TestExpr_TextMatch(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`text_match(VarCharField, "connection refused")`,
+		`TEXT_MATCH($meta["A"], 'timeout')`,
+		`text_match(VarCharField, "disk") || text_match(StringField, "memory")`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`text_match(VarCharField, "  ")`,
+		`text_match(BoolField, "true")`,
+		`text_match(VarCharField)`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `text_match(VarCharField, "Connection, REFUSED")`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.OpType_TextMatch, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "connection refused", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+}
+
+func TestExpr_Null(t *testing.T) {
+	schema := newTestSchema()
+	for _, field := range schema.GetFields() {
+		if field.GetName() == "Int64Field" || field.GetName() == "JSONField" {
+			field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"})
+		}
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	// there is no null check in the grammar, segcore can't evaluate it on nullable fields yet
+	invalidExprs := []string{
+		`Int64Field is null`,
+		`Int64Field IS NOT NULL`,
+		`JSONField is not null && Int64Field > 1`,
+		`not (Int64Field is null) || Int32Field < 10`,
+		`Int32Field is null`,
+		`NotExistField is not null`,
+		`Int64Field + 1 is null`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_BinaryRange(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -1,13 +1,21 @@
 package planparserv2
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"sync"
+	"unicode"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
 	antlrparser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/pkg/util/cache"
 )
 
+// patternCacheSize is the max number of compiled patterns kept by regex and text match caches.
+const patternCacheSize = 1024
+
 var (
 	lexerPool = sync.Pool{
 		New: func() interface{} {
@@ -19,6 +27,17 @@ var (
 			return antlrparser.NewPlanParser(nil)
 		},
 	}
+
+	regexCache = cache.NewLoadingCache(func(pattern string) (*regexp.Regexp, error) {
+		return regexp.Compile(pattern)
+	}, cache.WithMaximumSize[string, *regexp.Regexp](patternCacheSize))
+	textCache = cache.NewLoadingCache(func(query string) ([]string, error) {
+		tokens := tokenize(query)
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("no token found in text match query: %s", query)
+		}
+		return tokens, nil
+	}, cache.WithMaximumSize[string, []string](patternCacheSize))
 )
 
 func getLexer(stream *antlr.InputStream, listeners ...antlr.ErrorListener) *antlrparser.PlanLexer {
@@ -56,3 +75,20 @@ func putParser(parser *antlrparser.PlanParser) {
 	parser.SetInputStream(nil)
 	parserPool.Put(parser)
 }
+
+// getRegex returns the compiled regex pattern, the invalid pattern is not cached.
+func getRegex(pattern string) (*regexp.Regexp, error) {
+	return regexCache.Get(pattern)
+}
+
+// getTextTokens returns the tokens of the text match query.
+func getTextTokens(query string) ([]string, error) {
+	return textCache.Get(query)
+}
+
+// tokenize splits text into lower case words of letters and digits.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -36,3 +36,25 @@ func Test_getParser(t *testing.T) {
 	parser = getParser(lexer, &errorListener{})
 	assert.NotNil(t, parser)
 }
+
+func Test_getRegex(t *testing.T) {
+	re, err := getRegex(`^error: \d+`)
+	assert.NoError(t, err)
+	assert.True(t, re.MatchString("error: 404"))
+
+	cached, err := getRegex(`^error: \d+`)
+	assert.NoError(t, err)
+	assert.Same(t, re, cached)
+
+	_, err = getRegex(`(error`)
+	assert.Error(t, err)
+}
+
+func Test_getTextTokens(t *testing.T) {
+	tokens, err := getTextTokens("Connection  refused, retry-after 5s")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"connection", "refused", "retry", "after", "5s"}, tokens)
+
+	_, err = getTextTokens(" ,.! ")
+	assert.Error(t, err)
+}
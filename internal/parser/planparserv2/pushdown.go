@@ -0,0 +1,40 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// checkPushdown returns error if the expression can't be executed by segcore, the cast, functions and
+// comparisons of computed fields are translated by parser but not yet supported in execution backend.
+func checkPushdown(expr *planpb.Expr) error {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		return checkPushdown(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		if err := checkPushdown(e.BinaryExpr.GetLeft()); err != nil {
+			return err
+		}
+		return checkPushdown(e.BinaryExpr.GetRight())
+	case *planpb.Expr_BinaryArithExpr:
+		if err := checkPushdown(e.BinaryArithExpr.GetLeft()); err != nil {
+			return err
+		}
+		return checkPushdown(e.BinaryArithExpr.GetRight())
+	case *planpb.Expr_CastExpr:
+		return fmt.Errorf("cast of fields to %s is not supported in execution backend", e.CastExpr.GetDataType().String())
+	case *planpb.Expr_CallExpr:
+		return fmt.Errorf("function %s on fields is not supported in execution backend", e.CallExpr.GetFunctionName())
+	case *planpb.Expr_ComputedCompareExpr:
+		if err := checkPushdown(e.ComputedCompareExpr.GetLeft()); err != nil {
+			return err
+		}
+		if err := checkPushdown(e.ComputedCompareExpr.GetRight()); err != nil {
+			return err
+		}
+		return fmt.Errorf("comparison between arithmetic of multiple fields is not supported in execution backend")
+	default:
+		return nil
+	}
+}
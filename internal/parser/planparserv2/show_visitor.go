@@ -17,6 +17,9 @@ func extractColumnInfo(info *planpb.ColumnInfo) interface{} {
 	js["data_type"] = info.GetDataType().String()
 	js["auto_id"] = info.GetIsAutoID()
 	js["is_pk"] = info.GetIsPrimaryKey()
+	if len(info.GetNestedPath()) > 0 {
+		js["nested_path"] = info.GetNestedPath()
+	}
 	return js
 }
 
@@ -60,6 +63,18 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_ExistsExpr:
+		js["expr"] = v.VisitExistsExpr(realExpr.ExistsExpr)
+	case *planpb.Expr_JsonContainsExpr:
+		js["expr"] = v.VisitJSONContainsExpr(realExpr.JsonContainsExpr)
+	case *planpb.Expr_AlwaysTrueExpr:
+		js["expr"] = v.VisitAlwaysTrueExpr(realExpr.AlwaysTrueExpr)
+	case *planpb.Expr_CastExpr:
+		js["expr"] = v.VisitCastExpr(realExpr.CastExpr)
+	case *planpb.Expr_CallExpr:
+		js["expr"] = v.VisitCallExpr(realExpr.CallExpr)
+	case *planpb.Expr_ComputedCompareExpr:
+		js["expr"] = v.VisitComputedCompareExpr(realExpr.ComputedCompareExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -156,6 +171,61 @@ func (v *ShowExprVisitor) VisitColumnExpr(expr *planpb.ColumnExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitExistsExpr(expr *planpb.ExistsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "exists"
+	js["column_info"] = extractColumnInfo(expr.GetInfo())
+	return js
+}
+
+func (v *ShowExprVisitor) VisitJSONContainsExpr(expr *planpb.JSONContainsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "json_contains"
+	js["op"] = expr.GetOp().String()
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	elements := make([]interface{}, 0, len(expr.GetElements()))
+	for _, element := range expr.GetElements() {
+		elements = append(elements, extractGenericValue(element))
+	}
+	js["elements"] = elements
+	return js
+}
+
+func (v *ShowExprVisitor) VisitAlwaysTrueExpr(expr *planpb.AlwaysTrueExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "always_true"
+	return js
+}
+
+func (v *ShowExprVisitor) VisitCastExpr(expr *planpb.CastExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "cast"
+	js["child"] = v.VisitExpr(expr.GetChild())
+	js["data_type"] = expr.GetDataType().String()
+	return js
+}
+
+func (v *ShowExprVisitor) VisitCallExpr(expr *planpb.CallExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "call"
+	js["function_name"] = expr.GetFunctionName()
+	params := make([]interface{}, 0, len(expr.GetFunctionParameters()))
+	for _, param := range expr.GetFunctionParameters() {
+		params = append(params, v.VisitExpr(param))
+	}
+	js["function_parameters"] = params
+	return js
+}
+
+func (v *ShowExprVisitor) VisitComputedCompareExpr(expr *planpb.ComputedCompareExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "computed_compare"
+	js["left_expr"] = v.VisitExpr(expr.GetLeft())
+	js["right_expr"] = v.VisitExpr(expr.GetRight())
+	js["op"] = expr.GetOp().String()
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
@@ -372,6 +372,41 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 	}
 }
 
+// isComputedExpr returns whether the expression is computed from fields by cast, functions or
+// arithmetic which can't be combined into a range of single field, like a + b or (a + 1) * 2.
+func isComputedExpr(expr *planpb.Expr) bool {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_CastExpr, *planpb.Expr_CallExpr:
+		return true
+	case *planpb.Expr_BinaryArithExpr:
+		if e.BinaryArithExpr.GetOp() == planpb.ArithOpType_ArrayLength {
+			return false
+		}
+		left, right := e.BinaryArithExpr.GetLeft(), e.BinaryArithExpr.GetRight()
+		// a + 2 or 2 + a
+		return !(left.GetColumnExpr() != nil && right.GetValueExpr() != nil) &&
+			!(left.GetValueExpr() != nil && right.GetColumnExpr() != nil)
+	default:
+		return false
+	}
+}
+
+// handleComputedCompare compares the computed expressions, it's kept as is and checked by checkPushdown.
+func handleComputedCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*planpb.Expr, error) {
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("unsupported op type: %s", op)
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ComputedCompareExpr{
+			ComputedCompareExpr: &planpb.ComputedCompareExpr{
+				Left:  left.expr,
+				Right: right.expr,
+				Op:    op,
+			},
+		},
+	}, nil
+}
+
 func relationalCompatible(t1, t2 schemapb.DataType) bool {
 	both := (typeutil.IsStringType(t1) || typeutil.IsJSONType(t1)) && (typeutil.IsStringType(t2) || typeutil.IsJSONType(t2))
 	neither := !typeutil.IsStringType(t1) && !typeutil.IsStringType(t2)
@@ -424,6 +459,11 @@ func HandleCompare(op int, left, right *ExprWithType) (*planpb.Expr, error) {
 	}
 
 	cmpOp := cmpOpMap[op]
+	if isComputedExpr(left.expr) || isComputedExpr(right.expr) ||
+		(left.expr.GetBinaryArithExpr() != nil && right.expr.GetValueExpr() == nil) ||
+		(right.expr.GetBinaryArithExpr() != nil && left.expr.GetValueExpr() == nil) {
+		return handleComputedCompare(cmpOp, left, right)
+	}
 	if valueExpr := left.expr.GetValueExpr(); valueExpr != nil {
 		op, err := reverseOrder(cmpOp)
 		if err != nil {
@@ -459,7 +499,7 @@ func IsAlwaysTruePlan(plan *planpb.PlanNode) bool {
 	case *planpb.PlanNode_Predicates:
 		return isAlwaysTrueExpr(realPlan.Predicates)
 	case *planpb.PlanNode_Query:
-		return !realPlan.Query.GetIsCount() && isAlwaysTrueExpr(realPlan.Query.GetPredicates())
+		return !realPlan.Query.GetIsCount() && !IsAggregatePlan(plan) && isAlwaysTrueExpr(realPlan.Query.GetPredicates())
 	}
 	return false
 }
@@ -22,6 +22,7 @@ import (
 	"path"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -37,7 +38,11 @@ const (
 
 var (
 	_globalL, _globalW atomic.Value
-	once               sync.Once
+	// _globalF is the formatters of access log, nil if the access log is written in the default format
+	_globalF atomic.Pointer[Formatters]
+	// _globalS is the writer of formatted access log
+	_globalS atomic.Pointer[zapcore.WriteSyncer]
+	once     sync.Once
 )
 
 func A() *zap.Logger {
@@ -65,6 +70,14 @@ func InitAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 		return nil, nil
 	}
 
+	var formatters *Formatters
+	if configs := logCfg.Formatters.GetValue(); len(configs) > 0 {
+		formatters, err = NewFormatters(configs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var writeSyncer zapcore.WriteSyncer
 	if len(logCfg.Filename.GetValue()) > 0 {
 		lg, err = NewRotateLogger(logCfg, minioCfg)
@@ -94,6 +107,8 @@ func InitAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 
 	_globalL.Store(logger)
 	_globalW.Store(lg)
+	_globalS.Store(&writeSyncer)
+	_globalF.Store(formatters)
 	return lg, nil
 }
 
@@ -111,11 +126,20 @@ func NewAccessEncoder() zapcore.Encoder {
 	return log.NewTextEncoder(&encoderConfig, false, false)
 }
 
-func PrintAccessInfo(ctx context.Context, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo, timeCost int64) bool {
+func PrintAccessInfo(ctx context.Context, req, resp interface{}, err error, rpcInfo *grpc.UnaryServerInfo, timeCost int64) bool {
 	if _globalL.Load() == nil {
 		return false
 	}
 
+	_, methodName := path.Split(rpcInfo.FullMethod)
+	if formatters := _globalF.Load(); formatters != nil {
+		if f, ok := formatters.Get(methodName); ok {
+			duration := time.Duration(timeCost) * time.Millisecond
+			info := NewGrpcAccessInfo(ctx, methodName, req, resp, err, time.Now().Add(-duration), duration)
+			return writeFormatted(f, info)
+		}
+	}
+
 	fields := []zap.Field{
 		// format time cost of task
 		zap.String("timeCost", fmt.Sprintf("%d ms", timeCost)),
@@ -151,13 +175,33 @@ func PrintAccessInfo(ctx context.Context, resp interface{}, err error, rpcInfo *
 		Status = "TaskFailed"
 	}
 
-	// get method name of grpc
-	_, methodName := path.Split(rpcInfo.FullMethod)
-
 	A().Info(fmt.Sprintf("%v: %s-%s", Status, getAccessAddr(ctx), methodName), fields...)
 	return true
 }
 
+// PrintFormattedAccessInfo writes the access log of request by the formatter selected for its method,
+// the default format is used if no formatter is selected.
+func PrintFormattedAccessInfo(info *GrpcAccessInfo) bool {
+	if _globalL.Load() == nil {
+		return false
+	}
+	if formatters := _globalF.Load(); formatters != nil {
+		if f, ok := formatters.Get(info.method); ok {
+			return writeFormatted(f, info)
+		}
+	}
+	return PrintAccessInfo(info.ctx, info.req, info.resp, info.err, &grpc.UnaryServerInfo{FullMethod: info.method}, info.timeCost.Milliseconds())
+}
+
+func writeFormatted(f *Formatter, info *GrpcAccessInfo) bool {
+	writer := *_globalS.Load()
+	if _, err := writer.Write([]byte(f.Format(info) + "\n")); err != nil {
+		log.Warn("access log print failed", zap.Error(err))
+		return false
+	}
+	return true
+}
+
 func Rotate() error {
 	err := W().Rotate()
 	return err
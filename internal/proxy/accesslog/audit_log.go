@@ -0,0 +1,445 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// categories of audited requests
+const (
+	AuditCategoryDDL   = "ddl"
+	AuditCategoryDML   = "dml"
+	AuditCategoryDQL   = "dql"
+	AuditCategoryRBAC  = "rbac"
+	AuditCategoryOther = "other"
+)
+
+// privilege decisions of audited requests
+const (
+	// PrivilegeGranted means the request passed the privilege check
+	PrivilegeGranted = "granted"
+	// PrivilegeDenied means the request is rejected since the user isn't granted the privilege
+	PrivilegeDenied = "denied"
+	// PrivilegeUnchecked means authorization is disabled or the request requires no privilege
+	PrivilegeUnchecked = "unchecked"
+)
+
+// AuditUserUnknown is the user of requests rejected by authentication, the user claimed by them isn't trusted.
+const AuditUserUnknown = "unknown"
+
+var auditCategories = map[string]string{}
+
+func init() {
+	register := func(category string, methods ...string) {
+		for _, method := range methods {
+			auditCategories[method] = category
+		}
+	}
+	register(AuditCategoryDDL,
+		"CreateDatabase", "DropDatabase",
+		"CreateCollection", "DropCollection", "AlterCollection", "RenameCollection",
+		"LoadCollection", "ReleaseCollection",
+		"CreatePartition", "DropPartition", "LoadPartitions", "ReleasePartitions",
+		"CreateIndex", "DropIndex", "AlterIndex",
+		"CreateAlias", "DropAlias", "AlterAlias",
+		"CreateResourceGroup", "DropResourceGroup", "TransferNode", "TransferReplica",
+		"Flush", "FlushAll", "ManualCompaction", "LoadBalance", "Import",
+	)
+	register(AuditCategoryDML, "Insert", "Delete", "Upsert")
+	register(AuditCategoryDQL, "Search", "HybridSearch", "Query", "CalcDistance")
+	register(AuditCategoryRBAC,
+		"CreateCredential", "UpdateCredential", "DeleteCredential", "ListCredUsers",
+		"CreateRole", "DropRole", "OperateUserRole", "SelectRole", "SelectUser",
+		"OperatePrivilege", "SelectGrant",
+	)
+}
+
+// GetAuditCategory returns the category of method, the unknown ones are AuditCategoryOther.
+func GetAuditCategory(method string) string {
+	if category, ok := auditCategories[method]; ok {
+		return category
+	}
+	return AuditCategoryOther
+}
+
+// AuditEvent is a record of audit log, which is written as a line of json.
+// The schema is stable, the existing fields are never renamed or removed.
+type AuditEvent struct {
+	Time           string `json:"time"`
+	TraceID        string `json:"trace_id"`
+	User           string `json:"user"`
+	Address        string `json:"address"`
+	Method         string `json:"method"`
+	Category       string `json:"category"`
+	DbName         string `json:"db_name"`
+	CollectionName string `json:"collection_name"`
+	// GrpcCode is the code of grpc status, Code and Reason are the ones of milvus status
+	GrpcCode  string `json:"grpc_code"`
+	Code      int32  `json:"code"`
+	Reason    string `json:"reason,omitempty"`
+	Privilege string `json:"privilege"`
+	// RowCount is the number of rows inserted, deleted or upserted by mutation, or returned by query and search
+	RowCount int64 `json:"row_count"`
+	TimeCost int64 `json:"time_cost_ms"`
+	// HTTPStatus is the status of RESTful requests, which have no grpc code
+	HTTPStatus int `json:"http_status,omitempty"`
+}
+
+// auditFilter decides which requests are audited, the excluded methods win over the included ones,
+// and the included methods are audited regardless of their categories.
+type auditFilter struct {
+	categories typeutil.Set[string]
+	include    typeutil.Set[string]
+	exclude    typeutil.Set[string]
+}
+
+func splitList(value string) typeutil.Set[string] {
+	set := typeutil.NewSet[string]()
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			set.Insert(item)
+		}
+	}
+	return set
+}
+
+func newAuditFilter(categories, include, exclude string) *auditFilter {
+	return &auditFilter{
+		categories: splitList(strings.ToLower(categories)),
+		include:    splitList(include),
+		exclude:    splitList(exclude),
+	}
+}
+
+func (f *auditFilter) Match(method string) bool {
+	if f.exclude.Contain(method) {
+		return false
+	}
+	if f.include.Contain(method) {
+		return true
+	}
+	return f.categories.Len() == 0 || f.categories.Contain(GetAuditCategory(method))
+}
+
+// AuditLogger builds the audit events of requests and writes them to the sinks.
+type AuditLogger struct {
+	cfg   *paramtable.AuditLogConfig
+	sinks []AuditSink
+
+	// filter is rebuilt once the refreshable filter configs changed
+	filterMu  sync.Mutex
+	filterKey string
+	filter    *auditFilter
+}
+
+func NewAuditLogger(cfg *paramtable.AuditLogConfig, sinks ...AuditSink) *AuditLogger {
+	return &AuditLogger{
+		cfg:   cfg,
+		sinks: sinks,
+	}
+}
+
+func (l *AuditLogger) getFilter() *auditFilter {
+	categories := l.cfg.Categories.GetValue()
+	include := l.cfg.IncludeMethods.GetValue()
+	exclude := l.cfg.ExcludeMethods.GetValue()
+	key := strings.Join([]string{categories, include, exclude}, "|")
+
+	l.filterMu.Lock()
+	defer l.filterMu.Unlock()
+	if l.filter == nil || l.filterKey != key {
+		l.filter = newAuditFilter(categories, include, exclude)
+		l.filterKey = key
+	}
+	return l.filter
+}
+
+// Audit writes the audit event of request to all sinks if the method is audited.
+func (l *AuditLogger) Audit(ctx context.Context, method string, req interface{}, resp interface{}, err error, timeCost time.Duration) {
+	if !l.getFilter().Match(method) {
+		return
+	}
+	l.write(NewAuditEvent(ctx, method, req, resp, err, timeCost))
+}
+
+// AuditUnauthenticated writes the audit event of request rejected by authentication, whose user is unknown.
+func (l *AuditLogger) AuditUnauthenticated(ctx context.Context, method string, req interface{}, resp interface{}, err error, timeCost time.Duration) {
+	if !l.getFilter().Match(method) {
+		return
+	}
+	event := NewAuditEvent(ctx, method, req, resp, err, timeCost)
+	event.User = AuditUserUnknown
+	l.write(event)
+}
+
+func (l *AuditLogger) write(event *AuditEvent) {
+	method := event.Method
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("failed to marshal audit event", zap.String("method", method), zap.Error(err))
+		return
+	}
+	data = append(data, '\n')
+	for _, sink := range l.sinks {
+		if err := sink.Write(data); err != nil {
+			log.Warn("failed to write audit event", zap.String("sink", sink.Name()), zap.Error(err))
+		}
+	}
+}
+
+func (l *AuditLogger) Close() {
+	for _, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			log.Warn("failed to close audit sink", zap.String("sink", sink.Name()), zap.Error(err))
+		}
+	}
+}
+
+// NewAuditEvent returns the audit event of request, the user is read from the authorization header
+// which is filled by authentication interceptor, and the password is never recorded.
+func NewAuditEvent(ctx context.Context, method string, req interface{}, resp interface{}, err error, timeCost time.Duration) *AuditEvent {
+	event := &AuditEvent{
+		Time:     time.Now().Format(time.RFC3339Nano),
+		User:     getAuditUser(ctx),
+		Address:  getAccessAddr(ctx),
+		Method:   method,
+		Category: GetAuditCategory(method),
+		GrpcCode: status.Code(err).String(),
+		RowCount: getRowCount(resp),
+		TimeCost: timeCost.Milliseconds(),
+	}
+	event.TraceID, _ = getTraceID(ctx)
+	event.DbName, event.CollectionName = getAuditObject(ctx, req)
+
+	respStatus := getResponseStatus(resp)
+	if err != nil {
+		event.Code = merr.Code(err)
+		event.Reason = err.Error()
+	} else if !merr.Ok(respStatus) {
+		event.Code = respStatus.GetCode()
+		event.Reason = respStatus.GetReason()
+	}
+	event.Privilege = getPrivilegeDecision(req, respStatus, err)
+	return event
+}
+
+func getAuditUser(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	authorization := md.Get(util.HeaderAuthorize)
+	if len(authorization) < 1 {
+		return ""
+	}
+	rawToken, err := crypto.Base64Decode(authorization[0])
+	if err != nil {
+		return ""
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return ""
+	}
+	return secrets[0]
+}
+
+func getAuditObject(ctx context.Context, req interface{}) (string, string) {
+	var dbName, collectionName string
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		dbName = r.GetDbName()
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		collectionName = r.GetCollectionName()
+	}
+	if dbName == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(util.HeaderDBName); len(values) > 0 {
+				dbName = values[0]
+			}
+		}
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	return dbName, collectionName
+}
+
+func getResponseStatus(resp interface{}) *commonpb.Status {
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		return r
+	case BaseResponse:
+		return r.GetStatus()
+	default:
+		return nil
+	}
+}
+
+func getRowCount(resp interface{}) int64 {
+	switch r := resp.(type) {
+	case *milvuspb.MutationResult:
+		return r.GetInsertCnt() + r.GetDeleteCnt() + r.GetUpsertCnt()
+	case *milvuspb.QueryResults:
+		for _, field := range r.GetFieldsData() {
+			if rows, err := funcutil.GetNumRowOfFieldData(field); err == nil {
+				return int64(rows)
+			}
+		}
+		return 0
+	case *milvuspb.SearchResults:
+		return int64(len(r.GetResults().GetScores()))
+	default:
+		return 0
+	}
+}
+
+func getPrivilegeDecision(req interface{}, respStatus *commonpb.Status, err error) string {
+	if status.Code(err) == codes.PermissionDenied || merr.Code(err) == merr.Code(merr.ErrPrivilegeNotPermitted) ||
+		respStatus.GetCode() == merr.Code(merr.ErrPrivilegeNotPermitted) {
+		return PrivilegeDenied
+	}
+	if !paramtable.Get().CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return PrivilegeUnchecked
+	}
+	msg, ok := req.(proto.GeneratedMessage)
+	if !ok {
+		return PrivilegeUnchecked
+	}
+	if _, err := funcutil.GetPrivilegeExtObj(msg); err != nil {
+		return PrivilegeUnchecked
+	}
+	return PrivilegeGranted
+}
+
+var _globalAuditLogger atomic.Pointer[AuditLogger]
+
+// SetupAuditLog creates the sinks of audit log and starts auditing requests, it's a no-op if audit log is disabled.
+func SetupAuditLog(ctx context.Context, cfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig, kafkaCfg *paramtable.KafkaConfig) error {
+	if !cfg.Enable.GetAsBool() {
+		return nil
+	}
+	sinks, err := NewAuditSinks(ctx, cfg, minioCfg, kafkaCfg)
+	if err != nil {
+		return err
+	}
+	if old := _globalAuditLogger.Swap(NewAuditLogger(cfg, sinks...)); old != nil {
+		old.Close()
+	}
+	log.Info("Audit log start successful", zap.String("sinks", cfg.Sinks.GetValue()))
+	return nil
+}
+
+// CloseAuditLog stops auditing requests and closes the sinks.
+func CloseAuditLog() {
+	if l := _globalAuditLogger.Swap(nil); l != nil {
+		l.Close()
+	}
+}
+
+type auditRecordKey struct{}
+
+// auditRecord keeps the context of request once it's authenticated, the audit interceptor is placed before
+// authentication to audit the requests rejected by it, so the authenticated context is recorded by
+// UnaryAuditContextInterceptor for it.
+type auditRecord struct {
+	ctx atomic.Pointer[context.Context]
+}
+
+// UnaryAuditLogInterceptor writes the audit event of requests, it must be placed before the authentication interceptor,
+// and UnaryAuditContextInterceptor must be placed after the authentication and before the privilege interceptor,
+// so that the requests rejected by authentication are audited with unknown user and the privilege decision is observed.
+func UnaryAuditLogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	l := _globalAuditLogger.Load()
+	if l == nil {
+		return handler(ctx, req)
+	}
+	starttime := time.Now()
+	record := &auditRecord{}
+	resp, err := handler(context.WithValue(ctx, auditRecordKey{}, record), req)
+	_, methodName := path.Split(info.FullMethod)
+	if authCtx := record.ctx.Load(); authCtx != nil {
+		l.Audit(*authCtx, methodName, req, resp, err, time.Since(starttime))
+	} else {
+		l.AuditUnauthenticated(ctx, methodName, req, resp, err, time.Since(starttime))
+	}
+	return resp, err
+}
+
+// UnaryAuditContextInterceptor records the authenticated context of request for UnaryAuditLogInterceptor.
+func UnaryAuditContextInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if record, ok := ctx.Value(auditRecordKey{}).(*auditRecord); ok {
+		record.ctx.Store(&ctx)
+	}
+	return handler(ctx, req)
+}
+
+// AuditHTTP writes the audit event of RESTful request, method is the name of grpc method equivalent to it,
+// req is the request whose privilege is checked, and it's nil if the request is rejected before or requires no privilege.
+func AuditHTTP(ctx context.Context, method string, user string, address string, req interface{}, httpStatus int, timeCost time.Duration) {
+	l := _globalAuditLogger.Load()
+	if l == nil || !l.getFilter().Match(method) {
+		return
+	}
+	event := &AuditEvent{
+		Time:       time.Now().Format(time.RFC3339Nano),
+		User:       user,
+		Address:    address,
+		Method:     method,
+		Category:   GetAuditCategory(method),
+		HTTPStatus: httpStatus,
+		TimeCost:   timeCost.Milliseconds(),
+	}
+	event.TraceID, _ = getTraceID(ctx)
+	event.DbName, event.CollectionName = getAuditObject(ctx, req)
+	switch httpStatus {
+	case http.StatusUnauthorized:
+		event.User = AuditUserUnknown
+		event.Code = merr.Code(merr.ErrNeedAuthenticate)
+		event.Privilege = PrivilegeUnchecked
+	case http.StatusForbidden:
+		event.Code = merr.Code(merr.ErrPrivilegeNotPermitted)
+		event.Privilege = PrivilegeDenied
+	default:
+		event.Privilege = getPrivilegeDecision(req, nil, nil)
+	}
+	l.write(event)
+}
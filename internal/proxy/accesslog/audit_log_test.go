@@ -0,0 +1,268 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type memoryAuditSink struct {
+	mu     sync.Mutex
+	lines  [][]byte
+	closed bool
+}
+
+func (s *memoryAuditSink) Name() string {
+	return "memory"
+}
+
+func (s *memoryAuditSink) Write(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lines = append(s.lines, append([]byte{}, data...))
+	return nil
+}
+
+func (s *memoryAuditSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *memoryAuditSink) events(t *testing.T) []*AuditEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events := make([]*AuditEvent, 0, len(s.lines))
+	for _, line := range s.lines {
+		assert.True(t, strings.HasSuffix(string(line), "\n"))
+		event := &AuditEvent{}
+		assert.NoError(t, json.Unmarshal(line, event))
+		events = append(events, event)
+	}
+	return events
+}
+
+func auditContext(user string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.IPAddr{IP: net.IPv4(127, 0, 0, 1)},
+	})
+	authorization := crypto.Base64Encode(user + util.CredentialSeperator + "secret")
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(strings.ToLower(util.HeaderAuthorize), authorization))
+}
+
+func TestAuditFilter(t *testing.T) {
+	assert.Equal(t, AuditCategoryDML, GetAuditCategory("Insert"))
+	assert.Equal(t, AuditCategoryDQL, GetAuditCategory("Search"))
+	assert.Equal(t, AuditCategoryRBAC, GetAuditCategory("OperatePrivilege"))
+	assert.Equal(t, AuditCategoryOther, GetAuditCategory("GetVersion"))
+
+	f := newAuditFilter("", "", "")
+	assert.True(t, f.Match("Insert"))
+	assert.True(t, f.Match("GetVersion"))
+
+	f = newAuditFilter("DML, rbac", "Query", "Delete")
+	assert.True(t, f.Match("Insert"))
+	assert.True(t, f.Match("CreateRole"))
+	assert.True(t, f.Match("Query"))
+	assert.False(t, f.Match("Delete"))
+	assert.False(t, f.Match("Search"))
+	assert.False(t, f.Match("CreateCollection"))
+}
+
+func TestAuditEvent(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	ctx := auditContext("alice")
+
+	req := &milvuspb.InsertRequest{DbName: "db1", CollectionName: "coll"}
+	resp := &milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10}
+	event := NewAuditEvent(ctx, "Insert", req, resp, nil, 5*time.Millisecond)
+	assert.Equal(t, "alice", event.User)
+	assert.Equal(t, "Insert", event.Method)
+	assert.Equal(t, AuditCategoryDML, event.Category)
+	assert.Equal(t, "db1", event.DbName)
+	assert.Equal(t, "coll", event.CollectionName)
+	assert.Equal(t, codes.OK.String(), event.GrpcCode)
+	assert.EqualValues(t, 0, event.Code)
+	assert.EqualValues(t, 10, event.RowCount)
+	assert.EqualValues(t, 5, event.TimeCost)
+	assert.Equal(t, PrivilegeUnchecked, event.Privilege)
+
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	event = NewAuditEvent(ctx, "Insert", req, resp, nil, 0)
+	assert.Equal(t, PrivilegeGranted, event.Privilege)
+
+	// rejected by privilege interceptor
+	event = NewAuditEvent(ctx, "Insert", req, nil, status.Error(codes.PermissionDenied, "Insert: permission deny"), 0)
+	assert.Equal(t, PrivilegeDenied, event.Privilege)
+	assert.Equal(t, codes.PermissionDenied.String(), event.GrpcCode)
+	assert.NotEmpty(t, event.Reason)
+
+	// rejected by field privileges or row policies
+	queryReq := &milvuspb.QueryRequest{CollectionName: "coll"}
+	event = NewAuditEvent(ctx, "Query", queryReq, &milvuspb.QueryResults{
+		Status: merr.Status(merr.WrapErrPrivilegeNotPermitted("field not permitted")),
+	}, nil, 0)
+	assert.Equal(t, PrivilegeDenied, event.Privilege)
+	assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), event.Code)
+	// db name of header is used if the request doesn't specify it
+	assert.Equal(t, util.DefaultDBName, event.DbName)
+
+	event = NewAuditEvent(ctx, "Query", queryReq, &milvuspb.QueryResults{
+		Status: merr.Success(),
+		FieldsData: []*schemapb.FieldData{{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+			}},
+		}},
+	}, nil, 0)
+	assert.EqualValues(t, 3, event.RowCount)
+
+	event = NewAuditEvent(ctx, "Search", &milvuspb.SearchRequest{CollectionName: "coll"}, &milvuspb.SearchResults{
+		Status:  merr.Success(),
+		Results: &schemapb.SearchResultData{Scores: []float32{0.1, 0.2}},
+	}, nil, 0)
+	assert.EqualValues(t, 2, event.RowCount)
+
+	// no user of internal requests
+	event = NewAuditEvent(context.Background(), "GetComponentStates", &milvuspb.GetComponentStatesRequest{}, &milvuspb.ComponentStates{}, nil, 0)
+	assert.Equal(t, "", event.User)
+	assert.Equal(t, PrivilegeUnchecked, event.Privilege)
+}
+
+func TestAuditLogger(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	params.Save(params.ProxyCfg.AuditLog.Categories.Key, "dml")
+	sink := &memoryAuditSink{}
+	l := NewAuditLogger(&params.ProxyCfg.AuditLog, sink)
+	_globalAuditLogger.Store(l)
+	defer CloseAuditLog()
+
+	ctx := auditContext("alice")
+	call := func(method string, req interface{}) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/" + method}
+		_, err := UnaryAuditLogInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return UnaryAuditContextInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &milvuspb.MutationResult{Status: merr.Success(), DeleteCnt: 2}, nil
+			})
+		})
+		assert.NoError(t, err)
+	}
+	call("Delete", &milvuspb.DeleteRequest{CollectionName: "coll"})
+	call("CreateCollection", &milvuspb.CreateCollectionRequest{CollectionName: "coll"})
+
+	events := sink.events(t)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "Delete", events[0].Method)
+	assert.Equal(t, "alice", events[0].User)
+	assert.EqualValues(t, 2, events[0].RowCount)
+	// the password is never recorded
+	assert.NotContains(t, string(sink.lines[0]), "secret")
+
+	// filters are refreshed
+	params.Save(params.ProxyCfg.AuditLog.ExcludeMethods.Key, "Delete")
+	params.Save(params.ProxyCfg.AuditLog.IncludeMethods.Key, "CreateCollection")
+	call("Delete", &milvuspb.DeleteRequest{CollectionName: "coll"})
+	call("CreateCollection", &milvuspb.CreateCollectionRequest{CollectionName: "coll"})
+	events = sink.events(t)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "CreateCollection", events[1].Method)
+
+	// the requests rejected by authentication are audited with unknown user
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/CreateCollection"}
+	_, err := UnaryAuditLogInterceptor(ctx, &milvuspb.CreateCollectionRequest{CollectionName: "coll"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Unauthenticated, "auth check failure")
+	})
+	assert.Error(t, err)
+	events = sink.events(t)
+	assert.Len(t, events, 3)
+	assert.Equal(t, AuditUserUnknown, events[2].User)
+	assert.Equal(t, codes.Unauthenticated.String(), events[2].GrpcCode)
+
+	// RESTful requests
+	AuditHTTP(context.Background(), "CreateCollection", "bob", "127.0.0.1", &milvuspb.CreateCollectionRequest{DbName: "db1", CollectionName: "coll"}, http.StatusOK, time.Second)
+	AuditHTTP(context.Background(), "CreateCollection", "", "127.0.0.1", nil, http.StatusUnauthorized, time.Second)
+	AuditHTTP(context.Background(), "CreateCollection", "bob", "127.0.0.1", &milvuspb.CreateCollectionRequest{CollectionName: "coll"}, http.StatusForbidden, time.Second)
+	AuditHTTP(context.Background(), "Delete", "bob", "127.0.0.1", &milvuspb.DeleteRequest{CollectionName: "coll"}, http.StatusOK, time.Second)
+	events = sink.events(t)
+	assert.Len(t, events, 6)
+	assert.Equal(t, "bob", events[3].User)
+	assert.Equal(t, "db1", events[3].DbName)
+	assert.Equal(t, "coll", events[3].CollectionName)
+	assert.Equal(t, http.StatusOK, events[3].HTTPStatus)
+	assert.EqualValues(t, 1000, events[3].TimeCost)
+	assert.Equal(t, AuditUserUnknown, events[4].User)
+	assert.Equal(t, merr.Code(merr.ErrNeedAuthenticate), events[4].Code)
+	assert.Equal(t, PrivilegeDenied, events[5].Privilege)
+
+	CloseAuditLog()
+	assert.True(t, sink.closed)
+	assert.Nil(t, _globalAuditLogger.Load())
+	// requests are served without audit logger
+	call("Delete", &milvuspb.DeleteRequest{CollectionName: "coll"})
+	assert.Len(t, sink.events(t), 6)
+}
+
+func TestSetupAuditLog(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+
+	// disabled
+	err := SetupAuditLog(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.NoError(t, err)
+	assert.Nil(t, _globalAuditLogger.Load())
+
+	params.Save(params.ProxyCfg.AuditLog.Enable.Key, "true")
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, "unknown")
+	err = SetupAuditLog(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.Error(t, err)
+
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, "")
+	err = SetupAuditLog(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.Error(t, err)
+
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, AuditSinkFile)
+	params.Save(params.ProxyCfg.AuditLog.LocalPath.Key, t.TempDir())
+	err = SetupAuditLog(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.NoError(t, err)
+	assert.NotNil(t, _globalAuditLogger.Load())
+	CloseAuditLog()
+}
@@ -0,0 +1,418 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+// names of audit sinks
+const (
+	AuditSinkFile          = "file"
+	AuditSinkObjectStorage = "objectStorage"
+	AuditSinkKafka         = "kafka"
+)
+
+const (
+	auditLogPrefix       = "milvus_audit_log"
+	auditLogExt          = ".jsonl"
+	kafkaAuditBufferSize = 1024
+)
+
+// AuditSink is the destination of audit log, every Write is a json line of audit event.
+// Write is called concurrently by requests, it should not block for long.
+type AuditSink interface {
+	Name() string
+	Write(data []byte) error
+	Close() error
+}
+
+// NewAuditSinks creates the sinks configured by proxy.auditLog.sinks.
+func NewAuditSinks(ctx context.Context, cfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig, kafkaCfg *paramtable.KafkaConfig) ([]AuditSink, error) {
+	sinks := make([]AuditSink, 0)
+	closeAll := func() {
+		for _, sink := range sinks {
+			sink.Close()
+		}
+	}
+	for _, name := range splitList(cfg.Sinks.GetValue()).Collect() {
+		var sink AuditSink
+		var err error
+		switch name {
+		case AuditSinkFile:
+			sink = NewFileAuditSink(cfg)
+		case AuditSinkObjectStorage:
+			sink, err = NewObjectStorageAuditSink(ctx, cfg, minioCfg)
+		case AuditSinkKafka:
+			sink, err = NewKafkaAuditSink(ctx, cfg, kafkaCfg)
+		default:
+			err = fmt.Errorf("unknown audit sink %s", name)
+		}
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil, errors.New("no sink of audit log is configured")
+	}
+	spillToFileSink(sinks)
+	return sinks, nil
+}
+
+// spillToFileSink makes the remote sinks spill the events failed to deliver to the file sink if it's configured,
+// the file sink is moved to the last so that it's closed after the remote sinks spill their buffered events.
+func spillToFileSink(sinks []AuditSink) {
+	for i, sink := range sinks {
+		fileSink, ok := sink.(*FileAuditSink)
+		if !ok {
+			continue
+		}
+		for _, other := range sinks {
+			if s, ok := other.(interface{ setSpill(AuditSink) }); ok {
+				s.setSpill(fileSink)
+			}
+		}
+		copy(sinks[i:], sinks[i+1:])
+		sinks[len(sinks)-1] = fileSink
+		return
+	}
+}
+
+// auditSpiller handles the events a remote sink failed to deliver, they are written to the spill sink
+// if there is one, otherwise they are dropped. Both are counted by metrics.ProxyUndeliveredAuditEvents.
+type auditSpiller struct {
+	sink  string
+	spill AuditSink
+}
+
+func (s *auditSpiller) setSpill(sink AuditSink) {
+	s.spill = sink
+}
+
+// spillOrDrop handles data of json lines, every line is spilled separately since the data buffered by
+// object storage sink may exceed the max size of a file. It returns error if any line is dropped.
+func (s *auditSpiller) spillOrDrop(data []byte, cause error) error {
+	dropped := 0
+	for _, line := range bytes.SplitAfter(data, []byte{'\n'}) {
+		if len(line) == 0 {
+			continue
+		}
+		if s.spill != nil {
+			err := s.spill.Write(line)
+			if err == nil {
+				metrics.ProxyUndeliveredAuditEvents.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), s.sink, metrics.AuditEventSpilled).Inc()
+				continue
+			}
+			if dropped == 0 {
+				cause = errors.CombineErrors(cause, err)
+			}
+		}
+		metrics.ProxyUndeliveredAuditEvents.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), s.sink, metrics.AuditEventDropped).Inc()
+		dropped++
+	}
+	if dropped > 0 {
+		return errors.Wrapf(cause, "%d audit events dropped by %s audit sink", dropped, s.sink)
+	}
+	return nil
+}
+
+// FileAuditSink writes audit log to the local files rotated by size and time.
+type FileAuditSink struct {
+	logger *RotateLogger
+}
+
+func NewFileAuditSink(cfg *paramtable.AuditLogConfig) *FileAuditSink {
+	localPath := cfg.LocalPath.GetValue()
+	if localPath == "" {
+		localPath = path.Join(os.TempDir(), "milvus_auditlog")
+	}
+	logger := newRotateLogger(
+		localPath,
+		cfg.Filename.GetValue(),
+		cfg.RotatedTime.GetAsInt64(),
+		cfg.MaxSize.GetAsInt(),
+		cfg.MaxBackups.GetAsInt(),
+	)
+	logger.start()
+	log.Info("Audit log save to " + logger.dir())
+	return &FileAuditSink{logger: logger}
+}
+
+func (s *FileAuditSink) Name() string {
+	return AuditSinkFile
+}
+
+func (s *FileAuditSink) Write(data []byte) error {
+	_, err := s.logger.Write(data)
+	return err
+}
+
+func (s *FileAuditSink) Close() error {
+	return s.logger.Close()
+}
+
+// objectUploader puts an object of data to object storage.
+type objectUploader func(ctx context.Context, objectName string, data []byte) error
+
+// ObjectStorageAuditSink buffers audit log in memory, and uploads the buffer as an object
+// periodically or once it's full.
+type ObjectStorageAuditSink struct {
+	auditSpiller
+
+	rootPath string
+	maxSize  int
+	upload   objectUploader
+
+	mu  sync.Mutex
+	buf *bytes.Buffer
+
+	flushCh   chan struct{}
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func NewObjectStorageAuditSink(ctx context.Context, cfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig) (*ObjectStorageAuditSink, error) {
+	handlerCfg := config{
+		address:           minioCfg.Address.GetValue(),
+		bucketName:        minioCfg.BucketName.GetValue(),
+		accessKeyID:       minioCfg.AccessKeyID.GetValue(),
+		secretAccessKeyID: minioCfg.SecretAccessKey.GetValue(),
+		useSSL:            minioCfg.UseSSL.GetAsBool(),
+		createBucket:      true,
+		useIAM:            minioCfg.UseIAM.GetAsBool(),
+		iamEndpoint:       minioCfg.IAMEndpoint.GetValue(),
+	}
+	client, err := newMinioClient(ctx, handlerCfg)
+	if err != nil {
+		return nil, err
+	}
+	upload := func(ctx context.Context, objectName string, data []byte) error {
+		_, err := client.PutObject(ctx, handlerCfg.bucketName, objectName, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{})
+		return err
+	}
+	interval := time.Duration(cfg.FlushInterval.GetAsInt64()) * time.Second
+	return newObjectStorageAuditSink(cfg.RemotePath.GetValue(), cfg.MaxSize.GetAsInt()*megabyte, interval, upload), nil
+}
+
+func newObjectStorageAuditSink(rootPath string, maxSize int, interval time.Duration, upload objectUploader) *ObjectStorageAuditSink {
+	if !strings.HasSuffix(rootPath, "/") {
+		rootPath = rootPath + "/"
+	}
+	s := &ObjectStorageAuditSink{
+		auditSpiller: auditSpiller{sink: AuditSinkObjectStorage},
+		rootPath:     rootPath,
+		maxSize:      maxSize,
+		upload:       upload,
+		buf:          new(bytes.Buffer),
+		flushCh:      make(chan struct{}, 1),
+		closeCh:      make(chan struct{}),
+	}
+	s.closeWg.Add(1)
+	go s.flushLoop(interval)
+	return s
+}
+
+func (s *ObjectStorageAuditSink) Name() string {
+	return AuditSinkObjectStorage
+}
+
+func (s *ObjectStorageAuditSink) Write(data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buf.Write(data)
+	if s.maxSize > 0 && s.buf.Len() >= s.maxSize {
+		select {
+		case s.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (s *ObjectStorageAuditSink) flushLoop(interval time.Duration) {
+	defer s.closeWg.Done()
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeCh:
+			s.flush()
+			return
+		case <-ticker.C:
+			s.flush()
+		case <-s.flushCh:
+			s.flush()
+		}
+	}
+}
+
+func (s *ObjectStorageAuditSink) objectName() string {
+	return Join(s.rootPath, fmt.Sprintf("%s_%d%s%s", auditLogPrefix, paramtable.GetNodeID(), time.Now().Format(timeFormat), auditLogExt))
+}
+
+// flush uploads the buffered audit log, the buffer is spilled or dropped if uploading fails after retries,
+// so that the memory is bounded when object storage is unavailable.
+func (s *ObjectStorageAuditSink) flush() {
+	s.mu.Lock()
+	if s.buf.Len() == 0 {
+		s.mu.Unlock()
+		return
+	}
+	data := s.buf.Bytes()
+	s.buf = new(bytes.Buffer)
+	s.mu.Unlock()
+
+	objectName := s.objectName()
+	err := retry.Do(context.Background(), func() error {
+		return s.upload(context.Background(), objectName, data)
+	}, retry.Attempts(3))
+	if err != nil {
+		log.Warn("failed to upload audit log to object storage", zap.String("object", objectName), zap.Int("size", len(data)), zap.Error(err))
+		if err := s.spillOrDrop(data, err); err != nil {
+			log.Warn("audit log uploaded failed is dropped", zap.String("object", objectName), zap.Error(err))
+		}
+		return
+	}
+	log.Info("upload audit log to object storage", zap.String("object", objectName), zap.Int("size", len(data)))
+}
+
+func (s *ObjectStorageAuditSink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closeCh)
+		s.closeWg.Wait()
+	})
+	return nil
+}
+
+// KafkaAuditSink produces every audit event as a message of kafka topic.
+// The messages are sent in background since sending is synchronous, and they are spilled or dropped
+// if the buffer is full or sending fails.
+type KafkaAuditSink struct {
+	auditSpiller
+
+	topic    string
+	producer mqwrapper.Producer
+
+	ch        chan []byte
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func NewKafkaAuditSink(ctx context.Context, cfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) (*KafkaAuditSink, error) {
+	if kafkaCfg.Address.GetValue() == "" {
+		return nil, errors.New("kafka address is not configured for audit log")
+	}
+	client, err := kafka.NewKafkaClientInstanceWithConfig(ctx, kafkaCfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: cfg.KafkaTopic.GetValue()})
+	if err != nil {
+		return nil, err
+	}
+	return newKafkaAuditSink(cfg.KafkaTopic.GetValue(), producer), nil
+}
+
+func newKafkaAuditSink(topic string, producer mqwrapper.Producer) *KafkaAuditSink {
+	s := &KafkaAuditSink{
+		auditSpiller: auditSpiller{sink: AuditSinkKafka},
+		topic:        topic,
+		producer:     producer,
+		ch:           make(chan []byte, kafkaAuditBufferSize),
+		closeCh:      make(chan struct{}),
+	}
+	s.closeWg.Add(1)
+	go s.sendLoop()
+	return s
+}
+
+func (s *KafkaAuditSink) Name() string {
+	return AuditSinkKafka
+}
+
+func (s *KafkaAuditSink) Write(data []byte) error {
+	select {
+	case s.ch <- bytes.TrimSuffix(data, []byte{'\n'}):
+		return nil
+	default:
+		return s.spillOrDrop(data, errors.New("buffer of kafka audit sink is full"))
+	}
+}
+
+func (s *KafkaAuditSink) send(data []byte) {
+	if _, err := s.producer.Send(context.Background(), &mqwrapper.ProducerMessage{Payload: data}); err != nil {
+		log.Warn("failed to send audit log to kafka", zap.String("topic", s.topic), zap.Error(err))
+		if err := s.spillOrDrop(append(data, '\n'), err); err != nil {
+			log.Warn("audit log sent failed is dropped", zap.String("topic", s.topic), zap.Error(err))
+		}
+	}
+}
+
+func (s *KafkaAuditSink) sendLoop() {
+	defer s.closeWg.Done()
+	for {
+		select {
+		case data := <-s.ch:
+			s.send(data)
+		case <-s.closeCh:
+			// drain the buffered events before closing
+			for {
+				select {
+				case data := <-s.ch:
+					s.send(data)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *KafkaAuditSink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closeCh)
+		s.closeWg.Wait()
+		s.producer.Close()
+	})
+	return nil
+}
@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestFileAuditSink(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	testPath := t.TempDir()
+	params.Save(params.ProxyCfg.AuditLog.LocalPath.Key, testPath)
+
+	sink := NewFileAuditSink(&params.ProxyCfg.AuditLog)
+	assert.Equal(t, AuditSinkFile, sink.Name())
+	assert.NoError(t, sink.Write([]byte("{\"method\":\"Insert\"}\n")))
+	assert.NoError(t, sink.Write([]byte("{\"method\":\"Delete\"}\n")))
+	assert.NoError(t, sink.Close())
+
+	data, err := os.ReadFile(path.Join(testPath, params.ProxyCfg.AuditLog.Filename.GetValue()))
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"method\":\"Insert\"}\n{\"method\":\"Delete\"}\n", string(data))
+}
+
+func undeliveredAuditEvents(sink, status string) float64 {
+	return testutil.ToFloat64(metrics.ProxyUndeliveredAuditEvents.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), sink, status))
+}
+
+type memoryObjectStorage struct {
+	mu      sync.Mutex
+	objects map[string]string
+	fails   int
+}
+
+func (s *memoryObjectStorage) upload(ctx context.Context, objectName string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fails > 0 {
+		s.fails--
+		return errors.New("mock failure")
+	}
+	s.objects[objectName] = string(data)
+	return nil
+}
+
+func (s *memoryObjectStorage) content() (int, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	contents := make([]string, 0, len(s.objects))
+	for name, data := range s.objects {
+		if !strings.HasPrefix(name, "audit_log/"+auditLogPrefix) || !strings.HasSuffix(name, auditLogExt) {
+			return 0, ""
+		}
+		contents = append(contents, data)
+	}
+	return len(s.objects), strings.Join(contents, "")
+}
+
+func TestObjectStorageAuditSink(t *testing.T) {
+	paramtable.Init()
+	t.Run("flush on close", func(t *testing.T) {
+		storage := &memoryObjectStorage{objects: make(map[string]string), fails: 1}
+		sink := newObjectStorageAuditSink("audit_log", 1024, time.Hour, storage.upload)
+		assert.Equal(t, AuditSinkObjectStorage, sink.Name())
+		assert.NoError(t, sink.Write([]byte("a\n")))
+		assert.NoError(t, sink.Write([]byte("b\n")))
+		assert.NoError(t, sink.Close())
+
+		num, content := storage.content()
+		assert.Equal(t, 1, num)
+		assert.Equal(t, "a\nb\n", content)
+	})
+
+	t.Run("flush once full", func(t *testing.T) {
+		storage := &memoryObjectStorage{objects: make(map[string]string)}
+		sink := newObjectStorageAuditSink("audit_log/", 4, time.Hour, storage.upload)
+		defer sink.Close()
+		assert.NoError(t, sink.Write([]byte("a\n")))
+		assert.NoError(t, sink.Write([]byte("b\n")))
+		assert.Eventually(t, func() bool {
+			num, content := storage.content()
+			return num == 1 && content == "a\nb\n"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("spill if upload fails", func(t *testing.T) {
+		storage := &memoryObjectStorage{objects: make(map[string]string), fails: 3}
+		sink := newObjectStorageAuditSink("audit_log", 1024, time.Hour, storage.upload)
+		spill := &memoryAuditSink{}
+		sink.setSpill(spill)
+		spilled := undeliveredAuditEvents(AuditSinkObjectStorage, metrics.AuditEventSpilled)
+		assert.NoError(t, sink.Write([]byte("a\n")))
+		assert.NoError(t, sink.Write([]byte("b\n")))
+		assert.NoError(t, sink.Close())
+
+		num, _ := storage.content()
+		assert.Equal(t, 0, num)
+		assert.Equal(t, [][]byte{[]byte("a\n"), []byte("b\n")}, spill.lines)
+		assert.Equal(t, spilled+2, undeliveredAuditEvents(AuditSinkObjectStorage, metrics.AuditEventSpilled))
+	})
+
+	t.Run("flush periodically", func(t *testing.T) {
+		storage := &memoryObjectStorage{objects: make(map[string]string)}
+		sink := newObjectStorageAuditSink("audit_log", 1024, 10*time.Millisecond, storage.upload)
+		defer sink.Close()
+		assert.NoError(t, sink.Write([]byte("a\n")))
+		assert.Eventually(t, func() bool {
+			num, _ := storage.content()
+			return num == 1
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+type memoryProducer struct {
+	mu       sync.Mutex
+	messages []string
+	block    chan struct{}
+	closed   bool
+}
+
+func (p *memoryProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	if p.block != nil {
+		<-p.block
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, string(message.Payload))
+	return nil, nil
+}
+
+func (p *memoryProducer) Close() {
+	p.closed = true
+}
+
+func TestKafkaAuditSink(t *testing.T) {
+	producer := &memoryProducer{}
+	sink := newKafkaAuditSink("audit", producer)
+	assert.Equal(t, AuditSinkKafka, sink.Name())
+	assert.NoError(t, sink.Write([]byte("{\"method\":\"Insert\"}\n")))
+	assert.NoError(t, sink.Write([]byte("{\"method\":\"Delete\"}\n")))
+	assert.NoError(t, sink.Close())
+	assert.True(t, producer.closed)
+	assert.Equal(t, []string{"{\"method\":\"Insert\"}", "{\"method\":\"Delete\"}"}, producer.messages)
+
+	// events are dropped if the buffer is full
+	producer = &memoryProducer{block: make(chan struct{})}
+	sink = newKafkaAuditSink("audit", producer)
+	dropped := undeliveredAuditEvents(AuditSinkKafka, metrics.AuditEventDropped)
+	var err error
+	for i := 0; i < kafkaAuditBufferSize+2 && err == nil; i++ {
+		err = sink.Write([]byte("{}\n"))
+	}
+	assert.Error(t, err)
+	assert.Equal(t, dropped+1, undeliveredAuditEvents(AuditSinkKafka, metrics.AuditEventDropped))
+	close(producer.block)
+	assert.NoError(t, sink.Close())
+
+	// events are spilled if the buffer is full and there is a spill sink
+	producer = &memoryProducer{block: make(chan struct{})}
+	sink = newKafkaAuditSink("audit", producer)
+	spill := &memoryAuditSink{}
+	sink.setSpill(spill)
+	for i := 0; i < kafkaAuditBufferSize+2; i++ {
+		assert.NoError(t, sink.Write([]byte("{}\n")))
+	}
+	assert.NotEmpty(t, spill.lines)
+	close(producer.block)
+	assert.NoError(t, sink.Close())
+	assert.Equal(t, kafkaAuditBufferSize+2, len(producer.messages)+len(spill.lines))
+}
+
+func TestNewAuditSinks(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	params.Save(params.ProxyCfg.AuditLog.LocalPath.Key, t.TempDir())
+
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, "file, kafka")
+	params.Save(params.KafkaCfg.Address.Key, "")
+	_, err := NewAuditSinks(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.Error(t, err)
+
+	params.Save(params.ProxyCfg.AuditLog.Sinks.Key, "file")
+	sinks, err := NewAuditSinks(context.Background(), &params.ProxyCfg.AuditLog, &params.MinioCfg, &params.KafkaCfg)
+	assert.NoError(t, err)
+	assert.Len(t, sinks, 1)
+	for _, sink := range sinks {
+		sink.Close()
+	}
+}
+
+func TestSpillToFileSink(t *testing.T) {
+	paramtable.Init()
+	fileSink := NewFileAuditSink(&paramtable.Get().ProxyCfg.AuditLog)
+	objectSink := newObjectStorageAuditSink("audit_log", 1024, time.Hour, (&memoryObjectStorage{objects: make(map[string]string)}).upload)
+	kafkaSink := newKafkaAuditSink("audit", &memoryProducer{})
+	sinks := []AuditSink{objectSink, fileSink, kafkaSink}
+	spillToFileSink(sinks)
+	// the file sink is closed after the others spill
+	assert.Equal(t, []AuditSink{objectSink, kafkaSink, fileSink}, sinks)
+	assert.Equal(t, fileSink, objectSink.spill)
+	assert.Equal(t, fileSink, kafkaSink.spill)
+	for _, sink := range sinks {
+		sink.Close()
+	}
+
+	// nothing to spill to without file sink
+	objectSink = newObjectStorageAuditSink("audit_log", 1024, time.Hour, (&memoryObjectStorage{objects: make(map[string]string)}).upload)
+	spillToFileSink([]AuditSink{objectSink})
+	assert.Nil(t, objectSink.spill)
+	objectSink.Close()
+}
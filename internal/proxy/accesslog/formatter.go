@@ -0,0 +1,323 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	unknownString = "Unknown"
+
+	// baseFormatterName is the formatter of methods not selected by other formatters
+	baseFormatterName = "base"
+	formatKey         = "format"
+	methodsKey        = "methods"
+	accessTimeFormat  = "2006/01/02 15:04:05.000 -07:00"
+)
+
+// GrpcAccessInfo is the information of a request, which is rendered by the fields of formatter.
+type GrpcAccessInfo struct {
+	ctx      context.Context
+	req      interface{}
+	resp     interface{}
+	err      error
+	method   string
+	start    time.Time
+	timeCost time.Duration
+}
+
+func NewGrpcAccessInfo(ctx context.Context, method string, req, resp interface{}, err error, start time.Time, timeCost time.Duration) *GrpcAccessInfo {
+	return &GrpcAccessInfo{
+		ctx:      ctx,
+		req:      req,
+		resp:     resp,
+		err:      err,
+		method:   method,
+		start:    start,
+		timeCost: timeCost,
+	}
+}
+
+type fieldGetter func(i *GrpcAccessInfo) string
+
+var fieldGetters = map[string]fieldGetter{
+	"$time_now":        func(i *GrpcAccessInfo) string { return time.Now().Format(accessTimeFormat) },
+	"$time_start":      func(i *GrpcAccessInfo) string { return i.start.Format(accessTimeFormat) },
+	"$time_end":        func(i *GrpcAccessInfo) string { return i.start.Add(i.timeCost).Format(accessTimeFormat) },
+	"$time_cost":       func(i *GrpcAccessInfo) string { return fmt.Sprintf("%d ms", i.timeCost.Milliseconds()) },
+	"$method_name":     func(i *GrpcAccessInfo) string { return i.method },
+	"$method_status":   getMethodStatus,
+	"$user_name":       getUserName,
+	"$user_addr":       func(i *GrpcAccessInfo) string { return getAccessAddr(i.ctx) },
+	"$trace_id":        getTraceIDField,
+	"$response_size":   getResponseSizeField,
+	"$error_code":      getErrorCodeField,
+	"$error_msg":       getErrorMsg,
+	"$database_name":   func(i *GrpcAccessInfo) string { return extractField("$database_name", i) },
+	"$collection_name": func(i *GrpcAccessInfo) string { return extractField("$collection_name", i) },
+	"$partition_name":  func(i *GrpcAccessInfo) string { return extractField("$partition_name", i) },
+	"$expr":            func(i *GrpcAccessInfo) string { return extractField("$expr", i) },
+	"$output_fields":   func(i *GrpcAccessInfo) string { return extractField("$output_fields", i) },
+	"$sdk_version":     getSdkVersion,
+
+	// short aliases of the fields
+	"$method": func(i *GrpcAccessInfo) string { return i.method },
+	"$user":   getUserName,
+}
+
+func getMethodStatus(i *GrpcAccessInfo) string {
+	status := getGrpcStatus(i.err)
+	if errCode, ok := getErrCode(i.resp); status == "OK" && ok && errCode > 0 {
+		return "TaskFailed"
+	}
+	return status
+}
+
+func getUserName(i *GrpcAccessInfo) string {
+	if user := getAuditUser(i.ctx); user != "" {
+		return user
+	}
+	return unknownString
+}
+
+func getTraceIDField(i *GrpcAccessInfo) string {
+	if traceID, ok := getTraceID(i.ctx); ok {
+		return traceID
+	}
+	return unknownString
+}
+
+func getResponseSizeField(i *GrpcAccessInfo) string {
+	if size, ok := getResponseSize(i.resp); ok {
+		return fmt.Sprint(size)
+	}
+	return unknownString
+}
+
+func getErrorCodeField(i *GrpcAccessInfo) string {
+	if errCode, ok := getErrCode(i.resp); ok {
+		return fmt.Sprint(errCode)
+	}
+	return fmt.Sprint(-1)
+}
+
+func getErrorMsg(i *GrpcAccessInfo) string {
+	if i.err != nil {
+		return i.err.Error()
+	}
+	if status := getResponseStatus(i.resp); status.GetReason() != "" {
+		return status.GetReason()
+	}
+	return unknownString
+}
+
+// getSdkVersion returns the sdk of Connect request, or the user agent of grpc client for other requests.
+func getSdkVersion(i *GrpcAccessInfo) string {
+	if value := extractField("$sdk_version", i); value != unknownString {
+		return value
+	}
+	md, ok := metadata.FromIncomingContext(i.ctx)
+	if !ok {
+		return unknownString
+	}
+	if agent := md.Get("user-agent"); len(agent) > 0 {
+		return agent[0]
+	}
+	return unknownString
+}
+
+// FieldExtractor extracts the value of field from request, false if the request doesn't carry the field.
+type FieldExtractor func(req interface{}) (string, bool)
+
+var (
+	extractorMu sync.RWMutex
+	// extractors of request type for fields, the ones of nil type apply to all types
+	extractors = map[string]map[reflect.Type]FieldExtractor{}
+)
+
+// RegisterFieldExtractor registers the extractor of field for the request type of T.
+func RegisterFieldExtractor[T any](field string, extract func(req T) string) {
+	var zero T
+	registerExtractor(field, reflect.TypeOf(zero), func(req interface{}) (string, bool) {
+		return extract(req.(T)), true
+	})
+}
+
+func registerExtractor(field string, t reflect.Type, extractor FieldExtractor) {
+	extractorMu.Lock()
+	defer extractorMu.Unlock()
+	if _, ok := extractors[field]; !ok {
+		extractors[field] = make(map[reflect.Type]FieldExtractor)
+	}
+	extractors[field][t] = extractor
+}
+
+func extractField(field string, i *GrpcAccessInfo) string {
+	if i.req == nil {
+		return unknownString
+	}
+	extractorMu.RLock()
+	extractor, ok := extractors[field][reflect.TypeOf(i.req)]
+	if !ok {
+		extractor, ok = extractors[field][nil]
+	}
+	extractorMu.RUnlock()
+	if !ok {
+		return unknownString
+	}
+	if value, ok := extractor(i.req); ok && value != "" {
+		return value
+	}
+	return unknownString
+}
+
+func init() {
+	registerExtractor("$database_name", nil, func(req interface{}) (string, bool) {
+		r, ok := req.(interface{ GetDbName() string })
+		if !ok {
+			return "", false
+		}
+		return r.GetDbName(), true
+	})
+	registerExtractor("$collection_name", nil, func(req interface{}) (string, bool) {
+		r, ok := req.(interface{ GetCollectionName() string })
+		if !ok {
+			return "", false
+		}
+		return r.GetCollectionName(), true
+	})
+	registerExtractor("$partition_name", nil, func(req interface{}) (string, bool) {
+		switch r := req.(type) {
+		case interface{ GetPartitionName() string }:
+			return r.GetPartitionName(), true
+		case interface{ GetPartitionNames() []string }:
+			return strings.Join(r.GetPartitionNames(), ","), true
+		default:
+			return "", false
+		}
+	})
+
+	RegisterFieldExtractor("$expr", func(req *milvuspb.QueryRequest) string { return req.GetExpr() })
+	RegisterFieldExtractor("$expr", func(req *milvuspb.DeleteRequest) string { return req.GetExpr() })
+	RegisterFieldExtractor("$expr", func(req *milvuspb.SearchRequest) string { return req.GetDsl() })
+	RegisterFieldExtractor("$output_fields", func(req *milvuspb.QueryRequest) string {
+		return strings.Join(req.GetOutputFields(), ",")
+	})
+	RegisterFieldExtractor("$output_fields", func(req *milvuspb.SearchRequest) string {
+		return strings.Join(req.GetOutputFields(), ",")
+	})
+	RegisterFieldExtractor("$sdk_version", func(req *milvuspb.ConnectRequest) string {
+		info := req.GetClientInfo()
+		if info == nil {
+			return ""
+		}
+		return info.GetSdkType() + "-" + info.GetSdkVersion()
+	})
+}
+
+var fieldPattern = regexp.MustCompile(`\$[a-z_]+`)
+
+// Formatter renders the access log of request by a template, such as
+// "$time_now [$method] $user $trace_id $expr $time_cost",
+// in which the fields starting with $ are replaced by the values of request.
+type Formatter struct {
+	// literals are the text between fields, len(literals) == len(fields) + 1
+	literals []string
+	fields   []fieldGetter
+}
+
+func NewFormatter(template string) (*Formatter, error) {
+	f := &Formatter{}
+	last := 0
+	for _, loc := range fieldPattern.FindAllStringIndex(template, -1) {
+		name := template[loc[0]:loc[1]]
+		getter, ok := fieldGetters[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %s of access log format", name)
+		}
+		f.literals = append(f.literals, template[last:loc[0]])
+		f.fields = append(f.fields, getter)
+		last = loc[1]
+	}
+	f.literals = append(f.literals, template[last:])
+	return f, nil
+}
+
+func (f *Formatter) Format(i *GrpcAccessInfo) string {
+	var sb strings.Builder
+	for idx, getter := range f.fields {
+		sb.WriteString(f.literals[idx])
+		sb.WriteString(getter(i))
+	}
+	sb.WriteString(f.literals[len(f.literals)-1])
+	return sb.String()
+}
+
+// Formatters selects the formatter of method, the methods not listed by any formatter use the base one.
+type Formatters struct {
+	base    *Formatter
+	methods map[string]*Formatter
+}
+
+// NewFormatters parses the formatters of config group proxy.accessLog.formatters,
+// of which keys are <name>.format and <name>.methods.
+func NewFormatters(configs map[string]string) (*Formatters, error) {
+	fs := &Formatters{methods: make(map[string]*Formatter)}
+	names := typeutil.NewSet[string]()
+	for key := range configs {
+		if name, ok := strings.CutSuffix(key, "."+formatKey); ok {
+			names.Insert(name)
+		}
+	}
+	for _, name := range names.Collect() {
+		f, err := NewFormatter(configs[name+"."+formatKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid access log formatter %s: %w", name, err)
+		}
+		if name == baseFormatterName {
+			fs.base = f
+			continue
+		}
+		for _, method := range splitList(configs[name+"."+methodsKey]).Collect() {
+			if _, ok := fs.methods[method]; ok {
+				return nil, fmt.Errorf("method %s is selected by multiple access log formatters", method)
+			}
+			fs.methods[method] = f
+		}
+	}
+	return fs, nil
+}
+
+// Get returns the formatter of method, false if no formatter is configured for it.
+func (fs *Formatters) Get(method string) (*Formatter, bool) {
+	if f, ok := fs.methods[method]; ok {
+		return f, true
+	}
+	return fs.base, fs.base != nil
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestFormatter(t *testing.T) {
+	ctx := auditContext("alice")
+	ctx = metadata.AppendToOutgoingContext(ctx, clientRequestIDKey, "trace1")
+	req := &milvuspb.QueryRequest{
+		DbName:         "db1",
+		CollectionName: "coll",
+		PartitionNames: []string{"p1", "p2"},
+		Expr:           "age > 10",
+		OutputFields:   []string{"age", "name"},
+	}
+	resp := &milvuspb.QueryResults{Status: merr.Success()}
+	info := NewGrpcAccessInfo(ctx, "Query", req, resp, nil, time.Now(), 12*time.Millisecond)
+
+	f, err := NewFormatter("[$method_name] $user_name $trace_id $database_name.$collection_name [$partition_name] $expr $output_fields $time_cost $method_status")
+	assert.NoError(t, err)
+	assert.Equal(t, "[Query] alice trace1 db1.coll [p1,p2] age > 10 age,name 12 ms OK", f.Format(info))
+
+	// aliases of method and user name
+	f, err = NewFormatter("[$method] $user $user_addr $trace_id $expr $time_cost")
+	assert.NoError(t, err)
+	assert.Equal(t, "[Query] alice ip-127.0.0.1 trace1 age > 10 12 ms", f.Format(info))
+
+	// fields not carried by request
+	info = NewGrpcAccessInfo(ctx, "Insert", &milvuspb.InsertRequest{CollectionName: "coll"},
+		&milvuspb.MutationResult{Status: merr.Status(merr.WrapErrParameterInvalidMsg("invalid"))}, nil, time.Now(), 0)
+	f, err = NewFormatter("$collection_name $expr $output_fields $method_status $error_code $error_msg")
+	assert.NoError(t, err)
+	assert.Equal(t, "coll Unknown Unknown TaskFailed 5 invalid: invalid parameter", f.Format(info))
+
+	info = NewGrpcAccessInfo(context.Background(), "Search", &milvuspb.SearchRequest{Dsl: "id in [1]"}, nil, errors.New("mock error"), time.Now(), 0)
+	f, err = NewFormatter("$user_name $expr $error_msg")
+	assert.NoError(t, err)
+	assert.Equal(t, "Unknown id in [1] mock error", f.Format(info))
+
+	// sdk version
+	f, err = NewFormatter("$sdk_version")
+	assert.NoError(t, err)
+	info = NewGrpcAccessInfo(ctx, "Connect", &milvuspb.ConnectRequest{
+		ClientInfo: &commonpb.ClientInfo{SdkType: "Python", SdkVersion: "2.3.5"},
+	}, nil, nil, time.Now(), 0)
+	assert.Equal(t, "Python-2.3.5", f.Format(info))
+	agentCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-agent", "grpc-go/1.0"))
+	info = NewGrpcAccessInfo(agentCtx, "Query", req, nil, nil, time.Now(), 0)
+	assert.Equal(t, "grpc-go/1.0", f.Format(info))
+
+	// custom extractor
+	RegisterFieldExtractor("$expr", func(req *milvuspb.InsertRequest) string { return "insert" })
+	f, err = NewFormatter("$expr")
+	assert.NoError(t, err)
+	info = NewGrpcAccessInfo(ctx, "Insert", &milvuspb.InsertRequest{}, nil, nil, time.Now(), 0)
+	assert.Equal(t, "insert", f.Format(info))
+
+	_, err = NewFormatter("$method_name $unknown_field")
+	assert.Error(t, err)
+}
+
+func TestFormatters(t *testing.T) {
+	fs, err := NewFormatters(map[string]string{
+		"base.format":   "base $method_name",
+		"query.format":  "query $method_name",
+		"query.methods": "Query, Search",
+	})
+	assert.NoError(t, err)
+	info := NewGrpcAccessInfo(context.Background(), "Search", nil, nil, nil, time.Now(), 0)
+	f, ok := fs.Get("Search")
+	assert.True(t, ok)
+	assert.Equal(t, "query Search", f.Format(info))
+	f, ok = fs.Get("Insert")
+	assert.True(t, ok)
+	assert.Equal(t, "base Search", f.Format(info))
+
+	// no base formatter
+	fs, err = NewFormatters(map[string]string{
+		"query.format":  "query $method_name",
+		"query.methods": "Query",
+	})
+	assert.NoError(t, err)
+	_, ok = fs.Get("Insert")
+	assert.False(t, ok)
+
+	_, err = NewFormatters(map[string]string{"base.format": "$unknown"})
+	assert.Error(t, err)
+
+	_, err = NewFormatters(map[string]string{
+		"a.format":  "a",
+		"a.methods": "Query",
+		"b.format":  "b",
+		"b.methods": "Query",
+	})
+	assert.Error(t, err)
+}
+
+func TestAccessLogger_Formatters(t *testing.T) {
+	var Params paramtable.ComponentParam
+
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	testPath := t.TempDir()
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.LocalPath.Key, testPath)
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "formatted_access.log")
+	formatters := map[string]string{
+		"base.format":   "base $method_name $user_name",
+		"query.format":  "query $method_name $expr",
+		"query.methods": "Query",
+	}
+	Params.ProxyCfg.AccessLog.Formatters.GetFunc = func() map[string]string {
+		return formatters
+	}
+
+	lg, err := InitAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	defer func() {
+		lg.Close()
+		_globalF.Store(nil)
+	}()
+	// the file writer is cached, read the formatted logs from buffer
+	buf := &bytes.Buffer{}
+	writer := zapcore.AddSync(buf)
+	_globalS.Store(&writer)
+
+	ctx := auditContext("alice")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.QueryResults{Status: merr.Success()}, nil
+	}
+	_, err = UnaryAccessLoggerInterceptor(ctx, &milvuspb.QueryRequest{Expr: "age > 10"},
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Query"}, handler)
+	assert.NoError(t, err)
+	_, err = UnaryAccessLoggerInterceptor(ctx, &milvuspb.DescribeCollectionRequest{},
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DescribeCollection"}, handler)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "query Query age > 10\nbase DescribeCollection alice\n", buf.String())
+
+	// the fields of request are rendered by PrintAccessInfo too
+	buf.Reset()
+	assert.True(t, PrintAccessInfo(ctx, &milvuspb.QueryRequest{Expr: "age > 20"}, &milvuspb.QueryResults{Status: merr.Success()}, nil,
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Query"}, 0))
+	assert.Equal(t, "query Query age > 20\n", buf.String())
+
+	// invalid format
+	formatters["query.format"] = "$unknown"
+	_, err = InitAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+	assert.Error(t, err)
+}
@@ -86,14 +86,24 @@ type RotateLogger struct {
 	closeOnce sync.Once
 }
 
-func NewRotateLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (*RotateLogger, error) {
-	logger := &RotateLogger{
-		localPath:   logCfg.LocalPath.GetValue(),
-		fileName:    logCfg.Filename.GetValue(),
-		rotatedTime: logCfg.RotatedTime.GetAsInt64(),
-		maxSize:     logCfg.MaxSize.GetAsInt(),
-		maxBackups:  logCfg.MaxBackups.GetAsInt(),
+func newRotateLogger(localPath, fileName string, rotatedTime int64, maxSize, maxBackups int) *RotateLogger {
+	return &RotateLogger{
+		localPath:   localPath,
+		fileName:    fileName,
+		rotatedTime: rotatedTime,
+		maxSize:     maxSize,
+		maxBackups:  maxBackups,
 	}
+}
+
+func NewRotateLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (*RotateLogger, error) {
+	logger := newRotateLogger(
+		logCfg.LocalPath.GetValue(),
+		logCfg.Filename.GetValue(),
+		logCfg.RotatedTime.GetAsInt64(),
+		logCfg.MaxSize.GetAsInt(),
+		logCfg.MaxBackups.GetAsInt(),
+	)
 	log.Info("Access log save to " + logger.dir())
 	if logCfg.MinioEnable.GetAsBool() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
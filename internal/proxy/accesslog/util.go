@@ -19,6 +19,7 @@ package accesslog
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
@@ -41,7 +42,8 @@ type BaseResponse interface {
 func UnaryAccessLoggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	starttime := time.Now()
 	resp, err := handler(ctx, req)
-	PrintAccessInfo(ctx, resp, err, info, time.Since(starttime).Milliseconds())
+	_, methodName := path.Split(info.FullMethod)
+	PrintFormattedAccessInfo(NewGrpcAccessInfo(ctx, methodName, req, resp, err, starttime, time.Since(starttime)))
 	return resp, err
 }
 
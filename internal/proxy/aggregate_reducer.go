@@ -0,0 +1,32 @@
+package proxy
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// aggReducer merges the partial aggregates of shards, and returns the final aggregates of groups.
+type aggReducer struct {
+	params         *queryParams
+	schema         *schemapb.CollectionSchema
+	plan           *planpb.PlanNode
+	collectionName string
+}
+
+func (r *aggReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	aggregator := reduce.NewAggregator(r.plan.GetQuery())
+	for _, res := range results {
+		if err := aggregator.Merge(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		FieldsData:     aggregator.Result(r.schema, r.params.offset, r.params.limit),
+		CollectionName: r.collectionName,
+	}, nil
+}
@@ -60,14 +60,39 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 		if !validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) {
 			authStrArr := md[strings.ToLower(util.HeaderAuthorize)]
 
+			if len(authStrArr) < 1 && Params.ProxyCfg.MTLSEnabled.GetAsBool() {
+				// the user of service without authorization header is mapped from its client certificate
+				user, err := getCertUser(ctx)
+				if err != nil {
+					log.Warn("fail to map client certificate to user", zap.Error(err))
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, client certificate can't be mapped to user")
+				}
+				if user != "" {
+					metrics.UserRPCCounter.WithLabelValues(user).Inc()
+					userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+					md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+					return metadata.NewIncomingContext(ctx, md), nil
+				}
+			}
+
 			if len(authStrArr) < 1 {
 				log.Warn("key not found in header")
 				return nil, status.Error(codes.Unauthenticated, "missing authorization in header")
 			}
 
-			// token format: base64<username:password>
-			// token := strings.TrimPrefix(authorization[0], "Bearer ")
+			// token format: base64<username:password>, or Bearer <JWT> if jwt is enabled
 			token := authStrArr[0]
+			if Params.ProxyCfg.JWTEnabled.GetAsBool() && strings.HasPrefix(token, bearerPrefix) {
+				user, roles, err := globalJWTAuthenticator.Verify(ctx, strings.TrimPrefix(token, bearerPrefix))
+				if err != nil {
+					log.Warn("fail to verify jwt", zap.Error(err))
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check the bearer token is valid")
+				}
+				metrics.UserRPCCounter.WithLabelValues(user).Inc()
+				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				return WithJWTRoles(metadata.NewIncomingContext(ctx, md), roles), nil
+			}
 			rawToken, err := crypto.Base64Decode(token)
 			if err != nil {
 				log.Warn("fail to decode the token", zap.Error(err))
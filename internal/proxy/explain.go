@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	explainSearchPlan = "search"
+	explainQueryPlan  = "query"
+)
+
+// createExplainPlan creates the plan of request and returns the response without segments.
+func createExplainPlan(schema *schemapb.CollectionSchema, request *types.ExplainRequest) (*types.ExplainResponse, []*planparserv2.Predicate, error) {
+	resp := &types.ExplainResponse{Status: merr.Success(), Selectivity: 1}
+	var predicates *planpb.Expr
+	if request.AnnsField != "" {
+		plan, err := planparserv2.CreateSearchPlan(schema, request.Expr, request.AnnsField, &planpb.QueryInfo{})
+		if err != nil {
+			return nil, nil, merr.WrapErrParameterInvalidMsg("failed to create search plan: %v", err)
+		}
+		resp.PlanType = explainSearchPlan
+		resp.VectorField = request.AnnsField
+		predicates = plan.GetVectorAnns().GetPredicates()
+	} else {
+		plan, err := planparserv2.CreateRetrievePlan(schema, request.Expr)
+		if err != nil {
+			return nil, nil, merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+		}
+		resp.PlanType = explainQueryPlan
+		predicates = plan.GetQuery().GetPredicates()
+	}
+	if predicates == nil {
+		return resp, nil, nil
+	}
+
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Plan = planparserv2.ShowExprTree(predicates)
+	resp.Selectivity = planparserv2.EstimateSelectivity(predicates)
+	leaves := planparserv2.ExtractPredicates(predicates)
+	for _, leaf := range leaves {
+		predicate := &types.ExplainPredicate{
+			Expr:        planparserv2.ShowExprTree(leaf.Expr),
+			Fields:      make([]string, 0, len(leaf.Columns)),
+			Selectivity: leaf.Selectivity,
+			Segments:    []*types.ExplainSegment{},
+		}
+		for _, column := range leaf.Columns {
+			field, err := helper.GetFieldFromID(column.GetFieldId())
+			if err != nil {
+				return nil, nil, err
+			}
+			name := field.GetName()
+			if len(column.GetNestedPath()) > 0 {
+				name += "[" + strings.Join(column.GetNestedPath(), "][") + "]"
+			}
+			predicate.Fields = append(predicate.Fields, name)
+		}
+		resp.Predicates = append(resp.Predicates, predicate)
+	}
+	return resp, leaves, nil
+}
+
+// indexedField returns the field whose scalar index could be used by the predicate,
+// predicates on json paths, arithmetic and comparisons between fields scan raw data.
+func indexedField(predicate *planparserv2.Predicate) (int64, bool) {
+	if len(predicate.Columns) != 1 || len(predicate.Columns[0].GetNestedPath()) > 0 {
+		return 0, false
+	}
+	switch predicate.Expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr, *planpb.Expr_UnaryRangeExpr, *planpb.Expr_BinaryRangeExpr:
+		return predicate.Columns[0].GetFieldId(), true
+	default:
+		return 0, false
+	}
+}
+
+// fillExplainSegments fills the loaded segments of the partitions and the index used by every predicate,
+// all the loaded segments are explained if partitionIDs is empty.
+func fillExplainSegments(resp *types.ExplainResponse, predicates []*planparserv2.Predicate, segments []*querypb.SegmentInfo,
+	partitionIDs []int64, indexInfos map[int64]*indexpb.SegmentInfo,
+) {
+	partitions := typeutil.NewSet(partitionIDs...)
+	for _, segment := range segments {
+		if len(partitionIDs) > 0 && !partitions.Contain(segment.GetPartitionID()) {
+			continue
+		}
+		resp.NumRows += segment.GetNumRows()
+		for i, predicate := range predicates {
+			explained := &types.ExplainSegment{
+				SegmentID:   segment.GetSegmentID(),
+				PartitionID: segment.GetPartitionID(),
+				NumRows:     segment.GetNumRows(),
+			}
+			if fieldID, ok := indexedField(predicate); ok {
+				for _, index := range indexInfos[segment.GetSegmentID()].GetIndexInfos() {
+					if index.GetFieldID() == fieldID {
+						explained.IndexName = index.GetIndexName()
+						break
+					}
+				}
+			}
+			resp.Predicates[i].Segments = append(resp.Predicates[i].Segments, explained)
+		}
+	}
+	resp.EstimatedRows = int64(float64(resp.NumRows) * resp.Selectivity)
+}
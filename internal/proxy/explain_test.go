@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func genExplainSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test_explain",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}}},
+		},
+	}
+}
+
+func TestCreateExplainPlan(t *testing.T) {
+	schema := genExplainSchema()
+
+	resp, predicates, err := createExplainPlan(schema, &types.ExplainRequest{Expr: `age > 10 && meta["city"] == "sh"`})
+	require.NoError(t, err)
+	assert.Equal(t, explainQueryPlan, resp.PlanType)
+	assert.NotNil(t, resp.Plan)
+	require.Equal(t, 2, len(predicates))
+	require.Equal(t, 2, len(resp.Predicates))
+	assert.Equal(t, []string{"age"}, resp.Predicates[0].Fields)
+	assert.Equal(t, []string{"meta[city]"}, resp.Predicates[1].Fields)
+	assert.InDelta(t, resp.Predicates[0].Selectivity*resp.Predicates[1].Selectivity, resp.Selectivity, 1e-9)
+
+	resp, predicates, err = createExplainPlan(schema, &types.ExplainRequest{Expr: `pk in [1, 2]`, AnnsField: "vec"})
+	require.NoError(t, err)
+	assert.Equal(t, explainSearchPlan, resp.PlanType)
+	assert.Equal(t, "vec", resp.VectorField)
+	assert.Equal(t, 1, len(predicates))
+
+	resp, predicates, err = createExplainPlan(schema, &types.ExplainRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, predicates)
+	assert.Equal(t, float64(1), resp.Selectivity)
+
+	_, _, err = createExplainPlan(schema, &types.ExplainRequest{Expr: `unknown > 1`})
+	assert.Error(t, err)
+	_, _, err = createExplainPlan(schema, &types.ExplainRequest{Expr: `age > 1`, AnnsField: "age"})
+	assert.Error(t, err)
+}
+
+func TestFillExplainSegments(t *testing.T) {
+	schema := genExplainSchema()
+	resp, predicates, err := createExplainPlan(schema, &types.ExplainRequest{Expr: `age > 10 && meta["city"] == "sh"`})
+	require.NoError(t, err)
+
+	segments := []*querypb.SegmentInfo{
+		{SegmentID: 1, PartitionID: 10, NumRows: 1000},
+		{SegmentID: 2, PartitionID: 10, NumRows: 500},
+		{SegmentID: 3, PartitionID: 20, NumRows: 300},
+	}
+	indexInfos := map[int64]*indexpb.SegmentInfo{
+		1: {SegmentID: 1, IndexInfos: []*indexpb.IndexFilePathInfo{
+			{FieldID: 103, IndexName: "vec_index"},
+			{FieldID: 101, IndexName: "age_index"},
+		}},
+		2: {SegmentID: 2, IndexInfos: []*indexpb.IndexFilePathInfo{{FieldID: 102, IndexName: "meta_index"}}},
+	}
+	fillExplainSegments(resp, predicates, segments, []int64{10}, indexInfos)
+
+	assert.Equal(t, int64(1500), resp.NumRows)
+	assert.Equal(t, int64(float64(1500)*resp.Selectivity), resp.EstimatedRows)
+	age := resp.Predicates[0].Segments
+	require.Equal(t, 2, len(age))
+	assert.Equal(t, "age_index", age[0].IndexName)
+	assert.Equal(t, "", age[1].IndexName)
+	// json paths are scanned
+	for _, segment := range resp.Predicates[1].Segments {
+		assert.Equal(t, "", segment.IndexName)
+	}
+}
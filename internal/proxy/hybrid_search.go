@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// hybridRankInfo is parsed from the rank params of hybrid search.
+type hybridRankInfo struct {
+	limit        int64
+	offset       int64
+	roundDecimal int64
+}
+
+// parseHybridRankInfo returns limit, offset and round decimal of the fused results.
+func parseHybridRankInfo(rankParams []*commonpb.KeyValuePair) (*hybridRankInfo, error) {
+	info := &hybridRankInfo{roundDecimal: -1}
+	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, rankParams)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("%s not found in rank params", LimitKey)
+	}
+	info.limit, err = strconv.ParseInt(limitStr, 0, 64)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalid("integer", limitStr, "invalid limit")
+	}
+	if err := validateTopKLimit(info.limit); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("%s [%d] is invalid, %s", LimitKey, info.limit, err.Error())
+	}
+	if offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, rankParams); err == nil {
+		info.offset, err = strconv.ParseInt(offsetStr, 0, 64)
+		if err != nil || info.offset < 0 {
+			return nil, merr.WrapErrParameterInvalid("non-negative integer", offsetStr, "invalid offset")
+		}
+		if err := validateTopKLimit(info.limit + info.offset); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("%s+%s [%d] is invalid, %s", OffsetKey, LimitKey, info.limit+info.offset, err.Error())
+		}
+	}
+	if roundDecimalStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RoundDecimalKey, rankParams); err == nil {
+		info.roundDecimal, err = strconv.ParseInt(roundDecimalStr, 0, 64)
+		if err != nil || (info.roundDecimal != -1 && (info.roundDecimal > 6 || info.roundDecimal < 0)) {
+			return nil, merr.WrapErrParameterInvalid("-1 or an integer in range [0, 6]", roundDecimalStr, "invalid round_decimal")
+		}
+	}
+	return info, nil
+}
+
+// hybridSubSearchRequests returns the search requests of every vector field, the collection, partitions,
+// output fields and consistency of hybrid search are applied to them. The topk of a sub search is
+// limit + offset of the fused results if it's not given. It also returns the nq and metric types.
+func hybridSubSearchRequests(request *milvuspb.HybridSearchRequest, info *hybridRankInfo) ([]*milvuspb.SearchRequest, int64, []string, error) {
+	if len(request.GetRequests()) == 0 {
+		return nil, 0, nil, merr.WrapErrParameterInvalidMsg("hybrid search requires at least one search request")
+	}
+	if request.GetFunctionScore() != nil {
+		return nil, 0, nil, merr.WrapErrParameterInvalidMsg("function score of hybrid search is not supported, use rank params instead")
+	}
+	var nq int64
+	requests := make([]*milvuspb.SearchRequest, len(request.GetRequests()))
+	metricTypes := make([]string, len(request.GetRequests()))
+	for i, subRequest := range request.GetRequests() {
+		subNq, err := getNq(subRequest)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		if i == 0 {
+			nq = subNq
+		} else if subNq != nq {
+			return nil, 0, nil, merr.WrapErrParameterInvalid(nq, subNq, "nq of hybrid search requests mismatched")
+		}
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, subRequest.GetSearchParams()); err != nil {
+			return nil, 0, nil, merr.WrapErrParameterInvalidMsg("%s not found in search request %d", AnnsFieldKey, i)
+		}
+
+		sub := proto.Clone(subRequest).(*milvuspb.SearchRequest)
+		sub.DbName = request.GetDbName()
+		sub.CollectionName = request.GetCollectionName()
+		if len(sub.GetPartitionNames()) == 0 {
+			sub.PartitionNames = request.GetPartitionNames()
+		}
+		sub.OutputFields = request.GetOutputFields()
+		sub.TravelTimestamp = request.GetTravelTimestamp()
+		sub.GuaranteeTimestamp = request.GetGuaranteeTimestamp()
+		sub.NotReturnAllMeta = request.GetNotReturnAllMeta()
+		sub.ConsistencyLevel = request.GetConsistencyLevel()
+		sub.UseDefaultConsistency = request.GetUseDefaultConsistency()
+		sub.Nq = nq
+		// offset is applied to the fused results, and the distances are fused without rounding
+		sub.SearchParams = lo.Filter(sub.GetSearchParams(), func(kv *commonpb.KeyValuePair, _ int) bool {
+			return kv.GetKey() != OffsetKey && kv.GetKey() != RoundDecimalKey
+		})
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, sub.GetSearchParams()); err != nil {
+			sub.SearchParams = append(sub.SearchParams, &commonpb.KeyValuePair{
+				Key:   TopKKey,
+				Value: strconv.FormatInt(info.limit+info.offset, 10),
+			})
+		}
+		metricTypes[i], _ = funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, sub.GetSearchParams())
+		requests[i] = sub
+	}
+	return requests, nq, metricTypes, nil
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestParseHybridRankInfo(t *testing.T) {
+	paramtable.Init()
+
+	info, err := parseHybridRankInfo([]*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+		{Key: RoundDecimalKey, Value: "2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &hybridRankInfo{limit: 10, offset: 5, roundDecimal: 2}, info)
+
+	info, err = parseHybridRankInfo([]*commonpb.KeyValuePair{{Key: LimitKey, Value: "10"}})
+	require.NoError(t, err)
+	assert.Equal(t, &hybridRankInfo{limit: 10, roundDecimal: -1}, info)
+
+	invalidParams := [][]*commonpb.KeyValuePair{
+		nil,
+		{{Key: LimitKey, Value: "a"}},
+		{{Key: LimitKey, Value: "0"}},
+		{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "-1"}},
+		{{Key: LimitKey, Value: "10"}, {Key: OffsetKey, Value: "100000"}},
+		{{Key: LimitKey, Value: "10"}, {Key: RoundDecimalKey, Value: "7"}},
+	}
+	for _, params := range invalidParams {
+		_, err := parseHybridRankInfo(params)
+		assert.Error(t, err)
+	}
+}
+
+func Test_hybridSubSearchRequests(t *testing.T) {
+	genSubRequest := func(annsField string, nq int64, params ...*commonpb.KeyValuePair) *milvuspb.SearchRequest {
+		return &milvuspb.SearchRequest{
+			Dsl:          "age > 10",
+			DslType:      commonpb.DslType_BoolExprV1,
+			Nq:           nq,
+			SearchParams: append([]*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: annsField}}, params...),
+		}
+	}
+	request := &milvuspb.HybridSearchRequest{
+		DbName:         "db",
+		CollectionName: "test_hybrid",
+		PartitionNames: []string{"p1"},
+		OutputFields:   []string{"age"},
+		Requests: []*milvuspb.SearchRequest{
+			genSubRequest("vec1", 2, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: metric.L2}, &commonpb.KeyValuePair{Key: OffsetKey, Value: "3"}),
+			genSubRequest("vec2", 2, &commonpb.KeyValuePair{Key: TopKKey, Value: "20"}),
+		},
+	}
+	info := &hybridRankInfo{limit: 10, offset: 5, roundDecimal: -1}
+
+	requests, nq, metricTypes, err := hybridSubSearchRequests(request, info)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), nq)
+	assert.Equal(t, []string{metric.L2, ""}, metricTypes)
+	require.Equal(t, 2, len(requests))
+	for _, sub := range requests {
+		assert.Equal(t, "db", sub.GetDbName())
+		assert.Equal(t, "test_hybrid", sub.GetCollectionName())
+		assert.Equal(t, []string{"p1"}, sub.GetPartitionNames())
+		assert.Equal(t, []string{"age"}, sub.GetOutputFields())
+		_, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, sub.GetSearchParams())
+		assert.Error(t, err)
+	}
+	topK, _ := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, requests[0].GetSearchParams())
+	assert.Equal(t, "15", topK)
+	topK, _ = funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, requests[1].GetSearchParams())
+	assert.Equal(t, "20", topK)
+	// the requests of user are not changed
+	assert.Equal(t, 3, len(request.Requests[0].GetSearchParams()))
+	assert.Empty(t, request.Requests[0].GetCollectionName())
+
+	t.Run("no request", func(t *testing.T) {
+		_, _, _, err := hybridSubSearchRequests(&milvuspb.HybridSearchRequest{}, info)
+		assert.Error(t, err)
+	})
+
+	t.Run("nq mismatched", func(t *testing.T) {
+		request := &milvuspb.HybridSearchRequest{Requests: []*milvuspb.SearchRequest{genSubRequest("vec1", 1), genSubRequest("vec2", 2)}}
+		_, _, _, err := hybridSubSearchRequests(request, info)
+		assert.Error(t, err)
+	})
+
+	t.Run("no anns field", func(t *testing.T) {
+		request := &milvuspb.HybridSearchRequest{Requests: []*milvuspb.SearchRequest{{Nq: 1}}}
+		_, _, _, err := hybridSubSearchRequests(request, info)
+		assert.Error(t, err)
+	})
+
+	t.Run("function score", func(t *testing.T) {
+		request := &milvuspb.HybridSearchRequest{
+			Requests:      []*milvuspb.SearchRequest{genSubRequest("vec1", 1)},
+			FunctionScore: &schemapb.FunctionScore{},
+		}
+		_, _, _, err := hybridSubSearchRequests(request, info)
+		assert.Error(t, err)
+	})
+}
@@ -31,16 +31,22 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -319,6 +325,17 @@ func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListData
 	return dct.result, nil
 }
 
+// AlterDatabase alters the properties of database, which aren't supported by root coord yet.
+func (node *Proxy) AlterDatabase(ctx context.Context, request *milvuspb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("AlterDatabase unimplemented")), nil
+}
+
+func (node *Proxy) DescribeDatabase(ctx context.Context, request *milvuspb.DescribeDatabaseRequest) (*milvuspb.DescribeDatabaseResponse, error) {
+	return &milvuspb.DescribeDatabaseResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("DescribeDatabase unimplemented")),
+	}, nil
+}
+
 // CreateCollection create a collection by the schema.
 // TODO(dragondriver): add more detailed ut for ConsistencyLevel, should we support multiple consistency level in Proxy?
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
@@ -1059,6 +1076,138 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 	return act.result, nil
 }
 
+// AddCollectionField adds a defaulted field to an existing collection, the schema of request is the serialized field schema.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *milvuspb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionField")
+	defer sp.End()
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	t := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(t); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", t.BeginTs()),
+			zap.Uint64("EndTs", t.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return t.result, nil
+}
+
+// AlterCollectionField alters the properties of field, which aren't supported by root coord yet.
+func (node *Proxy) AlterCollectionField(ctx context.Context, request *milvuspb.AlterCollectionFieldRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("AlterCollectionField unimplemented")), nil
+}
+
+// DropCollectionField drops a scalar field from an existing collection.
+func (node *Proxy) DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropCollectionField")
+	defer sp.End()
+	method := "DropCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	t := &dropCollectionFieldTask{
+		ctx:                        ctx,
+		Condition:                  NewTaskCondition(ctx),
+		DropCollectionFieldRequest: request,
+		rootCoord:                  node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetFieldName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(t); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", t.BeginTs()),
+			zap.Uint64("EndTs", t.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return t.result, nil
+}
+
 // CreatePartition create a partition in specific collection.
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -2033,6 +2182,11 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	return dit.result, nil
 }
 
+// AlterIndex alters the params of index, which isn't supported by index coord yet.
+func (node *Proxy) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("AlterIndex unimplemented")), nil
+}
+
 // GetIndexBuildProgress gets index build progress with field_name and index_name.
 // IndexRows is the num of indexed rows. And TotalRows is the total number of segment rows.
 // Deprecated: use DescribeIndex instead
@@ -2404,6 +2558,15 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		commonpbutil.WithSourceID(paramtable.GetNodeID()),
 	)
 
+	if err := node.checkUpsertRowPolicies(ctx, request); err != nil {
+		log.Info("Failed to check row policies of upsert request", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+
 	it := &upsertTask{
 		baseMsg: msgstream.BaseMsg{
 			HashValues: request.HashKeys,
@@ -2490,6 +2653,17 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	if isIteratorRequest(request.GetSearchParams()) {
+		result, token, err := node.SearchIterator(ctx, request)
+		if err != nil {
+			return &milvuspb.SearchResults{Status: merr.Status(err)}, nil
+		}
+		// the proto of search results has no field for it, so the token is returned by grpc header
+		if err := grpc.SetHeader(ctx, metadata.Pairs(SearchIteratorTokenKey, token)); err != nil {
+			log.Ctx(ctx).Debug("failed to set search iterator token header", zap.Error(err))
+		}
+		return result, nil
+	}
 	receiveSize := proto.Size(request)
 	metrics.ProxyReceiveBytes.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
@@ -2650,6 +2824,295 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return qt.result, nil
 }
 
+// SearchIterator reads one page of the search iterator, the nearest neighbours are returned
+// page by page without the limit of topk and offset. A new iterator is opened if the request
+// has "iterator" in search params, and it's resumed if "iterator_token" is given with the same
+// collection name. The topk in search params is the batch size, and radius is the outer bound
+// if it's given. It returns the token of next page, or an empty token if there are no more entities.
+// An iterator is resumed only by the user who opens it.
+func (node *Proxy) SearchIterator(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error) {
+	if node.searchCursorMgr == nil {
+		return nil, "", merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "search iterator not initialized")
+	}
+	token, _ := funcutil.GetAttrByKeyFromRepeatedKV(SearchIteratorTokenKey, request.GetSearchParams())
+	username, _ := GetCurUserFromContext(ctx)
+	var cursor *searchCursor
+	if token != "" {
+		var err error
+		cursor, err = node.searchCursorMgr.take(token, username)
+		if err != nil {
+			return nil, "", err
+		}
+		// collection name is still required so the privilege of the collection is checked for every page
+		if request.GetCollectionName() != cursor.request.GetCollectionName() ||
+			(request.GetDbName() != "" && request.GetDbName() != cursor.request.GetDbName()) {
+			node.searchCursorMgr.put(token, username, cursor)
+			return nil, "", merr.WrapErrParameterInvalid(cursor.request.GetCollectionName(), request.GetCollectionName(), "collection of search iterator mismatched")
+		}
+	} else {
+		schema, err := GetCachedCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+		if err != nil {
+			return nil, "", err
+		}
+		pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+		if err != nil {
+			return nil, "", err
+		}
+		cursor, err = newSearchCursor(request, pkField)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	batchSize := cursor.batchSize
+	if topK, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, request.GetSearchParams()); err == nil && token != "" {
+		batchSize, err = strconv.ParseInt(topK, 0, 64)
+		if err != nil {
+			node.searchCursorMgr.put(token, username, cursor)
+			return nil, "", merr.WrapErrParameterInvalid("integer", topK, "invalid topk")
+		}
+	}
+	pageRequest, err := cursor.pageRequest(batchSize)
+	if err == nil {
+		var result *milvuspb.SearchResults
+		result, err = node.Search(ctx, pageRequest)
+		if err == nil {
+			err = merr.Error(result.GetStatus())
+		}
+		if err == nil {
+			if !cursor.advance(result.GetResults(), batchSize) {
+				return result, "", nil
+			}
+			token, err = node.searchCursorMgr.put(token, username, cursor)
+			if err != nil {
+				return nil, "", err
+			}
+			return result, token, nil
+		}
+	}
+	if token != "" {
+		// the cursor isn't moved, so the failed page could be retried with the same token
+		node.searchCursorMgr.put(token, username, cursor)
+	}
+	return nil, "", err
+}
+
+// HybridSearch searches several vector fields of a collection and fuses the results by the reranker
+// of rank params. Every sub search is reduced as a normal search before it's fused, so the row policies
+// of user are applied to each of them. The nq of all sub searches is counted by the rate limiter.
+func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSearchRequest) (*milvuspb.SearchResults, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &milvuspb.SearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	method := "HybridSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.TotalLabel,
+	).Inc()
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-HybridSearch")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Int("requests", len(request.GetRequests())))
+
+	fail := func(err error) (*milvuspb.SearchResults, error) {
+		log.Warn("hybrid search failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(
+			strconv.FormatInt(paramtable.GetNodeID(), 10),
+			method,
+			metrics.FailLabel,
+		).Inc()
+		return &milvuspb.SearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	info, err := parseHybridRankInfo(request.GetRankParams())
+	if err != nil {
+		return fail(err)
+	}
+	subRequests, nq, metricTypes, err := hybridSubSearchRequests(request, info)
+	if err != nil {
+		return fail(err)
+	}
+	rr, err := newReranker(request.GetRankParams(), metricTypes)
+	if err != nil {
+		return fail(err)
+	}
+
+	subResults := make([]*schemapb.SearchResultData, len(subRequests))
+	var outputFields []string
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	for i, subRequest := range subRequests {
+		i, subRequest := i, subRequest
+		g.Go(func() error {
+			result, err := node.Search(gctx, subRequest)
+			if err == nil {
+				err = merr.Error(result.GetStatus())
+			}
+			if err != nil {
+				return err
+			}
+			subResults[i] = result.GetResults()
+			mu.Lock()
+			defer mu.Unlock()
+			if len(outputFields) == 0 {
+				outputFields = result.GetResults().GetOutputFields()
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return fail(err)
+	}
+
+	result, err := reduceHybridSearchResultData(ctx, subResults, rr, nq, info.limit, info.offset, info.roundDecimal)
+	if err != nil {
+		return fail(err)
+	}
+	result.CollectionName = request.GetCollectionName()
+	result.Results.OutputFields = outputFields
+
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.SuccessLabel,
+	).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return result, nil
+}
+
+// Explain returns the plan of the filter expression, the index each predicate could use in every loaded
+// segment and the estimated selectivity, the query or search is never executed.
+func (node *Proxy) Explain(ctx context.Context, request *types.ExplainRequest) (*types.ExplainResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &types.ExplainResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	method := "Explain"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.TotalLabel,
+	).Inc()
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Explain")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("expr", request.Expr))
+
+	fail := func(err error) (*types.ExplainResponse, error) {
+		log.Warn("explain failed", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(
+			strconv.FormatInt(paramtable.GetNodeID(), 10),
+			method,
+			metrics.FailLabel,
+		).Inc()
+		return &types.ExplainResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return fail(err)
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return fail(err)
+	}
+	// the expression is explained as it's executed for the user, denied fields can't be probed by explaining either
+	privilege := commonpb.ObjectPrivilege_PrivilegeQuery
+	if request.AnnsField != "" {
+		privilege = commonpb.ObjectPrivilege_PrivilegeSearch
+	}
+	if err := checkFilterFieldPrivileges(ctx, request.DbName, schema, privilege, request.Expr); err != nil {
+		return fail(err)
+	}
+	expr, err := applyRowPolicies(ctx, schema, request.DbName, request.CollectionName, request.Expr)
+	if err != nil {
+		return fail(err)
+	}
+	request = &types.ExplainRequest{
+		DbName:         request.DbName,
+		CollectionName: request.CollectionName,
+		PartitionNames: request.PartitionNames,
+		Expr:           expr,
+		AnnsField:      request.AnnsField,
+	}
+	resp, predicates, err := createExplainPlan(schema, request)
+	if err != nil {
+		return fail(err)
+	}
+
+	partitionIDs := make([]int64, 0, len(request.PartitionNames))
+	for _, partitionName := range request.PartitionNames {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, request.DbName, request.CollectionName, partitionName)
+		if err != nil {
+			return fail(err)
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+
+	segmentResp, err := node.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SegmentInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err == nil {
+		err = merr.Error(segmentResp.GetStatus())
+	}
+	if err != nil {
+		return fail(err)
+	}
+	segmentIDs := lo.Map(segmentResp.GetInfos(), func(info *querypb.SegmentInfo, _ int) int64 {
+		return info.GetSegmentID()
+	})
+
+	var indexInfos map[int64]*indexpb.SegmentInfo
+	if len(segmentIDs) > 0 && len(predicates) > 0 {
+		indexResp, err := node.dataCoord.GetIndexInfos(ctx, &indexpb.GetIndexInfoRequest{
+			CollectionID: collectionID,
+			SegmentIDs:   segmentIDs,
+		})
+		if err == nil {
+			err = merr.Error(indexResp.GetStatus())
+		}
+		if err != nil {
+			return fail(err)
+		}
+		indexInfos = indexResp.GetSegmentInfo()
+	}
+	fillExplainSegments(resp, predicates, segmentResp.GetInfos(), partitionIDs, indexInfos)
+
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.SuccessLabel,
+	).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
 func (node *Proxy) getVectorPlaceholderGroupForSearchByPks(ctx context.Context, request *milvuspb.SearchRequest) ([]byte, error) {
 	placeholderGroup := &commonpb.PlaceholderGroup{}
 	err := proto.Unmarshal(request.PlaceholderGroup, placeholderGroup)
@@ -2906,6 +3369,17 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 
 // Query get the records by primary keys.
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	if isIteratorRequest(request.GetQueryParams()) {
+		result, token, err := node.QueryIterator(ctx, request)
+		if err != nil {
+			return &milvuspb.QueryResults{Status: merr.Status(err)}, nil
+		}
+		// the proto of query results has no field for it, so the token is returned by grpc header
+		if err := grpc.SetHeader(ctx, metadata.Pairs(QueryIteratorTokenKey, token)); err != nil {
+			log.Ctx(ctx).Debug("failed to set query iterator token header", zap.Error(err))
+		}
+		return result, nil
+	}
 	qt := &queryTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -2923,6 +3397,140 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	return node.query(ctx, qt)
 }
 
+// isIteratorRequest returns whether the query or search params open or resume an iterator.
+func isIteratorRequest(params []*commonpb.KeyValuePair) bool {
+	for _, kv := range params {
+		if kv.GetKey() == QueryIteratorTokenKey && kv.GetValue() != "" {
+			return true
+		}
+		if kv.GetKey() == QueryIteratorKey {
+			if enabled, _ := strconv.ParseBool(kv.GetValue()); enabled {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// QueryIterator reads one page of the query iterator. A new iterator is opened if the
+// request has "iterator" in query params, and it's resumed if "iterator_token" is given
+// with the same collection name, expression, output fields and partitions of the resumed
+// iterator are always the same as the first page. The limit in query params is the batch
+// size. It returns the token of next page, or an empty token if there are no more entities.
+// An iterator is resumed only by the user who opens it, and the row policies of the user are
+// applied to every page.
+func (node *Proxy) QueryIterator(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error) {
+	if node.queryCursorMgr == nil {
+		return nil, "", merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "query iterator not initialized")
+	}
+	token, _ := funcutil.GetAttrByKeyFromRepeatedKV(QueryIteratorTokenKey, request.GetQueryParams())
+	username, _ := GetCurUserFromContext(ctx)
+	var cursor *queryCursor
+	if token != "" {
+		var err error
+		cursor, err = node.queryCursorMgr.take(token, username)
+		if err != nil {
+			return nil, "", err
+		}
+		// collection name is still required so the privilege of the collection is checked for every page
+		if request.GetCollectionName() != cursor.collectionName ||
+			(request.GetDbName() != "" && request.GetDbName() != cursor.dbName) {
+			node.queryCursorMgr.put(token, username, cursor)
+			return nil, "", merr.WrapErrParameterInvalid(cursor.collectionName, request.GetCollectionName(), "collection of query iterator mismatched")
+		}
+		queryParams := []*commonpb.KeyValuePair{{Key: LimitKey, Value: strconv.FormatInt(cursor.batchSize, 10)}}
+		if limit, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, request.GetQueryParams()); err == nil {
+			queryParams[0].Value = limit
+		}
+		request = &milvuspb.QueryRequest{
+			Base:           request.GetBase(),
+			DbName:         cursor.dbName,
+			CollectionName: cursor.collectionName,
+			Expr:           cursor.expr,
+			OutputFields:   cursor.outputFields,
+			PartitionNames: cursor.partitionNames,
+			QueryParams:    queryParams,
+		}
+	} else {
+		cursor = &queryCursor{
+			dbName:         request.GetDbName(),
+			collectionName: request.GetCollectionName(),
+			expr:           request.GetExpr(),
+			outputFields:   append([]string{}, request.GetOutputFields()...),
+			partitionNames: append([]string{}, request.GetPartitionNames()...),
+			lastPKs:        make(map[string]interface{}),
+			exhausted:      typeutil.NewSet[string](),
+		}
+	}
+
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		qc:      node.queryCoord,
+		lb:      node.lbPolicy,
+		cursor:  cursor,
+	}
+	result, err := node.query(ctx, qt)
+	if err == nil {
+		err = merr.Error(result.GetStatus())
+	}
+	if err != nil {
+		if token != "" {
+			// the cursor isn't moved, so the failed page could be retried with the same token
+			node.queryCursorMgr.put(token, username, cursor)
+		}
+		return nil, "", err
+	}
+
+	if !qt.iteratorMore {
+		return result, "", nil
+	}
+	token, err = node.queryCursorMgr.put(token, username, cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	return result, token, nil
+}
+
+// QueryStream gets the records matching the expression and sends them to sender batch by batch,
+// so the results don't need to be buffered and reduced in proxy. Sender is called serially,
+// blocking in sender pauses the query, and an error returned by sender aborts it.
+// Offset and count(*) are not supported in streaming mode.
+func (node *Proxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error) error {
+	// the task holds a slot of dql queue until the stream is finished, so it's bounded for slow clients
+	ctx, cancel := context.WithTimeout(ctx, Params.ProxyCfg.QueryStreamTimeout.GetAsDuration(time.Second))
+	defer cancel()
+
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request:      request,
+		qc:           node.queryCoord,
+		lb:           node.lbPolicy,
+		streamSender: sender,
+	}
+	result, err := node.query(ctx, qt)
+	if err != nil {
+		return err
+	}
+	return merr.Error(result.GetStatus())
+}
+
 // CreateAlias create alias for collection, then you can search the collection with alias.
 func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -4345,7 +4953,7 @@ func (node *Proxy) validPrivilegeParams(req *milvuspb.OperatePrivilegeRequest) e
 	if err := ValidateObjectType(req.Entity.Object.Name); err != nil {
 		return err
 	}
-	if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
+	if err := validateGrantObjectName(req.Entity.Object.Name, req.Entity.ObjectName); err != nil {
 		return err
 	}
 	if req.Entity.Role == nil {
@@ -4396,7 +5004,7 @@ func (node *Proxy) validGrantParams(req *milvuspb.SelectGrantRequest) error {
 			return err
 		}
 
-		if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
+		if err := validateGrantObjectName(req.Entity.Object.Name, req.Entity.ObjectName); err != nil {
 			return err
 		}
 	}
@@ -4440,6 +5048,202 @@ func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantReq
 	return result, nil
 }
 
+// BackupRBAC, RestoreRBAC, privilege groups and user tags aren't supported by root coord yet.
+
+func (node *Proxy) BackupRBAC(ctx context.Context, req *milvuspb.BackupRBACMetaRequest) (*milvuspb.BackupRBACMetaResponse, error) {
+	return &milvuspb.BackupRBACMetaResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("BackupRBAC unimplemented")),
+	}, nil
+}
+
+func (node *Proxy) RestoreRBAC(ctx context.Context, req *milvuspb.RestoreRBACMetaRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("RestoreRBAC unimplemented")), nil
+}
+
+func (node *Proxy) CreatePrivilegeGroup(ctx context.Context, req *milvuspb.CreatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("CreatePrivilegeGroup unimplemented")), nil
+}
+
+func (node *Proxy) DropPrivilegeGroup(ctx context.Context, req *milvuspb.DropPrivilegeGroupRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("DropPrivilegeGroup unimplemented")), nil
+}
+
+func (node *Proxy) ListPrivilegeGroups(ctx context.Context, req *milvuspb.ListPrivilegeGroupsRequest) (*milvuspb.ListPrivilegeGroupsResponse, error) {
+	return &milvuspb.ListPrivilegeGroupsResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("ListPrivilegeGroups unimplemented")),
+	}, nil
+}
+
+func (node *Proxy) OperatePrivilegeGroup(ctx context.Context, req *milvuspb.OperatePrivilegeGroupRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("OperatePrivilegeGroup unimplemented")), nil
+}
+
+func (node *Proxy) AddUserTags(ctx context.Context, req *milvuspb.AddUserTagsRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("AddUserTags unimplemented")), nil
+}
+
+func (node *Proxy) DeleteUserTags(ctx context.Context, req *milvuspb.DeleteUserTagsRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("DeleteUserTags unimplemented")), nil
+}
+
+func (node *Proxy) GetUserTags(ctx context.Context, req *milvuspb.GetUserTagsRequest) (*milvuspb.GetUserTagsResponse, error) {
+	return &milvuspb.GetUserTagsResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("GetUserTags unimplemented")),
+	}, nil
+}
+
+func (node *Proxy) ListUsersWithTag(ctx context.Context, req *milvuspb.ListUsersWithTagRequest) (*milvuspb.ListUsersWithTagResponse, error) {
+	return &milvuspb.ListUsersWithTagResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("ListUsersWithTag unimplemented")),
+	}, nil
+}
+
+func (node *Proxy) operateRowPolicy(ctx context.Context, policy *internalpb.RowPolicy, operateType rootcoordpb.OperateRowPolicyType) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", policy.GetDbName()),
+		zap.String("collection", policy.GetCollectionName()),
+		zap.String("roleName", policy.GetRole()),
+		zap.String("policyName", policy.GetPolicyName()),
+		zap.String("operateType", operateType.String()))
+	log.Debug("OperateRowPolicy", zap.String("expr", policy.GetExpr()))
+	if err := ValidateRoleName(policy.GetRole()); err != nil {
+		return merr.Status(err), nil
+	}
+	result, err := node.rootCoord.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		Policy: policy,
+		Type:   operateType,
+	})
+	if err != nil {
+		log.Warn("fail to operate row policy", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// listRowPolicies returns the row policies stored by rootcoord on the collection, the policies of all collections
+// of the database are returned if the collection name is empty.
+func (node *Proxy) listRowPolicies(ctx context.Context, dbName, collectionName string) ([]*internalpb.RowPolicy, error) {
+	resp, err := node.rootCoord.ListPolicy(ctx, &internalpb.ListPolicyRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	return lo.Filter(resp.GetRowPolicies(), func(policy *internalpb.RowPolicy, _ int) bool {
+		return policy.GetDbName() == dbName && (collectionName == "" || policy.GetCollectionName() == collectionName)
+	}), nil
+}
+
+// CreateRowPolicy creates the row policy on collection, the rows visible to users of the roles are limited to
+// the ones matching the using expression. The policy is stored per role and applies to all the row policy actions,
+// so the check expression must be empty or the same as the using expression.
+func (node *Proxy) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateRowPolicy")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetPolicyName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the policy name of row policy is empty")), nil
+	}
+	if len(req.GetRoles()) == 0 {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the roles of row policy are empty")), nil
+	}
+	if len(req.GetActions()) != 0 && len(lo.Uniq(req.GetActions())) != len(milvuspb.RowPolicyAction_name) {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("row policy applies to all the actions, got %v", req.GetActions())), nil
+	}
+	if req.GetCheckExpr() != "" && req.GetCheckExpr() != req.GetUsingExpr() {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the check expression of row policy must be the same as the using expression")), nil
+	}
+	// the expression is checked against the schema, so that the queries of the roles won't fail on it
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	if _, err := planparserv2.CreateRetrievePlan(schema, req.GetUsingExpr()); err != nil || req.GetUsingExpr() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("invalid expression of row policy %s: %v", req.GetUsingExpr(), err)), nil
+	}
+
+	for _, role := range lo.Uniq(req.GetRoles()) {
+		status, err := node.operateRowPolicy(ctx, &internalpb.RowPolicy{
+			Role:           role,
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetCollectionName(),
+			PolicyName:     req.GetPolicyName(),
+			Expr:           req.GetUsingExpr(),
+		}, rootcoordpb.OperateRowPolicyType_AddRowPolicy)
+		if err != nil || !merr.Ok(status) {
+			return status, err
+		}
+	}
+	return merr.Success(), nil
+}
+
+// DropRowPolicy drops the row policy on collection of all the roles.
+func (node *Proxy) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropRowPolicy")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetPolicyName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the policy name of row policy is empty")), nil
+	}
+	policies, err := node.listRowPolicies(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to list row policies", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	for _, policy := range policies {
+		if policy.GetPolicyName() != req.GetPolicyName() {
+			continue
+		}
+		status, err := node.operateRowPolicy(ctx, policy, rootcoordpb.OperateRowPolicyType_DropRowPolicy)
+		if err != nil || !merr.Ok(status) {
+			return status, err
+		}
+	}
+	return merr.Success(), nil
+}
+
+// ListRowPolicies lists the row policies on collection, the policies of all collections of the database
+// are listed if the collection name is empty.
+func (node *Proxy) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListRowPolicies")
+	defer sp.End()
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &milvuspb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	policies, err := node.listRowPolicies(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to list row policies", zap.Error(err))
+		return &milvuspb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	return &milvuspb.ListRowPoliciesResponse{
+		Status:         merr.Success(),
+		Policies:       groupRowPolicies(policies),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	}, nil
+}
+
 func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RefreshPolicyInfoCache")
 	defer sp.End()
@@ -4968,6 +5772,11 @@ func (node *Proxy) DescribeResourceGroup(ctx context.Context, request *milvuspb.
 	return t.result, nil
 }
 
+// UpdateResourceGroups updates the configs of resource groups, which isn't supported by query coord yet.
+func (node *Proxy) UpdateResourceGroups(ctx context.Context, request *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return merr.Status(merr.WrapErrServiceUnavailable("UpdateResourceGroups unimplemented")), nil
+}
+
 func (node *Proxy) ListIndexedSegment(ctx context.Context, request *federpb.ListIndexedSegmentRequest) (*federpb.ListIndexedSegmentResponse, error) {
 	return &federpb.ListIndexedSegmentResponse{
 		Status: merr.Status(merr.WrapErrServiceUnavailable("unimp")),
@@ -5179,3 +5988,10 @@ func (node *Proxy) GetVersion(ctx context.Context, request *milvuspb.GetVersionR
 		Status: merr.Success(),
 	}, nil
 }
+
+// RunAnalyzer runs the analyzer of text field, which isn't supported by this version.
+func (node *Proxy) RunAnalyzer(ctx context.Context, req *milvuspb.RunAnalyzerRequest) (*milvuspb.RunAnalyzerResponse, error) {
+	return &milvuspb.RunAnalyzerResponse{
+		Status: merr.Status(merr.WrapErrServiceUnavailable("RunAnalyzer unimplemented")),
+	}, nil
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
@@ -32,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -39,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
@@ -1071,6 +1074,108 @@ func TestProxyListDatabase(t *testing.T) {
 	})
 }
 
+func TestProxyAlterCollectionField(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		ctx := context.Background()
+		resp, err := node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+
+		resp, err = node.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	factory := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+
+	node, err := NewProxy(ctx, factory)
+	assert.NoError(t, err)
+	node.tsoAllocator = &timestampAllocator{
+		tso: newMockTimestampAllocatorInterface(),
+	}
+	node.multiRateLimiter = NewMultiRateLimiter()
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	node.sched, err = newTaskScheduler(ctx, node.tsoAllocator, node.factory)
+	node.sched.ddQueue.setMaxTaskNum(10)
+	assert.NoError(t, err)
+	err = node.sched.Start()
+	assert.NoError(t, err)
+	defer node.sched.Close()
+
+	field := &schemapb.FieldSchema{
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+	}
+	fieldBytes, err := proto.Marshal(field)
+	assert.NoError(t, err)
+
+	t.Run("invalid request", func(t *testing.T) {
+		node.rootCoord = mocks.NewMockRootCoordClient(t)
+		resp, err := node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		resp, err = node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "coll", Schema: []byte("invalid")})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		nullable, err := proto.Marshal(&schemapb.FieldSchema{
+			Name:       "nullable",
+			DataType:   schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+		})
+		assert.NoError(t, err)
+		resp, err = node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{
+			CollectionName: "coll",
+			Schema:         nullable,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		resp, err = node.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{FieldName: "added"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("rootcoord failed", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.On("AddCollectionField", mock.Anything, mock.Anything).
+			Return(nil, errors.New("fail"))
+		rc.On("DropCollectionField", mock.Anything, mock.Anything).
+			Return(nil, errors.New("fail"))
+		node.rootCoord = rc
+		resp, err := node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "coll", Schema: fieldBytes})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+
+		resp, err = node.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{CollectionName: "coll", FieldName: "added"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.On("AddCollectionField", mock.Anything, mock.Anything).
+			Return(merr.Success(), nil)
+		rc.On("DropCollectionField", mock.Anything, mock.Anything).
+			Return(merr.Success(), nil)
+		node.rootCoord = rc
+		resp, err := node.AddCollectionField(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "coll", Schema: fieldBytes})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		resp, err = node.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{CollectionName: "coll", FieldName: "added"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestProxy_AllocTimestamp(t *testing.T) {
 	t.Run("proxy unhealthy", func(t *testing.T) {
 		node := &Proxy{}
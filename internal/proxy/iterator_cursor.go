@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var errIteratorNotFound = errors.New("iterator not found or expired")
+
+type cursorEntry[C any] struct {
+	cursor   C
+	username string
+	expireAt time.Time
+}
+
+// cursorManager keeps the server side cursors of iterators by opaque tokens. A cursor is
+// taken out when a page is being read and put back after that, so one cursor is never
+// used concurrently. Cursors not put back within proxy.iterator.ttl are released.
+// A cursor is bound to the user who opens it, the token can't be used by other users.
+type cursorManager[C any] struct {
+	mu      sync.Mutex
+	cursors map[string]*cursorEntry[C]
+}
+
+func newCursorManager[C any]() *cursorManager[C] {
+	return &cursorManager[C]{
+		cursors: make(map[string]*cursorEntry[C]),
+	}
+}
+
+// newToken generates an opaque token, the node id is encoded so a token sent to
+// another proxy could be reported clearly.
+func (m *cursorManager[C]) newToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	raw := fmt.Sprintf("%d:%s", paramtable.GetNodeID(), hex.EncodeToString(buf))
+	return base64.RawURLEncoding.EncodeToString([]byte(raw)), nil
+}
+
+func (m *cursorManager[C]) checkToken(token string) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid iterator token")
+	}
+	nodeID, _, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("invalid iterator token")
+	}
+	if nodeID != strconv.FormatInt(paramtable.GetNodeID(), 10) {
+		return merr.WrapErrParameterInvalidMsg(fmt.Sprintf("iterator token is issued by proxy %s, current proxy %d", nodeID, paramtable.GetNodeID()))
+	}
+	return nil
+}
+
+// take removes the cursor of the token from manager and returns it, the cursor is kept if it's opened by another user.
+func (m *cursorManager[C]) take(token string, username string) (C, error) {
+	var cursor C
+	if err := m.checkToken(token); err != nil {
+		return cursor, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.cursors[token]
+	if !ok {
+		return cursor, merr.WrapErrParameterInvalidMsg(errIteratorNotFound.Error())
+	}
+	if entry.username != username {
+		return cursor, merr.WrapErrPrivilegeNotPermitted("iterator is opened by another user")
+	}
+	delete(m.cursors, token)
+	if time.Now().After(entry.expireAt) {
+		return cursor, merr.WrapErrParameterInvalidMsg(errIteratorNotFound.Error())
+	}
+	return entry.cursor, nil
+}
+
+// put puts the cursor of user back with a refreshed ttl, a new token is generated if token is empty.
+func (m *cursorManager[C]) put(token string, username string, cursor C) (string, error) {
+	if token == "" {
+		var err error
+		token, err = m.newToken()
+		if err != nil {
+			return "", err
+		}
+	}
+	ttl := paramtable.Get().ProxyCfg.IteratorTTL.GetAsDuration(time.Second)
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for t, entry := range m.cursors {
+		if now.After(entry.expireAt) {
+			delete(m.cursors, t)
+		}
+	}
+	maxNum := paramtable.Get().ProxyCfg.MaxIteratorNum.GetAsInt()
+	if len(m.cursors) >= maxNum {
+		return "", merr.WrapErrServiceRequestLimitExceeded(int32(maxNum), "too many iterators")
+	}
+	m.cursors[token] = &cursorEntry[C]{cursor: cursor, username: username, expireAt: now.Add(ttl)}
+	return token, nil
+}
+
+// remove releases the cursor of the token.
+func (m *cursorManager[C]) remove(token string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.cursors, token)
+}
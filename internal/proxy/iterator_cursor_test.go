@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type CursorManagerSuite struct {
+	suite.Suite
+}
+
+func (s *CursorManagerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *CursorManagerSuite) TestTakeAndPut() {
+	mgr := newCursorManager[*queryCursor]()
+
+	cursor := &queryCursor{collectionName: "test_iterator"}
+	token, err := mgr.put("", "alice", cursor)
+	s.NoError(err)
+	s.NotEmpty(token)
+
+	taken, err := mgr.take(token, "alice")
+	s.NoError(err)
+	s.Equal(cursor, taken)
+
+	// one cursor can't be taken twice
+	_, err = mgr.take(token, "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	// token is kept when cursor is put back
+	token2, err := mgr.put(token, "alice", taken)
+	s.NoError(err)
+	s.Equal(token, token2)
+	mgr.remove(token)
+	_, err = mgr.take(token, "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (s *CursorManagerSuite) TestOtherUser() {
+	mgr := newCursorManager[*queryCursor]()
+
+	cursor := &queryCursor{collectionName: "test_iterator"}
+	token, err := mgr.put("", "alice", cursor)
+	s.NoError(err)
+
+	_, err = mgr.take(token, "bob")
+	s.ErrorIs(err, merr.ErrPrivilegeNotPermitted)
+	_, err = mgr.take(token, "")
+	s.ErrorIs(err, merr.ErrPrivilegeNotPermitted)
+
+	// cursor is kept for the user who opens it
+	taken, err := mgr.take(token, "alice")
+	s.NoError(err)
+	s.Equal(cursor, taken)
+}
+
+func (s *CursorManagerSuite) TestInvalidToken() {
+	mgr := newCursorManager[*queryCursor]()
+
+	_, err := mgr.take("invalid token", "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+	_, err = mgr.take(base64.RawURLEncoding.EncodeToString([]byte("abc")), "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+	// token of another proxy
+	_, err = mgr.take(base64.RawURLEncoding.EncodeToString([]byte("-1:abc")), "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (s *CursorManagerSuite) TestExpire() {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.IteratorTTL.Key, "0")
+	defer params.Reset(params.ProxyCfg.IteratorTTL.Key)
+
+	mgr := newCursorManager[*queryCursor]()
+	token, err := mgr.put("", "alice", &queryCursor{})
+	s.NoError(err)
+	time.Sleep(time.Millisecond)
+	_, err = mgr.take(token, "alice")
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (s *CursorManagerSuite) TestMaxNum() {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.MaxIteratorNum.Key, "1")
+	defer params.Reset(params.ProxyCfg.MaxIteratorNum.Key)
+
+	mgr := newCursorManager[*queryCursor]()
+	_, err := mgr.put("", "alice", &queryCursor{})
+	s.NoError(err)
+	_, err = mgr.put("", "alice", &queryCursor{})
+	s.ErrorIs(err, merr.ErrServiceRequestLimitExceeded)
+}
+
+func TestCursorManager(t *testing.T) {
+	suite.Run(t, new(CursorManagerSuite))
+}
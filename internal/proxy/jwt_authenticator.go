@@ -0,0 +1,352 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+)
+
+const (
+	// bearerPrefix is the prefix of JWT in authorization header.
+	bearerPrefix = "Bearer "
+
+	// minJWKSRefreshInterval limits the refreshing of json web key set caused by unknown keys or failures.
+	minJWKSRefreshInterval = 10 * time.Second
+	jwksFetchTimeout       = 5 * time.Second
+	maxJWKSSize            = 1 << 20
+)
+
+// jwksHTTPClient fetches json web key sets, its timeout also covers reading the body.
+var jwksHTTPClient = &http.Client{Timeout: jwksFetchTimeout}
+
+// jwtValidMethods are the asymmetric signing methods accepted, symmetric ones are never used by OIDC provider's JWKS.
+var jwtValidMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// globalJWTAuthenticator verifies the bearer JWT of requests.
+var globalJWTAuthenticator = newJWTAuthenticator(fetchJWKS)
+
+type jwtRolesKey struct{}
+
+// IsJWT returns whether the bearer token of RESTful request should be verified as JWT, which is enabled and the token
+// is made of three segments, since the API key and username:password are carried by bearer token of RESTful request too.
+func IsJWT(token string) bool {
+	return Params.ProxyCfg.JWTEnabled.GetAsBool() && !strings.Contains(token, util.CredentialSeperator) && strings.Count(token, ".") == 2
+}
+
+// VerifyJWT verifies the JWT by the authenticator of grpc requests, and returns the user and roles of its claims.
+func VerifyJWT(ctx context.Context, token string) (string, []string, error) {
+	return globalJWTAuthenticator.Verify(ctx, token)
+}
+
+// WithJWTRoles returns the context carrying the roles claimed by JWT.
+func WithJWTRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, jwtRolesKey{}, roles)
+}
+
+// getJWTRoles returns the roles claimed by JWT of request, nil if the request isn't authenticated by JWT.
+func getJWTRoles(ctx context.Context) []string {
+	roles, _ := ctx.Value(jwtRolesKey{}).([]string)
+	return roles
+}
+
+// jsonWebKey is a public key of json web key set, see RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// publicKey returns the *rsa.PublicKey or *ecdsa.PublicKey of json web key.
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent of RSA key %s", k.Kid)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s of EC key %s", k.Crv, k.Kid)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("invalid point of EC key %s", k.Kid)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s of key %s", k.Kty, k.Kid)
+	}
+}
+
+// parseJWKS parses the signature keys of json web key set, keys of other usages or unsupported types are skipped.
+func parseJWKS(data []byte) (map[string]interface{}, error) {
+	jwks := jsonWebKeySet{}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			log.Warn("skip invalid json web key", zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// jwksFetcher fetches the json web key set from url.
+type jwksFetcher func(ctx context.Context, url string) ([]byte, error)
+
+func fetchJWKS(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := jwksHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch json web key set from %s, status: %s", url, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+// jwtAuthenticator verifies JWT by the json web key set of OIDC provider, and maps its claims to user and roles.
+// The keys are cached and refreshed periodically, they are also refreshed if a JWT is signed by an unknown key,
+// so that the rotated keys are picked up in time.
+type jwtAuthenticator struct {
+	mu    sync.RWMutex
+	sf    conc.Singleflight[struct{}]
+	fetch jwksFetcher
+	// url of cached keys
+	url         string
+	keys        map[string]interface{}
+	refreshedAt time.Time
+	triedAt     time.Time
+}
+
+func newJWTAuthenticator(fetch jwksFetcher) *jwtAuthenticator {
+	return &jwtAuthenticator{
+		fetch: fetch,
+		keys:  make(map[string]interface{}),
+	}
+}
+
+// lookup returns the key of kid, the only key is used if the JWT doesn't specify kid.
+func (a *jwtAuthenticator) lookup(kid string) (interface{}, bool) {
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, true
+		}
+	}
+	key, ok := a.keys[kid]
+	return key, ok
+}
+
+func (a *jwtAuthenticator) getKey(kid string) (interface{}, error) {
+	url := Params.ProxyCfg.JWTJWKSURL.GetValue()
+	if url == "" {
+		return nil, errors.New("json web key set url is not configured")
+	}
+	refreshInterval := Params.ProxyCfg.JWTJWKSRefreshInterval.GetAsDuration(time.Second)
+
+	a.mu.RLock()
+	key, ok := a.lookup(kid)
+	ok = ok && a.url == url
+	fresh := a.url == url && time.Since(a.refreshedAt) < refreshInterval
+	a.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	// concurrent verifications share one fetching
+	if _, err, _ := a.sf.Do(url, func() (struct{}, error) {
+		return struct{}{}, a.refresh(url)
+	}); err != nil {
+		// keep using the cached keys if refreshing fails
+		log.Warn("failed to refresh json web key set", zap.String("url", url), zap.Error(err))
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.url == url {
+		key, ok = a.lookup(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key %s of JWT", kid)
+	}
+	return key, nil
+}
+
+// refresh fetches the json web key set of url without holding the lock, so verifications with cached keys
+// aren't blocked by a slow OIDC provider, and the cached keys are swapped under the lock.
+func (a *jwtAuthenticator) refresh(url string) error {
+	a.mu.Lock()
+	if a.url != url {
+		a.url = url
+		a.keys = make(map[string]interface{})
+		a.refreshedAt = time.Time{}
+	} else if time.Since(a.triedAt) < minJWKSRefreshInterval {
+		a.mu.Unlock()
+		return nil
+	}
+	a.triedAt = time.Now()
+	a.mu.Unlock()
+
+	// the fetching is shared by verifications, so it's not cancelled with any of them
+	data, err := a.fetch(context.Background(), url)
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// the url is changed during fetching
+	if a.url != url {
+		return nil
+	}
+	a.keys = keys
+	a.refreshedAt = time.Now()
+	log.Info("json web key set refreshed", zap.String("url", url), zap.Int("keyNum", len(keys)))
+	return nil
+}
+
+// parseRolesClaim returns the roles of claim, which is a list of role names or a string of them separated by space or comma.
+func parseRolesClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool {
+			return r == ' ' || r == ','
+		})
+	case []interface{}:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			if s, ok := role.(string); ok && s != "" {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
+
+// Verify verifies the signature, expiration, issuer and audience of JWT, and returns the user and roles of its claims.
+func (a *jwtAuthenticator) Verify(ctx context.Context, token string) (string, []string, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(jwtValidMethods))
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return a.getKey(kid)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return "", nil, errors.New("JWT without exp claim is not accepted")
+	}
+	if issuer := Params.ProxyCfg.JWTIssuer.GetValue(); issuer != "" && !claims.VerifyIssuer(issuer, true) {
+		return "", nil, fmt.Errorf("iss of JWT mismatched, expected %s", issuer)
+	}
+	if audience := Params.ProxyCfg.JWTAudience.GetValue(); audience != "" && !claims.VerifyAudience(audience, true) {
+		return "", nil, fmt.Errorf("aud of JWT mismatched, expected %s", audience)
+	}
+
+	userClaim := Params.ProxyCfg.JWTUserClaim.GetValue()
+	user, _ := claims[userClaim].(string)
+	if user == "" {
+		return "", nil, fmt.Errorf("claim %s of user not found in JWT", userClaim)
+	}
+	// root bypasses the privilege check, it must be authenticated by password
+	if user == util.UserRoot {
+		return "", nil, fmt.Errorf("JWT can't be mapped to %s user", util.UserRoot)
+	}
+	return user, parseRolesClaim(claims[Params.ProxyCfg.JWTRolesClaim.GetValue()]), nil
+}
@@ -0,0 +1,260 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type JWTAuthenticatorSuite struct {
+	suite.Suite
+	rsaKey  *rsa.PrivateKey
+	ecKey   *ecdsa.PrivateKey
+	jwks    []jsonWebKey
+	fetches int
+	auth    *jwtAuthenticator
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func (s *JWTAuthenticatorSuite) SetupSuite() {
+	paramtable.Init()
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+}
+
+func (s *JWTAuthenticatorSuite) SetupTest() {
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.JWTJWKSURL.Key, "http://idp/jwks")
+	params.Save(params.ProxyCfg.JWTIssuer.Key, "http://idp")
+	params.Save(params.ProxyCfg.JWTAudience.Key, "milvus")
+
+	s.jwks = []jsonWebKey{{
+		Kty: "RSA", Kid: "rsa1", Use: "sig",
+		N: encodeBigInt(s.rsaKey.N), E: encodeBigInt(big.NewInt(int64(s.rsaKey.E))),
+	}}
+	s.fetches = 0
+	s.auth = newJWTAuthenticator(func(ctx context.Context, url string) ([]byte, error) {
+		s.fetches++
+		return json.Marshal(jsonWebKeySet{Keys: s.jwks})
+	})
+}
+
+func (s *JWTAuthenticatorSuite) TearDownTest() {
+	params := paramtable.Get()
+	params.Reset(params.ProxyCfg.JWTJWKSURL.Key)
+	params.Reset(params.ProxyCfg.JWTIssuer.Key)
+	params.Reset(params.ProxyCfg.JWTAudience.Key)
+}
+
+func (s *JWTAuthenticatorSuite) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   "http://idp",
+		"aud":   "milvus",
+		"sub":   "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"reader", "writer"},
+	}
+}
+
+func (s *JWTAuthenticatorSuite) sign(method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	s.Require().NoError(err)
+	return signed
+}
+
+func (s *JWTAuthenticatorSuite) TestVerify() {
+	ctx := context.Background()
+	user, roles, err := s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.NoError(err)
+	s.Equal("alice", user)
+	s.Equal([]string{"reader", "writer"}, roles)
+	s.Equal(1, s.fetches)
+
+	// keys are cached
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.NoError(err)
+	s.Equal(1, s.fetches)
+
+	// roles separated by space or comma
+	claims := s.claims()
+	claims["roles"] = "reader, writer admin"
+	_, roles, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, claims))
+	s.NoError(err)
+	s.Equal([]string{"reader", "writer", "admin"}, roles)
+
+	invalids := []func(jwt.MapClaims){
+		func(c jwt.MapClaims) { c["iss"] = "http://other" },
+		func(c jwt.MapClaims) { c["aud"] = "other" },
+		func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		func(c jwt.MapClaims) { delete(c, "exp") },
+		func(c jwt.MapClaims) { delete(c, "sub") },
+		func(c jwt.MapClaims) { c["sub"] = util.UserRoot },
+	}
+	for _, invalid := range invalids {
+		claims := s.claims()
+		invalid(claims)
+		_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, claims))
+		s.Error(err)
+	}
+
+	// signed by other key
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", otherKey, s.claims()))
+	s.Error(err)
+
+	// symmetric method is not accepted
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodHS256, "rsa1", []byte("secret"), s.claims()))
+	s.Error(err)
+}
+
+func (s *JWTAuthenticatorSuite) TestKeyRotation() {
+	ctx := context.Background()
+	_, _, err := s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.NoError(err)
+
+	// a new key is rotated in
+	s.jwks = append(s.jwks, jsonWebKey{
+		Kty: "EC", Kid: "ec1", Crv: "P-256",
+		X: encodeBigInt(s.ecKey.X), Y: encodeBigInt(s.ecKey.Y),
+	})
+	// unknown key refreshing is limited
+	s.auth.triedAt = time.Now()
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodES256, "ec1", s.ecKey, s.claims()))
+	s.Error(err)
+	s.Equal(1, s.fetches)
+
+	s.auth.triedAt = time.Time{}
+	user, _, err := s.auth.Verify(ctx, s.sign(jwt.SigningMethodES256, "ec1", s.ecKey, s.claims()))
+	s.NoError(err)
+	s.Equal("alice", user)
+	s.Equal(2, s.fetches)
+
+	// the old key is rotated out after refresh interval
+	s.jwks = s.jwks[1:]
+	s.auth.refreshedAt = time.Now().Add(-2 * time.Hour)
+	s.auth.triedAt = time.Time{}
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.Error(err)
+	s.Equal(3, s.fetches)
+}
+
+func (s *JWTAuthenticatorSuite) TestConcurrentRefresh() {
+	ctx := context.Background()
+	_, _, err := s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.NoError(err)
+
+	s.jwks = append(s.jwks, jsonWebKey{
+		Kty: "EC", Kid: "ec1", Crv: "P-256",
+		X: encodeBigInt(s.ecKey.X), Y: encodeBigInt(s.ecKey.Y),
+	})
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var fetches atomic.Int32
+	s.auth.fetch = func(ctx context.Context, url string) ([]byte, error) {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return json.Marshal(jsonWebKeySet{Keys: s.jwks})
+	}
+	s.auth.triedAt = time.Time{}
+
+	token := s.sign(jwt.SigningMethodES256, "ec1", s.ecKey, s.claims())
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, _, err := s.auth.Verify(ctx, token)
+			s.NoError(err)
+			s.Equal("alice", user)
+		}()
+	}
+
+	<-started
+	// the lock isn't held during fetching, cached keys are still served
+	s.True(s.auth.mu.TryLock())
+	s.auth.mu.Unlock()
+	_, _, err = s.auth.Verify(ctx, s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims()))
+	s.NoError(err)
+
+	close(release)
+	wg.Wait()
+	s.Equal(int32(1), fetches.Load())
+}
+
+func (s *JWTAuthenticatorSuite) TestInterceptor() {
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	params.Save(params.ProxyCfg.JWTEnabled.Key, "true")
+	defer func() {
+		params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+		params.Reset(params.ProxyCfg.JWTEnabled.Key)
+	}()
+	if globalMetaCache == nil {
+		globalMetaCache = NewMockCache(s.T())
+	}
+	globalJWTAuthenticator = s.auth
+	defer func() {
+		globalJWTAuthenticator = newJWTAuthenticator(fetchJWKS)
+	}()
+
+	token := bearerPrefix + s.sign(jwt.SigningMethodRS256, "rsa1", s.rsaKey, s.claims())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(strings.ToLower(util.HeaderAuthorize), token))
+	newCtx, err := AuthenticationInterceptor(ctx)
+	s.NoError(err)
+	user, err := GetCurUserFromContext(newCtx)
+	s.NoError(err)
+	s.Equal("alice", user)
+	s.Equal([]string{"reader", "writer"}, getJWTRoles(newCtx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(strings.ToLower(util.HeaderAuthorize), bearerPrefix+"invalid"))
+	_, err = AuthenticationInterceptor(ctx)
+	s.Error(err)
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	suite.Run(t, new(JWTAuthenticatorSuite))
+}
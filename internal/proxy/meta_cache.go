@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sync"
@@ -81,7 +82,9 @@ type Cache interface {
 	GetPrivilegeInfo(ctx context.Context) []string
 	GetUserRole(username string) []string
 	RefreshPolicyInfo(op typeutil.CacheOp) error
-	InitPolicyInfo(info []string, userRoles []string)
+	InitPolicyInfo(info []string, userRoles []string, rowPolicies []*internalpb.RowPolicy)
+	// GetRowPolicies get the row policies of roles on specific collection.
+	GetRowPolicies(database, collectionName string, roles []string) []*internalpb.RowPolicy
 
 	RemoveDatabase(ctx context.Context, database string)
 }
@@ -198,6 +201,7 @@ type MetaCache struct {
 	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
 	privilegeInfos map[string]struct{}                   // privileges cache
 	userToRoles    map[string]map[string]struct{}        // user to role cache
+	rowPolicies    map[string][]*internalpb.RowPolicy    // role to row policies cache
 	mu             sync.RWMutex
 	credMut        sync.RWMutex
 	privilegeMut   sync.RWMutex
@@ -221,12 +225,21 @@ func InitMetaCache(ctx context.Context, rootCoord types.RootCoordClient, queryCo
 		log.Error("fail to init meta cache", zap.Error(err))
 		return err
 	}
-	globalMetaCache.InitPolicyInfo(resp.PolicyInfos, resp.UserRoles)
+	globalMetaCache.InitPolicyInfo(resp.PolicyInfos, resp.UserRoles, resp.RowPolicies)
 	log.Info("success to init meta cache", zap.Strings("policy_infos", resp.PolicyInfos))
 	globalMetaCache.expireShardLeaderCache(ctx)
 	return nil
 }
 
+// GetCachedCollectionSchema returns the collection schema from globalMetaCache,
+// it's used by the RESTful handlers to validate request bodies before calling proxy.
+func GetCachedCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
+	if globalMetaCache == nil {
+		return nil, merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "meta cache not initialized")
+	}
+	return globalMetaCache.GetCollectionSchema(ctx, database, collectionName)
+}
+
 // NewMetaCache creates a MetaCache with provided RootCoord and QueryNode
 func NewMetaCache(rootCoord types.RootCoordClient, queryCoord types.QueryCoordClient, shardMgr shardClientMgr) (*MetaCache, error) {
 	return &MetaCache{
@@ -237,6 +250,7 @@ func NewMetaCache(rootCoord types.RootCoordClient, queryCoord types.QueryCoordCl
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
+		rowPolicies:    map[string][]*internalpb.RowPolicy{},
 	}, nil
 }
 
@@ -897,14 +911,15 @@ func (m *MetaCache) expireShardLeaderCache(ctx context.Context) {
 	}()
 }
 
-func (m *MetaCache) InitPolicyInfo(info []string, userRoles []string) {
+func (m *MetaCache) InitPolicyInfo(info []string, userRoles []string, rowPolicies []*internalpb.RowPolicy) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.unsafeInitPolicyInfo(info, userRoles)
+	m.unsafeInitPolicyInfo(info, userRoles, rowPolicies)
 }
 
-func (m *MetaCache) unsafeInitPolicyInfo(info []string, userRoles []string) {
+func (m *MetaCache) unsafeInitPolicyInfo(info []string, userRoles []string, rowPolicies []*internalpb.RowPolicy) {
 	m.privilegeInfos = util.StringSet(info)
+	privilegeEnforcer.invalidate()
 	for _, userRole := range userRoles {
 		user, role, err := funcutil.DecodeUserRoleCache(userRole)
 		if err != nil {
@@ -916,6 +931,47 @@ func (m *MetaCache) unsafeInitPolicyInfo(info []string, userRoles []string) {
 		}
 		m.userToRoles[user][role] = struct{}{}
 	}
+	m.rowPolicies = make(map[string][]*internalpb.RowPolicy)
+	for _, policy := range rowPolicies {
+		m.unsafeAddRowPolicy(policy)
+	}
+}
+
+func isSameRowPolicy(a, b *internalpb.RowPolicy) bool {
+	return a.GetRole() == b.GetRole() && a.GetDbName() == b.GetDbName() &&
+		a.GetCollectionName() == b.GetCollectionName() && a.GetPolicyName() == b.GetPolicyName()
+}
+
+// unsafeAddRowPolicy adds the row policy, the one of same name is replaced.
+func (m *MetaCache) unsafeAddRowPolicy(policy *internalpb.RowPolicy) {
+	m.unsafeDropRowPolicy(policy)
+	m.rowPolicies[policy.GetRole()] = append(m.rowPolicies[policy.GetRole()], policy)
+}
+
+func (m *MetaCache) unsafeDropRowPolicy(policy *internalpb.RowPolicy) {
+	policies := lo.Filter(m.rowPolicies[policy.GetRole()], func(p *internalpb.RowPolicy, _ int) bool {
+		return !isSameRowPolicy(p, policy)
+	})
+	if len(policies) == 0 {
+		delete(m.rowPolicies, policy.GetRole())
+		return
+	}
+	m.rowPolicies[policy.GetRole()] = policies
+}
+
+func (m *MetaCache) GetRowPolicies(database, collectionName string, roles []string) []*internalpb.RowPolicy {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var policies []*internalpb.RowPolicy
+	for _, role := range roles {
+		for _, policy := range m.rowPolicies[role] {
+			if policy.GetDbName() == database && policy.GetCollectionName() == collectionName {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return policies
 }
 
 func (m *MetaCache) GetPrivilegeInfo(ctx context.Context) []string {
@@ -943,8 +999,10 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 	switch op.OpType {
 	case typeutil.CacheGrantPrivilege:
 		m.privilegeInfos[op.OpKey] = struct{}{}
+		privilegeEnforcer.invalidate()
 	case typeutil.CacheRevokePrivilege:
 		delete(m.privilegeInfos, op.OpKey)
+		privilegeEnforcer.invalidate()
 	case typeutil.CacheAddUserToRole:
 		user, role, err := funcutil.DecodeUserRoleCache(op.OpKey)
 		if err != nil {
@@ -968,6 +1026,17 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 		for user := range m.userToRoles {
 			delete(m.userToRoles[user], op.OpKey)
 		}
+		delete(m.rowPolicies, op.OpKey)
+	case typeutil.CacheAddRowPolicy, typeutil.CacheDropRowPolicy:
+		policy := &internalpb.RowPolicy{}
+		if err := json.Unmarshal([]byte(op.OpKey), policy); err != nil {
+			return fmt.Errorf("invalid opKey, fail to decode, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
+		}
+		if op.OpType == typeutil.CacheAddRowPolicy {
+			m.unsafeAddRowPolicy(policy)
+		} else {
+			m.unsafeDropRowPolicy(policy)
+		}
 	case typeutil.CacheRefresh:
 		resp, err := m.rootCoord.ListPolicy(context.Background(), &internalpb.ListPolicyRequest{})
 		if err != nil {
@@ -979,7 +1048,7 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 		defer m.mu.Unlock()
 		m.userToRoles = make(map[string]map[string]struct{})
 		m.privilegeInfos = make(map[string]struct{})
-		m.unsafeInitPolicyInfo(resp.PolicyInfos, resp.UserRoles)
+		m.unsafeInitPolicyInfo(resp.PolicyInfos, resp.UserRoles, resp.RowPolicies)
 	default:
 		return fmt.Errorf("invalid opType, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
 	}
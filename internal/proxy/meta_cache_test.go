@@ -18,6 +18,7 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -716,6 +717,52 @@ func TestMetaCache_PolicyInfo(t *testing.T) {
 		roles = globalMetaCache.GetUserRole("foo")
 		assert.Len(t, roles, 2)
 	})
+
+	t.Run("Row policies", func(t *testing.T) {
+		client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+			return &internalpb.ListPolicyResponse{
+				Status: merr.Success(),
+				RowPolicies: []*internalpb.RowPolicy{
+					{Role: "role1", DbName: "default", CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 1"},
+					{Role: "role2", DbName: "default", CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 2"},
+					{Role: "role1", DbName: "default", CollectionName: "other", PolicyName: "p1", Expr: "tenant == 1"},
+				},
+			}, nil
+		}
+		err := InitMetaCache(context.Background(), client, qc, mgr)
+		assert.NoError(t, err)
+		policies := globalMetaCache.GetRowPolicies("default", "coll", []string{"role1", "role3"})
+		assert.Len(t, policies, 1)
+		assert.Equal(t, "tenant == 1", policies[0].GetExpr())
+
+		encode := func(policy *internalpb.RowPolicy) string {
+			b, err := json.Marshal(policy)
+			assert.NoError(t, err)
+			return string(b)
+		}
+		// the policy of same name is replaced
+		policy := &internalpb.RowPolicy{Role: "role1", DbName: "default", CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 3"}
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheAddRowPolicy, OpKey: encode(policy)})
+		assert.NoError(t, err)
+		policy = &internalpb.RowPolicy{Role: "role1", DbName: "default", CollectionName: "coll", PolicyName: "p2", Expr: "tenant == 4"}
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheAddRowPolicy, OpKey: encode(policy)})
+		assert.NoError(t, err)
+		policies = globalMetaCache.GetRowPolicies("default", "coll", []string{"role1"})
+		assert.Len(t, policies, 2)
+		assert.Equal(t, "tenant == 3", policies[0].GetExpr())
+		assert.Equal(t, "tenant == 4", policies[1].GetExpr())
+
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheDropRowPolicy, OpKey: encode(policy)})
+		assert.NoError(t, err)
+		assert.Len(t, globalMetaCache.GetRowPolicies("default", "coll", []string{"role1"}), 1)
+
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheDropRole, OpKey: "role2"})
+		assert.NoError(t, err)
+		assert.Len(t, globalMetaCache.GetRowPolicies("default", "coll", []string{"role2"}), 0)
+
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheAddRowPolicy, OpKey: "invalid"})
+		assert.Error(t, err)
+	})
 }
 
 func TestMetaCache_RemoveCollection(t *testing.T) {
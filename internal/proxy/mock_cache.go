@@ -548,6 +548,52 @@ func (_c *MockCache_GetPrivilegeInfo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetRowPolicies provides a mock function with given fields: database, collectionName, roles
+func (_m *MockCache) GetRowPolicies(database string, collectionName string, roles []string) []*internalpb.RowPolicy {
+	ret := _m.Called(database, collectionName, roles)
+
+	var r0 []*internalpb.RowPolicy
+	if rf, ok := ret.Get(0).(func(string, string, []string) []*internalpb.RowPolicy); ok {
+		r0 = rf(database, collectionName, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	return r0
+}
+
+// MockCache_GetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRowPolicies'
+type MockCache_GetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetRowPolicies is a helper method to define mock.On call
+//   - database string
+//   - collectionName string
+//   - roles []string
+func (_e *MockCache_Expecter) GetRowPolicies(database interface{}, collectionName interface{}, roles interface{}) *MockCache_GetRowPolicies_Call {
+	return &MockCache_GetRowPolicies_Call{Call: _e.mock.On("GetRowPolicies", database, collectionName, roles)}
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Run(run func(database string, collectionName string, roles []string)) *MockCache_GetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Return(_a0 []*internalpb.RowPolicy) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) RunAndReturn(run func(string, string, []string) []*internalpb.RowPolicy) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShards provides a mock function with given fields: ctx, withCache, database, collectionName, collectionID
 func (_m *MockCache) GetShards(ctx context.Context, withCache bool, database string, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	ret := _m.Called(ctx, withCache, database, collectionName, collectionID)
@@ -650,9 +696,9 @@ func (_c *MockCache_GetUserRole_Call) RunAndReturn(run func(string) []string) *M
 	return _c
 }
 
-// InitPolicyInfo provides a mock function with given fields: info, userRoles
-func (_m *MockCache) InitPolicyInfo(info []string, userRoles []string) {
-	_m.Called(info, userRoles)
+// InitPolicyInfo provides a mock function with given fields: info, userRoles, rowPolicies
+func (_m *MockCache) InitPolicyInfo(info []string, userRoles []string, rowPolicies []*internalpb.RowPolicy) {
+	_m.Called(info, userRoles, rowPolicies)
 }
 
 // MockCache_InitPolicyInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitPolicyInfo'
@@ -663,13 +709,14 @@ type MockCache_InitPolicyInfo_Call struct {
 // InitPolicyInfo is a helper method to define mock.On call
 //   - info []string
 //   - userRoles []string
-func (_e *MockCache_Expecter) InitPolicyInfo(info interface{}, userRoles interface{}) *MockCache_InitPolicyInfo_Call {
-	return &MockCache_InitPolicyInfo_Call{Call: _e.mock.On("InitPolicyInfo", info, userRoles)}
+//   - rowPolicies []*internalpb.RowPolicy
+func (_e *MockCache_Expecter) InitPolicyInfo(info interface{}, userRoles interface{}, rowPolicies interface{}) *MockCache_InitPolicyInfo_Call {
+	return &MockCache_InitPolicyInfo_Call{Call: _e.mock.On("InitPolicyInfo", info, userRoles, rowPolicies)}
 }
 
-func (_c *MockCache_InitPolicyInfo_Call) Run(run func(info []string, userRoles []string)) *MockCache_InitPolicyInfo_Call {
+func (_c *MockCache_InitPolicyInfo_Call) Run(run func(info []string, userRoles []string, rowPolicies []*internalpb.RowPolicy)) *MockCache_InitPolicyInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]string), args[1].([]string))
+		run(args[0].([]string), args[1].([]string), args[2].([]*internalpb.RowPolicy))
 	})
 	return _c
 }
@@ -679,7 +726,7 @@ func (_c *MockCache_InitPolicyInfo_Call) Return() *MockCache_InitPolicyInfo_Call
 	return _c
 }
 
-func (_c *MockCache_InitPolicyInfo_Call) RunAndReturn(run func([]string, []string)) *MockCache_InitPolicyInfo_Call {
+func (_c *MockCache_InitPolicyInfo_Call) RunAndReturn(run func([]string, []string, []*internalpb.RowPolicy)) *MockCache_InitPolicyInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
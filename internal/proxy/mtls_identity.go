@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+// fields of client certificate which could be mapped to user
+const (
+	certFieldSubject = "subject"
+	certFieldCN      = "cn"
+	certFieldDNS     = "dns"
+	certFieldURI     = "uri"
+	certFieldEmail   = "email"
+)
+
+// certUserMappingRule maps the client certificate whose field matches the regexp to user.
+type certUserMappingRule struct {
+	Field string `json:"field"`
+	// Match is the regexp matched against the whole value of field, a partial match doesn't map the certificate
+	Match string `json:"match"`
+	// User is the template of user name, $1 or ${name} is expanded by the submatch, default is the whole match
+	User string `json:"user"`
+
+	regex *regexp.Regexp
+}
+
+func parseCertUserMappingRules(value string) ([]*certUserMappingRule, error) {
+	if value == "" {
+		return nil, nil
+	}
+	rules := make([]*certUserMappingRule, 0)
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return nil, err
+	}
+	for i, rule := range rules {
+		switch rule.Field {
+		case certFieldSubject, certFieldCN, certFieldDNS, certFieldURI, certFieldEmail:
+		default:
+			return nil, fmt.Errorf("unknown certificate field %s of rule %d", rule.Field, i)
+		}
+		// anchored so that a certificate whose field merely contains the pattern can't impersonate the user
+		regex, err := regexp.Compile("^(?:" + rule.Match + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid match of rule %d: %w", i, err)
+		}
+		rule.regex = regex
+		if rule.User == "" {
+			rule.User = "$0"
+		}
+	}
+	return rules, nil
+}
+
+// certFieldValues returns the values of field of certificate, the SAN fields may have multiple values.
+func certFieldValues(cert *x509.Certificate, field string) []string {
+	switch field {
+	case certFieldSubject:
+		return []string{cert.Subject.String()}
+	case certFieldCN:
+		return []string{cert.Subject.CommonName}
+	case certFieldDNS:
+		return cert.DNSNames
+	case certFieldURI:
+		values := make([]string, 0, len(cert.URIs))
+		for _, uri := range cert.URIs {
+			values = append(values, uri.String())
+		}
+		return values
+	case certFieldEmail:
+		return cert.EmailAddresses
+	default:
+		return nil
+	}
+}
+
+// mapCertToUser returns the user of the first rule matched by certificate, empty if none of rules matches.
+func mapCertToUser(cert *x509.Certificate, rules []*certUserMappingRule) string {
+	for _, rule := range rules {
+		for _, value := range certFieldValues(cert, rule.Field) {
+			match := rule.regex.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+			if user := string(rule.regex.ExpandString(nil, rule.User, value, match)); user != "" {
+				return user
+			}
+		}
+	}
+	return ""
+}
+
+// certUserMapper caches the mapping rules parsed from config, they are parsed again once the config is changed.
+type certUserMapper struct {
+	mu    sync.Mutex
+	value string
+	rules []*certUserMappingRule
+	err   error
+}
+
+var globalCertUserMapper = &certUserMapper{}
+
+func (m *certUserMapper) getRules() ([]*certUserMappingRule, error) {
+	value := Params.ProxyCfg.MTLSUserMappingRules.GetValue()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.rules == nil && m.err == nil || m.value != value {
+		m.value = value
+		m.rules, m.err = parseCertUserMappingRules(value)
+	}
+	return m.rules, m.err
+}
+
+// getPeerCertificate returns the verified client certificate of request, nil if the request isn't from a mTLS connection.
+func getPeerCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	// the leaf of verified chain is the client certificate, there are verified chains only if client certificate is required
+	if len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return tlsInfo.State.VerifiedChains[0][0]
+}
+
+// getCertUser returns the user mapped from the client certificate of request, empty if there is no verified
+// client certificate or none of rules matches.
+func getCertUser(ctx context.Context) (string, error) {
+	cert := getPeerCertificate(ctx)
+	if cert == nil {
+		return "", nil
+	}
+	rules, err := globalCertUserMapper.getRules()
+	if err != nil {
+		return "", fmt.Errorf("invalid user mapping rules of client certificate: %w", err)
+	}
+	user := mapCertToUser(cert, rules)
+	// root bypasses the privilege check, it must be authenticated by password
+	if user == util.UserRoot {
+		return "", fmt.Errorf("client certificate %s can't be mapped to %s user", cert.Subject.String(), util.UserRoot)
+	}
+	return user, nil
+}
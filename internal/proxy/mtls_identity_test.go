@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestClientCert(t *testing.T, cn string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	uri, err := url.Parse("spiffe://cluster.local/ns/milvus/sa/etl")
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(1),
+		Subject:        pkix.Name{CommonName: cn, Organization: []string{"milvus"}},
+		NotBefore:      time.Now(),
+		NotAfter:       time.Now().Add(time.Hour),
+		DNSNames:       []string{"loader.milvus.svc"},
+		URIs:           []*url.URL{uri},
+		EmailAddresses: []string{"ops@milvus.io"},
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func withPeerCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	state := tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+	return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+}
+
+func TestMapCertToUser(t *testing.T) {
+	cert := newTestClientCert(t, "reporter")
+
+	_, err := parseCertUserMappingRules(`[{"field": "unknown", "match": ".+"}]`)
+	assert.Error(t, err)
+	_, err = parseCertUserMappingRules(`[{"field": "cn", "match": "("}]`)
+	assert.Error(t, err)
+	_, err = parseCertUserMappingRules(`{}`)
+	assert.Error(t, err)
+	rules, err := parseCertUserMappingRules("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", mapCertToUser(cert, rules))
+
+	cases := []struct {
+		rules string
+		user  string
+	}{
+		{`[{"field": "cn", "match": ".+"}]`, "reporter"},
+		{`[{"field": "subject", "match": "^CN=(\\w+),O=milvus$", "user": "svc-$1"}]`, "svc-reporter"},
+		{`[{"field": "dns", "match": "^(?P<name>\\w+)\\.milvus\\.svc$", "user": "${name}"}]`, "loader"},
+		{`[{"field": "uri", "match": "^spiffe://cluster.local/ns/milvus/sa/(.+)$", "user": "$1"}]`, "etl"},
+		{`[{"field": "email", "match": "^(\\w+)@milvus.io$", "user": "$1"}]`, "ops"},
+		// the first matched rule wins
+		{`[{"field": "cn", "match": "^writer$"}, {"field": "uri", "match": ".+/(etl)", "user": "$1"}, {"field": "cn", "match": ".+"}]`, "etl"},
+		{`[{"field": "cn", "match": "^writer$"}]`, ""},
+		// the whole value of field must match
+		{`[{"field": "cn", "match": "report"}]`, ""},
+		{`[{"field": "cn", "match": "porter"}]`, ""},
+		{`[{"field": "uri", "match": "etl"}]`, ""},
+		{`[{"field": "cn", "match": "writer|report"}]`, ""},
+		{`[{"field": "cn", "match": "writer|reporter"}]`, "reporter"},
+		{`[{"field": "email", "match": "ops@milvus.io"}]`, "ops@milvus.io"},
+	}
+	for _, c := range cases {
+		rules, err := parseCertUserMappingRules(c.rules)
+		assert.NoError(t, err)
+		assert.Equal(t, c.user, mapCertToUser(cert, rules), c.rules)
+	}
+}
+
+func TestAuthenticationInterceptor_ClientCertificate(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	params.Save(params.ProxyCfg.MTLSEnabled.Key, "true")
+	params.Save(params.ProxyCfg.MTLSUserMappingRules.Key, `[{"field": "cn", "match": "^[a-z]+$"}]`)
+	defer func() {
+		params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+		params.Reset(params.ProxyCfg.MTLSEnabled.Key)
+		params.Reset(params.ProxyCfg.MTLSUserMappingRules.Key)
+	}()
+	if globalMetaCache == nil {
+		globalMetaCache = NewMockCache(t)
+	}
+
+	md := metadata.MD{}
+	ctx := withPeerCertificate(metadata.NewIncomingContext(context.Background(), md), newTestClientCert(t, "reporter"))
+	newCtx, err := AuthenticationInterceptor(ctx)
+	assert.NoError(t, err)
+	user, err := GetCurUserFromContext(newCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, "reporter", user)
+
+	// root can't be mapped
+	ctx = withPeerCertificate(metadata.NewIncomingContext(context.Background(), metadata.MD{}), newTestClientCert(t, "root"))
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Error(t, err)
+
+	// none of rules matches
+	ctx = withPeerCertificate(metadata.NewIncomingContext(context.Background(), metadata.MD{}), newTestClientCert(t, "Reporter1"))
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Error(t, err)
+
+	// no client certificate
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), metadata.MD{}))
+	assert.Error(t, err)
+
+	// disabled
+	params.Save(params.ProxyCfg.MTLSEnabled.Key, "false")
+	ctx = withPeerCertificate(metadata.NewIncomingContext(context.Background(), metadata.MD{}), newTestClientCert(t, "reporter"))
+	_, err = AuthenticationInterceptor(ctx)
+	assert.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -49,12 +50,30 @@ func GetQuotaErrorString(errCode commonpb.ErrorCode) string {
 	return QuotaErrorString[errCode]
 }
 
+// rateLevel is the level of rateLimiter, which decides the default rates of limiter.
+type rateLevel int
+
+const (
+	globalLevel rateLevel = iota
+	databaseLevel
+	collectionLevel
+)
+
+// identityLimiterIdleTimeout is the idle time after which the limiter of user or role is removed, a limiter
+// idle for so long is refilled, so removing it doesn't change the limiting.
+const identityLimiterIdleTimeout = 10 * time.Minute
+
 // MultiRateLimiter includes multilevel rate limiters, such as global rateLimiter,
-// collection level rateLimiter and so on. It also implements Limiter interface.
+// database, user, role and collection level rateLimiter and so on. It also implements Limiter interface.
 type MultiRateLimiter struct {
 	quotaStatesMu sync.RWMutex
 	// for DML and DQL
+	databaseLimiters   map[string]*rateLimiter
 	collectionLimiters map[int64]*rateLimiter
+	// every user and role has its own limiter, which is created with the rates of level on demand,
+	// requests without user share the limiter of empty user
+	userLimiters *identityLimiters
+	roleLimiters *identityLimiters
 	// for DDL
 	globalDDLLimiter *rateLimiter
 }
@@ -62,14 +81,18 @@ type MultiRateLimiter struct {
 // NewMultiRateLimiter returns a new MultiRateLimiter.
 func NewMultiRateLimiter() *MultiRateLimiter {
 	m := &MultiRateLimiter{
+		databaseLimiters:   make(map[string]*rateLimiter, 0),
 		collectionLimiters: make(map[int64]*rateLimiter, 0),
-		globalDDLLimiter:   newRateLimiter(true),
+		userLimiters:       newIdentityLimiters(),
+		roleLimiters:       newIdentityLimiters(),
+		globalDDLLimiter:   newRateLimiter(globalLevel),
 	}
 	return m
 }
 
-// Check checks if request would be limited or denied.
-func (m *MultiRateLimiter) Check(collectionID int64, rt internalpb.RateType, n int) error {
+// Check checks if request would be limited or denied, the request of database, user and collection
+// passes only if all the limiters of global, database, user, roles of user and collection level allow it.
+func (m *MultiRateLimiter) Check(database string, user string, roles []string, collectionID int64, rt internalpb.RateType, n int) error {
 	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		return nil
 	}
@@ -94,17 +117,32 @@ func (m *MultiRateLimiter) Check(collectionID int64, rt internalpb.RateType, n i
 
 	// first, check global level rate limits
 	ret := checkFunc(m.globalDDLLimiter)
+	if ret != nil || IsDDLRequest(rt) {
+		return ret
+	}
 
-	// second check collection level rate limits
-	if ret == nil && !IsDDLRequest(rt) {
-		// only dml and dql have collection level rate limits
-		ret = checkFunc(m.collectionLimiters[collectionID])
-		if ret != nil {
-			m.globalDDLLimiter.cancel(rt, n)
+	// then check database, user, role and collection level rate limits,
+	// only dml and dql have them. Tokens taken by passed limiters are
+	// given back if the request is rejected by a lower level.
+	passed := []*rateLimiter{m.globalDDLLimiter}
+	limiters := []*rateLimiter{m.databaseLimiters[database], m.userLimiters.get(user)}
+	for _, role := range roles {
+		limiters = append(limiters, m.roleLimiters.get(role))
+	}
+	limiters = append(limiters, m.collectionLimiters[collectionID])
+	for _, limiter := range limiters {
+		if limiter == nil {
+			continue
+		}
+		if ret = checkFunc(limiter); ret != nil {
+			for _, p := range passed {
+				p.cancel(rt, n)
+			}
+			return ret
 		}
+		passed = append(passed, limiter)
 	}
-
-	return ret
+	return nil
 }
 
 func IsDDLRequest(rt internalpb.RateType) bool {
@@ -124,7 +162,7 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 	serviceStates := make(map[milvuspb.QuotaState]typeutil.Set[commonpb.ErrorCode])
 
 	// deduplicate same (state, code) pair from different collection
-	for _, limiter := range m.collectionLimiters {
+	collectStates := func(limiter *rateLimiter) {
 		limiter.quotaStates.Range(func(state milvuspb.QuotaState, errCode commonpb.ErrorCode) bool {
 			if serviceStates[state] == nil {
 				serviceStates[state] = typeutil.NewSet[commonpb.ErrorCode]()
@@ -133,6 +171,12 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 			return true
 		})
 	}
+	for _, limiter := range m.databaseLimiters {
+		collectStates(limiter)
+	}
+	for _, limiter := range m.collectionLimiters {
+		collectStates(limiter)
+	}
 
 	states := make([]milvuspb.QuotaState, 0)
 	reasons := make([]string, 0)
@@ -146,46 +190,150 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 	return states, reasons
 }
 
-// SetQuotaStates sets quota states for MultiRateLimiter.
+// getOrNewRateLimiter returns the rateLimiter of key, it's created with the default rates of level if absent.
+func getOrNewRateLimiter[K comparable](limiters map[K]*rateLimiter, key K, level rateLevel) *rateLimiter {
+	limiter, ok := limiters[key]
+	if !ok {
+		limiter = newRateLimiter(level)
+		limiters[key] = limiter
+	}
+	return limiter
+}
+
+// removeStaleRateLimiters removes the rateLimiters whose keys are not in set.
+func removeStaleRateLimiters[K comparable](limiters map[K]*rateLimiter, set typeutil.Set[K]) {
+	for key := range limiters {
+		if !set.Contain(key) {
+			delete(limiters, key)
+		}
+	}
+}
+
+// SetRates sets rates and quota states of database, user, role and collection level for MultiRateLimiter.
 func (m *MultiRateLimiter) SetRates(rates []*proxypb.CollectionRate) error {
 	m.quotaStatesMu.Lock()
 	defer m.quotaStatesMu.Unlock()
-	collectionSet := typeutil.NewUniqueSet()
+	databaseSet := typeutil.NewSet[string]()
+	collectionSet := typeutil.NewSet[int64]()
+	var userRates, roleRates *proxypb.CollectionRate
 	for _, collectionRates := range rates {
-		collectionSet.Insert(collectionRates.Collection)
-		rateLimiter, ok := m.collectionLimiters[collectionRates.GetCollection()]
-		if !ok {
-			rateLimiter = newRateLimiter(false)
+		var rateLimiter *rateLimiter
+		switch collectionRates.GetScope() {
+		case proxypb.RateScope_Database:
+			databaseSet.Insert(collectionRates.GetDatabase())
+			rateLimiter = getOrNewRateLimiter(m.databaseLimiters, collectionRates.GetDatabase(), databaseLevel)
+		case proxypb.RateScope_User:
+			userRates = collectionRates
+			continue
+		case proxypb.RateScope_Role:
+			roleRates = collectionRates
+			continue
+		default:
+			collectionSet.Insert(collectionRates.GetCollection())
+			rateLimiter = getOrNewRateLimiter(m.collectionLimiters, collectionRates.GetCollection(), collectionLevel)
 		}
 		err := rateLimiter.setRates(collectionRates)
 		if err != nil {
 			return err
 		}
-		m.collectionLimiters[collectionRates.GetCollection()] = rateLimiter
 	}
 
-	// remove dropped collection's rate limiter
-	for collectionID := range m.collectionLimiters {
-		if !collectionSet.Contain(collectionID) {
-			delete(m.collectionLimiters, collectionID)
-		}
+	if err := m.userLimiters.setRates(userRates); err != nil {
+		return err
+	}
+	if err := m.roleLimiters.setRates(roleRates); err != nil {
+		return err
 	}
+
+	// remove dropped database's and collection's rate limiter
+	removeStaleRateLimiters(m.databaseLimiters, databaseSet)
+	removeStaleRateLimiters(m.collectionLimiters, collectionSet)
 	return nil
 }
 
+// identityLimiters are the rate limiters of users or roles, all of them have the same rates. Users and
+// roles aren't known ahead, for example users authenticated by JWT or client certificate, so the
+// limiter of an identity is created on its first request.
+type identityLimiters struct {
+	// rates are the rates of every identity, nil if the level isn't limited
+	rates    atomic.Pointer[proxypb.CollectionRate]
+	limiters *typeutil.ConcurrentMap[string, *identityLimiter]
+}
+
+type identityLimiter struct {
+	*rateLimiter
+	lastActive atomic.Int64
+}
+
+func newIdentityLimiters() *identityLimiters {
+	return &identityLimiters{
+		limiters: typeutil.NewConcurrentMap[string, *identityLimiter](),
+	}
+}
+
+// get returns the rateLimiter of identity, nil if the level isn't limited.
+func (l *identityLimiters) get(identity string) *rateLimiter {
+	rates := l.rates.Load()
+	if rates == nil {
+		return nil
+	}
+	limiter, ok := l.limiters.Get(identity)
+	if !ok {
+		limiter, _ = l.limiters.GetOrInsert(identity, &identityLimiter{rateLimiter: newRateLimiterWithRates(rates)})
+	}
+	limiter.lastActive.Store(time.Now().UnixNano())
+	return limiter.rateLimiter
+}
+
+// setRates sets the rates of all identities, and removes the limiters idle for long.
+func (l *identityLimiters) setRates(rates *proxypb.CollectionRate) error {
+	l.rates.Store(rates)
+	if rates == nil {
+		l.limiters = typeutil.NewConcurrentMap[string, *identityLimiter]()
+		return nil
+	}
+	var err error
+	idleBefore := time.Now().Add(-identityLimiterIdleTimeout).UnixNano()
+	l.limiters.Range(func(identity string, limiter *identityLimiter) bool {
+		if limiter.lastActive.Load() < idleBefore {
+			l.limiters.Remove(identity)
+			return true
+		}
+		err = limiter.setRates(rates)
+		return err == nil
+	})
+	return err
+}
+
 // rateLimiter implements Limiter.
 type rateLimiter struct {
 	limiters    *typeutil.ConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter]
 	quotaStates *typeutil.ConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode]
 }
 
-// newRateLimiter returns a new RateLimiter.
-func newRateLimiter(globalLevel bool) *rateLimiter {
+// newRateLimiterWithRates returns a new RateLimiter of rates, which isn't refreshed by config.
+func newRateLimiterWithRates(rates *proxypb.CollectionRate) *rateLimiter {
+	rl := &rateLimiter{
+		limiters:    typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter](),
+		quotaStates: typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode](),
+	}
+	for _, r := range rates.GetRates() {
+		// use rate as burst, the same as registerLimiters
+		rl.limiters.Insert(r.GetRt(), ratelimitutil.NewLimiter(ratelimitutil.Limit(r.GetR()), r.GetR()))
+	}
+	for i := 0; i < len(rates.GetStates()); i++ {
+		rl.quotaStates.Insert(rates.GetStates()[i], rates.GetCodes()[i])
+	}
+	return rl
+}
+
+// newRateLimiter returns a new RateLimiter with the default rates of level.
+func newRateLimiter(level rateLevel) *rateLimiter {
 	rl := &rateLimiter{
 		limiters:    typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter](),
 		quotaStates: typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode](),
 	}
-	rl.registerLimiters(globalLevel)
+	rl.registerLimiters(level)
 	return rl
 }
 
@@ -210,12 +358,16 @@ func (rl *rateLimiter) cancel(rt internalpb.RateType, n int) {
 func (rl *rateLimiter) setRates(collectionRate *proxypb.CollectionRate) error {
 	log := log.Ctx(context.TODO()).WithRateGroup("proxy.rateLimiter", 1.0, 60.0).With(
 		zap.Int64("proxyNodeID", paramtable.GetNodeID()),
+		zap.String("scope", collectionRate.GetScope().String()),
 		zap.Int64("CollectionID", collectionRate.Collection),
+		zap.String("database", collectionRate.GetDatabase()),
 	)
 	for _, r := range collectionRate.GetRates() {
 		if limit, ok := rl.limiters.Get(r.GetRt()); ok {
 			limit.SetLimit(ratelimitutil.Limit(r.GetR()))
-			setRateGaugeByRateType(r.GetRt(), paramtable.GetNodeID(), collectionRate.Collection, r.GetR())
+			if collectionRate.GetScope() == proxypb.RateScope_Collection {
+				setRateGaugeByRateType(r.GetRt(), paramtable.GetNodeID(), collectionRate.Collection, r.GetR())
+			}
 		} else {
 			return fmt.Errorf("unregister rateLimiter for rateType %s", r.GetRt().String())
 		}
@@ -273,8 +425,20 @@ func setRateGaugeByRateType(rateType internalpb.RateType, nodeID int64, collecti
 	}
 }
 
+// levelRate returns the rate param of level.
+func levelRate(level rateLevel, global, database, collection *paramtable.ParamItem) *paramtable.ParamItem {
+	switch level {
+	case globalLevel:
+		return global
+	case databaseLevel:
+		return database
+	default:
+		return collection
+	}
+}
+
 // registerLimiters register limiter for all rate types.
-func (rl *rateLimiter) registerLimiters(globalLevel bool) {
+func (rl *rateLimiter) registerLimiters(level rateLevel) {
 	log := log.Ctx(context.TODO()).WithRateGroup("proxy.rateLimiter", 1.0, 60.0)
 	quotaConfig := &Params.QuotaConfig
 	for rt := range internalpb.RateType_name {
@@ -291,41 +455,17 @@ func (rl *rateLimiter) registerLimiters(globalLevel bool) {
 		case internalpb.RateType_DDLCompaction:
 			r = &quotaConfig.MaxCompactionRate
 		case internalpb.RateType_DMLInsert:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxInsertRate
-			} else {
-				r = &quotaConfig.DMLMaxInsertRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DMLMaxInsertRate, &quotaConfig.DMLMaxInsertRatePerDB, &quotaConfig.DMLMaxInsertRatePerCollection)
 		case internalpb.RateType_DMLUpsert:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxUpsertRate
-			} else {
-				r = &quotaConfig.DMLMaxUpsertRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DMLMaxUpsertRate, &quotaConfig.DMLMaxUpsertRatePerDB, &quotaConfig.DMLMaxUpsertRatePerCollection)
 		case internalpb.RateType_DMLDelete:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxDeleteRate
-			} else {
-				r = &quotaConfig.DMLMaxDeleteRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DMLMaxDeleteRate, &quotaConfig.DMLMaxDeleteRatePerDB, &quotaConfig.DMLMaxDeleteRatePerCollection)
 		case internalpb.RateType_DMLBulkLoad:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxBulkLoadRate
-			} else {
-				r = &quotaConfig.DMLMaxBulkLoadRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DMLMaxBulkLoadRate, &quotaConfig.DMLMaxBulkLoadRatePerDB, &quotaConfig.DMLMaxBulkLoadRatePerCollection)
 		case internalpb.RateType_DQLSearch:
-			if globalLevel {
-				r = &quotaConfig.DQLMaxSearchRate
-			} else {
-				r = &quotaConfig.DQLMaxSearchRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DQLMaxSearchRate, &quotaConfig.DQLMaxSearchRatePerDB, &quotaConfig.DQLMaxSearchRatePerCollection)
 		case internalpb.RateType_DQLQuery:
-			if globalLevel {
-				r = &quotaConfig.DQLMaxQueryRate
-			} else {
-				r = &quotaConfig.DQLMaxQueryRatePerCollection
-			}
+			r = levelRate(level, &quotaConfig.DQLMaxQueryRate, &quotaConfig.DQLMaxQueryRatePerDB, &quotaConfig.DQLMaxQueryRatePerCollection)
 		}
 		limit := ratelimitutil.Limit(r.GetAsFloat())
 		burst := r.GetAsFloat() // use rate as burst, because Limiter is with punishment mechanism, burst is insignificant.
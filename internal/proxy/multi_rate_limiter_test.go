@@ -42,7 +42,7 @@ func TestMultiRateLimiter(t *testing.T) {
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(collectionLevel)
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
 				multiLimiter.globalDDLLimiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
@@ -52,18 +52,18 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), math.MaxInt)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), math.MaxInt)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
@@ -74,9 +74,9 @@ func TestMultiRateLimiter(t *testing.T) {
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[1] = newRateLimiter(false)
-		multiLimiter.collectionLimiters[2] = newRateLimiter(false)
-		multiLimiter.collectionLimiters[3] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[1] = newRateLimiter(collectionLevel)
+		multiLimiter.collectionLimiters[2] = newRateLimiter(collectionLevel)
+		multiLimiter.collectionLimiters[3] = newRateLimiter(collectionLevel)
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
 				multiLimiter.globalDDLLimiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
@@ -89,18 +89,18 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check(1, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", "", nil, 1, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(1, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", "", nil, 1, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(1, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", "", nil, 1, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check(1, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", "", nil, 1, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(2, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check("", "", nil, 2, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(3, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check("", "", nil, 3, internalpb.RateType(rt), 1)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
@@ -109,11 +109,11 @@ func TestMultiRateLimiter(t *testing.T) {
 
 	t.Run("not enable quotaAndLimit", func(t *testing.T) {
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(collectionLevel)
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
 		for _, rt := range internalpb.RateType_value {
-			err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+			err := multiLimiter.Check("", "", nil, collectionID, internalpb.RateType(rt), 1)
 			assert.NoError(t, err)
 		}
 		Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
@@ -126,7 +126,7 @@ func TestMultiRateLimiter(t *testing.T) {
 			multiLimiter := NewMultiRateLimiter()
 			bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 			paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
-			err := multiLimiter.Check(collectionID, internalpb.RateType_DMLInsert, 1*1024*1024)
+			err := multiLimiter.Check("", "", nil, collectionID, internalpb.RateType_DMLInsert, 1*1024*1024)
 			assert.NoError(t, err)
 			Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
 			Params.Save(Params.QuotaConfig.DMLMaxInsertRate.Key, bakInsertRate)
@@ -200,11 +200,87 @@ func TestMultiRateLimiter(t *testing.T) {
 		assert.Contains(t, codes, GetQuotaErrorString(commonpb.ErrorCode_DiskQuotaExhausted))
 		assert.Contains(t, codes, GetQuotaErrorString(commonpb.ErrorCode_ForceDeny))
 	})
+
+	t.Run("test database, user and role limits", func(t *testing.T) {
+		multiLimiter := NewMultiRateLimiter()
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+
+		zeroRates := []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 0}}
+		err := multiLimiter.SetRates([]*proxypb.CollectionRate{
+			{Collection: 1},
+			{
+				Scope: proxypb.RateScope_Database, Database: "db1", Rates: zeroRates,
+				States: []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite},
+				Codes:  []commonpb.ErrorCode{commonpb.ErrorCode_ForceDeny},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, multiLimiter.collectionLimiters, 1)
+		assert.Len(t, multiLimiter.databaseLimiters, 1)
+
+		err = multiLimiter.Check("db1", "user1", nil, 1, internalpb.RateType_DMLInsert, 1)
+		assert.Error(t, err)
+		err = multiLimiter.Check("db2", "user1", []string{"role1"}, 1, internalpb.RateType_DMLInsert, 1)
+		assert.NoError(t, err)
+		// users and roles aren't limited without rates
+		assert.Equal(t, 0, multiLimiter.userLimiters.limiters.Len())
+		assert.Equal(t, 0, multiLimiter.roleLimiters.limiters.Len())
+		// ddl is limited only by global limiter
+		err = multiLimiter.Check("db1", "user1", nil, 1, internalpb.RateType_DDLCollection, 1)
+		assert.NoError(t, err)
+
+		// every user, including the unknown one, has its own limiter, which allows requests until its tokens run out
+		userRates := []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 2}}
+		err = multiLimiter.SetRates([]*proxypb.CollectionRate{
+			{Collection: 1},
+			{Scope: proxypb.RateScope_User, Rates: userRates},
+		})
+		assert.NoError(t, err)
+		for _, user := range []string{"user1", "jwt-user", ""} {
+			err = multiLimiter.Check("db1", user, nil, 1, internalpb.RateType_DMLInsert, 3)
+			assert.NoError(t, err)
+			err = multiLimiter.Check("db1", user, nil, 1, internalpb.RateType_DMLInsert, 3)
+			assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		}
+		assert.Equal(t, 3, multiLimiter.userLimiters.limiters.Len())
+
+		// the limiter of role is shared by its users
+		err = multiLimiter.SetRates([]*proxypb.CollectionRate{
+			{Collection: 1},
+			{Scope: proxypb.RateScope_Role, Rates: userRates},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, multiLimiter.userLimiters.limiters.Len())
+		err = multiLimiter.Check("db1", "user1", []string{"role1"}, 1, internalpb.RateType_DMLInsert, 3)
+		assert.NoError(t, err)
+		err = multiLimiter.Check("db1", "user2", []string{"role1"}, 1, internalpb.RateType_DMLInsert, 3)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		err = multiLimiter.Check("db1", "user2", []string{"role2"}, 1, internalpb.RateType_DMLInsert, 3)
+		assert.NoError(t, err)
+
+		// idle limiters are removed
+		limiter, _ := multiLimiter.roleLimiters.limiters.Get("role1")
+		limiter.lastActive.Store(time.Now().Add(-2 * identityLimiterIdleTimeout).UnixNano())
+		err = multiLimiter.SetRates([]*proxypb.CollectionRate{
+			{Scope: proxypb.RateScope_Database, Database: "db2"},
+			{Scope: proxypb.RateScope_Role, Rates: userRates},
+		})
+		assert.NoError(t, err)
+		assert.False(t, multiLimiter.roleLimiters.limiters.Contain("role1"))
+		assert.True(t, multiLimiter.roleLimiters.limiters.Contain("role2"))
+
+		// stale limiters are removed
+		assert.Len(t, multiLimiter.collectionLimiters, 0)
+		assert.Contains(t, multiLimiter.databaseLimiters, "db2")
+		assert.NotContains(t, multiLimiter.databaseLimiters, "db1")
+	})
 }
 
 func TestRateLimiter(t *testing.T) {
 	t.Run("test limit", func(t *testing.T) {
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel)
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -219,7 +295,7 @@ func TestRateLimiter(t *testing.T) {
 	})
 
 	t.Run("test setRates", func(t *testing.T) {
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel)
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -259,7 +335,7 @@ func TestRateLimiter(t *testing.T) {
 	})
 
 	t.Run("test get error code", func(t *testing.T) {
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel)
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -288,7 +364,7 @@ func TestRateLimiter(t *testing.T) {
 	})
 
 	t.Run("tests refresh rate by config", func(t *testing.T) {
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel)
 
 		etcdCli, _ := etcd.GetEtcdClient(
 			Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
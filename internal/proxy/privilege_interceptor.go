@@ -2,22 +2,31 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
 	jsonadapter "github.com/casbin/json-adapter/v2"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type PrivilegeFunc func(ctx context.Context, req interface{}) (context.Context, error)
@@ -67,7 +76,7 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		return ctx, nil
 	}
 	log.Debug("PrivilegeInterceptor", zap.String("type", reflect.TypeOf(req).String()))
-	privilegeExt, err := funcutil.GetPrivilegeExtObj(req)
+	privilegeExt, err := getPrivilegeExt(req)
 	if err != nil {
 		log.Info("GetPrivilegeExtObj err", zap.Error(err))
 		return ctx, nil
@@ -85,6 +94,7 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		log.Warn("GetRole fail", zap.String("username", username), zap.Error(err))
 		return ctx, err
 	}
+	roleNames = append(roleNames, getJWTRoles(ctx)...)
 	roleNames = append(roleNames, util.RolePublic)
 	objectType := privilegeExt.ObjectType.String()
 	objectNameIndex := privilegeExt.ObjectNameIndex
@@ -106,16 +116,11 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		zap.String("policy_info", policyInfo))
 
 	policy := fmt.Sprintf("[%s]", policyInfo)
-	b := []byte(policy)
-	a := jsonadapter.NewAdapter(&b)
-	// the `templateModel` object isn't safe in the concurrent situation
-	casbinModel := templateModel.Copy()
-	e, err := casbin.NewEnforcer(casbinModel, a)
+	e, err := privilegeEnforcer.get(ctx)
 	if err != nil {
 		log.Warn("NewEnforcer fail", zap.String("policy", policy), zap.Error(err))
 		return ctx, err
 	}
-	e.AddFunction("dbMatch", DBMatchFunc)
 	for _, roleName := range roleNames {
 		permitFunc := func(resName string) (bool, error) {
 			object := funcutil.PolicyForResource(dbName, objectType, resName)
@@ -162,6 +167,95 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	return ctx, status.Error(codes.PermissionDenied, fmt.Sprintf("%s: permission deny", objectPrivilege))
 }
 
+// privilegeExtsOutOfProto are the privileges of requests which aren't defined by milvus proto, or whose
+// privilege of milvus proto can't be granted yet. Schema evolution changes the schema of collection,
+// so adding a field requires the privilege to create collections and dropping a field requires the
+// privilege to drop collections, the same as altering a field by milvus proto. Row policies limit the
+// rows visible to roles, so they are managed by the admin privileges of roles and grants instead of
+// the ownership of collection.
+var privilegeExtsOutOfProto = map[reflect.Type]commonpb.PrivilegeExt{
+	reflect.TypeOf(&milvuspb.AddCollectionFieldRequest{}): {
+		ObjectType:      commonpb.ObjectType_Global,
+		ObjectPrivilege: commonpb.ObjectPrivilege_PrivilegeCreateCollection,
+		ObjectNameIndex: -1,
+	},
+	reflect.TypeOf(&rootcoordpb.DropCollectionFieldRequest{}): {
+		ObjectType:      commonpb.ObjectType_Global,
+		ObjectPrivilege: commonpb.ObjectPrivilege_PrivilegeDropCollection,
+		ObjectNameIndex: -1,
+	},
+	reflect.TypeOf(&milvuspb.CreateRowPolicyRequest{}): {
+		ObjectType:      commonpb.ObjectType_Global,
+		ObjectPrivilege: commonpb.ObjectPrivilege_PrivilegeCreateOwnership,
+		ObjectNameIndex: -1,
+	},
+	reflect.TypeOf(&milvuspb.DropRowPolicyRequest{}): {
+		ObjectType:      commonpb.ObjectType_Global,
+		ObjectPrivilege: commonpb.ObjectPrivilege_PrivilegeDropOwnership,
+		ObjectNameIndex: -1,
+	},
+	reflect.TypeOf(&milvuspb.ListRowPoliciesRequest{}): {
+		ObjectType:      commonpb.ObjectType_Global,
+		ObjectPrivilege: commonpb.ObjectPrivilege_PrivilegeSelectOwnership,
+		ObjectNameIndex: -1,
+	},
+}
+
+func getPrivilegeExt(req interface{}) (commonpb.PrivilegeExt, error) {
+	if privilegeExt, ok := privilegeExtsOutOfProto[reflect.TypeOf(req)]; ok {
+		return privilegeExt, nil
+	}
+	return funcutil.GetPrivilegeExtObj(req)
+}
+
+func newPrivilegeEnforcer(policy string) (*casbin.SyncedEnforcer, error) {
+	b := []byte(policy)
+	a := jsonadapter.NewAdapter(&b)
+	// the `templateModel` object isn't safe in the concurrent situation
+	casbinModel := templateModel.Copy()
+	e, err := casbin.NewSyncedEnforcer(casbinModel, a)
+	if err != nil {
+		return nil, err
+	}
+	e.AddFunction("dbMatch", DBMatchFunc)
+	return e, nil
+}
+
+// privilegeEnforcerCache caches the enforcer of privilege infos in meta cache, since creating it
+// for every request is expensive. It's invalidated when the privileges are refreshed, and the
+// policies are compared as well so an enforcer of stale policies is never used.
+type privilegeEnforcerCache struct {
+	mu       sync.Mutex
+	policy   string
+	enforcer *casbin.SyncedEnforcer
+}
+
+var privilegeEnforcer = &privilegeEnforcerCache{}
+
+func (c *privilegeEnforcerCache) get(ctx context.Context) (*casbin.SyncedEnforcer, error) {
+	policyInfos := globalMetaCache.GetPrivilegeInfo(ctx)
+	sort.Strings(policyInfos)
+	policy := fmt.Sprintf("[%s]", strings.Join(policyInfos, ","))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.enforcer != nil && c.policy == policy {
+		return c.enforcer, nil
+	}
+	e, err := newPrivilegeEnforcer(policy)
+	if err != nil {
+		return nil, err
+	}
+	c.policy, c.enforcer = policy, e
+	return e, nil
+}
+
+func (c *privilegeEnforcerCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policy, c.enforcer = "", nil
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
@@ -172,6 +266,90 @@ func isCurUserObject(objectType string, curUser string, object string) bool {
 	return curUser == object
 }
 
+// grantPolicy is the grant in privilege info of meta cache, see funcutil.PolicyForPrivilege.
+type grantPolicy struct {
+	V0 string
+	V1 string
+	V2 string
+}
+
+// getDeniedFields returns the fields the user of request isn't granted to read by the privilege.
+// Only the fields which field-level privileges are granted on are restricted, and the primary key is never restricted
+// since entities are identified by it. The request without user is rejected when authorization is enabled.
+func getDeniedFields(ctx context.Context, dbName string, schema *schemapb.CollectionSchema, privilege commonpb.ObjectPrivilege, fields []string) ([]string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() || globalMetaCache == nil || len(fields) == 0 {
+		return nil, nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(merr.ErrNeedAuthenticate, "field privileges can't be checked for request without user")
+	}
+	if username == util.UserRoot {
+		return nil, nil
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+
+	// resource of field -> field name
+	resources := make(map[string]string)
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() || !lo.Contains(fields, field.GetName()) {
+			continue
+		}
+		objectName := funcutil.FieldObjectName(schema.GetName(), field.GetName())
+		resources[funcutil.PolicyForResource(dbName, util.ObjectTypeField, objectName)] = field.GetName()
+	}
+	policyInfos := globalMetaCache.GetPrivilegeInfo(ctx)
+	restricted := make([]string, 0)
+	for _, info := range policyInfos {
+		policy := grantPolicy{}
+		if err := json.Unmarshal([]byte(info), &policy); err != nil {
+			continue
+		}
+		if field, ok := resources[policy.V1]; ok && !lo.Contains(restricted, field) {
+			restricted = append(restricted, field)
+		}
+	}
+	if len(restricted) == 0 {
+		return nil, nil
+	}
+
+	roleNames, err := GetRole(username)
+	if err != nil {
+		return nil, err
+	}
+	roleNames = append(roleNames, getJWTRoles(ctx)...)
+	roleNames = append(roleNames, util.RolePublic)
+	e, err := privilegeEnforcer.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	denied := make([]string, 0)
+	for _, field := range restricted {
+		object := funcutil.PolicyForResource(dbName, util.ObjectTypeField, funcutil.FieldObjectName(schema.GetName(), field))
+		permitted := false
+		for _, roleName := range roleNames {
+			permitted, err = e.Enforce(roleName, object, privilege.String())
+			if err != nil {
+				return nil, err
+			}
+			if permitted {
+				break
+			}
+		}
+		if !permitted {
+			denied = append(denied, field)
+		}
+	}
+	if len(denied) > 0 {
+		log.Ctx(ctx).Info("field privilege denied", zap.String("username", username), zap.Strings("role_names", roleNames),
+			zap.String("db_name", dbName), zap.String("collection_name", schema.GetName()),
+			zap.String("privilege", privilege.String()), zap.Strings("fields", denied))
+	}
+	return denied, nil
+}
+
 func DBMatchFunc(args ...interface{}) (interface{}, error) {
 	name1 := args[0].(string)
 	name2 := args[1].(string)
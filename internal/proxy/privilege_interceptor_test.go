@@ -9,11 +9,16 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func TestUnaryServerInterceptor(t *testing.T) {
@@ -221,3 +226,187 @@ func TestResourceGroupPrivilege(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestCollectionFieldPrivilege(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	client := &MockRootCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				// the privileges of data don't permit changing the schema
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeInsert.String(), "default"),
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeDelete.String(), "default"),
+				funcutil.PolicyForPrivilege("role2", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeCreateCollection.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("alice", "role1"),
+				funcutil.EncodeUserRoleCache("bob", "role2"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(context.Background(), client, &mocks.MockQueryCoordClient{}, newShardClientMgr())
+	assert.NoError(t, err)
+
+	ctx := GetContext(context.Background(), "alice:123456")
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &rootcoordpb.DropCollectionFieldRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+
+	ctx = GetContext(context.Background(), "bob:123456")
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.AddCollectionFieldRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &rootcoordpb.DropCollectionFieldRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+}
+
+func TestRowPolicyPrivilege(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	client := &MockRootCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				// the privileges of collection don't permit managing the rows visible to roles
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeAll.String(), "default"),
+				funcutil.PolicyForPrivilege("role2", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeSelectOwnership.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("alice", "role1"),
+				funcutil.EncodeUserRoleCache("bob", "role2"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(context.Background(), client, &mocks.MockQueryCoordClient{}, newShardClientMgr())
+	assert.NoError(t, err)
+
+	ctx := GetContext(context.Background(), "alice:123456")
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.CreateRowPolicyRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.DropRowPolicyRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.ListRowPoliciesRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+
+	ctx = GetContext(context.Background(), "bob:123456")
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.CreateRowPolicyRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.ListRowPoliciesRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+
+	ctx = GetContext(context.Background(), "root:123456")
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.CreateRowPolicyRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+}
+
+func TestFieldPrivileges(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	client := &MockRootCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", util.ObjectTypeField, funcutil.FieldObjectName("col1", "ssn"), commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+				funcutil.PolicyForPrivilege("role2", util.ObjectTypeField, funcutil.FieldObjectName("col1", "*"), commonpb.ObjectPrivilege_PrivilegeSearch.String(), "default"),
+				funcutil.PolicyForPrivilege("role2", util.ObjectTypeField, funcutil.FieldObjectName("col1", "email"), commonpb.ObjectPrivilege_PrivilegeSearch.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("alice", "role1"),
+				funcutil.EncodeUserRoleCache("bob", "role2"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(context.Background(), client, &mocks.MockQueryCoordClient{}, newShardClientMgr())
+	assert.NoError(t, err)
+
+	schema := &schemapb.CollectionSchema{
+		Name: "col1",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "ssn", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "email", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	fields := []string{"pk", "ssn", "email", "age"}
+	aliceCtx := GetContext(context.Background(), "alice:123456")
+	bobCtx := GetContext(context.Background(), "bob:123456")
+
+	// fields without field privileges aren't restricted
+	denied, err := getDeniedFields(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, fields)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"email"}, denied)
+	denied, err = getDeniedFields(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeSearch, fields)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ssn", "email"}, denied)
+	// granted by wildcard
+	denied, err = getDeniedFields(bobCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeSearch, fields)
+	assert.NoError(t, err)
+	assert.Empty(t, denied)
+	denied, err = getDeniedFields(GetContext(context.Background(), "root:123456"), "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, fields)
+	assert.NoError(t, err)
+	assert.Empty(t, denied)
+	// privileges of other database
+	denied, err = getDeniedFields(aliceCtx, "db1", schema, commonpb.ObjectPrivilege_PrivilegeQuery, fields)
+	assert.NoError(t, err)
+	assert.Empty(t, denied)
+	// request without user is denied
+	_, err = getDeniedFields(context.Background(), "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, fields)
+	assert.ErrorIs(t, err, merr.ErrNeedAuthenticate)
+
+	// denied fields can't be filtered
+	err = checkFilterFieldPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, `age > 10 and not (email like "a%")`)
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+	err = checkFilterFieldPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, `age > 10 and ssn == "1"`)
+	assert.NoError(t, err)
+	err = checkColumnPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, []*planpb.ColumnInfo{{FieldId: 102}})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+
+	// denied fields requested explicitly are rejected
+	_, _, _, err = applyFieldPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, []string{"email"}, fields, fields)
+	assert.Error(t, err)
+	outputFields, userOutputFields, masked, err := applyFieldPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, []string{"*"}, fields, fields)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pk", "ssn", "age"}, outputFields)
+	assert.Equal(t, []string{"pk", "ssn", "age"}, userOutputFields)
+	assert.Empty(t, masked)
+
+	params.Save(params.ProxyCfg.FieldPrivilegeDeniedAction.Key, "mask")
+	defer params.Reset(params.ProxyCfg.FieldPrivilegeDeniedAction.Key)
+	outputFields, userOutputFields, masked, err = applyFieldPrivileges(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, []string{"email"}, fields, fields)
+	assert.NoError(t, err)
+	assert.Equal(t, fields, outputFields)
+	assert.Equal(t, fields, userOutputFields)
+	assert.Equal(t, []string{"email"}, masked)
+
+	// the enforcer is cached until the privileges are refreshed
+	e1, err := privilegeEnforcer.get(context.Background())
+	assert.NoError(t, err)
+	e2, err := privilegeEnforcer.get(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, e1, e2)
+	err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{
+		OpType: typeutil.CacheGrantPrivilege,
+		OpKey:  funcutil.PolicyForPrivilege("role1", util.ObjectTypeField, funcutil.FieldObjectName("col1", "email"), commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+	})
+	assert.NoError(t, err)
+	e3, err := privilegeEnforcer.get(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, e1, e3)
+	denied, err = getDeniedFields(aliceCtx, "", schema, commonpb.ObjectPrivilege_PrivilegeQuery, fields)
+	assert.NoError(t, err)
+	assert.Empty(t, denied)
+}
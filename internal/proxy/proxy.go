@@ -119,6 +119,10 @@ type Proxy struct {
 	// resource manager
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
+
+	// server side cursors of query and search iterators
+	queryCursorMgr  *cursorManager[*queryCursor]
+	searchCursorMgr *cursorManager[*searchCursor]
 }
 
 // NewProxy returns a Proxy struct.
@@ -141,6 +145,8 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		lbPolicy:               lbPolicy,
 		resourceManager:        resourceManager,
 		replicateStreamManager: replicateStreamManager,
+		queryCursorMgr:         newCursorManager[*queryCursor](),
+		searchCursorMgr:        newCursorManager[*searchCursor](),
 	}
 	node.UpdateStateCode(commonpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -220,6 +226,12 @@ func (node *Proxy) Init() error {
 	accesslog.SetupAccseeLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	if err := accesslog.SetupAuditLog(node.ctx, &Params.ProxyCfg.AuditLog, &Params.MinioCfg, &Params.KafkaCfg); err != nil {
+		log.Warn("failed to init audit log for Proxy", zap.Error(err))
+		return err
+	}
+	log.Debug("init audit log for Proxy done")
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
@@ -477,6 +489,8 @@ func (node *Proxy) Stop() error {
 
 	GetConnectionManager().stop()
 
+	accesslog.CloseAuditLog()
+
 	return nil
 }
 
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// QueryIteratorKey in query params opens a query iterator, the value is "true".
+	QueryIteratorKey = "iterator"
+	// QueryIteratorTokenKey in query params resumes the query iterator of the token,
+	// it's also the grpc header key which carries the token of next page.
+	QueryIteratorTokenKey = "iterator_token"
+)
+
+// queryCursor is the server side state of a query iterator. The MVCC timestamp is pinned
+// by the first page, and every page only reads entities whose primary keys are greater
+// than the last primary key returned from the same shard.
+type queryCursor struct {
+	dbName         string
+	collectionName string
+	collectionID   int64
+	expr           string
+	outputFields   []string
+	partitionNames []string
+	batchSize      int64
+
+	pkField   *schemapb.FieldSchema
+	mvccTs    uint64
+	lastPKs   map[string]interface{} // channel -> last returned primary key
+	exhausted typeutil.Set[string]   // channels which have no more entities
+}
+
+// shardExpr returns the filter expression of the channel for the next page, expr is the
+// expression of the page which the row policies are applied to.
+func (c *queryCursor) shardExpr(channel string, expr string) string {
+	lastPK, ok := c.lastPKs[channel]
+	if !ok {
+		return expr
+	}
+	var pkExpr string
+	switch pk := lastPK.(type) {
+	case int64:
+		pkExpr = fmt.Sprintf("%s > %d", c.pkField.GetName(), pk)
+	case string:
+		pkExpr = fmt.Sprintf("%s > %s", c.pkField.GetName(), strconv.Quote(pk))
+	}
+	if strings.TrimSpace(expr) == "" {
+		return pkExpr
+	}
+	return fmt.Sprintf("(%s) && %s", expr, pkExpr)
+}
+
+// advance moves the cursor after the page, shardResults are the retrieve results of each channel
+// and page is the reduced result returned to client. It returns false if no more entities left.
+func (c *queryCursor) advance(shardResults map[string]*internalpb.RetrieveResults, page []*schemapb.FieldData) (bool, error) {
+	pkData, err := typeutil.GetPrimaryFieldData(page, c.pkField)
+	if err != nil {
+		return false, err
+	}
+	size := typeutil.GetPKSize(pkData)
+	if size == 0 {
+		return false, nil
+	}
+	// every shard returns its smallest primary keys, so every entity not greater than the max
+	// primary key of page has been returned, no matter which shard it comes from.
+	lastPK := typeutil.GetData(pkData, 0)
+	for i := 1; i < size; i++ {
+		if pk := typeutil.GetData(pkData, i); typeutil.ComparePK(lastPK, pk) {
+			lastPK = pk
+		}
+	}
+
+	for channel, result := range shardResults {
+		ids := result.GetIds()
+		n := typeutil.GetSizeOfIDs(ids)
+		if n == 0 {
+			c.exhausted.Insert(channel)
+			continue
+		}
+		c.lastPKs[channel] = lastPK
+		// the shard has no more entities if all of its entities are in the page
+		returned := true
+		for i := 0; i < n; i++ {
+			if typeutil.ComparePK(lastPK, typeutil.GetPK(ids, int64(i))) {
+				returned = false
+				break
+			}
+		}
+		if int64(n) < c.batchSize && returned {
+			c.exhausted.Insert(channel)
+		}
+	}
+
+	for channel := range shardResults {
+		if !c.exhausted.Contain(channel) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
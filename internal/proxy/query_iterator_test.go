@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type QueryIteratorSuite struct {
+	suite.Suite
+	pkField *schemapb.FieldSchema
+}
+
+func (s *QueryIteratorSuite) SetupSuite() {
+	paramtable.Init()
+	s.pkField = &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+}
+
+func (s *QueryIteratorSuite) newCursor(expr string) *queryCursor {
+	return &queryCursor{
+		collectionName: "test_iterator",
+		expr:           expr,
+		batchSize:      3,
+		pkField:        s.pkField,
+		lastPKs:        make(map[string]interface{}),
+		exhausted:      typeutil.NewSet[string](),
+	}
+}
+
+func (s *QueryIteratorSuite) genResult(pks ...int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+	}
+}
+
+func (s *QueryIteratorSuite) genPage(pks ...int64) []*schemapb.FieldData {
+	return []*schemapb.FieldData{getFieldData("pk", 100, schemapb.DataType_Int64, pks, 1)}
+}
+
+func (s *QueryIteratorSuite) TestShardExpr() {
+	cursor := s.newCursor("age > 10")
+	s.Equal("age > 10", cursor.shardExpr("ch1", "age > 10"))
+
+	cursor.lastPKs["ch1"] = int64(5)
+	s.Equal("(age > 10) && pk > 5", cursor.shardExpr("ch1", "age > 10"))
+	// expression of the page with row policies applied
+	s.Equal("((age > 10) && (tenant == 1)) && pk > 5", cursor.shardExpr("ch1", "(age > 10) && (tenant == 1)"))
+
+	cursor = s.newCursor("")
+	cursor.lastPKs["ch1"] = int64(5)
+	s.Equal("pk > 5", cursor.shardExpr("ch1", ""))
+
+	cursor.pkField = &schemapb.FieldSchema{FieldID: 100, Name: "name", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar}
+	cursor.lastPKs["ch1"] = "a\"b"
+	s.Equal(`name > "a\"b"`, cursor.shardExpr("ch1", ""))
+}
+
+func (s *QueryIteratorSuite) TestAdvance() {
+	cursor := s.newCursor("")
+
+	// ch1 has 1, 2, 4, 6, ch2 has 3, 5
+	more, err := cursor.advance(map[string]*internalpb.RetrieveResults{
+		"ch1": s.genResult(1, 2, 4),
+		"ch2": s.genResult(3, 5),
+	}, s.genPage(1, 2, 3))
+	s.NoError(err)
+	s.True(more)
+	s.Equal(int64(3), cursor.lastPKs["ch1"])
+	s.Equal(int64(3), cursor.lastPKs["ch2"])
+	s.False(cursor.exhausted.Contain("ch1"))
+	s.False(cursor.exhausted.Contain("ch2"))
+
+	more, err = cursor.advance(map[string]*internalpb.RetrieveResults{
+		"ch1": s.genResult(4, 6),
+		"ch2": s.genResult(5),
+	}, s.genPage(4, 5, 6))
+	s.NoError(err)
+	s.False(more)
+	s.True(cursor.exhausted.Contain("ch1"))
+	s.True(cursor.exhausted.Contain("ch2"))
+
+	// entities of shards and page aren't ordered by primary key, the max one is the cursor
+	cursor = s.newCursor("")
+	more, err = cursor.advance(map[string]*internalpb.RetrieveResults{
+		"ch1": s.genResult(4, 1),
+		"ch2": s.genResult(2, 7),
+	}, s.genPage(2, 4, 1))
+	s.NoError(err)
+	s.True(more)
+	s.Equal(int64(4), cursor.lastPKs["ch1"])
+	s.Equal(int64(4), cursor.lastPKs["ch2"])
+	s.True(cursor.exhausted.Contain("ch1"))
+	s.False(cursor.exhausted.Contain("ch2"))
+
+	// empty page
+	cursor = s.newCursor("")
+	more, err = cursor.advance(map[string]*internalpb.RetrieveResults{}, s.genPage())
+	s.NoError(err)
+	s.False(more)
+
+	// no pk in page
+	_, err = cursor.advance(map[string]*internalpb.RetrieveResults{}, nil)
+	s.Error(err)
+}
+
+func (s *QueryIteratorSuite) TestPrimaryKeyOrder() {
+	schema := &schemapb.CollectionSchema{
+		Name:   "test_iterator",
+		Fields: []*schemapb.FieldSchema{s.pkField, {FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64}},
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, "age > 10")
+	s.Require().NoError(err)
+	task := &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{CollectionID: 1, Limit: 3},
+		request:         &milvuspb.QueryRequest{Expr: "age > 10"},
+		schema:          schema,
+		plan:            plan,
+		queryParams:     &queryParams{limit: 3},
+		cursor:          s.newCursor("age > 10"),
+	}
+	task.cursor.pkField = nil
+	s.NoError(task.initCursor())
+	s.Equal(s.pkField, task.cursor.pkField)
+	s.NotNil(task.queryParams.order)
+	s.Require().Len(task.plan.GetQuery().GetOrderBy(), 1)
+	s.Equal(int64(100), task.plan.GetQuery().GetOrderBy()[0].GetColumn().GetFieldId())
+	s.True(task.plan.GetQuery().GetOrderBy()[0].GetAscending())
+
+	// the plans of next pages keep the order
+	task.cursor.lastPKs["ch1"] = int64(5)
+	bs, err := task.createShardPlan("ch1")
+	s.NoError(err)
+	shardPlan := &planpb.PlanNode{}
+	s.NoError(proto.Unmarshal(bs, shardPlan))
+	s.Equal(task.plan.GetQuery().GetOrderBy(), shardPlan.GetQuery().GetOrderBy())
+	s.Equal(int64(3), shardPlan.GetQuery().GetLimit())
+}
+
+func TestQueryIterator(t *testing.T) {
+	suite.Run(t, new(QueryIteratorSuite))
+}
+
+func TestIsIteratorRequest(t *testing.T) {
+	assert.False(t, isIteratorRequest(nil))
+	assert.True(t, isIteratorRequest([]*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "true"}}))
+	assert.False(t, isIteratorRequest([]*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "false"}}))
+	assert.True(t, isIteratorRequest([]*commonpb.KeyValuePair{{Key: QueryIteratorTokenKey, Value: "token"}}))
+	assert.False(t, isIteratorRequest([]*commonpb.KeyValuePair{{Key: QueryIteratorTokenKey, Value: ""}}))
+}
@@ -23,11 +23,13 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
@@ -39,7 +41,9 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		err = limiter.Check(collectionID, rt, n)
+		database := getRequestDBName(ctx, req)
+		user, _ := GetCurUserFromContext(ctx)
+		err = limiter.Check(database, user, getRateLimitRoles(ctx, user), collectionID, rt, n)
 		if err != nil {
 			rsp := getFailedResponse(req, rt, err, info.FullMethod)
 			if rsp != nil {
@@ -50,6 +54,25 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	}
 }
 
+// getRequestDBName returns the database of request, the database of metadata is used if the request doesn't give one.
+func getRequestDBName(ctx context.Context, req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok && r.GetDbName() != "" {
+		return r.GetDbName()
+	}
+	return GetCurDBNameFromContextOrDefault(ctx)
+}
+
+// getRateLimitRoles returns the roles of user whose rate limits apply to the request. The public role
+// is granted to every user, so it isn't limited as a role.
+func getRateLimitRoles(ctx context.Context, user string) []string {
+	var roles []string
+	if user != "" && globalMetaCache != nil {
+		roles = globalMetaCache.GetUserRole(user)
+	}
+	roles = append(roles, getJWTRoles(ctx)...)
+	return lo.Without(lo.Uniq(roles), util.RolePublic)
+}
+
 // getRequestInfo returns collection name and rateType of request and return tokens needed.
 func getRequestInfo(req interface{}) (int64, internalpb.RateType, int, error) {
 	switch r := req.(type) {
@@ -68,6 +91,13 @@ func getRequestInfo(req interface{}) (int64, internalpb.RateType, int, error) {
 	case *milvuspb.SearchRequest:
 		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
 		return collectionID, internalpb.RateType_DQLSearch, int(r.GetNq()), nil
+	case *milvuspb.HybridSearchRequest:
+		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
+		nq := 0
+		for _, request := range r.GetRequests() {
+			nq += int(request.GetNq())
+		}
+		return collectionID, internalpb.RateType_DQLSearch, nq, nil
 	case *milvuspb.QueryRequest:
 		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
 		return collectionID, internalpb.RateType_DQLQuery, 1, nil // think of the query request's nq as 1
@@ -148,7 +178,7 @@ func getFailedResponse(req any, rt internalpb.RateType, err error, fullMethod st
 		return &milvuspb.ImportResponse{
 			Status: merr.Status(err),
 		}
-	case *milvuspb.SearchRequest:
+	case *milvuspb.SearchRequest, *milvuspb.HybridSearchRequest:
 		return &milvuspb.SearchResults{
 			Status: merr.Status(err),
 		}
@@ -24,10 +24,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
@@ -38,7 +40,7 @@ type limiterMock struct {
 	quotaStateReasons []commonpb.ErrorCode
 }
 
-func (l *limiterMock) Check(collection int64, rt internalpb.RateType, n int) error {
+func (l *limiterMock) Check(database string, user string, roles []string, collection int64, rt internalpb.RateType, n int) error {
 	if l.rate == 0 {
 		return merr.ErrServiceForceDeny
 	}
@@ -87,6 +89,12 @@ func TestRateLimitInterceptor(t *testing.T) {
 		assert.Equal(t, internalpb.RateType_DQLSearch, rt)
 		assert.Equal(t, collection, int64(0))
 
+		collection, rt, size, err = getRequestInfo(&milvuspb.HybridSearchRequest{Requests: []*milvuspb.SearchRequest{{Nq: 2}, {Nq: 2}}})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, size)
+		assert.Equal(t, internalpb.RateType_DQLSearch, rt)
+		assert.Equal(t, collection, int64(0))
+
 		collection, rt, size, err = getRequestInfo(&milvuspb.QueryRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
@@ -175,6 +183,7 @@ func TestRateLimitInterceptor(t *testing.T) {
 		testGetFailedResponse(&milvuspb.UpsertRequest{}, internalpb.RateType_DMLUpsert, merr.ErrServiceForceDeny, "upsert")
 		testGetFailedResponse(&milvuspb.ImportRequest{}, internalpb.RateType_DMLBulkLoad, merr.ErrServiceMemoryLimitExceeded, "import")
 		testGetFailedResponse(&milvuspb.SearchRequest{}, internalpb.RateType_DQLSearch, merr.ErrServiceDiskLimitExceeded, "search")
+		testGetFailedResponse(&milvuspb.HybridSearchRequest{}, internalpb.RateType_DQLSearch, merr.ErrServiceDiskLimitExceeded, "hybrid_search")
 		testGetFailedResponse(&milvuspb.QueryRequest{}, internalpb.RateType_DQLQuery, merr.ErrServiceForceDeny, "query")
 		testGetFailedResponse(&milvuspb.CreateCollectionRequest{}, internalpb.RateType_DDLCollection, merr.ErrServiceRateLimit, "createCollection")
 		testGetFailedResponse(&milvuspb.FlushRequest{}, internalpb.RateType_DDLFlush, merr.ErrServiceRateLimit, "flush")
@@ -223,4 +232,23 @@ func TestRateLimitInterceptor(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_ForceDeny, rsp.(*milvuspb.MutationResult).GetStatus().GetErrorCode())
 		assert.NoError(t, err)
 	})
+
+	t.Run("test getRequestDBName", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, "db1"))
+		assert.Equal(t, "db2", getRequestDBName(ctx, &milvuspb.InsertRequest{DbName: "db2"}))
+		assert.Equal(t, "db1", getRequestDBName(ctx, &milvuspb.InsertRequest{}))
+		assert.Equal(t, "db1", getRequestDBName(ctx, &milvuspb.ManualCompactionRequest{}))
+		assert.Equal(t, util.DefaultDBName, getRequestDBName(context.Background(), &milvuspb.SearchRequest{}))
+	})
+
+	t.Run("test getRateLimitRoles", func(t *testing.T) {
+		mockCache := NewMockCache(t)
+		mockCache.EXPECT().GetUserRole("user1").Return([]string{"role1", util.RolePublic})
+		globalMetaCache = mockCache
+
+		ctx := WithJWTRoles(context.Background(), []string{"role1", "role2"})
+		assert.ElementsMatch(t, []string{"role1", "role2"}, getRateLimitRoles(ctx, "user1"))
+		// requests without user have no roles
+		assert.Empty(t, getRateLimitRoles(context.Background(), ""))
+	})
 }
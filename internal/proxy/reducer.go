@@ -2,11 +2,21 @@ package proxy
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type milvusReducer interface {
@@ -19,5 +29,132 @@ func createMilvusReducer(ctx context.Context, params *queryParams, req *internal
 			collectionName: collectionName,
 		}
 	}
+	if planparserv2.IsAggregatePlan(plan) {
+		return &aggReducer{
+			params:         params,
+			schema:         schema,
+			plan:           plan,
+			collectionName: collectionName,
+		}
+	}
 	return newDefaultLimitReducer(ctx, params, req, schema, collectionName)
 }
+
+// hybridHit is an entity in the results of hybrid search sub searches.
+type hybridHit struct {
+	pk    interface{}
+	score float32
+	// the first sub search result and row where the entity is found, its output fields are taken from there
+	resultIdx int
+	row       int64
+}
+
+// reduceHybridSearchResultData fuses the reduced results of the sub searches of hybrid search by the reranker,
+// the fused scores are in descending order. Every sub search result must have the same nq and output fields.
+func reduceHybridSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, rr reranker, nq int64, limit int64, offset int64, roundDecimal int64) (*milvuspb.SearchResults, error) {
+	log := log.Ctx(ctx)
+	ret := &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+
+	// output fields of sub search results are aligned with the first non-empty one,
+	// the fields data may be in different orders if some of them are requeried.
+	var fieldNames []string
+	for _, data := range subSearchResultData {
+		if len(data.GetFieldsData()) > 0 {
+			for _, fieldData := range data.GetFieldsData() {
+				fieldNames = append(fieldNames, fieldData.GetFieldName())
+			}
+			break
+		}
+	}
+	ret.Results.FieldsData = make([]*schemapb.FieldData, len(fieldNames))
+	alignedFieldsData := make([][]*schemapb.FieldData, len(subSearchResultData))
+	nqOffsets := make([][]int64, len(subSearchResultData))
+	for i, data := range subSearchResultData {
+		if data.GetNumQueries() != nq || int64(len(data.GetTopks())) != nq {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("nq of the result of search request %d mismatched, expected %d but got %d", i, nq, data.GetNumQueries()))
+		}
+		nqOffsets[i] = make([]int64, nq)
+		for q := int64(1); q < nq; q++ {
+			nqOffsets[i][q] = nqOffsets[i][q-1] + data.GetTopks()[q-1]
+		}
+		if typeutil.GetSizeOfIDs(data.GetIds()) == 0 {
+			continue
+		}
+		fieldsData := make(map[string]*schemapb.FieldData, len(data.GetFieldsData()))
+		for _, fieldData := range data.GetFieldsData() {
+			fieldsData[fieldData.GetFieldName()] = fieldData
+		}
+		alignedFieldsData[i] = make([]*schemapb.FieldData, len(fieldNames))
+		for j, name := range fieldNames {
+			fieldData, ok := fieldsData[name]
+			if !ok {
+				return nil, merr.WrapErrServiceInternal(fmt.Sprintf("output field %s missing in the result of search request %d", name, i))
+			}
+			alignedFieldsData[i][j] = fieldData
+		}
+	}
+
+	var (
+		retSize       int64
+		maxOutputSize = paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+		multiplier    = math.Pow(10, float64(roundDecimal))
+	)
+	for q := int64(0); q < nq; q++ {
+		hits := make([]*hybridHit, 0)
+		hitIndex := make(map[interface{}]*hybridHit)
+		for i, data := range subSearchResultData {
+			for rank := int64(0); rank < data.GetTopks()[q]; rank++ {
+				row := nqOffsets[i][q] + rank
+				pk := typeutil.GetPK(data.GetIds(), row)
+				score := rr.score(i, int(rank), data.GetScores()[row])
+				if hit, ok := hitIndex[pk]; ok {
+					hit.score += score
+					continue
+				}
+				hit := &hybridHit{pk: pk, score: score, resultIdx: i, row: row}
+				hits = append(hits, hit)
+				hitIndex[pk] = hit
+			}
+		}
+		// keep the order of sub searches for the entities with same score
+		sort.SliceStable(hits, func(i, j int) bool {
+			return hits[i].score > hits[j].score
+		})
+
+		var topk int64
+		for k := offset; k < int64(len(hits)) && topk < limit; k++ {
+			hit := hits[k]
+			score := hit.score
+			if roundDecimal != -1 {
+				score = float32(math.Floor(float64(score)*multiplier+0.5) / multiplier)
+			}
+			typeutil.AppendPKs(ret.Results.Ids, hit.pk)
+			ret.Results.Scores = append(ret.Results.Scores, score)
+			retSize += typeutil.AppendFieldData(ret.Results.FieldsData, alignedFieldsData[hit.resultIdx], hit.row)
+			topk++
+		}
+		ret.Results.Topks = append(ret.Results.Topks, topk)
+		if topk > ret.Results.TopK {
+			ret.Results.TopK = topk
+		}
+
+		// limit search result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	log.Debug("reduce hybrid search results",
+		zap.Int("number of sub searches", len(subSearchResultData)),
+		zap.Int64("nq", nq),
+		zap.Int64("limit", limit),
+		zap.Int64("offset", offset))
+	return ret, nil
+}
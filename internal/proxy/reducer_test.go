@@ -5,8 +5,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func Test_createMilvusReducer(t *testing.T) {
@@ -28,4 +32,103 @@ func Test_createMilvusReducer(t *testing.T) {
 	r = createMilvusReducer(ctx, nil, nil, nil, n, "")
 	_, ok = r.(*cntReducer)
 	assert.True(t, ok)
+
+	n.Node.(*planpb.PlanNode_Query).Query.IsCount = false
+	n.Node.(*planpb.PlanNode_Query).Query.Aggregates = []*planpb.Aggregate{{Op: planpb.Aggregate_Count}}
+	r = createMilvusReducer(ctx, nil, nil, nil, n, "")
+	_, ok = r.(*aggReducer)
+	assert.True(t, ok)
+}
+
+func Test_aggReducer(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Double},
+		},
+	}
+	query := &planpb.QueryPlanNode{
+		GroupBy: []*planpb.ColumnInfo{{FieldId: 101, DataType: schemapb.DataType_VarChar}},
+		Aggregates: []*planpb.Aggregate{
+			{Op: planpb.Aggregate_Sum, Column: &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_Double}},
+		},
+	}
+	genPartial := func(categories []string, counts []int64, sums []float64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("category", 101, schemapb.DataType_VarChar, categories, 1),
+				getFieldData("", 0, schemapb.DataType_Int64, counts, 1),
+				getFieldData("", 0, schemapb.DataType_Double, sums, 1),
+			},
+		}
+	}
+
+	r := &aggReducer{
+		params:         &queryParams{limit: 2, offset: 1},
+		schema:         schema,
+		plan:           &planpb.PlanNode{Node: &planpb.PlanNode_Query{Query: query}},
+		collectionName: "test",
+	}
+	res, err := r.Reduce([]*internalpb.RetrieveResults{
+		genPartial([]string{"c", "a"}, []int64{1, 2}, []float64{1.5, 2}),
+		genPartial([]string{"b", "a", "d"}, []int64{1, 1, 1}, []float64{3, 4, 5}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "test", res.GetCollectionName())
+	require.Equal(t, 2, len(res.GetFieldsData()))
+	assert.Equal(t, []string{"b", "c"}, res.GetFieldsData()[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, "sum(price)", res.GetFieldsData()[1].GetFieldName())
+	assert.Equal(t, []float64{3, 1.5}, res.GetFieldsData()[1].GetScalars().GetDoubleData().GetData())
+
+	_, err = r.Reduce([]*internalpb.RetrieveResults{{FieldsData: []*schemapb.FieldData{
+		getFieldData("category", 101, schemapb.DataType_VarChar, []string{"a"}, 1),
+	}}})
+	assert.Error(t, err)
+}
+
+func Test_reduceHybridSearchResultData(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	genResult := func(topks []int64, pks []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: int64(len(topks)),
+			Topks:      topks,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Scores:     scores,
+			FieldsData: []*schemapb.FieldData{getFieldData("pk", 100, schemapb.DataType_Int64, pks, 1)},
+		}
+	}
+	rr := &rrfReranker{k: 0}
+
+	// nq = 2, 1st query: [1, 2] and [2, 3], 2nd query: [4] and [5, 4]
+	subResults := []*schemapb.SearchResultData{
+		genResult([]int64{2, 1}, []int64{1, 2, 4}, []float32{0.1, 0.2, 0.3}),
+		genResult([]int64{2, 2}, []int64{2, 3, 5, 4}, []float32{0.1, 0.2, 0.3, 0.4}),
+	}
+	ret, err := reduceHybridSearchResultData(ctx, subResults, rr, 2, 2, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 2}, ret.GetResults().GetTopks())
+	assert.Equal(t, int64(2), ret.GetResults().GetTopK())
+	// 1st query: 2 = 1/2 + 1, 1 = 1, 3 = 1/2; 2nd query: 4 = 1 + 1/2, 5 = 1
+	assert.Equal(t, []int64{2, 1, 4, 5}, ret.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{1.5, 1, 1.5, 1}, ret.GetResults().GetScores())
+	assert.Equal(t, []int64{2, 1, 4, 5}, ret.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	// offset and round decimal
+	rr.k = 1
+	ret, err = reduceHybridSearchResultData(ctx, subResults, rr, 2, 1, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 1}, ret.GetResults().GetTopks())
+	assert.Equal(t, []int64{1, 5}, ret.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.5, 0.5}, ret.GetResults().GetScores())
+
+	// nq mismatched
+	_, err = reduceHybridSearchResultData(ctx, subResults, rr, 3, 1, 0, -1)
+	assert.Error(t, err)
+
+	// output field missing
+	subResults[1].FieldsData = nil
+	_, err = reduceHybridSearchResultData(ctx, subResults, rr, 2, 1, 0, -1)
+	assert.Error(t, err)
 }
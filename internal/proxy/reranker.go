@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+const (
+	// RankTypeKey in rank params is the strategy of reranker, "rrf" or "weighted".
+	RankTypeKey = "strategy"
+	// RankParamsKey in rank params is the json params of reranker.
+	RankParamsKey = "params"
+
+	RRFRankType      = "rrf"
+	WeightedRankType = "weighted"
+
+	defaultRRFParamsK = 60
+)
+
+// reranker fuses the results of the sub searches of hybrid search, the fused score is the sum
+// of the scores given by the reranker to the entity in every sub search result, higher is better.
+type reranker interface {
+	// score returns the score of the entity which is ranked at rank (starts from 0) with distance
+	// in the result of the idx-th sub search.
+	score(idx int, rank int, distance float32) float32
+}
+
+// rerankerFactory creates the reranker from its json params, metricTypes are the metric types of sub searches.
+type rerankerFactory func(params map[string]interface{}, metricTypes []string) (reranker, error)
+
+var rerankerFactories = map[string]rerankerFactory{
+	RRFRankType:      newRRFReranker,
+	WeightedRankType: newWeightedReranker,
+}
+
+// newReranker creates the reranker of rank params, metricTypes are the metric types of sub searches.
+func newReranker(rankParams []*commonpb.KeyValuePair, metricTypes []string) (reranker, error) {
+	rankType, err := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams)
+	if err != nil {
+		rankType = RRFRankType
+	}
+	factory, ok := rerankerFactories[strings.ToLower(rankType)]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalid("rrf or weighted", rankType, "unsupported rank strategy")
+	}
+	params := make(map[string]interface{})
+	if paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankParamsKey, rankParams); err == nil && paramsStr != "" {
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, merr.WrapErrParameterInvalid("json object", paramsStr, "invalid rank params")
+		}
+	}
+	return factory(params, metricTypes)
+}
+
+// rrfReranker is reciprocal rank fusion, the entity ranked at rank gets 1 / (k + rank + 1).
+type rrfReranker struct {
+	k float32
+}
+
+func newRRFReranker(params map[string]interface{}, _ []string) (reranker, error) {
+	k := float64(defaultRRFParamsK)
+	if value, ok := params["k"]; ok {
+		k, ok = value.(float64)
+		if !ok || k <= 0 || k >= 16384 {
+			return nil, merr.WrapErrParameterInvalid("number in range (0, 16384)", value, "invalid k of rrf")
+		}
+	}
+	return &rrfReranker{k: float32(k)}, nil
+}
+
+func (r *rrfReranker) score(_ int, rank int, _ float32) float32 {
+	return 1 / (r.k + float32(rank) + 1)
+}
+
+// weightedReranker normalizes the distances of every sub search into [0, 1], higher is better,
+// and sums them with the weights.
+type weightedReranker struct {
+	weights     []float32
+	metricTypes []string
+}
+
+func newWeightedReranker(params map[string]interface{}, metricTypes []string) (reranker, error) {
+	values, ok := params["weights"].([]interface{})
+	if !ok || len(values) != len(metricTypes) {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprintf("%d weights", len(metricTypes)), params["weights"], "invalid weights of weighted rank")
+	}
+	weights := make([]float32, len(values))
+	for i, value := range values {
+		weight, ok := value.(float64)
+		if !ok || weight < 0 || weight > 1 {
+			return nil, merr.WrapErrParameterInvalid("number in range [0, 1]", value, "invalid weights of weighted rank")
+		}
+		weights[i] = float32(weight)
+	}
+	for i, metricType := range metricTypes {
+		if metricType == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("metric_type is required by weighted rank, missing in search request %d", i)
+		}
+	}
+	return &weightedReranker{weights: weights, metricTypes: metricTypes}, nil
+}
+
+func (r *weightedReranker) score(idx int, _ int, distance float32) float32 {
+	return r.weights[idx] * normalizeDistance(r.metricTypes[idx], distance)
+}
+
+// normalizeDistance maps the distance of metric type into [0, 1], higher is better.
+func normalizeDistance(metricType string, distance float32) float32 {
+	switch strings.ToUpper(metricType) {
+	case metric.COSINE:
+		return (1 + distance) * 0.5
+	case metric.IP:
+		return 0.5 + float32(math.Atan(float64(distance)))/math.Pi
+	default:
+		// distances of L2, HAMMING and JACCARD are not negative, smaller is better
+		return 1.0 - 2*float32(math.Atan(float64(distance)))/math.Pi
+	}
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+func TestNewReranker(t *testing.T) {
+	genParams := func(strategy string, params string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: strategy},
+			{Key: RankParamsKey, Value: params},
+		}
+	}
+	metricTypes := []string{metric.L2, metric.IP}
+
+	t.Run("rrf", func(t *testing.T) {
+		rr, err := newReranker(nil, metricTypes)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0/61, rr.score(0, 0, 0.5), 1e-6)
+
+		rr, err = newReranker(genParams("RRF", `{"k": 1}`), metricTypes)
+		require.NoError(t, err)
+		assert.Equal(t, float32(0.5), rr.score(1, 0, 0.5))
+		assert.Equal(t, float32(0.25), rr.score(1, 2, 0.5))
+
+		_, err = newReranker(genParams(RRFRankType, `{"k": 0}`), metricTypes)
+		assert.Error(t, err)
+		_, err = newReranker(genParams(RRFRankType, `{"k": "a"}`), metricTypes)
+		assert.Error(t, err)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		rr, err := newReranker(genParams(WeightedRankType, `{"weights": [0.5, 1]}`), metricTypes)
+		require.NoError(t, err)
+		assert.Equal(t, float32(0.5), rr.score(0, 0, 0))
+		assert.Equal(t, float32(0.5), rr.score(1, 3, 0))
+		assert.Greater(t, rr.score(1, 0, 10), rr.score(1, 0, 1))
+		assert.Less(t, rr.score(0, 0, 10), rr.score(0, 0, 1))
+
+		_, err = newReranker(genParams(WeightedRankType, `{"weights": [0.5]}`), metricTypes)
+		assert.Error(t, err)
+		_, err = newReranker(genParams(WeightedRankType, `{"weights": [0.5, 2]}`), metricTypes)
+		assert.Error(t, err)
+		_, err = newReranker(genParams(WeightedRankType, `{"weights": [0.5, 1]}`), []string{metric.L2, ""})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := newReranker(genParams("unknown", ""), metricTypes)
+		assert.Error(t, err)
+		_, err = newReranker(genParams(RRFRankType, "{"), metricTypes)
+		assert.Error(t, err)
+	})
+}
+
+func TestNormalizeDistance(t *testing.T) {
+	assert.Equal(t, float32(1), normalizeDistance(metric.COSINE, 1))
+	assert.Equal(t, float32(0), normalizeDistance(metric.COSINE, -1))
+	assert.Equal(t, float32(0.5), normalizeDistance(metric.IP, 0))
+	assert.Equal(t, float32(1), normalizeDistance(metric.L2, 0))
+	assert.Less(t, normalizeDistance(metric.L2, 100), float32(0.01))
+}
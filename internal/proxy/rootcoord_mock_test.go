@@ -1026,6 +1026,10 @@ func (coord *RootCoordMock) ListPolicy(ctx context.Context, in *internalpb.ListP
 	return &internalpb.ListPolicyResponse{}, nil
 }
 
+func (coord *RootCoordMock) OperateRowPolicy(ctx context.Context, req *rootcoordpb.OperateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 func (coord *RootCoordMock) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, nil
 }
@@ -1053,6 +1057,14 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *rootcoordpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropCollectionField(ctx context.Context, req *rootcoordpb.DropCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
 
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var errNullValue = errors.New("null value")
+
+// rowEvaluator evaluates the filter expressions on the rows of insert field data by proxy. Only the
+// predicates on scalar fields are supported, which are enough for the row policies.
+type rowEvaluator struct {
+	fields map[int64]*schemapb.FieldData
+}
+
+func newRowEvaluator(fieldsData []*schemapb.FieldData) *rowEvaluator {
+	fields := make(map[int64]*schemapb.FieldData, len(fieldsData))
+	for _, field := range fieldsData {
+		fields[field.GetFieldId()] = field
+	}
+	return &rowEvaluator{fields: fields}
+}
+
+// eval returns whether the row matches the expression, an error is returned if the row can't be evaluated.
+func (e *rowEvaluator) eval(expr *planpb.Expr, row int) (bool, error) {
+	switch expr := expr.GetExpr().(type) {
+	case *planpb.Expr_AlwaysTrueExpr:
+		return true, nil
+	case *planpb.Expr_UnaryExpr:
+		if expr.UnaryExpr.GetOp() != planpb.UnaryExpr_Not {
+			return false, fmt.Errorf("unary operator %s is not supported", expr.UnaryExpr.GetOp())
+		}
+		ret, err := e.eval(expr.UnaryExpr.GetChild(), row)
+		return !ret, err
+	case *planpb.Expr_BinaryExpr:
+		left, err := e.eval(expr.BinaryExpr.GetLeft(), row)
+		if err != nil {
+			return false, err
+		}
+		switch expr.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			if !left {
+				return false, nil
+			}
+		case planpb.BinaryExpr_LogicalOr:
+			if left {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("binary operator %s is not supported", expr.BinaryExpr.GetOp())
+		}
+		return e.eval(expr.BinaryExpr.GetRight(), row)
+	case *planpb.Expr_TermExpr:
+		if expr.TermExpr.GetIsInField() {
+			return false, fmt.Errorf("term expression on array field is not supported")
+		}
+		value, err := e.value(expr.TermExpr.GetColumnInfo(), row)
+		if err != nil {
+			return false, err
+		}
+		for _, term := range expr.TermExpr.GetValues() {
+			if ok, err := matchOp(planpb.OpType_Equal, value, term); err == nil && ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	case *planpb.Expr_UnaryRangeExpr:
+		value, err := e.value(expr.UnaryRangeExpr.GetColumnInfo(), row)
+		if err != nil {
+			return false, err
+		}
+		return matchOp(expr.UnaryRangeExpr.GetOp(), value, expr.UnaryRangeExpr.GetValue())
+	case *planpb.Expr_BinaryRangeExpr:
+		value, err := e.value(expr.BinaryRangeExpr.GetColumnInfo(), row)
+		if err != nil {
+			return false, err
+		}
+		lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+		if expr.BinaryRangeExpr.GetLowerInclusive() {
+			lowerOp = planpb.OpType_GreaterEqual
+		}
+		if expr.BinaryRangeExpr.GetUpperInclusive() {
+			upperOp = planpb.OpType_LessEqual
+		}
+		ok, err := matchOp(lowerOp, value, expr.BinaryRangeExpr.GetLowerValue())
+		if err != nil || !ok {
+			return false, err
+		}
+		return matchOp(upperOp, value, expr.BinaryRangeExpr.GetUpperValue())
+	case *planpb.Expr_CompareExpr:
+		left, err := e.value(expr.CompareExpr.GetLeftColumnInfo(), row)
+		if err != nil {
+			return false, err
+		}
+		right, err := e.value(expr.CompareExpr.GetRightColumnInfo(), row)
+		if err != nil {
+			return false, err
+		}
+		return matchOp(expr.CompareExpr.GetOp(), left, right)
+	}
+	return false, fmt.Errorf("expression %T is not supported", expr.GetExpr())
+}
+
+// value returns the value of column in the row.
+func (e *rowEvaluator) value(column *planpb.ColumnInfo, row int) (*planpb.GenericValue, error) {
+	if len(column.GetNestedPath()) > 0 || typeutil.IsJSONType(column.GetDataType()) || typeutil.IsArrayType(column.GetDataType()) {
+		return nil, fmt.Errorf("field of type %s is not supported", column.GetDataType())
+	}
+	field, ok := e.fields[column.GetFieldId()]
+	if !ok || typeutil.IsNullFieldData(field) {
+		return nil, errNullValue
+	}
+	if rows, err := getNumRowsOfScalarField(field); err != nil || row >= rows {
+		return nil, fmt.Errorf("row %d out of range of field %s", row, field.GetFieldName())
+	}
+	switch data := typeutil.GetData(field, row).(type) {
+	case bool:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_BoolVal{BoolVal: data}}, nil
+	case int32:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(data)}}, nil
+	case int64:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: data}}, nil
+	case float32:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: float64(data)}}, nil
+	case float64:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: data}}, nil
+	case string:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: data}}, nil
+	}
+	return nil, fmt.Errorf("field of type %s is not supported", column.GetDataType())
+}
+
+func getNumRowsOfScalarField(field *schemapb.FieldData) (int, error) {
+	switch field.GetType() {
+	case schemapb.DataType_Bool:
+		return len(field.GetScalars().GetBoolData().GetData()), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return len(field.GetScalars().GetIntData().GetData()), nil
+	case schemapb.DataType_Int64:
+		return len(field.GetScalars().GetLongData().GetData()), nil
+	case schemapb.DataType_Float:
+		return len(field.GetScalars().GetFloatData().GetData()), nil
+	case schemapb.DataType_Double:
+		return len(field.GetScalars().GetDoubleData().GetData()), nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return len(field.GetScalars().GetStringData().GetData()), nil
+	}
+	return 0, fmt.Errorf("field of type %s is not supported", field.GetType())
+}
+
+// matchOp returns whether value op operand is true.
+func matchOp(op planpb.OpType, value, operand *planpb.GenericValue) (bool, error) {
+	switch op {
+	case planpb.OpType_PrefixMatch, planpb.OpType_PostfixMatch, planpb.OpType_RegexMatch:
+		str, ok := value.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok {
+			return false, fmt.Errorf("operator %s on non-string value", op)
+		}
+		switch op {
+		case planpb.OpType_PrefixMatch:
+			return strings.HasPrefix(str.StringVal, operand.GetStringVal()), nil
+		case planpb.OpType_PostfixMatch:
+			return strings.HasSuffix(str.StringVal, operand.GetStringVal()), nil
+		default:
+			return regexp.MatchString(operand.GetStringVal(), str.StringVal)
+		}
+	case planpb.OpType_Equal, planpb.OpType_NotEqual:
+		ret, err := compareValue(value, operand)
+		if err != nil {
+			return false, err
+		}
+		return (ret == 0) == (op == planpb.OpType_Equal), nil
+	case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan, planpb.OpType_LessEqual:
+		if _, ok := value.GetVal().(*planpb.GenericValue_BoolVal); ok {
+			return false, fmt.Errorf("operator %s on bool value", op)
+		}
+		ret, err := compareValue(value, operand)
+		if err != nil {
+			return false, err
+		}
+		switch op {
+		case planpb.OpType_GreaterThan:
+			return ret > 0, nil
+		case planpb.OpType_GreaterEqual:
+			return ret >= 0, nil
+		case planpb.OpType_LessThan:
+			return ret < 0, nil
+		default:
+			return ret <= 0, nil
+		}
+	}
+	return false, fmt.Errorf("operator %s is not supported", op)
+}
+
+// compareValue returns -1, 0 or 1 if a is less than, equal to or greater than b.
+func compareValue(a, b *planpb.GenericValue) (int, error) {
+	toFloat := func(v *planpb.GenericValue) (float64, bool) {
+		switch val := v.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return float64(val.Int64Val), true
+		case *planpb.GenericValue_FloatVal:
+			return val.FloatVal, true
+		}
+		return 0, false
+	}
+	switch aVal := a.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		if bVal, ok := b.GetVal().(*planpb.GenericValue_BoolVal); ok {
+			if aVal.BoolVal == bVal.BoolVal {
+				return 0, nil
+			}
+			return 1, nil
+		}
+	case *planpb.GenericValue_StringVal:
+		if bVal, ok := b.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return strings.Compare(aVal.StringVal, bVal.StringVal), nil
+		}
+	case *planpb.GenericValue_Int64Val:
+		if bVal, ok := b.GetVal().(*planpb.GenericValue_Int64Val); ok {
+			switch {
+			case aVal.Int64Val < bVal.Int64Val:
+				return -1, nil
+			case aVal.Int64Val > bVal.Int64Val:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	aFloat, aOk := toFloat(a)
+	bFloat, bOk := toFloat(b)
+	if !aOk || !bOk {
+		return 0, fmt.Errorf("incompatible values %v and %v", a, b)
+	}
+	switch {
+	case aFloat < bFloat:
+		return -1, nil
+	case aFloat > bFloat:
+		return 1, nil
+	}
+	return 0, nil
+}
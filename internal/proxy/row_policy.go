@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// groupRowPolicies merges the row policies stored per role into the row policies of milvus api,
+// the policies of the same collection and policy name are merged, which apply to all the actions.
+func groupRowPolicies(policies []*internalpb.RowPolicy) []*milvuspb.RowPolicy {
+	actions := lo.Map(lo.Keys(milvuspb.RowPolicyAction_name), func(action int32, _ int) milvuspb.RowPolicyAction {
+		return milvuspb.RowPolicyAction(action)
+	})
+	sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+
+	ret := make([]*milvuspb.RowPolicy, 0, len(policies))
+	merged := make(map[string]*milvuspb.RowPolicy)
+	for _, policy := range policies {
+		key := policy.GetCollectionName() + "/" + policy.GetPolicyName()
+		if rowPolicy, ok := merged[key]; ok {
+			rowPolicy.Roles = append(rowPolicy.Roles, policy.GetRole())
+			continue
+		}
+		rowPolicy := &milvuspb.RowPolicy{
+			PolicyName: policy.GetPolicyName(),
+			Actions:    actions,
+			Roles:      []string{policy.GetRole()},
+			UsingExpr:  policy.GetExpr(),
+			CheckExpr:  policy.GetExpr(),
+		}
+		merged[key] = rowPolicy
+		ret = append(ret, rowPolicy)
+	}
+	return ret
+}
+
+// getRowPolicies returns the row policies applied to the user of request on the collection,
+// nil if authorization is disabled or the request is sent by root. The request without user is
+// rejected when authorization is enabled, since the rows visible to it can't be decided.
+func getRowPolicies(ctx context.Context, dbName, collectionName string) (string, []*internalpb.RowPolicy, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() || globalMetaCache == nil {
+		return "", nil, nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return "", nil, errors.Wrap(merr.ErrNeedAuthenticate, "row policies can't be applied to request without user")
+	}
+	if username == util.UserRoot {
+		return username, nil, nil
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	roleNames := globalMetaCache.GetUserRole(username)
+	roleNames = append(roleNames, getJWTRoles(ctx)...)
+	roleNames = append(roleNames, util.RolePublic)
+	return username, globalMetaCache.GetRowPolicies(dbName, collectionName, lo.Uniq(roleNames)), nil
+}
+
+// combineRowPolicies returns the expression matching rows permitted by any of the policies.
+func combineRowPolicies(policies []*internalpb.RowPolicy) string {
+	exprs := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		exprs = append(exprs, fmt.Sprintf("(%s)", policy.GetExpr()))
+	}
+	return strings.Join(exprs, " or ")
+}
+
+// applyRowPolicies returns the filter expression of user combined with the row policies of its roles,
+// so that only the rows permitted by at least one of the policies are visible to the user.
+// The expression of user is parsed alone at first, it can't escape from the policies by unbalanced parentheses.
+func applyRowPolicies(ctx context.Context, schema *schemapb.CollectionSchema, dbName, collectionName, expr string) (string, error) {
+	username, policies, err := getRowPolicies(ctx, dbName, collectionName)
+	if err != nil {
+		return "", err
+	}
+	if len(policies) == 0 {
+		return expr, nil
+	}
+
+	filter := combineRowPolicies(policies)
+	if expr != "" {
+		schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+		if err != nil {
+			return "", err
+		}
+		if _, err := planparserv2.ParseExpr(schemaHelper, expr); err != nil {
+			return "", merr.WrapErrParameterInvalidMsg("invalid expression %s: %s", expr, err.Error())
+		}
+		filter = fmt.Sprintf("(%s) and (%s)", filter, expr)
+	}
+	log.Ctx(ctx).Info("apply row policies",
+		zap.String("username", username),
+		zap.String("db_name", dbName),
+		zap.String("collection_name", collectionName),
+		zap.Strings("policies", lo.Map(policies, func(policy *internalpb.RowPolicy, _ int) string {
+			return policy.GetRole() + "/" + policy.GetPolicyName()
+		})),
+		zap.String("expr", expr),
+		zap.String("filter", filter))
+	return filter, nil
+}
+
+// checkUpsertRowPolicies checks the existing rows replaced by upsert request are permitted by the row policies of user,
+// so that the rows of other tenants can't be overwritten. The new rows are checked by checkInsertRowPolicies.
+func (node *Proxy) checkUpsertRowPolicies(ctx context.Context, request *milvuspb.UpsertRequest) error {
+	username, policies, err := getRowPolicies(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	pkData, ok := lo.Find(request.GetFieldsData(), func(field *schemapb.FieldData) bool {
+		return field.GetFieldName() == pkField.GetName()
+	})
+	if !ok {
+		// the request is rejected by upsert task
+		return nil
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return err
+	}
+	if typeutil.GetSizeOfIDs(ids) == 0 {
+		return nil
+	}
+
+	// the plan is created here, the row policies aren't applied again by query task
+	expr := fmt.Sprintf("(%s) and not (%s)", IDs2Expr(pkField.GetName(), ids), combineRowPolicies(policies))
+	plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+	if err != nil {
+		return err
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: &milvuspb.QueryRequest{
+			DbName:         request.GetDbName(),
+			CollectionName: request.GetCollectionName(),
+			OutputFields:   []string{pkField.GetName()},
+			QueryParams:    []*commonpb.KeyValuePair{{Key: LimitKey, Value: "1"}},
+		},
+		plan: plan,
+		qc:   node.queryCoord,
+		lb:   node.lbPolicy,
+	}
+	result, err := node.query(ctx, qt)
+	if err == nil {
+		err = merr.Error(result.GetStatus())
+	}
+	if err != nil {
+		return err
+	}
+	for _, field := range result.GetFieldsData() {
+		if rows, _ := funcutil.GetNumRowOfFieldData(field); rows > 0 {
+			log.Ctx(ctx).Info("upsert rejected by row policies",
+				zap.String("username", username),
+				zap.String("db_name", request.GetDbName()),
+				zap.String("collection_name", request.GetCollectionName()))
+			return merr.WrapErrPrivilegeNotPermitted("upsert rows not permitted by row policies of user %s", username)
+		}
+	}
+	return nil
+}
+
+// checkInsertRowPolicies checks the rows written by insert or upsert request are permitted by the row policies of user,
+// so that rows can't be written to other tenants. Every row must match at least one of the policies, the row which
+// can't be evaluated by proxy, e.g. it's null or the policy uses json fields, is rejected as well.
+// The field data must have been filled with field ids and default values.
+func checkInsertRowPolicies(ctx context.Context, schema *schemapb.CollectionSchema, dbName, collectionName string, fieldsData []*schemapb.FieldData, numRows uint64) error {
+	username, policies, err := getRowPolicies(ctx, dbName, collectionName)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+	exprs := make([]*planpb.Expr, 0, len(policies))
+	for _, policy := range policies {
+		expr, err := planparserv2.ParseExpr(schemaHelper, policy.GetExpr())
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("invalid expression of row policy %s: %s", policy.GetPolicyName(), err.Error())
+		}
+		exprs = append(exprs, expr)
+	}
+
+	evaluator := newRowEvaluator(fieldsData)
+	for row := 0; row < int(numRows); row++ {
+		var reason error
+		permitted := false
+		for _, expr := range exprs {
+			ok, err := evaluator.eval(expr, row)
+			if err != nil {
+				reason = err
+				continue
+			}
+			if ok {
+				permitted = true
+				break
+			}
+		}
+		if !permitted {
+			log.Ctx(ctx).Info("insert rejected by row policies",
+				zap.String("username", username),
+				zap.String("db_name", dbName),
+				zap.String("collection_name", collectionName),
+				zap.Int("row", row),
+				zap.Error(reason))
+			if reason != nil {
+				return merr.WrapErrPrivilegeNotPermitted("row %d not permitted by row policies of user %s: %s", row, username, reason.Error())
+			}
+			return merr.WrapErrPrivilegeNotPermitted("row %d not permitted by row policies of user %s", row, username)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestApplyRowPolicies(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	userCtx := func(user string) context.Context {
+		authorization := crypto.Base64Encode(user + util.CredentialSeperator + "password")
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(strings.ToLower(util.HeaderAuthorize), authorization))
+	}
+
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetUserRole("alice").Return([]string{"role1", "role2"}).Maybe()
+	mockCache.EXPECT().GetUserRole("bob").Return([]string{}).Maybe()
+	mockCache.EXPECT().GetRowPolicies(util.DefaultDBName, "coll", []string{"role1", "role2", util.RolePublic}).Return([]*internalpb.RowPolicy{
+		{Role: "role1", PolicyName: "p1", Expr: "tenant == 1"},
+		{Role: "role2", PolicyName: "p2", Expr: "tenant in [2, 3]"},
+	}).Maybe()
+	mockCache.EXPECT().GetRowPolicies(util.DefaultDBName, "coll", mock.Anything).Return(nil).Maybe()
+	globalMetaCache = mockCache
+
+	// authorization disabled
+	expr, err := applyRowPolicies(userCtx("alice"), schema, "", "coll", "age > 10")
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 10", expr)
+
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	expr, err = applyRowPolicies(userCtx("alice"), schema, "", "coll", "age > 10")
+	assert.NoError(t, err)
+	assert.Equal(t, "((tenant == 1) or (tenant in [2, 3])) and (age > 10)", expr)
+
+	expr, err = applyRowPolicies(userCtx("alice"), schema, util.DefaultDBName, "coll", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "(tenant == 1) or (tenant in [2, 3])", expr)
+
+	// expression of user can't escape from the policies
+	_, err = applyRowPolicies(userCtx("alice"), schema, "", "coll", "age > 10) or (tenant > 0")
+	assert.Error(t, err)
+
+	// no policies of roles
+	expr, err = applyRowPolicies(userCtx("bob"), schema, "", "coll", "age > 10")
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 10", expr)
+
+	// root bypasses the policies
+	expr, err = applyRowPolicies(userCtx(util.UserRoot), schema, "", "coll", "age > 10")
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 10", expr)
+
+	// request without user is rejected
+	_, err = applyRowPolicies(context.Background(), schema, "", "coll", "age > 10")
+	assert.ErrorIs(t, err, merr.ErrNeedAuthenticate)
+}
+
+func TestCheckInsertRowPolicies(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "region", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "32"}}},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+		},
+	}
+	userCtx := func(user string) context.Context {
+		authorization := crypto.Base64Encode(user + util.CredentialSeperator + "password")
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(strings.ToLower(util.HeaderAuthorize), authorization))
+	}
+	fieldsData := func(tenants []int64, regions []string) []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			getFieldData("pk", 100, schemapb.DataType_Int64, make([]int64, len(tenants)), 1),
+			getFieldData("tenant", 101, schemapb.DataType_Int64, tenants, 1),
+			getFieldData("region", 102, schemapb.DataType_VarChar, regions, 1),
+		}
+	}
+
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetUserRole("alice").Return([]string{"role1"}).Maybe()
+	mockCache.EXPECT().GetUserRole("bob").Return([]string{"role2"}).Maybe()
+	mockCache.EXPECT().GetUserRole("carol").Return([]string{}).Maybe()
+	mockCache.EXPECT().GetRowPolicies(util.DefaultDBName, "coll", []string{"role1", util.RolePublic}).Return([]*internalpb.RowPolicy{
+		{Role: "role1", PolicyName: "p1", Expr: "tenant == 1"},
+		{Role: "role1", PolicyName: "p2", Expr: "tenant in [2, 3] and region like \"eu%\""},
+	}).Maybe()
+	mockCache.EXPECT().GetRowPolicies(util.DefaultDBName, "coll", []string{"role2", util.RolePublic}).Return([]*internalpb.RowPolicy{
+		{Role: "role2", PolicyName: "p3", Expr: "meta[\"tenant\"] == 1"},
+	}).Maybe()
+	mockCache.EXPECT().GetRowPolicies(util.DefaultDBName, "coll", mock.Anything).Return(nil).Maybe()
+	globalMetaCache = mockCache
+
+	// authorization disabled
+	err := checkInsertRowPolicies(userCtx("alice"), schema, "", "coll", fieldsData([]int64{4}, []string{"us"}), 1)
+	assert.NoError(t, err)
+
+	params.Save(params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer params.Reset(params.CommonCfg.AuthorizationEnabled.Key)
+
+	err = checkInsertRowPolicies(userCtx("alice"), schema, "", "coll", fieldsData([]int64{1, 2, 3}, []string{"us", "eu-west", "eu"}), 3)
+	assert.NoError(t, err)
+
+	err = checkInsertRowPolicies(userCtx("alice"), schema, "", "coll", fieldsData([]int64{1, 2}, []string{"us", "us"}), 2)
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+
+	// null values can't be decided
+	fields := fieldsData([]int64{1}, []string{"us"})
+	fields[1] = typeutil.NewNullFieldData(schema.Fields[1])
+	err = checkInsertRowPolicies(userCtx("alice"), schema, "", "coll", fields, 1)
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+
+	// policies on json fields can't be evaluated by proxy
+	err = checkInsertRowPolicies(userCtx("bob"), schema, "", "coll", fieldsData([]int64{1}, []string{"us"}), 1)
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+
+	// no policies of roles, root and request without user
+	err = checkInsertRowPolicies(userCtx("carol"), schema, "", "coll", fieldsData([]int64{4}, []string{"us"}), 1)
+	assert.NoError(t, err)
+	err = checkInsertRowPolicies(userCtx(util.UserRoot), schema, "", "coll", fieldsData([]int64{4}, []string{"us"}), 1)
+	assert.NoError(t, err)
+	err = checkInsertRowPolicies(context.Background(), schema, "", "coll", fieldsData([]int64{4}, []string{"us"}), 1)
+	assert.ErrorIs(t, err, merr.ErrNeedAuthenticate)
+}
+
+func TestProxy_RowPolicy(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+		},
+	}
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "coll").Return(schema, nil).Maybe()
+	globalMetaCache = mockCache
+
+	var operated []*rootcoordpb.OperateRowPolicyRequest
+	rc := mocks.NewMockRootCoordClient(t)
+	rc.EXPECT().OperateRowPolicy(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *rootcoordpb.OperateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			operated = append(operated, req)
+			return merr.Success(), nil
+		}).Maybe()
+	rc.EXPECT().ListPolicy(mock.Anything, mock.Anything).Return(&internalpb.ListPolicyResponse{
+		Status: merr.Success(),
+		RowPolicies: []*internalpb.RowPolicy{
+			{Role: "role1", DbName: util.DefaultDBName, CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 1"},
+			{Role: "role2", DbName: util.DefaultDBName, CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 1"},
+			{Role: "role1", DbName: util.DefaultDBName, CollectionName: "coll", PolicyName: "p2", Expr: "tenant == 2"},
+			{Role: "role1", DbName: util.DefaultDBName, CollectionName: "coll2", PolicyName: "p1", Expr: "tenant == 3"},
+			{Role: "role1", DbName: "db2", CollectionName: "coll", PolicyName: "p1", Expr: "tenant == 4"},
+		},
+	}, nil).Maybe()
+	node := &Proxy{rootCoord: rc}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	t.Run("create", func(t *testing.T) {
+		operated = nil
+		status, err := node.CreateRowPolicy(ctx, &milvuspb.CreateRowPolicyRequest{
+			CollectionName: "coll",
+			PolicyName:     "p1",
+			Roles:          []string{"role1", "role2", "role1"},
+			UsingExpr:      "tenant == 1",
+			CheckExpr:      "tenant == 1",
+		})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		assert.Len(t, operated, 2)
+		for i, role := range []string{"role1", "role2"} {
+			assert.Equal(t, rootcoordpb.OperateRowPolicyType_AddRowPolicy, operated[i].GetType())
+			assert.Equal(t, role, operated[i].GetPolicy().GetRole())
+			assert.Equal(t, "tenant == 1", operated[i].GetPolicy().GetExpr())
+		}
+	})
+
+	t.Run("create invalid", func(t *testing.T) {
+		operated = nil
+		for _, req := range []*milvuspb.CreateRowPolicyRequest{
+			{CollectionName: "coll", Roles: []string{"role1"}, UsingExpr: "tenant == 1"},
+			{CollectionName: "coll", PolicyName: "p1", UsingExpr: "tenant == 1"},
+			{CollectionName: "coll", PolicyName: "p1", Roles: []string{"role1"}, UsingExpr: "tenant == 1", CheckExpr: "tenant == 2"},
+			{CollectionName: "coll", PolicyName: "p1", Roles: []string{"role1"}, UsingExpr: "tenant == 1", Actions: []milvuspb.RowPolicyAction{milvuspb.RowPolicyAction_Query}},
+			{CollectionName: "coll", PolicyName: "p1", Roles: []string{"role1"}, UsingExpr: "age == 1"},
+			{CollectionName: "coll", PolicyName: "p1", Roles: []string{"role1"}},
+		} {
+			status, err := node.CreateRowPolicy(ctx, req)
+			assert.NoError(t, err)
+			assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+		}
+		assert.Empty(t, operated)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		operated = nil
+		status, err := node.DropRowPolicy(ctx, &milvuspb.DropRowPolicyRequest{CollectionName: "coll", PolicyName: "p1"})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		assert.Len(t, operated, 2)
+		for i, role := range []string{"role1", "role2"} {
+			assert.Equal(t, rootcoordpb.OperateRowPolicyType_DropRowPolicy, operated[i].GetType())
+			assert.Equal(t, role, operated[i].GetPolicy().GetRole())
+			assert.Equal(t, "coll", operated[i].GetPolicy().GetCollectionName())
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := node.ListRowPolicies(ctx, &milvuspb.ListRowPoliciesRequest{CollectionName: "coll"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetPolicies(), 2)
+		assert.Equal(t, "p1", resp.GetPolicies()[0].GetPolicyName())
+		assert.Equal(t, []string{"role1", "role2"}, resp.GetPolicies()[0].GetRoles())
+		assert.Equal(t, "tenant == 1", resp.GetPolicies()[0].GetUsingExpr())
+		assert.Len(t, resp.GetPolicies()[0].GetActions(), len(milvuspb.RowPolicyAction_name))
+		assert.Equal(t, "p2", resp.GetPolicies()[1].GetPolicyName())
+
+		resp, err = node.ListRowPolicies(ctx, &milvuspb.ListRowPoliciesRequest{})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetPolicies(), 3)
+	})
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		status, err := node.CreateRowPolicy(ctx, &milvuspb.CreateRowPolicyRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrServiceNotReady)
+		status, err = node.DropRowPolicy(ctx, &milvuspb.DropRowPolicyRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrServiceNotReady)
+		resp, err := node.ListRowPolicies(ctx, &milvuspb.ListRowPoliciesRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceNotReady)
+	})
+}
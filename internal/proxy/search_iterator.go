@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// SearchIteratorKey in search params opens a search iterator, the value is "true".
+	SearchIteratorKey = "iterator"
+	// SearchIteratorTokenKey in search params resumes the search iterator of the token,
+	// it's also the grpc header key which carries the token of next page.
+	SearchIteratorTokenKey = "iterator_token"
+
+	RadiusKey      = "radius"
+	RangeFilterKey = "range_filter"
+)
+
+// searchCursor is the server side state of a search iterator. Every page after the first one
+// is a range search from the distance of the last returned entity, the entities returned with
+// exactly that distance are excluded by the filter expression.
+type searchCursor struct {
+	request    *milvuspb.SearchRequest // request of the first page without iterator params
+	batchSize  int64
+	metricType string
+	pkField    *schemapb.FieldSchema
+
+	// radius is the outer bound given by user, nil means no bound
+	radius *float64
+
+	started      bool
+	lastDistance float32
+	lastPKs      []interface{} // returned primary keys whose distance is lastDistance
+}
+
+// newSearchCursor validates the first page request of search iterator and creates the cursor.
+func newSearchCursor(request *milvuspb.SearchRequest, pkField *schemapb.FieldSchema) (*searchCursor, error) {
+	nq, err := getNq(request)
+	if err != nil {
+		return nil, err
+	}
+	if nq != 1 {
+		return nil, merr.WrapErrParameterInvalid(1, nq, "search iterator only supports one query vector")
+	}
+	if request.GetDslType() != commonpb.DslType_BoolExprV1 {
+		return nil, merr.WrapErrParameterInvalidMsg("search iterator only supports boolean expression")
+	}
+	searchParams := request.GetSearchParams()
+	if offset, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, searchParams); err == nil && offset != "" && offset != "0" {
+		return nil, merr.WrapErrParameterInvalidMsg("offset is not supported by search iterator")
+	}
+	// distances are compared between pages, they can't be rounded
+	if roundDecimal, err := funcutil.GetAttrByKeyFromRepeatedKV(RoundDecimalKey, searchParams); err == nil && roundDecimal != "-1" {
+		return nil, merr.WrapErrParameterInvalidMsg("round_decimal is not supported by search iterator")
+	}
+	metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, searchParams)
+	if err != nil || metricType == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("metric_type is required by search iterator")
+	}
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, searchParams)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("topk is required as the batch size of search iterator")
+	}
+	batchSize, err := strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalid("integer", topKStr, "invalid topk")
+	}
+
+	cursor := &searchCursor{
+		request:    proto.Clone(request).(*milvuspb.SearchRequest),
+		batchSize:  batchSize,
+		metricType: metricType,
+		pkField:    pkField,
+	}
+	cursor.request.SearchParams = lo.Filter(cursor.request.GetSearchParams(), func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != SearchIteratorKey && kv.GetKey() != SearchIteratorTokenKey
+	})
+
+	params, err := cursor.params()
+	if err != nil {
+		return nil, err
+	}
+	if radius, ok := params[RadiusKey]; ok {
+		value, ok := radius.(float64)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid("number", radius, "invalid radius")
+		}
+		cursor.radius = &value
+	}
+	return cursor, nil
+}
+
+// params returns the index params of the first page request.
+func (c *searchCursor) params() (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, c.request.GetSearchParams())
+	if err != nil || paramsStr == "" {
+		return params, nil
+	}
+	if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+		return nil, merr.WrapErrParameterInvalid("json object", paramsStr, "invalid search params")
+	}
+	return params, nil
+}
+
+// pageRequest returns the search request of next page with batch size.
+func (c *searchCursor) pageRequest(batchSize int64) (*milvuspb.SearchRequest, error) {
+	request := proto.Clone(c.request).(*milvuspb.SearchRequest)
+	setKV := func(key, value string) {
+		for _, kv := range request.SearchParams {
+			if kv.GetKey() == key {
+				kv.Value = value
+				return
+			}
+		}
+		request.SearchParams = append(request.SearchParams, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	setKV(TopKKey, strconv.FormatInt(batchSize, 10))
+	if !c.started {
+		return request, nil
+	}
+
+	// range search from the last distance, range_filter is inclusive and radius is exclusive
+	params, err := c.params()
+	if err != nil {
+		return nil, err
+	}
+	params[RangeFilterKey] = float64(c.lastDistance)
+	if c.radius != nil {
+		params[RadiusKey] = *c.radius
+	} else if metric.PositivelyRelated(c.metricType) {
+		params[RadiusKey] = -math.MaxFloat32
+	} else {
+		params[RadiusKey] = math.MaxFloat32
+	}
+	bs, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	setKV(SearchParamsKey, string(bs))
+
+	if len(c.lastPKs) > 0 {
+		request.Dsl = c.excludeExpr(request.GetDsl())
+	}
+	return request, nil
+}
+
+// excludeExpr appends the filter which excludes returned entities with last distance.
+func (c *searchCursor) excludeExpr(expr string) string {
+	pks := lo.Map(c.lastPKs, func(pk interface{}, _ int) string {
+		switch v := pk.(type) {
+		case string:
+			return strconv.Quote(v)
+		default:
+			return fmt.Sprint(v)
+		}
+	})
+	excludeExpr := fmt.Sprintf("%s not in [%s]", c.pkField.GetName(), strings.Join(pks, ", "))
+	if strings.TrimSpace(expr) == "" {
+		return excludeExpr
+	}
+	return fmt.Sprintf("(%s) && %s", expr, excludeExpr)
+}
+
+// advance moves the cursor after the page, it returns false if no more entities left.
+func (c *searchCursor) advance(result *schemapb.SearchResultData, batchSize int64) bool {
+	ids := result.GetIds()
+	n := typeutil.GetSizeOfIDs(ids)
+	if n == 0 {
+		return false
+	}
+	lastDistance := result.GetScores()[n-1]
+	if !c.started || lastDistance != c.lastDistance {
+		c.lastPKs = nil
+	}
+	for i := n - 1; i >= 0 && result.GetScores()[i] == lastDistance; i-- {
+		c.lastPKs = append(c.lastPKs, typeutil.GetPK(ids, int64(i)))
+	}
+	c.started = true
+	c.lastDistance = lastDistance
+	return int64(n) >= batchSize
+}
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+type SearchIteratorSuite struct {
+	suite.Suite
+	pkField *schemapb.FieldSchema
+}
+
+func (s *SearchIteratorSuite) SetupSuite() {
+	s.pkField = &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+}
+
+func (s *SearchIteratorSuite) genRequest(nq int, params map[string]string) *milvuspb.SearchRequest {
+	placeholderGroup := &commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{
+			Tag:    "$0",
+			Type:   commonpb.PlaceholderType_FloatVector,
+			Values: make([][]byte, nq),
+		}},
+	}
+	bs, err := proto.Marshal(placeholderGroup)
+	s.Require().NoError(err)
+	kvs := []*commonpb.KeyValuePair{
+		{Key: SearchIteratorKey, Value: "true"},
+		{Key: TopKKey, Value: "3"},
+		{Key: common.MetricTypeKey, Value: metric.L2},
+		{Key: RoundDecimalKey, Value: "-1"},
+	}
+	for k, v := range params {
+		kvs = append(kvs, &commonpb.KeyValuePair{Key: k, Value: v})
+	}
+	return &milvuspb.SearchRequest{
+		CollectionName:   "test_iterator",
+		Dsl:              "age > 10",
+		DslType:          commonpb.DslType_BoolExprV1,
+		PlaceholderGroup: bs,
+		SearchParams:     kvs,
+	}
+}
+
+func (s *SearchIteratorSuite) genResult(pks []int64, scores []float32) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: 1,
+		TopK:       int64(len(pks)),
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		Scores:     scores,
+		Topks:      []int64{int64(len(pks))},
+	}
+}
+
+func (s *SearchIteratorSuite) pageParams(request *milvuspb.SearchRequest) map[string]interface{} {
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, request.GetSearchParams())
+	s.Require().NoError(err)
+	params := make(map[string]interface{})
+	s.Require().NoError(json.Unmarshal([]byte(paramsStr), &params))
+	return params
+}
+
+func (s *SearchIteratorSuite) TestNewSearchCursor() {
+	cursor, err := newSearchCursor(s.genRequest(1, map[string]string{SearchParamsKey: `{"nprobe": 10, "radius": 5}`}), s.pkField)
+	s.NoError(err)
+	s.EqualValues(3, cursor.batchSize)
+	s.Equal(metric.L2, cursor.metricType)
+	s.Require().NotNil(cursor.radius)
+	s.Equal(5.0, *cursor.radius)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(SearchIteratorKey, cursor.request.GetSearchParams())
+	s.Error(err)
+
+	s.Run("multiple vectors", func() {
+		_, err := newSearchCursor(s.genRequest(2, nil), s.pkField)
+		s.Error(err)
+	})
+
+	s.Run("offset", func() {
+		_, err := newSearchCursor(s.genRequest(1, map[string]string{OffsetKey: "10"}), s.pkField)
+		s.Error(err)
+	})
+
+	s.Run("round decimal", func() {
+		request := s.genRequest(1, nil)
+		request.SearchParams[3].Value = "2"
+		_, err := newSearchCursor(request, s.pkField)
+		s.Error(err)
+	})
+
+	s.Run("invalid radius", func() {
+		_, err := newSearchCursor(s.genRequest(1, map[string]string{SearchParamsKey: `{"radius": "a"}`}), s.pkField)
+		s.Error(err)
+	})
+}
+
+func (s *SearchIteratorSuite) TestPageRequest() {
+	cursor, err := newSearchCursor(s.genRequest(1, map[string]string{SearchParamsKey: `{"nprobe": 10}`}), s.pkField)
+	s.Require().NoError(err)
+
+	request, err := cursor.pageRequest(5)
+	s.NoError(err)
+	s.Equal("age > 10", request.GetDsl())
+	topK, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, request.GetSearchParams())
+	s.NoError(err)
+	s.Equal("5", topK)
+	s.NotContains(s.pageParams(request), RangeFilterKey)
+
+	s.True(cursor.advance(s.genResult([]int64{1, 2, 3}, []float32{0.1, 0.2, 0.2}), 3))
+	request, err = cursor.pageRequest(3)
+	s.NoError(err)
+	s.Equal("(age > 10) && pk not in [3, 2]", request.GetDsl())
+	params := s.pageParams(request)
+	s.InDelta(0.2, params[RangeFilterKey], 1e-6)
+	s.Equal(float64(math.MaxFloat32), params[RadiusKey])
+	s.Equal(10.0, params["nprobe"])
+
+	// positively related metric searches towards smaller scores
+	cursor.metricType = metric.IP
+	request, err = cursor.pageRequest(3)
+	s.NoError(err)
+	s.Equal(float64(-math.MaxFloat32), s.pageParams(request)[RadiusKey])
+}
+
+func (s *SearchIteratorSuite) TestAdvance() {
+	cursor, err := newSearchCursor(s.genRequest(1, nil), s.pkField)
+	s.Require().NoError(err)
+
+	s.True(cursor.advance(s.genResult([]int64{1, 2, 3}, []float32{0.1, 0.2, 0.2}), 3))
+	s.ElementsMatch([]interface{}{int64(2), int64(3)}, cursor.lastPKs)
+
+	// ties with the last distance are accumulated across pages
+	s.True(cursor.advance(s.genResult([]int64{4, 5, 6}, []float32{0.2, 0.2, 0.2}), 3))
+	s.ElementsMatch([]interface{}{int64(2), int64(3), int64(4), int64(5), int64(6)}, cursor.lastPKs)
+
+	s.False(cursor.advance(s.genResult([]int64{7}, []float32{0.3}), 3))
+	s.Equal([]interface{}{int64(7)}, cursor.lastPKs)
+	s.Equal(float32(0.3), cursor.lastDistance)
+
+	s.False(cursor.advance(s.genResult(nil, nil), 3))
+}
+
+func TestSearchIterator(t *testing.T) {
+	suite.Run(t, new(SearchIteratorSuite))
+}
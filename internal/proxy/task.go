@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -52,6 +53,10 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	GroupByFieldKey      = "group_by_field"
+	GroupSizeKey         = "group_size"
+	GroupByFieldsKey     = "group_by_fields"
+	OrderByKey           = "order_by"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -73,6 +78,8 @@ const (
 	DropAliasTaskName             = "DropAliasTask"
 	AlterAliasTaskName            = "AlterAliasTask"
 	AlterCollectionTaskName       = "AlterCollectionTask"
+	AddCollectionFieldTaskName    = "AddCollectionFieldTask"
+	DropCollectionFieldTaskName   = "DropCollectionFieldTask"
 	UpsertTaskName                = "UpsertTask"
 	CreateResourceGroupTaskName   = "CreateResourceGroupTask"
 	DropResourceGroupTaskName     = "DropResourceGroupTask"
@@ -289,6 +296,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		// validate nullable scalar field
+		if err := validateNullable(field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(t.schema); err != nil {
@@ -813,6 +824,163 @@ func (t *alterCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// addCollectionFieldTask adds a defaulted field to an existing collection.
+type addCollectionFieldTask struct {
+	Condition
+	*milvuspb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+	field     *schemapb.FieldSchema
+}
+
+func (t *addCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *addCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *addCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (t *addCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *addCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *addCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	if len(t.GetSchema()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("the added field is empty")
+	}
+	t.field = &schemapb.FieldSchema{}
+	if err := proto.Unmarshal(t.GetSchema(), t.field); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid schema of the added field: %s", err.Error())
+	}
+	if err := validateFieldName(t.field.GetName()); err != nil {
+		return err
+	}
+	// segcore can't read null yet, the rows written before must be filled with the default value
+	if t.field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field %s must have a default value", t.field.GetName())
+	}
+	// the remaining checks of field depend on the collection, they're done by rootcoord
+	return nil
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AddCollectionField(ctx, &rootcoordpb.AddCollectionFieldRequest{
+		Base:           t.GetBase(),
+		DbName:         t.GetDbName(),
+		CollectionName: t.GetCollectionName(),
+		Field:          t.field,
+	})
+	return err
+}
+
+func (t *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// dropCollectionFieldTask drops a scalar field without index from an existing collection.
+type dropCollectionFieldTask struct {
+	Condition
+	*rootcoordpb.DropCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *dropCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *dropCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *dropCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *dropCollectionFieldTask) Name() string {
+	return DropCollectionFieldTaskName
+}
+
+func (t *dropCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *dropCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *dropCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *dropCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	return validateFieldName(t.GetFieldName())
+}
+
+func (t *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.DropCollectionField(ctx, t.DropCollectionFieldRequest)
+	return err
+}
+
+func (t *dropCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
@@ -1461,7 +1629,7 @@ func (t *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	for _, index := range indexResponse.IndexInfos {
 		fieldIndexIDs[index.FieldID] = index.IndexID
 		for _, field := range collSchema.Fields {
-			if index.FieldID == field.FieldID && (field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_Float16Vector) {
+			if index.FieldID == field.FieldID && isVectorType(field.DataType) {
 				hasVecIndex = true
 			}
 		}
@@ -1701,7 +1869,7 @@ func (t *loadPartitionsTask) Execute(ctx context.Context) error {
 	for _, index := range indexResponse.IndexInfos {
 		fieldIndexIDs[index.FieldID] = index.IndexID
 		for _, field := range collSchema.Fields {
-			if index.FieldID == field.FieldID && (field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_Float16Vector) {
+			if index.FieldID == field.FieldID && isVectorType(field.DataType) {
 				hasVecIndex = true
 			}
 		}
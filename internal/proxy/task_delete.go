@@ -205,6 +205,14 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 	}
 	dt.schema = schema
 
+	// empty expression is rejected on execution, it mustn't delete all the rows permitted by row policies
+	if len(dt.req.GetExpr()) > 0 {
+		dt.req.Expr, err = applyRowPolicies(ctx, schema, dt.req.GetDbName(), collName, dt.req.GetExpr())
+		if err != nil {
+			return err
+		}
+	}
+
 	// hash primary keys to channels
 	channelNames, err := dt.chMgr.getVChannels(dt.collectionID)
 	if err != nil {
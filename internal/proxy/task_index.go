@@ -304,6 +304,8 @@ func fillDimension(field *schemapb.FieldSchema, indexParams map[string]string) e
 		schemapb.DataType_FloatVector,
 		schemapb.DataType_BinaryVector,
 		schemapb.DataType_Float16Vector,
+		typeutil.BFloat16Vector,
+		typeutil.Int8Vector,
 	}
 	if !funcutil.SliceContain(vecDataTypes, field.GetDataType()) {
 		return nil
@@ -333,6 +335,9 @@ func checkTrain(field *schemapb.FieldSchema, indexParams map[string]string) erro
 		schemapb.DataType_FloatVector,
 		schemapb.DataType_BinaryVector,
 		schemapb.DataType_Float16Vector,
+		typeutil.BFloat16Vector,
+		typeutil.Int8Vector,
+		typeutil.SparseFloatVector,
 	}
 	if !funcutil.SliceContain(vecDataTypes, field.GetDataType()) {
 		return indexparamcheck.CheckIndexValid(field.GetDataType(), indexType, indexParams)
@@ -203,6 +203,11 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := checkInsertRowPolicies(ctx, schema, it.insertMsg.GetDbName(), collectionName, it.insertMsg.GetFieldsData(), it.insertMsg.NRows()); err != nil {
+		log.Warn("check row policies of inserted rows failed", zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done")
 
 	return nil
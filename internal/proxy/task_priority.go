@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// taskPriority is the priority class of task, smaller is more urgent.
+type taskPriority int
+
+const (
+	taskPriorityHigh taskPriority = iota
+	taskPriorityNormal
+	taskPriorityLow
+
+	numTaskPriorities = 3
+)
+
+var taskPriorityNames = map[string]taskPriority{
+	"high":   taskPriorityHigh,
+	"normal": taskPriorityNormal,
+	"low":    taskPriorityLow,
+}
+
+func parseTaskPriority(name string) (taskPriority, bool) {
+	priority, ok := taskPriorityNames[strings.ToLower(strings.TrimSpace(name))]
+	return priority, ok
+}
+
+// getTaskPriority returns the priority of request, which is the most urgent priority of roles of user in config,
+// normal if none of the roles is configured. The request-priority metadata of client isn't trusted, it could only
+// lower the priority. Requests are normal priority if task priority is disabled.
+func getTaskPriority(ctx context.Context) taskPriority {
+	params := paramtable.Get()
+	if ctx == nil || !params.ProxyCfg.TaskPriorityEnabled.GetAsBool() {
+		return taskPriorityNormal
+	}
+	priority := getRoleTaskPriority(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(util.HeaderRequestPriority); len(values) > 0 {
+			if requested, ok := parseTaskPriority(values[0]); ok && requested > priority {
+				priority = requested
+			}
+		}
+	}
+	return priority
+}
+
+// getRoleTaskPriority returns the most urgent priority of roles of user, normal if none of them is configured.
+func getRoleTaskPriority(ctx context.Context) taskPriority {
+	rolePriorities := paramtable.Get().ProxyCfg.TaskPriorityRoles.GetAsJSONMap()
+	if len(rolePriorities) == 0 || globalMetaCache == nil {
+		return taskPriorityNormal
+	}
+	user, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return taskPriorityNormal
+	}
+	priority, found := taskPriorityLow, false
+	for _, role := range globalMetaCache.GetUserRole(user) {
+		if p, ok := parseTaskPriority(rolePriorities[role]); ok && (!found || p < priority) {
+			priority, found = p, true
+		}
+	}
+	if !found {
+		return taskPriorityNormal
+	}
+	return priority
+}
+
+// getTaskPriorityWeights returns the weights of weighted fair dequeuing, at least 1.
+func getTaskPriorityWeights() [numTaskPriorities]int {
+	params := paramtable.Get()
+	weights := [numTaskPriorities]int{
+		params.ProxyCfg.TaskPriorityHighWeight.GetAsInt(),
+		params.ProxyCfg.TaskPriorityNormalWeight.GetAsInt(),
+		params.ProxyCfg.TaskPriorityLowWeight.GetAsInt(),
+	}
+	for i := range weights {
+		if weights[i] < 1 {
+			weights[i] = 1
+		}
+	}
+	return weights
+}
+
+// queuedTask is the task waiting in priorityTaskList.
+type queuedTask struct {
+	task        task
+	enqueueTime time.Time
+}
+
+// priorityTaskList keeps the tasks of every priority in FIFO order, and dequeues them by weighted
+// round-robin, so that the tasks of low priority are delayed but not starved by more urgent ones.
+// It's not thread safe.
+type priorityTaskList struct {
+	lists [numTaskPriorities]*list.List
+	// credits are the numbers of tasks which could be dequeued from every priority in current round
+	credits [numTaskPriorities]int
+}
+
+func newPriorityTaskList() *priorityTaskList {
+	l := &priorityTaskList{}
+	for i := range l.lists {
+		l.lists[i] = list.New()
+	}
+	return l
+}
+
+// Len returns the number of tasks of all priorities.
+func (l *priorityTaskList) Len() int {
+	n := 0
+	for _, tasks := range l.lists {
+		n += tasks.Len()
+	}
+	return n
+}
+
+// PushBack adds the task to the end of the list of its priority.
+func (l *priorityTaskList) PushBack(t task, priority taskPriority) {
+	l.lists[priority].PushBack(&queuedTask{task: t, enqueueTime: time.Now()})
+}
+
+// next returns the priority whose task would be dequeued next, or -1 if the list is empty.
+// The most urgent non-empty priority with credits is chosen, if none of them has credits,
+// a new round starts and the most urgent non-empty priority is chosen.
+func (l *priorityTaskList) next() int {
+	first := -1
+	for i, tasks := range l.lists {
+		if tasks.Len() == 0 {
+			continue
+		}
+		if l.credits[i] > 0 {
+			return i
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	return first
+}
+
+// Front returns the task which would be dequeued next, nil if the list is empty.
+func (l *priorityTaskList) Front() task {
+	i := l.next()
+	if i < 0 {
+		return nil
+	}
+	return l.lists[i].Front().Value.(*queuedTask).task
+}
+
+// PopFront removes and returns the task which would be dequeued next, nil if the list is empty.
+func (l *priorityTaskList) PopFront() task {
+	i := l.next()
+	if i < 0 {
+		return nil
+	}
+	if l.credits[i] <= 0 {
+		l.credits = getTaskPriorityWeights()
+	}
+	l.credits[i]--
+	return l.lists[i].Remove(l.lists[i].Front()).(*queuedTask).task
+}
+
+// Range calls fn on every task until it returns false.
+func (l *priorityTaskList) Range(fn func(t task) bool) {
+	for _, tasks := range l.lists {
+		for e := tasks.Front(); e != nil; e = e.Next() {
+			if !fn(e.Value.(*queuedTask).task) {
+				return
+			}
+		}
+	}
+}
+
+// maxWait returns how long the oldest task has waited in the list.
+func (l *priorityTaskList) maxWait() time.Duration {
+	var wait time.Duration
+	for _, tasks := range l.lists {
+		if e := tasks.Front(); e != nil {
+			if d := time.Since(e.Value.(*queuedTask).enqueueTime); d > wait {
+				wait = d
+			}
+		}
+	}
+	return wait
+}
@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetTaskPriority(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	priorityCtx := func(priority string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderRequestPriority, priority))
+	}
+
+	// disabled
+	assert.Equal(t, taskPriorityNormal, getTaskPriority(priorityCtx("high")))
+
+	params.Save(params.ProxyCfg.TaskPriorityEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.TaskPriorityEnabled.Key)
+	// client could only lower the priority
+	assert.Equal(t, taskPriorityNormal, getTaskPriority(priorityCtx("high")))
+	assert.Equal(t, taskPriorityLow, getTaskPriority(priorityCtx("Low")))
+	assert.Equal(t, taskPriorityNormal, getTaskPriority(priorityCtx("unknown")))
+	assert.Equal(t, taskPriorityNormal, getTaskPriority(context.Background()))
+
+	// by roles of user
+	params.Save(params.ProxyCfg.TaskPriorityRoles.Key, `{"etl": "low", "admin": "high"}`)
+	defer params.Reset(params.ProxyCfg.TaskPriorityRoles.Key)
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetUserRole("alice").Return([]string{"public", "etl"})
+	mockCache.EXPECT().GetUserRole("bob").Return([]string{"etl", "admin"})
+	globalMetaCache = mockCache
+	userCtx := func(user string) context.Context {
+		authorization := crypto.Base64Encode(user + util.CredentialSeperator + "password")
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(strings.ToLower(util.HeaderAuthorize), authorization))
+	}
+	assert.Equal(t, taskPriorityLow, getTaskPriority(userCtx("alice")))
+	assert.Equal(t, taskPriorityHigh, getTaskPriority(userCtx("bob")))
+
+	// the metadata of client can't raise the priority of user
+	withPriority := func(ctx context.Context, priority string) context.Context {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return metadata.NewIncomingContext(ctx, metadata.Join(md, metadata.Pairs(util.HeaderRequestPriority, priority)))
+	}
+	assert.Equal(t, taskPriorityLow, getTaskPriority(withPriority(userCtx("alice"), "high")))
+	assert.Equal(t, taskPriorityNormal, getTaskPriority(withPriority(userCtx("bob"), "normal")))
+}
+
+func TestPriorityTaskList(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.TaskPriorityHighWeight.Key, "2")
+	params.Save(params.ProxyCfg.TaskPriorityNormalWeight.Key, "1")
+	params.Save(params.ProxyCfg.TaskPriorityLowWeight.Key, "0")
+	defer func() {
+		params.Reset(params.ProxyCfg.TaskPriorityHighWeight.Key)
+		params.Reset(params.ProxyCfg.TaskPriorityNormalWeight.Key)
+		params.Reset(params.ProxyCfg.TaskPriorityLowWeight.Key)
+	}()
+
+	l := newPriorityTaskList()
+	assert.Nil(t, l.Front())
+	assert.Nil(t, l.PopFront())
+
+	tasks := make(map[task]taskPriority)
+	for _, priority := range []taskPriority{taskPriorityLow, taskPriorityNormal, taskPriorityHigh} {
+		for i := 0; i < 3; i++ {
+			mt := newDefaultMockTask()
+			tasks[mt] = priority
+			l.PushBack(mt, priority)
+		}
+	}
+	assert.Equal(t, 9, l.Len())
+
+	count := 0
+	l.Range(func(t task) bool {
+		count++
+		return count < 4
+	})
+	assert.Equal(t, 4, count)
+
+	// high, high, normal, low, high, normal, low, normal, low
+	expected := []taskPriority{
+		taskPriorityHigh, taskPriorityHigh, taskPriorityNormal, taskPriorityLow,
+		taskPriorityHigh, taskPriorityNormal, taskPriorityLow,
+		taskPriorityNormal, taskPriorityLow,
+	}
+	for _, priority := range expected {
+		front := l.Front()
+		popped := l.PopFront()
+		assert.Equal(t, front, popped)
+		assert.Equal(t, priority, tasks[popped])
+	}
+	assert.Equal(t, 0, l.Len())
+	assert.Equal(t, time.Duration(0), l.maxWait())
+}
+
+func TestDqTaskQueue_ShedLowPriority(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.TaskPriorityEnabled.Key, "true")
+	params.Save(params.ProxyCfg.TaskPriorityMaxQueueWait.Key, "1")
+	defer func() {
+		params.Reset(params.ProxyCfg.TaskPriorityEnabled.Key)
+		params.Reset(params.ProxyCfg.TaskPriorityMaxQueueWait.Key)
+	}()
+
+	queue := newDqTaskQueue(newMockTsoAllocator())
+	err := queue.Enqueue(newDefaultMockTask())
+	assert.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	lowCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderRequestPriority, "low"))
+	err = queue.Enqueue(newMockTask(lowCtx))
+	assert.True(t, errors.Is(err, merr.ErrServiceRateLimit))
+
+	highCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderRequestPriority, "high"))
+	err = queue.Enqueue(newMockTask(highCtx))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, queue.unissuedTasks.Len())
+
+	// ddl and dml tasks are neither shed nor reordered
+	for _, queue := range []*baseTaskQueue{newDdTaskQueue(newMockTsoAllocator()).baseTaskQueue, newDmTaskQueue(newMockTsoAllocator()).baseTaskQueue} {
+		first := newDefaultMockTask()
+		assert.NoError(t, queue.Enqueue(first))
+		time.Sleep(10 * time.Millisecond)
+		second := newMockTask(lowCtx)
+		assert.NoError(t, queue.Enqueue(second))
+		third := newMockTask(highCtx)
+		assert.NoError(t, queue.Enqueue(third))
+		assert.Equal(t, first, queue.PopUnissuedTask())
+		assert.Equal(t, second, queue.PopUnissuedTask())
+		assert.Equal(t, third, queue.PopUnissuedTask())
+	}
+}
@@ -3,8 +3,10 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -19,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -26,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -55,18 +59,41 @@ type queryTask struct {
 	schema         *schemapb.CollectionSchema
 
 	userOutputFields []string
+	// maskedFields are the output fields masked since the user isn't granted to read them
+	maskedFields []string
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.RetrieveResults]
 
 	plan             *planpb.PlanNode
 	partitionKeyMode bool
 	lb               LBPolicy
+
+	// streamSender is set for streaming query, results are sent to it batch by batch
+	// as query nodes produce them instead of being reduced in PostExecute.
+	streamSender func(*milvuspb.QueryResults) error
+	streamMu     sync.Mutex
+	streamedRows int64
+	streamCancel context.CancelFunc
+	// streamedPKs are the primary keys sent to streamSender, the entities of them in later batches are skipped
+	streamedPKs map[interface{}]struct{}
+
+	// cursor is set for query iterator, each shard is queried from its last returned primary key
+	cursor       *queryCursor
+	shardResults *typeutil.ConcurrentMap[string, *internalpb.RetrieveResults]
+	iteratorMore bool
+
+	// the order by fields are output only for reduce, they're removed from results after reduce
+	orderByFieldsAppended []int64
 }
 
 type queryParams struct {
 	limit             int64
 	offset            int64
 	reduceStopForBest bool
+	groupBy           []string
+	orderBy           []string
+	// order is parsed from orderBy by createPlan, results are merged by primary keys if it's nil
+	order *reduce.OrderBy
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -74,7 +101,7 @@ func translateToOutputFieldIDs(outputFields []string, schema *schemapb.Collectio
 	outputFieldIDs := make([]UniqueID, 0, len(outputFields)+1)
 	if len(outputFields) == 0 {
 		for _, field := range schema.Fields {
-			if field.FieldID >= common.StartOfUserFieldID && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector && field.DataType != schemapb.DataType_Float16Vector {
+			if field.FieldID >= common.StartOfUserFieldID && !typeutil.IsVectorType(field.DataType) {
 				outputFieldIDs = append(outputFieldIDs, field.FieldID)
 			}
 		}
@@ -143,10 +170,30 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		}
 	}
 
+	// group_by_fields is a comma separated list of fields
+	var groupBy []string
+	if groupByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldsKey, queryParamsPair); err == nil {
+		for _, field := range strings.Split(groupByStr, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				groupBy = append(groupBy, field)
+			}
+		}
+	}
+
+	// order_by is a comma separated list of fields with optional asc or desc, like "price desc, id"
+	var orderBy []string
+	if orderByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OrderByKey, queryParamsPair); err == nil {
+		for _, item := range strings.Split(orderByStr, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				orderBy = append(orderBy, item)
+			}
+		}
+	}
+
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
-		return &queryParams{limit: typeutil.Unlimited, reduceStopForBest: reduceStopForBest}, nil
+		return &queryParams{limit: typeutil.Unlimited, reduceStopForBest: reduceStopForBest, groupBy: groupBy, orderBy: orderBy}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
 	if err != nil {
@@ -171,6 +218,8 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		limit:             limit,
 		offset:            offset,
 		reduceStopForBest: reduceStopForBest,
+		groupBy:           groupBy,
+		orderBy:           orderBy,
 	}, nil
 }
 
@@ -203,15 +252,32 @@ func createCntPlan(expr string, schema *schemapb.CollectionSchema) (*planpb.Plan
 func (t *queryTask) createPlan(ctx context.Context) error {
 	schema := t.schema
 
+	var groupBy, orderBy []string
+	if t.queryParams != nil {
+		groupBy = t.queryParams.groupBy
+		orderBy = t.queryParams.orderBy
+	}
+
 	cntMatch := matchCountRule(t.request.GetOutputFields())
-	if cntMatch {
+	if cntMatch && len(groupBy) == 0 {
+		if len(orderBy) > 0 {
+			return fmt.Errorf("count entities with order by is not allowed")
+		}
 		var err error
 		t.plan, err = createCntPlan(t.request.GetExpr(), schema)
 		t.userOutputFields = []string{"count(*)"}
 		return err
 	}
+	if len(groupBy) > 0 || lo.ContainsBy(t.request.GetOutputFields(), planparserv2.IsAggregate) {
+		if len(orderBy) > 0 {
+			return fmt.Errorf("aggregation with order by is not allowed")
+		}
+		return t.createAggregatePlan(ctx, groupBy)
+	}
 
 	var err error
+	// the output fields of requery are checked by search task
+	requery := t.plan != nil
 	if t.plan == nil {
 		t.plan, err = planparserv2.CreateRetrievePlan(schema, t.request.Expr)
 		if err != nil {
@@ -219,15 +285,29 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 		}
 	}
 
+	requestedFields := t.request.GetOutputFields()
 	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
 	if err != nil {
 		return err
 	}
+	if !requery {
+		t.request.OutputFields, t.userOutputFields, t.maskedFields, err = applyFieldPrivileges(ctx, t.request.GetDbName(), schema,
+			commonpb.ObjectPrivilege_PrivilegeQuery, requestedFields, t.request.GetOutputFields(), t.userOutputFields)
+		if err != nil {
+			return err
+		}
+	}
 
 	outputFieldIDs, err := translateToOutputFieldIDs(t.request.GetOutputFields(), schema)
 	if err != nil {
 		return err
 	}
+	if len(orderBy) > 0 {
+		outputFieldIDs, err = t.applyOrderBy(ctx, orderBy, outputFieldIDs)
+		if err != nil {
+			return err
+		}
+	}
 	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	t.plan.OutputFieldIds = outputFieldIDs
@@ -238,6 +318,73 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	return nil
 }
 
+// applyOrderBy orders the entities of plan by the order by fields instead of primary keys, the fields
+// which are not in outputFieldIDs are appended to it, and removed from the results after reduce.
+// The fields not granted to query are rejected since the order reveals their values.
+func (t *queryTask) applyOrderBy(ctx context.Context, orderBy []string, outputFieldIDs []UniqueID) ([]UniqueID, error) {
+	schema, err := typeutil.CreateSchemaHelper(t.schema)
+	if err != nil {
+		return nil, err
+	}
+	query := t.plan.GetQuery()
+	query.OrderBy = make([]*planpb.OrderBy, 0, len(orderBy))
+	t.orderByFieldsAppended = nil
+	for _, item := range orderBy {
+		order, err := planparserv2.ParseOrderBy(schema, item)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkColumnPrivileges(ctx, t.request.GetDbName(), t.schema, commonpb.ObjectPrivilege_PrivilegeQuery, []*planpb.ColumnInfo{order.GetColumn()}); err != nil {
+			return nil, err
+		}
+		query.OrderBy = append(query.OrderBy, order)
+		fieldID := order.GetColumn().GetFieldId()
+		if !lo.Contains(outputFieldIDs, fieldID) {
+			outputFieldIDs = append(outputFieldIDs, fieldID)
+			t.orderByFieldsAppended = append(t.orderByFieldsAppended, fieldID)
+		}
+	}
+	t.queryParams.order = reduce.NewOrderBy(query)
+	return outputFieldIDs, nil
+}
+
+// createAggregatePlan creates the plan which returns the aggregates of output fields for every group
+// instead of entities, the fields of aggregates and group by are retrieved from segments.
+func (t *queryTask) createAggregatePlan(ctx context.Context, groupBy []string) error {
+	var err error
+	t.plan, err = planparserv2.CreateAggregatePlan(t.schema, t.request.GetExpr(), t.request.GetOutputFields(), groupBy)
+	if err != nil {
+		return err
+	}
+	query := t.plan.GetQuery()
+	outputFieldIDs := make([]UniqueID, 0, len(query.GetGroupBy())+len(query.GetAggregates())+1)
+	for _, column := range query.GetGroupBy() {
+		outputFieldIDs = append(outputFieldIDs, column.GetFieldId())
+	}
+	for _, aggregate := range query.GetAggregates() {
+		if aggregate.GetColumn() != nil {
+			outputFieldIDs = append(outputFieldIDs, aggregate.GetColumn().GetFieldId())
+		}
+	}
+	outputFieldIDs = lo.Uniq(outputFieldIDs)
+	// aggregates of denied fields are always rejected, masking them makes no sense
+	fieldNames := lo.FilterMap(t.schema.GetFields(), func(field *schemapb.FieldSchema, _ int) (string, bool) {
+		return field.GetName(), lo.Contains(outputFieldIDs, field.GetFieldID())
+	})
+	denied, err := getDeniedFields(ctx, t.request.GetDbName(), t.schema, commonpb.ObjectPrivilege_PrivilegeQuery, fieldNames)
+	if err != nil {
+		return err
+	}
+	if len(denied) > 0 {
+		return merr.WrapErrPrivilegeNotPermitted("%s of field %s is not granted", commonpb.ObjectPrivilege_PrivilegeQuery.String(), denied[0])
+	}
+	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.plan.OutputFieldIds = outputFieldIDs
+	t.userOutputFields = reduce.OutputNames(query, t.schema)
+	return nil
+}
+
 func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_Retrieve
 	t.Base.SourceID = paramtable.GetNodeID()
@@ -316,12 +463,36 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.request.Expr = IDs2Expr(pkField, t.ids)
 	}
 
+	// requery of search is already filtered by the row policies, every page of query iterator
+	// applies the current policies of user since the cursor keeps the expression of user only
+	if t.plan == nil && t.ids == nil {
+		if err := checkFilterFieldPrivileges(ctx, t.request.GetDbName(), schema, commonpb.ObjectPrivilege_PrivilegeQuery, t.request.GetExpr()); err != nil {
+			return err
+		}
+		t.request.Expr, err = applyRowPolicies(ctx, schema, t.request.GetDbName(), collectionName, t.request.GetExpr())
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
+	// every entity is aggregated by querynodes, limit and offset are applied to the groups by proxy
+	if planparserv2.IsAggregatePlan(t.plan) {
+		t.RetrieveRequest.Limit = typeutil.Unlimited
+	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
+	// segcore cuts the entities by primary keys, so the limit of ordered query is applied to every
+	// segment by querynodes, which retrieve the order by fields first and the output fields of top-N only
+	if len(t.plan.GetQuery().GetOrderBy()) > 0 {
+		t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = typeutil.Unlimited
+		t.queryParams.reduceStopForBest = false
+		t.RetrieveRequest.ReduceStopForBest = false
+	}
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	// streaming query doesn't buffer results in proxy, so it could scan the whole collection
+	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited && t.streamSender == nil {
 		return fmt.Errorf("empty expression should be used with limit")
 	}
 
@@ -349,7 +520,29 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("count entities with pagination is not allowed")
 	}
 
+	if t.cursor != nil {
+		if err := t.initCursor(); err != nil {
+			return err
+		}
+	}
+
+	if t.streamSender != nil {
+		if t.plan.GetQuery().GetIsCount() {
+			return merr.WrapErrParameterInvalidMsg("count entities is not supported by streaming query")
+		}
+		if planparserv2.IsAggregatePlan(t.plan) {
+			return merr.WrapErrParameterInvalidMsg("aggregation is not supported by streaming query")
+		}
+		if len(t.plan.GetQuery().GetOrderBy()) > 0 {
+			return merr.WrapErrParameterInvalidMsg("order by is not supported by streaming query")
+		}
+		if t.queryParams.offset > 0 {
+			return merr.WrapErrParameterInvalidMsg("offset is not supported by streaming query")
+		}
+	}
+
 	t.RetrieveRequest.IsCount = t.plan.GetQuery().GetIsCount()
+	t.RetrieveRequest.IsAggregate = planparserv2.IsAggregatePlan(t.plan)
 	t.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(t.plan)
 	if err != nil {
 		return err
@@ -361,6 +554,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.MvccTimestamp = t.BeginTs()
+	if t.cursor != nil && t.cursor.mvccTs != 0 {
+		// keep reading the same snapshot as the first page of iterator
+		t.MvccTimestamp = t.cursor.mvccTs
+	}
 	collectionInfo, err2 := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, t.CollectionID)
 	if err2 != nil {
 		log.Warn("Proxy::queryTask::PreExecute failed to GetCollectionInfo from cache",
@@ -385,6 +582,14 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	if t.cursor != nil {
+		if t.cursor.mvccTs == 0 {
+			t.cursor.mvccTs = t.MvccTimestamp
+		} else {
+			// the pinned snapshot has been served by the first page
+			guaranteeTs = t.cursor.mvccTs
+		}
+	}
 	t.GuaranteeTimestamp = guaranteeTs
 
 	deadline, ok := t.TraceCtx().Deadline()
@@ -408,13 +613,27 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.String("requestType", "query"))
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
+	t.shardResults = typeutil.NewConcurrentMap[string, *internalpb.RetrieveResults]()
+	exec := t.queryShard
+	if t.streamSender != nil {
+		// the cancel func is used to stop the remaining shard streams once limit is reached
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		t.streamCancel = cancel
+		exec = t.queryShardStream
+	}
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.CollectionID,
 		collectionName: t.collectionName,
 		nq:             1,
-		exec:           t.queryShard,
+		exec:           exec,
 	})
+	if err != nil && t.streamLimitReached() {
+		log.Debug("streaming query stopped since limit reached", zap.Int64("streamedRows", t.streamedRows))
+		err = nil
+	}
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
 		return errors.Wrap(err, "failed to query")
@@ -436,6 +655,17 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 
 	var err error
 
+	if t.streamSender != nil {
+		// results have already been sent to client during Execute
+		t.result = &milvuspb.QueryResults{
+			Status:         merr.Success(),
+			CollectionName: t.collectionName,
+			OutputFields:   t.userOutputFields,
+		}
+		log.Debug("Query stream PostExecute done", zap.Int64("streamedRows", t.streamedRows))
+		return nil
+	}
+
 	toReduceResults := make([]*internalpb.RetrieveResults, 0)
 	select {
 	case <-t.TraceCtx().Done():
@@ -461,6 +691,24 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	t.result.OutputFields = t.userOutputFields
+	if len(t.orderByFieldsAppended) > 0 {
+		t.result.FieldsData = lo.Filter(t.result.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+			return !lo.Contains(t.orderByFieldsAppended, fieldData.GetFieldId())
+		})
+	}
+	if t.cursor != nil {
+		shardResults := make(map[string]*internalpb.RetrieveResults)
+		t.shardResults.Range(func(channel string, result *internalpb.RetrieveResults) bool {
+			shardResults[channel] = result
+			return true
+		})
+		t.iteratorMore, err = t.cursor.advance(shardResults, t.result.GetFieldsData())
+		if err != nil {
+			log.Warn("fail to advance query iterator", zap.Error(err))
+			return err
+		}
+	}
+	maskFieldsData(t.result.GetFieldsData(), t.maskedFields)
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	log.Debug("Query PostExecute done")
@@ -470,6 +718,17 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channelIDs ...string) error {
 	retrieveReq := typeutil.Clone(t.RetrieveRequest)
 	retrieveReq.GetBase().TargetID = nodeID
+	if t.cursor != nil {
+		channel := channelIDs[0]
+		if t.cursor.exhausted.Contain(channel) {
+			return nil
+		}
+		plan, err := t.createShardPlan(channel)
+		if err != nil {
+			return err
+		}
+		retrieveReq.SerializedExprPlan = plan
+	}
 	req := &querypb.QueryRequest{
 		Req:         retrieveReq,
 		DmlChannels: channelIDs,
@@ -499,10 +758,257 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 
 	log.Debug("get query result")
 	t.resultBuf.Insert(result)
+	if t.cursor != nil {
+		t.shardResults.Insert(channelIDs[0], result)
+	}
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
 	return nil
 }
 
+// initCursor validates the iterator request and fills the cursor of the first page.
+func (t *queryTask) initCursor() error {
+	if t.plan.GetQuery().GetIsCount() {
+		return merr.WrapErrParameterInvalidMsg("count entities is not supported by query iterator")
+	}
+	if planparserv2.IsAggregatePlan(t.plan) {
+		return merr.WrapErrParameterInvalidMsg("aggregation is not supported by query iterator")
+	}
+	if len(t.plan.GetQuery().GetOrderBy()) > 0 {
+		return merr.WrapErrParameterInvalidMsg("order by is not supported by query iterator")
+	}
+	if t.queryParams.offset > 0 {
+		return merr.WrapErrParameterInvalidMsg("offset is not supported by query iterator")
+	}
+	if t.queryParams.limit == typeutil.Unlimited {
+		return merr.WrapErrParameterInvalidMsg("limit is required as the batch size of query iterator")
+	}
+	// pages are cut by primary key, so results must be merged by primary key order
+	t.queryParams.reduceStopForBest = false
+	t.RetrieveRequest.ReduceStopForBest = false
+	t.cursor.batchSize = t.queryParams.limit
+
+	if t.cursor.mvccTs != 0 {
+		if t.cursor.collectionID != t.CollectionID {
+			return merr.WrapErrParameterInvalidMsg("collection of query iterator has been dropped")
+		}
+	} else {
+		pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+		if err != nil {
+			return err
+		}
+		t.cursor.collectionID = t.CollectionID
+		t.cursor.pkField = pkField
+	}
+
+	// entities of segments aren't ordered by primary key, the smallest primary keys of every shard
+	// are retrieved in order, so no entity before the last primary key of page is left behind
+	schema, err := typeutil.CreateSchemaHelper(t.schema)
+	if err != nil {
+		return err
+	}
+	order, err := planparserv2.ParseOrderBy(schema, t.cursor.pkField.GetName())
+	if err != nil {
+		return err
+	}
+	t.plan.GetQuery().OrderBy = []*planpb.OrderBy{order}
+	t.queryParams.order = reduce.NewOrderBy(t.plan.GetQuery())
+	return nil
+}
+
+// createShardPlan creates the serialized plan which filters the entities returned by previous pages of the channel.
+func (t *queryTask) createShardPlan(channel string) ([]byte, error) {
+	if _, ok := t.cursor.lastPKs[channel]; !ok {
+		return t.RetrieveRequest.GetSerializedExprPlan(), nil
+	}
+	plan, err := planparserv2.CreateRetrievePlan(t.schema, t.cursor.shardExpr(channel, t.request.GetExpr()))
+	if err != nil {
+		return nil, err
+	}
+	plan.OutputFieldIds = t.plan.GetOutputFieldIds()
+	plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
+	plan.Node.(*planpb.PlanNode_Query).Query.OrderBy = t.plan.GetQuery().GetOrderBy()
+	return proto.Marshal(plan)
+}
+
+// queryShardStream queries the shard by stream rpc and sends the results to streamSender batch by batch.
+// Once any batch of the shard has been sent, the error is marked unrecoverable to avoid
+// retrying on another replica, which would send duplicated entities to client.
+func (t *queryTask) queryShardStream(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channelIDs ...string) error {
+	retrieveReq := typeutil.Clone(t.RetrieveRequest)
+	retrieveReq.GetBase().TargetID = nodeID
+	req := &querypb.QueryRequest{
+		Req:         retrieveReq,
+		DmlChannels: channelIDs,
+		Scope:       querypb.DataScope_All,
+	}
+
+	log := log.Ctx(ctx).With(zap.Int64("collection", t.GetCollectionID()),
+		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
+		zap.Int64("nodeID", nodeID),
+		zap.Strings("channels", channelIDs))
+
+	client, err := qn.QueryStream(ctx, req)
+	if err != nil {
+		log.Warn("QueryNode query stream return error", zap.Error(err))
+		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		return err
+	}
+
+	sent := false
+	wrapErr := func(err error) error {
+		if sent {
+			return retry.Unrecoverable(err)
+		}
+		return err
+	}
+	for {
+		result, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				log.Debug("query stream finished")
+				return nil
+			}
+			log.Warn("QueryNode query stream recv error", zap.Error(err))
+			return wrapErr(err)
+		}
+		if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
+			log.Warn("QueryNode is not shardLeader")
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+			return wrapErr(errInvalidShardLeaders)
+		}
+		if err := merr.Error(result.GetStatus()); err != nil {
+			log.Warn("QueryNode query stream result error", zap.Error(err))
+			return wrapErr(err)
+		}
+
+		if err := t.sendStreamResult(ctx, result); err != nil {
+			log.Warn("failed to send query stream result", zap.Error(err))
+			return retry.Unrecoverable(err)
+		}
+		sent = true
+		t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
+	}
+}
+
+// sendStreamResult converts one batch of retrieve results to QueryResults and sends it to streamSender,
+// the batches are sent one at a time so a slow client throttles the query nodes.
+func (t *queryTask) sendStreamResult(ctx context.Context, result *internalpb.RetrieveResults) error {
+	t.streamMu.Lock()
+	defer t.streamMu.Unlock()
+
+	if t.streamedPKs == nil {
+		t.streamedPKs = make(map[interface{}]struct{})
+	}
+	result = dedupStreamResult(result, t.streamedPKs)
+	size := int64(typeutil.GetSizeOfIDs(result.GetIds()))
+	if size == 0 {
+		return nil
+	}
+	params := &queryParams{limit: typeutil.Unlimited}
+	if t.queryParams.limit != typeutil.Unlimited {
+		remain := t.queryParams.limit - t.streamedRows
+		if remain <= 0 {
+			return nil
+		}
+		params.limit = remain
+		if size > remain {
+			size = remain
+		}
+	}
+
+	// afterReduce modifies output field ids in place, so each batch needs its own copy
+	req := &internalpb.RetrieveRequest{
+		OutputFieldsId: append([]int64{}, t.GetOutputFieldsId()...),
+	}
+	reducer := &defaultLimitReducer{
+		ctx:            ctx,
+		req:            req,
+		params:         params,
+		schema:         t.schema,
+		collectionName: t.collectionName,
+	}
+	res, err := reducer.Reduce([]*internalpb.RetrieveResults{result})
+	if err != nil {
+		return err
+	}
+	res.Status = merr.Success()
+	res.OutputFields = t.userOutputFields
+	maskFieldsData(res.GetFieldsData(), t.maskedFields)
+	if err := t.streamSender(res); err != nil {
+		return err
+	}
+
+	for i := 0; i < typeutil.GetSizeOfIDs(result.GetIds()); i++ {
+		t.streamedPKs[typeutil.GetPK(result.GetIds(), int64(i))] = struct{}{}
+	}
+	t.streamedRows += size
+	if t.streamLimitReachedLocked() && t.streamCancel != nil {
+		t.streamCancel()
+	}
+	return nil
+}
+
+// dedupStreamResult removes the entities whose primary keys have been sent from the batch. The entities of
+// a primary key in the batch are reduced to the one with the latest timestamp like the reduce of query nodes,
+// while the entity sent in former batches is kept since it can't be recalled, like reduceRetrieveResults.
+func dedupStreamResult(result *internalpb.RetrieveResults, sent map[interface{}]struct{}) *internalpb.RetrieveResults {
+	size := typeutil.GetSizeOfIDs(result.GetIds())
+	var timestamps []int64
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == common.TimeStampField {
+			timestamps = fieldData.GetScalars().GetLongData().GetData()
+		}
+	}
+
+	// the offset of the kept entity of each primary key, primary keys are in the order of first appearance
+	selected := make(map[interface{}]int, size)
+	pks := make([]interface{}, 0, size)
+	for i := 0; i < size; i++ {
+		pk := typeutil.GetPK(result.GetIds(), int64(i))
+		if _, ok := sent[pk]; ok {
+			continue
+		}
+		j, ok := selected[pk]
+		if !ok {
+			selected[pk] = i
+			pks = append(pks, pk)
+			continue
+		}
+		if i < len(timestamps) && timestamps[i] > timestamps[j] {
+			selected[pk] = i
+		}
+	}
+	if len(pks) == size {
+		return result
+	}
+	log.Debug("skip duplicated query stream result", zap.Int("count", size-len(pks)))
+
+	ret := &internalpb.RetrieveResults{
+		Status:          result.GetStatus(),
+		Ids:             &schemapb.IDs{},
+		FieldsData:      make([]*schemapb.FieldData, len(result.GetFieldsData())),
+		CostAggregation: result.GetCostAggregation(),
+	}
+	for _, pk := range pks {
+		typeutil.AppendPKs(ret.Ids, pk)
+		typeutil.AppendFieldData(ret.FieldsData, result.GetFieldsData(), int64(selected[pk]))
+	}
+	return ret
+}
+
+func (t *queryTask) streamLimitReached() bool {
+	if t.streamSender == nil {
+		return false
+	}
+	t.streamMu.Lock()
+	defer t.streamMu.Unlock()
+	return t.streamLimitReachedLocked()
+}
+
+func (t *queryTask) streamLimitReachedLocked() bool {
+	return t.queryParams.limit != typeutil.Unlimited && t.streamedRows >= t.queryParams.limit
+}
+
 // IDs2Expr converts ids slices to bool expresion with specified field name
 func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	var idsStr string
@@ -547,6 +1053,24 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	cursors := make([]int64, len(validRetrieveResults))
 
 	realLimit := typeutil.Unlimited
+	reduceStopForBest := false
+	if queryParams != nil {
+		reduceStopForBest = queryParams.reduceStopForBest
+	}
+	selectMin := func() int {
+		return typeutil.SelectMinPK(validRetrieveResults, cursors, reduceStopForBest, realLimit)
+	}
+	// ordered results are merged in the order of order by fields instead of primary keys
+	if queryParams != nil && queryParams.order != nil {
+		merger, err := reduce.NewMerger(queryParams.order, validRetrieveResults)
+		if err != nil {
+			return nil, err
+		}
+		selectMin = func() int {
+			return merger.SelectMin(cursors)
+		}
+	}
+
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		realLimit = queryParams.limit
 		if !queryParams.reduceStopForBest {
@@ -554,7 +1078,7 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 		}
 		if queryParams.offset > 0 {
 			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors, queryParams.reduceStopForBest, realLimit)
+				sel := selectMin()
 				if sel == -1 {
 					return ret, nil
 				}
@@ -562,15 +1086,11 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 			}
 		}
 	}
-	reduceStopForBest := false
-	if queryParams != nil {
-		reduceStopForBest = queryParams.reduceStopForBest
-	}
 
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
 	for j := 0; j < loopEnd; j++ {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors, reduceStopForBest, realLimit)
+		sel := selectMin()
 		if sel == -1 {
 			break
 		}
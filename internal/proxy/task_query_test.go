@@ -28,18 +28,24 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -408,6 +414,8 @@ func TestTaskQuery_functions(t *testing.T) {
 			{"invalid offset negative", []string{LimitKey, OffsetKey}, []string{"1", "-1"}, true, 0, 0},
 			{"invalid offset 16385", []string{LimitKey, OffsetKey}, []string{"1", "16385"}, true, 0, 0},
 			{"invalid limit=16384 offset=16384", []string{LimitKey, OffsetKey}, []string{"16384", "16384"}, true, 0, 0},
+			{"valid group_by_fields", []string{GroupByFieldsKey}, []string{"a, b"}, false, typeutil.Unlimited, 0},
+			{"valid order_by", []string{OrderByKey, LimitKey}, []string{"a desc, b", "10"}, false, 10, 0},
 		}
 
 		for _, test := range tests {
@@ -427,6 +435,12 @@ func TestTaskQuery_functions(t *testing.T) {
 					assert.NoError(t, err)
 					assert.Equal(t, test.outLimit, ret.limit)
 					assert.Equal(t, test.outOffset, ret.offset)
+					if test.description == "valid group_by_fields" {
+						assert.Equal(t, []string{"a", "b"}, ret.groupBy)
+					}
+					if test.description == "valid order_by" {
+						assert.Equal(t, []string{"a desc", "b"}, ret.orderBy)
+					}
 				}
 			})
 		}
@@ -609,6 +623,42 @@ func TestTaskQuery_functions(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("test reduceRetrieveResults with order by", func(t *testing.T) {
+		const (
+			Int64FieldName = "Int64Field"
+			Int64FieldID   = common.StartOfUserFieldID + 1
+		)
+		// results of shards are ordered by Int64Field desc
+		r1 := &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 3}}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{30, 10}, 1),
+			},
+		}
+		r2 := &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{2, 4}}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{20, 20}, 1),
+			},
+		}
+		order := reduce.NewOrderBy(&planpb.QueryPlanNode{
+			OrderBy: []*planpb.OrderBy{{
+				Column:    &planpb.ColumnInfo{FieldId: Int64FieldID, DataType: schemapb.DataType_Int64},
+				Ascending: false,
+			}},
+		})
+
+		result, err := reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2},
+			&queryParams{limit: 2, offset: 1, order: order})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{20, 20}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		result, err = reduceRetrieveResults(context.Background(), []*internalpb.RetrieveResults{r1, r2},
+			&queryParams{limit: typeutil.Unlimited, order: order})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{30, 20, 20, 10}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
 }
 
 func getFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType, fieldValue interface{}, dim int64) *schemapb.FieldData {
@@ -919,6 +969,88 @@ func Test_queryTask_createPlan(t *testing.T) {
 		err := tsk.createPlan(context.TODO())
 		assert.Error(t, err)
 	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "a", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "b", DataType: schemapb.DataType_VarChar},
+				{FieldID: 102, Name: "c", DataType: schemapb.DataType_Double},
+			},
+		}
+
+		tsk := &queryTask{
+			schema: schema,
+			request: &milvuspb.QueryRequest{
+				OutputFields: []string{"count(*)", "b", "avg(c)", "max(c)"},
+				Expr:         "a > 2",
+			},
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			queryParams:     &queryParams{limit: typeutil.Unlimited, groupBy: []string{"b"}},
+		}
+		err := tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		assert.True(t, planparserv2.IsAggregatePlan(tsk.plan))
+		assert.Equal(t, []int64{101, 102, common.TimeStampField}, tsk.plan.GetOutputFieldIds())
+		assert.Equal(t, []string{"b", "count(*)", "avg(c)", "max(c)"}, tsk.userOutputFields)
+
+		// count(*) with group by isn't the plain count
+		tsk.request.OutputFields = []string{"count(*)"}
+		err = tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, tsk.plan.GetQuery().GetIsCount())
+		assert.Equal(t, []string{"b", "count(*)"}, tsk.userOutputFields)
+
+		tsk.request.OutputFields = []string{"c", "count(*)"}
+		err = tsk.createPlan(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("order by", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "a", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "b", DataType: schemapb.DataType_VarChar},
+				{FieldID: 102, Name: "c", DataType: schemapb.DataType_Double},
+				{FieldID: 103, Name: "d", DataType: schemapb.DataType_JSON},
+			},
+		}
+
+		tsk := &queryTask{
+			schema: schema,
+			request: &milvuspb.QueryRequest{
+				OutputFields: []string{"a", "b"},
+				Expr:         "a > 2",
+			},
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			queryParams:     &queryParams{limit: 10, orderBy: []string{"c desc", "b"}},
+		}
+		err := tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		orderBy := tsk.plan.GetQuery().GetOrderBy()
+		assert.Equal(t, 2, len(orderBy))
+		assert.Equal(t, int64(102), orderBy[0].GetColumn().GetFieldId())
+		assert.False(t, orderBy[0].GetAscending())
+		assert.Equal(t, int64(101), orderBy[1].GetColumn().GetFieldId())
+		assert.True(t, orderBy[1].GetAscending())
+		assert.Equal(t, []int64{100, 101, 102, common.TimeStampField}, tsk.plan.GetOutputFieldIds())
+		assert.Equal(t, []int64{102}, tsk.orderByFieldsAppended)
+		assert.NotNil(t, tsk.queryParams.order)
+
+		tsk.queryParams.orderBy = []string{"d"}
+		tsk.plan = nil
+		err = tsk.createPlan(context.TODO())
+		assert.Error(t, err)
+
+		tsk.queryParams.orderBy = []string{"b"}
+		tsk.request.OutputFields = []string{"count(*)"}
+		err = tsk.createPlan(context.TODO())
+		assert.Error(t, err)
+
+		tsk.request.OutputFields = []string{"max(c)"}
+		err = tsk.createPlan(context.TODO())
+		assert.Error(t, err)
+	})
 }
 
 func TestQueryTask_IDs2Expr(t *testing.T) {
@@ -945,3 +1077,167 @@ func TestQueryTask_IDs2Expr(t *testing.T) {
 	expectStrExpr := "pk in [ \"a\", \"b\", \"c\" ]"
 	assert.Equal(t, expectStrExpr, strExpr)
 }
+
+func TestQueryTask_queryShardStream(t *testing.T) {
+	paramtable.Init()
+	pkFieldID := common.StartOfUserFieldID
+	schema := &schemapb.CollectionSchema{
+		Name: "test_stream",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: pkFieldID, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	genResult := func(pks ...int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Status: merr.Success(),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("pk", pkFieldID, schemapb.DataType_Int64, pks, 1),
+			},
+		}
+	}
+	newTask := func(limit int64, sender func(*milvuspb.QueryResults) error) *queryTask {
+		lb := NewMockLBPolicy(t)
+		lb.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything).Maybe()
+		return &queryTask{
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base:           &commonpb.MsgBase{},
+				OutputFieldsId: []int64{pkFieldID},
+			},
+			request:          &milvuspb.QueryRequest{},
+			collectionName:   "test_stream",
+			schema:           schema,
+			queryParams:      &queryParams{limit: limit},
+			userOutputFields: []string{"pk"},
+			lb:               lb,
+			streamSender:     sender,
+		}
+	}
+	mockStream := func(qn *mocks.MockQueryNodeClient, results ...*internalpb.RetrieveResults) {
+		qn.EXPECT().QueryStream(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, in *querypb.QueryRequest, opts ...grpc.CallOption) querypb.QueryNode_QueryStreamClient {
+				client := streamrpc.NewLocalQueryClient(ctx)
+				server := client.CreateServer()
+				for _, result := range results {
+					server.Send(result)
+				}
+				server.FinishSend(nil)
+				return client
+			}, nil)
+	}
+
+	t.Run("send batches until limit", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mockStream(qn, genResult(1, 2, 3), genResult(4, 5), genResult(6))
+
+		var received []int64
+		task := newTask(4, func(result *milvuspb.QueryResults) error {
+			assert.Equal(t, []string{"pk"}, result.GetOutputFields())
+			received = append(received, result.GetFieldsData()[0].GetScalars().GetLongData().GetData()...)
+			return nil
+		})
+		cancelled := false
+		task.streamCancel = func() { cancelled = true }
+
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 4}, received)
+		assert.True(t, cancelled)
+		assert.True(t, task.streamLimitReached())
+	})
+
+	t.Run("duplicated entities are sent once", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mockStream(qn, genResult(1, 2), genResult(2, 3, 3), genResult(1, 4))
+
+		var received []int64
+		task := newTask(typeutil.Unlimited, func(result *milvuspb.QueryResults) error {
+			received = append(received, result.GetFieldsData()[0].GetScalars().GetLongData().GetData()...)
+			return nil
+		})
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3, 4}, received)
+	})
+
+	t.Run("status error before sending is recoverable", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mockStream(qn, &internalpb.RetrieveResults{Status: merr.Status(merr.ErrServiceInternal)})
+
+		task := newTask(typeutil.Unlimited, func(result *milvuspb.QueryResults) error {
+			return nil
+		})
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.ErrorIs(t, err, merr.ErrServiceInternal)
+		assert.True(t, retry.IsRecoverable(err))
+	})
+
+	t.Run("error after sending is unrecoverable", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mockStream(qn, genResult(1, 2), &internalpb.RetrieveResults{Status: merr.Status(merr.ErrServiceInternal)})
+
+		task := newTask(typeutil.Unlimited, func(result *milvuspb.QueryResults) error {
+			return nil
+		})
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.Error(t, err)
+		assert.False(t, retry.IsRecoverable(err))
+	})
+
+	t.Run("sender failed", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		mockStream(qn, genResult(1, 2))
+
+		task := newTask(typeutil.Unlimited, func(result *milvuspb.QueryResults) error {
+			return errors.New("client gone")
+		})
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.Error(t, err)
+		assert.False(t, retry.IsRecoverable(err))
+	})
+
+	t.Run("create stream failed", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		qn.EXPECT().QueryStream(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+		mockCache := NewMockCache(t)
+		mockCache.EXPECT().DeprecateShardCache(mock.Anything, mock.Anything).Return()
+		globalMetaCache = mockCache
+
+		task := newTask(typeutil.Unlimited, func(result *milvuspb.QueryResults) error {
+			return nil
+		})
+		err := task.queryShardStream(context.Background(), 1, qn, "ch1")
+		assert.Error(t, err)
+	})
+}
+
+func TestQueryTask_dedupStreamResult(t *testing.T) {
+	pkFieldID := common.StartOfUserFieldID
+	result := &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 1, 3}}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			getFieldData("pk", pkFieldID, schemapb.DataType_Int64, []int64{1, 2, 1, 3}, 1),
+			getFieldData("ts", common.TimeStampField, schemapb.DataType_Int64, []int64{10, 20, 30, 40}, 1),
+			getFieldData("value", pkFieldID+1, schemapb.DataType_Int64, []int64{100, 200, 300, 400}, 1),
+		},
+	}
+
+	// nothing to remove
+	unique := &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 6}}},
+		},
+	}
+	assert.Same(t, unique, dedupStreamResult(unique, map[interface{}]struct{}{int64(2): {}}))
+
+	// pk 2 has been sent, the latest entity of pk 1 is kept
+	ret := dedupStreamResult(result, map[interface{}]struct{}{int64(2): {}})
+	assert.Equal(t, []int64{1, 3}, ret.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{1, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{30, 40}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{300, 400}, ret.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+}
@@ -17,8 +17,8 @@
 package proxy
 
 import (
-	"container/list"
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -53,7 +53,7 @@ var _ taskQueue = (*baseTaskQueue)(nil)
 
 // baseTaskQueue implements taskQueue.
 type baseTaskQueue struct {
-	unissuedTasks *list.List
+	unissuedTasks *priorityTaskList
 	activeTasks   map[UniqueID]task
 	utLock        sync.RWMutex
 	atLock        sync.RWMutex
@@ -65,6 +65,10 @@ type baseTaskQueue struct {
 	utBufChan chan int // to block scheduler
 
 	tsoAllocatorIns tsoAllocator
+
+	// prioritized queue dequeues tasks by their priorities and sheds low priority tasks if it's overloaded,
+	// the others keep tasks in FIFO order since DDL and DML tasks must be executed in the order of timestamp.
+	prioritized bool
 }
 
 func (queue *baseTaskQueue) utChan() <-chan int {
@@ -82,13 +86,24 @@ func (queue *baseTaskQueue) utFull() bool {
 }
 
 func (queue *baseTaskQueue) addUnissuedTask(t task) error {
+	priority := taskPriorityNormal
+	if queue.prioritized {
+		priority = getTaskPriority(t.TraceCtx())
+	}
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
 
 	if queue.utFull() {
 		return merr.WrapErrServiceRequestLimitExceeded(int32(queue.getMaxTaskNum()))
 	}
-	queue.unissuedTasks.PushBack(t)
+	// shed low priority tasks if the queue is overloaded
+	if priority == taskPriorityLow {
+		maxQueueWait := Params.ProxyCfg.TaskPriorityMaxQueueWait.GetAsDuration(time.Millisecond)
+		if wait := queue.unissuedTasks.maxWait(); maxQueueWait > 0 && wait > maxQueueWait {
+			return merr.WrapErrServiceRateLimit(0, fmt.Sprintf("low priority request is shed, queued tasks have waited for %s", wait))
+		}
+	}
+	queue.unissuedTasks.PushBack(t, priority)
 	queue.utBufChan <- 1
 	return nil
 }
@@ -97,25 +112,14 @@ func (queue *baseTaskQueue) FrontUnissuedTask() task {
 	queue.utLock.RLock()
 	defer queue.utLock.RUnlock()
 
-	if queue.unissuedTasks.Len() <= 0 {
-		return nil
-	}
-
-	return queue.unissuedTasks.Front().Value.(task)
+	return queue.unissuedTasks.Front()
 }
 
 func (queue *baseTaskQueue) PopUnissuedTask() task {
 	queue.utLock.Lock()
 	defer queue.utLock.Unlock()
 
-	if queue.unissuedTasks.Len() <= 0 {
-		return nil
-	}
-
-	ft := queue.unissuedTasks.Front()
-	queue.unissuedTasks.Remove(ft)
-
-	return ft.Value.(task)
+	return queue.unissuedTasks.PopFront()
 }
 
 func (queue *baseTaskQueue) AddActiveTask(t task) {
@@ -144,14 +148,19 @@ func (queue *baseTaskQueue) PopActiveTask(taskID UniqueID) task {
 }
 
 func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
+	var unissuedTask task
 	queue.utLock.RLock()
-	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
-		if e.Value.(task).ID() == reqID {
-			queue.utLock.RUnlock()
-			return e.Value.(task)
+	queue.unissuedTasks.Range(func(t task) bool {
+		if t.ID() == reqID {
+			unissuedTask = t
+			return false
 		}
-	}
+		return true
+	})
 	queue.utLock.RUnlock()
+	if unissuedTask != nil {
+		return unissuedTask
+	}
 
 	queue.atLock.RLock()
 	for tID, t := range queue.activeTasks {
@@ -198,7 +207,7 @@ func (queue *baseTaskQueue) getMaxTaskNum() int64 {
 
 func newBaseTaskQueue(tsoAllocatorIns tsoAllocator) *baseTaskQueue {
 	return &baseTaskQueue{
-		unissuedTasks:   list.New(),
+		unissuedTasks:   newPriorityTaskList(),
 		activeTasks:     make(map[UniqueID]task),
 		utLock:          sync.RWMutex{},
 		atLock:          sync.RWMutex{},
@@ -364,9 +373,11 @@ func newDmTaskQueue(tsoAllocatorIns tsoAllocator) *dmTaskQueue {
 }
 
 func newDqTaskQueue(tsoAllocatorIns tsoAllocator) *dqTaskQueue {
-	return &dqTaskQueue{
+	queue := &dqTaskQueue{
 		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns),
 	}
+	queue.prioritized = true
+	return queue
 }
 
 // taskScheduler schedules the gRPC tasks.
@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -59,10 +60,16 @@ type searchTask struct {
 	requery        bool
 
 	userOutputFields []string
+	// maskedFields are the output fields masked since the user isn't granted to read them
+	maskedFields []string
 
 	offset    int64
 	resultBuf *typeutil.ConcurrentSet[*internalpb.SearchResults]
 
+	groupBy *reduce.GroupByInfo
+	// the group by field is output only for reduce, it's removed from results after reduce
+	groupByFieldAppended bool
+
 	qc   types.QueryCoordClient
 	node types.ProxyComponent
 	lb   LBPolicy
@@ -169,6 +176,53 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	}, offset, nil
 }
 
+// parseGroupByInfo sets the group by field of query info if group_by_field is in search params.
+// The topk of query info is the number of groups, it becomes the number of candidates searched for them.
+func parseGroupByInfo(schema *schemapb.CollectionSchema, searchParamsPair []*commonpb.KeyValuePair, queryInfo *planpb.QueryInfo) error {
+	fieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil || fieldName == "" {
+		return nil
+	}
+	var field *schemapb.FieldSchema
+	for _, f := range schema.GetFields() {
+		if f.GetName() == fieldName {
+			field = f
+			break
+		}
+	}
+	if field == nil {
+		return merr.WrapErrFieldNotFound(fieldName, "group by field not found")
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_VarChar:
+	default:
+		return merr.WrapErrParameterInvalidMsg("group by field %s of type %s is not supported", fieldName, field.GetDataType().String())
+	}
+
+	groupSize := int64(1)
+	if groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair); err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return merr.WrapErrParameterInvalid("positive integer", groupSizeStr, "invalid group_size")
+		}
+	}
+	groupLimit := queryInfo.GetTopk()
+	if err := validateTopKLimit(groupLimit * groupSize); err != nil {
+		return fmt.Errorf("%s*%s [%d] is invalid, %w", TopKKey, GroupSizeKey, groupLimit*groupSize, err)
+	}
+
+	candidates := groupLimit * groupSize * Params.ProxyCfg.GroupByCandidateRatio.GetAsInt64()
+	if topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64(); candidates > topKLimit || candidates <= 0 {
+		candidates = topKLimit
+	}
+	queryInfo.Topk = candidates
+	queryInfo.GroupByFieldId = field.GetFieldID()
+	queryInfo.GroupSize = groupSize
+	queryInfo.GroupLimit = groupLimit
+	return nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -236,11 +290,18 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return errors.New("not support manually specifying the partition names if partition key mode is used")
 	}
 
+	requestedFields := t.request.GetOutputFields()
 	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		log.Warn("translate output fields failed", zap.Error(err))
 		return err
 	}
+	t.request.OutputFields, t.userOutputFields, t.maskedFields, err = applyFieldPrivileges(ctx, t.request.GetDbName(), t.schema,
+		commonpb.ObjectPrivilege_PrivilegeSearch, requestedFields, t.request.GetOutputFields(), t.userOutputFields)
+	if err != nil {
+		log.Warn("check privileges of output fields failed", zap.Error(err))
+		return err
+	}
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
@@ -296,7 +357,29 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			return err
 		}
 		t.offset = offset
+		if err := parseGroupByInfo(t.schema, t.request.GetSearchParams(), queryInfo); err != nil {
+			return err
+		}
+		t.groupBy = reduce.NewGroupByInfo(queryInfo)
+		if t.groupBy != nil {
+			// the values of group by field are returned, it's never masked
+			groupByField, _ := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, t.request.GetSearchParams())
+			denied, err := getDeniedFields(ctx, t.request.GetDbName(), t.schema, commonpb.ObjectPrivilege_PrivilegeSearch, []string{groupByField})
+			if err != nil {
+				return err
+			}
+			if len(denied) > 0 {
+				return merr.WrapErrPrivilegeNotPermitted("%s of field %s is not granted", commonpb.ObjectPrivilege_PrivilegeSearch.String(), groupByField)
+			}
+		}
 
+		if err := checkFilterFieldPrivileges(ctx, t.request.GetDbName(), t.schema, commonpb.ObjectPrivilege_PrivilegeSearch, t.request.GetDsl()); err != nil {
+			return err
+		}
+		t.request.Dsl, err = applyRowPolicies(ctx, t.schema, t.request.GetDbName(), collectionName, t.request.GetDsl())
+		if err != nil {
+			return err
+		}
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Warn("failed to create query plan", zap.Error(err),
@@ -325,6 +408,11 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 
 		plan.OutputFieldIds = outputFieldIDs
+		// group values are read from the output fields while reducing
+		if t.groupBy != nil && !lo.Contains(outputFieldIDs, t.groupBy.FieldID) {
+			plan.OutputFieldIds = append(lo.Clone(outputFieldIDs), t.groupBy.FieldID)
+			t.groupByFieldAppended = true
+		}
 
 		t.SearchRequest.Topk = queryInfo.GetTopk()
 		t.SearchRequest.MetricType = queryInfo.GetMetricType()
@@ -338,6 +426,10 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if estimateSize >= requeryThreshold {
 			t.requery = true
 			plan.OutputFieldIds = nil
+			if t.groupBy != nil {
+				plan.OutputFieldIds = []int64{t.groupBy.FieldID}
+				t.groupByFieldAppended = true
+			}
 		}
 
 		t.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
@@ -481,11 +573,20 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	if t.groupBy != nil {
+		t.result, err = t.reduceGroupByResults(ctx, validSearchResults, Nq, MetricType, primaryFieldSchema.DataType)
+	} else {
+		t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	}
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		return err
 	}
+	if t.groupByFieldAppended {
+		t.result.Results.FieldsData = lo.Filter(t.result.GetResults().GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+			return fieldData.GetFieldId() != t.groupBy.FieldID
+		})
+	}
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
@@ -500,6 +601,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		}
 	}
 	t.result.Results.OutputFields = t.userOutputFields
+	maskFieldsData(t.result.GetResults().GetFieldsData(), t.maskedFields)
 
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -507,6 +609,52 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// reduceGroupByResults reduces the grouped search results. The candidates of a query may be taken by a few
+// dominant groups, then the search is executed again with twice the candidates, until no group is missing
+// or the candidates reach the topk limit.
+func (t *searchTask) reduceGroupByResults(ctx context.Context, results []*schemapb.SearchResultData, nq int64, metricType string, pkType schemapb.DataType) (*milvuspb.SearchResults, error) {
+	topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64()
+	for {
+		ret, truncated, err := reduceSearchResultDataWithGroupBy(ctx, results, nq, t.SearchRequest.GetTopk(), metricType, pkType, t.offset, t.groupBy)
+		if err != nil || !truncated || t.SearchRequest.GetTopk() >= topKLimit {
+			return ret, err
+		}
+
+		candidates := t.SearchRequest.GetTopk() * 2
+		if candidates > topKLimit {
+			candidates = topKLimit
+		}
+		log.Ctx(ctx).Debug("groups are truncated, search with more candidates",
+			zap.Int64("collection", t.GetCollectionID()),
+			zap.Int64("candidates", candidates))
+		plan := &planpb.PlanNode{}
+		if err := proto.Unmarshal(t.SearchRequest.GetSerializedExprPlan(), plan); err != nil {
+			return nil, err
+		}
+		plan.GetVectorAnns().GetQueryInfo().Topk = candidates
+		t.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
+		if err != nil {
+			return nil, err
+		}
+		t.SearchRequest.Topk = candidates
+
+		if err := t.Execute(ctx); err != nil {
+			return nil, err
+		}
+		toReduceResults, err := t.collectSearchResults(ctx)
+		if err != nil {
+			return nil, err
+		}
+		results, err = decodeSearchResults(ctx, toReduceResults)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			return ret, nil
+		}
+	}
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channelIDs ...string) error {
 	searchReq := typeutil.Clone(t.SearchRequest)
 	searchReq.GetBase().TargetID = nodeID
@@ -918,6 +1066,141 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 	return ret, nil
 }
 
+// reduceSearchResultDataWithGroupBy reduces the search results grouped by a scalar field, at most GroupSize hits are
+// kept for each group value, and the hits of the first offset groups are skipped. The hits are ordered by scores.
+// It also returns whether the groups of some query may be truncated, see GroupCounter.Truncated.
+func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, groupBy *reduce.GroupByInfo) (*milvuspb.SearchResults, bool, error) {
+	tr := timerecord.NewTimeRecorder("reduceSearchResultDataWithGroupBy")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	log := log.Ctx(ctx)
+	log.Debug("reduceSearchResultDataWithGroupBy",
+		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
+		zap.Int64("nq", nq),
+		zap.Int64("offset", offset),
+		zap.Int64("groupByField", groupBy.FieldID),
+		zap.Int64("groupSize", groupBy.GroupSize),
+		zap.Int64("groupLimit", groupBy.GroupLimit),
+		zap.String("metricType", metricType))
+
+	ret := &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			FieldsData: make([]*schemapb.FieldData, len(subSearchResultData[0].FieldsData)),
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+
+	switch pkType {
+	case schemapb.DataType_Int64:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	case schemapb.DataType_VarChar:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	default:
+		return nil, false, errors.New("unsupported pk type")
+	}
+
+	var (
+		subSearchNum = len(subSearchResultData)
+		// for results of each subSearchResultData, storing the start offset of each query of nq queries
+		subSearchNqOffset = make([][]int64, subSearchNum)
+		groupValues       = make([]*schemapb.FieldData, subSearchNum)
+		truncated         bool
+	)
+	for i, sData := range subSearchResultData {
+		if err := checkSearchResultData(sData, nq, topk); err != nil {
+			log.Warn("invalid search results", zap.Error(err))
+			return ret, false, err
+		}
+		subSearchNqOffset[i] = make([]int64, sData.GetNumQueries())
+		for j := int64(1); j < nq; j++ {
+			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + sData.Topks[j-1]
+		}
+		if typeutil.GetSizeOfIDs(sData.GetIds()) == 0 {
+			continue
+		}
+		var err error
+		groupValues[i], err = groupBy.GroupValues(sData.GetFieldsData())
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var (
+		skipDupCnt    int64
+		retSize       int64
+		maxOutputSize = paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	)
+	for i := int64(0); i < nq; i++ {
+		var (
+			cursors = make([]int64, subSearchNum)
+			idSet   = make(map[interface{}]struct{})
+			counter = groupBy.NewCounter()
+			j       int64
+		)
+		for !counter.Full() {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+
+			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+			if _, ok := idSet[id]; ok {
+				// skip entity with same id
+				skipDupCnt++
+				continue
+			}
+			rank, accepted := counter.Accept(groupValues[subSearchIdx], resultDataIdx)
+			if !accepted {
+				continue
+			}
+			idSet[id] = struct{}{}
+			// skip the hits of offset groups
+			if rank < offset {
+				continue
+			}
+			retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, id)
+			ret.Results.Scores = append(ret.Results.Scores, subSearchResultData[subSearchIdx].Scores[resultDataIdx])
+			j++
+		}
+		ret.Results.Topks = append(ret.Results.Topks, j)
+		if j > ret.Results.TopK {
+			ret.Results.TopK = j
+		}
+		truncated = truncated || counter.Truncated()
+
+		// limit search result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, false, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	if skipDupCnt > 0 {
+		log.Info("skip duplicated search result", zap.Int64("count", skipDupCnt))
+	}
+
+	if !metric.PositivelyRelated(metricType) {
+		for k := range ret.Results.Scores {
+			ret.Results.Scores[k] *= -1
+		}
+	}
+	return ret, truncated, nil
+}
+
 func (t *searchTask) TraceCtx() context.Context {
 	return t.ctx
 }
@@ -35,9 +35,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -1879,6 +1881,185 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 	})
 }
 
+func TestTaskSearch_parseGroupByInfo(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Float},
+		},
+	}
+	params := func(kvs ...string) []*commonpb.KeyValuePair {
+		pairs := make([]*commonpb.KeyValuePair, 0, len(kvs)/2)
+		for i := 0; i+1 < len(kvs); i += 2 {
+			pairs = append(pairs, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+		}
+		return pairs
+	}
+
+	t.Run("no group by", func(t *testing.T) {
+		queryInfo := &planpb.QueryInfo{Topk: 10}
+		assert.NoError(t, parseGroupByInfo(schema, params(), queryInfo))
+		assert.Equal(t, int64(10), queryInfo.GetTopk())
+		assert.Nil(t, reduce.NewGroupByInfo(queryInfo))
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		queryInfo := &planpb.QueryInfo{Topk: 10}
+		assert.NoError(t, parseGroupByInfo(schema, params(GroupByFieldKey, "category", GroupSizeKey, "2"), queryInfo))
+		ratio := Params.ProxyCfg.GroupByCandidateRatio.GetAsInt64()
+		assert.Equal(t, 10*2*ratio, queryInfo.GetTopk())
+		assert.Equal(t, &reduce.GroupByInfo{FieldID: 101, GroupSize: 2, GroupLimit: 10}, reduce.NewGroupByInfo(queryInfo))
+	})
+
+	t.Run("candidates capped", func(t *testing.T) {
+		topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64()
+		queryInfo := &planpb.QueryInfo{Topk: topKLimit}
+		assert.NoError(t, parseGroupByInfo(schema, params(GroupByFieldKey, "category"), queryInfo))
+		assert.Equal(t, topKLimit, queryInfo.GetTopk())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Error(t, parseGroupByInfo(schema, params(GroupByFieldKey, "unknown"), &planpb.QueryInfo{Topk: 10}))
+		assert.Error(t, parseGroupByInfo(schema, params(GroupByFieldKey, "price"), &planpb.QueryInfo{Topk: 10}))
+		assert.Error(t, parseGroupByInfo(schema, params(GroupByFieldKey, "category", GroupSizeKey, "0"), &planpb.QueryInfo{Topk: 10}))
+		assert.Error(t, parseGroupByInfo(schema, params(GroupByFieldKey, "category", GroupSizeKey, "x"), &planpb.QueryInfo{Topk: 10}))
+		topKLimit := Params.QuotaConfig.TopKLimit.GetAsInt64()
+		assert.Error(t, parseGroupByInfo(schema, params(GroupByFieldKey, "category", GroupSizeKey, "2"), &planpb.QueryInfo{Topk: topKLimit}))
+	})
+}
+
+func TestTaskSearch_reduceSearchResultDataWithGroupBy(t *testing.T) {
+	paramtable.Init()
+	var (
+		nq   int64 = 1
+		topk int64 = 4
+	)
+	genResult := func(ids []int64, scores []float32, groups []string) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       topk,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores:     scores,
+			Topks:      []int64{int64(len(ids))},
+			FieldsData: []*schemapb.FieldData{getFieldData("category", 101, schemapb.DataType_VarChar, groups, 1)},
+		}
+	}
+	results := []*schemapb.SearchResultData{
+		genResult([]int64{1, 2, 3, 4}, []float32{10, 9, 8, 7}, []string{"a", "a", "b", "c"}),
+		genResult([]int64{5, 2, 6, 7}, []float32{9.5, 9, 7.5, 6}, []string{"b", "a", "c", "d"}),
+	}
+
+	t.Run("group size 1", func(t *testing.T) {
+		groupBy := &reduce.GroupByInfo{FieldID: 101, GroupSize: 1, GroupLimit: 3}
+		ret, truncated, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, nq, topk, metric.IP, schemapb.DataType_Int64, 0, groupBy)
+		assert.NoError(t, err)
+		assert.False(t, truncated)
+		assert.Equal(t, []int64{1, 5, 6}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{10, 9.5, 7.5}, ret.GetResults().GetScores())
+		assert.Equal(t, []int64{3}, ret.GetResults().GetTopks())
+		assert.Equal(t, []string{"a", "b", "c"}, ret.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("group size 2 with offset", func(t *testing.T) {
+		groupBy := &reduce.GroupByInfo{FieldID: 101, GroupSize: 2, GroupLimit: 2}
+		ret, _, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, nq, topk, metric.L2, schemapb.DataType_Int64, 1, groupBy)
+		assert.NoError(t, err)
+		// group a is skipped by offset, duplicated pk 2 is counted once
+		assert.Equal(t, []int64{5, 3}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{-9.5, -8}, ret.GetResults().GetScores())
+	})
+
+	t.Run("dominant group", func(t *testing.T) {
+		groupBy := &reduce.GroupByInfo{FieldID: 101, GroupSize: 1, GroupLimit: 3}
+		dominant := []*schemapb.SearchResultData{
+			genResult([]int64{1, 2, 3, 4}, []float32{10, 9, 8, 7}, []string{"a", "a", "a", "a"}),
+		}
+		ret, truncated, err := reduceSearchResultDataWithGroupBy(context.TODO(), dominant, nq, topk, metric.IP, schemapb.DataType_Int64, 0, groupBy)
+		assert.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, []int64{1}, ret.GetResults().GetIds().GetIntId().GetData())
+	})
+}
+
+func TestSearchTask_reduceGroupByResults(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	// the first 8 hits of the collection belong to group a
+	var (
+		ids    = make([]int64, 12)
+		scores = make([]float32, 12)
+		groups = make([]string, 12)
+	)
+	for i := range ids {
+		ids[i] = int64(i)
+		scores[i] = float32(100 - i)
+		groups[i] = "a"
+		if i >= 8 {
+			groups[i] = fmt.Sprintf("g%d", i)
+		}
+	}
+	genResult := func(topk int64) *schemapb.SearchResultData {
+		n := topk
+		if n > int64(len(ids)) {
+			n = int64(len(ids))
+		}
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       topk,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids[:n]}}},
+			Scores:     scores[:n],
+			Topks:      []int64{n},
+			FieldsData: []*schemapb.FieldData{getFieldData("category", 101, schemapb.DataType_VarChar, groups[:n], 1)},
+		}
+	}
+
+	var topks []int64
+	qn := mocks.NewMockQueryNodeClient(t)
+	qn.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.SearchRequest, _ ...grpc.CallOption) (*internalpb.SearchResults, error) {
+		plan := &planpb.PlanNode{}
+		assert.NoError(t, proto.Unmarshal(req.GetReq().GetSerializedExprPlan(), plan))
+		topk := req.GetReq().GetTopk()
+		assert.Equal(t, topk, plan.GetVectorAnns().GetQueryInfo().GetTopk())
+		topks = append(topks, topk)
+		blob, err := proto.Marshal(genResult(topk))
+		assert.NoError(t, err)
+		return &internalpb.SearchResults{Status: merr.Success(), SlicedBlob: blob}, nil
+	})
+	lb := NewMockLBPolicy(t)
+	lb.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, workload CollectionWorkLoad) error {
+		return workload.exec(ctx, 0, qn)
+	})
+	lb.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything).Return()
+
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{QueryInfo: &planpb.QueryInfo{Topk: 4}},
+		},
+	})
+	assert.NoError(t, err)
+	task := &searchTask{
+		ctx: ctx,
+		SearchRequest: &internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{},
+			Topk:               4,
+			SerializedExprPlan: plan,
+		},
+		request: &milvuspb.SearchRequest{},
+		groupBy: &reduce.GroupByInfo{FieldID: 101, GroupSize: 1, GroupLimit: 3},
+		lb:      lb,
+	}
+
+	// group a takes all the 4 candidates, the search is executed with 8 and 16 candidates
+	ret, err := task.reduceGroupByResults(ctx, []*schemapb.SearchResultData{genResult(4)}, 1, metric.IP, schemapb.DataType_Int64)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{8, 16}, topks)
+	assert.Equal(t, []int64{0, 8, 9}, ret.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []string{"a", "g8", "g9"}, ret.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+}
+
 func getSearchResultData(nq, topk int64) *schemapb.SearchResultData {
 	result := schemapb.SearchResultData{
 		NumQueries: nq,
@@ -218,6 +218,11 @@ func (it *upsertTask) insertPreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := checkInsertRowPolicies(ctx, it.schema, it.req.GetDbName(), collectionName, it.upsertMsg.InsertMsg.GetFieldsData(), it.upsertMsg.InsertMsg.NRows()); err != nil {
+		log.Warn("check row policies of upserted rows failed", zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Upsert insertPreExecute done")
 
 	return nil
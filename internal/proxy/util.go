@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/metadata"
@@ -88,7 +89,10 @@ func isNumber(c uint8) bool {
 func isVectorType(dataType schemapb.DataType) bool {
 	return dataType == schemapb.DataType_FloatVector ||
 		dataType == schemapb.DataType_BinaryVector ||
-		dataType == schemapb.DataType_Float16Vector
+		dataType == schemapb.DataType_Float16Vector ||
+		dataType == typeutil.BFloat16Vector ||
+		dataType == typeutil.Int8Vector ||
+		dataType == typeutil.SparseFloatVector
 }
 
 func validateMaxQueryResultWindow(offset int64, limit int64) error {
@@ -285,6 +289,15 @@ func validateFieldName(fieldName string) error {
 }
 
 func validateDimension(field *schemapb.FieldSchema) error {
+	// the dim of sparse float vector is decided by the max index of rows
+	if typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+		for _, param := range field.TypeParams {
+			if param.Key == common.DimKey {
+				return errors.New("dimension should not be specified for sparse float vector field")
+			}
+		}
+		return nil
+	}
 	exist := false
 	var dim int64
 	for _, param := range field.TypeParams {
@@ -359,6 +372,35 @@ func validateMaxCapacityPerRow(collectionName string, field *schemapb.FieldSchem
 	return nil
 }
 
+// validateNullable checks the type param nullable of field, only scalar fields except primary key
+// and partition key can be nullable.
+func validateNullable(field *schemapb.FieldSchema) error {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() != common.NullableKey {
+			continue
+		}
+
+		nullable, err := strconv.ParseBool(param.GetValue())
+		if err != nil {
+			return fmt.Errorf("the value of %s must be true or false", common.NullableKey)
+		}
+		if !nullable {
+			continue
+		}
+		if isVectorType(field.GetDataType()) {
+			return fmt.Errorf("vector field %s can't be nullable", field.GetName())
+		}
+		if field.GetIsPrimaryKey() {
+			return fmt.Errorf("primary key field %s can't be nullable", field.GetName())
+		}
+		if field.GetIsPartitionKey() {
+			return fmt.Errorf("partition key field %s can't be nullable", field.GetName())
+		}
+	}
+
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if !isVectorType(field.DataType) {
 		return nil
@@ -490,7 +532,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 		schemapb.DataType_Float, schemapb.DataType_Double:
 		return false, nil
 
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector,
+		typeutil.BFloat16Vector, typeutil.Int8Vector, typeutil.SparseFloatVector:
 		return true, nil
 	}
 
@@ -501,7 +544,10 @@ func validateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) err
 	metricTypeStr := strings.ToUpper(metricTypeStrRaw)
 	switch metricTypeStr {
 	case metric.L2, metric.IP, metric.COSINE:
-		if dataType == schemapb.DataType_FloatVector || dataType == schemapb.DataType_Float16Vector {
+		if dataType == schemapb.DataType_FloatVector || typeutil.IsBytesVectorType(dataType) {
+			return nil
+		}
+		if metricTypeStr == metric.IP && typeutil.IsSparseFloatVectorType(dataType) {
 			return nil
 		}
 	case metric.JACCARD, metric.HAMMING, metric.SUBSTRUCTURE, metric.SUPERSTRUCTURE:
@@ -605,7 +651,7 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector || dType == schemapb.DataType_Float16Vector
+		isVec := isVectorType(dType)
 		if isVec && vecExist && !enableMultipleVectorFields {
 			return fmt.Errorf(
 				"multiple vector fields is not supported, fields name: %s, %s",
@@ -841,6 +887,23 @@ func ValidateObjectName(entity string) error {
 	return validateName(entity, "role name")
 }
 
+// validateGrantObjectName validates the object name of grant, the object name of field-level privileges is collection.field.
+func validateGrantObjectName(objectType string, objectName string) error {
+	if objectType != util.ObjectTypeField || util.IsAnyWord(objectName) {
+		return ValidateObjectName(objectName)
+	}
+	names := strings.SplitN(objectName, ".", 2)
+	if len(names) != 2 {
+		return merr.WrapErrParameterInvalidMsg("the object name of %s should be collection.field, but got %s", objectType, objectName)
+	}
+	for _, name := range names {
+		if err := ValidateObjectName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func ValidateObjectType(entity string) error {
 	return validateName(entity, "ObjectType")
 }
@@ -1046,6 +1109,119 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 	return resultFieldNames, userOutputFields, nil
 }
 
+// applyFieldPrivileges checks the privilege of output fields translated by translateOutputFields.
+// The denied fields requested explicitly are rejected and the ones expanded from * are omitted,
+// unless the denied action is mask, then the denied fields are returned to be masked in results.
+func applyFieldPrivileges(ctx context.Context, dbName string, schema *schemapb.CollectionSchema, privilege commonpb.ObjectPrivilege,
+	requestedFields []string, outputFields []string, userOutputFields []string,
+) ([]string, []string, []string, error) {
+	denied, err := getDeniedFields(ctx, dbName, schema, privilege, userOutputFields)
+	if err != nil || len(denied) == 0 {
+		return outputFields, userOutputFields, nil, err
+	}
+	if strings.EqualFold(Params.ProxyCfg.FieldPrivilegeDeniedAction.GetValue(), "mask") {
+		return outputFields, userOutputFields, denied, nil
+	}
+	for _, field := range denied {
+		if lo.ContainsBy(requestedFields, func(name string) bool { return strings.TrimSpace(name) == field }) {
+			return nil, nil, nil, merr.WrapErrPrivilegeNotPermitted("%s of field %s is not granted", privilege.String(), field)
+		}
+	}
+	return lo.Without(outputFields, denied...), lo.Without(userOutputFields, denied...), nil, nil
+}
+
+// checkFilterFieldPrivileges rejects the filter expression of user which refers to the fields not granted by the privilege,
+// otherwise the values of them could be probed by filtering even if they are omitted or masked in results.
+// It's checked before the row policies are applied, the fields in policies are decided by administrators.
+func checkFilterFieldPrivileges(ctx context.Context, dbName string, schema *schemapb.CollectionSchema, privilege commonpb.ObjectPrivilege, expr string) error {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() || strings.TrimSpace(expr) == "" {
+		return nil
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+	parsed, err := planparserv2.ParseExpr(schemaHelper, expr)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid expression %s: %s", expr, err.Error())
+	}
+	return checkColumnPrivileges(ctx, dbName, schema, privilege, planparserv2.ExprColumns(parsed))
+}
+
+// checkColumnPrivileges rejects the request if any of the columns isn't granted by the privilege.
+func checkColumnPrivileges(ctx context.Context, dbName string, schema *schemapb.CollectionSchema, privilege commonpb.ObjectPrivilege, columns []*planpb.ColumnInfo) error {
+	fieldIDs := lo.Map(columns, func(column *planpb.ColumnInfo, _ int) int64 { return column.GetFieldId() })
+	fieldNames := lo.FilterMap(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) (string, bool) {
+		return field.GetName(), lo.Contains(fieldIDs, field.GetFieldID())
+	})
+	denied, err := getDeniedFields(ctx, dbName, schema, privilege, fieldNames)
+	if err != nil {
+		return err
+	}
+	if len(denied) > 0 {
+		return merr.WrapErrPrivilegeNotPermitted("%s of field %s is not granted", privilege.String(), denied[0])
+	}
+	return nil
+}
+
+// maskFieldsData replaces the values of masked fields, strings are replaced by the mask value
+// and other types by zero values, the number of rows is kept.
+func maskFieldsData(fieldsData []*schemapb.FieldData, maskedFields []string) {
+	if len(maskedFields) == 0 {
+		return
+	}
+	maskValue := Params.ProxyCfg.FieldPrivilegeMaskValue.GetValue()
+	for _, fieldData := range fieldsData {
+		if !lo.Contains(maskedFields, fieldData.GetFieldName()) {
+			continue
+		}
+		switch field := fieldData.GetField().(type) {
+		case *schemapb.FieldData_Scalars:
+			scalars := field.Scalars
+			switch data := scalars.GetData().(type) {
+			case *schemapb.ScalarField_BoolData:
+				data.BoolData.Data = make([]bool, len(data.BoolData.GetData()))
+			case *schemapb.ScalarField_IntData:
+				data.IntData.Data = make([]int32, len(data.IntData.GetData()))
+			case *schemapb.ScalarField_LongData:
+				data.LongData.Data = make([]int64, len(data.LongData.GetData()))
+			case *schemapb.ScalarField_FloatData:
+				data.FloatData.Data = make([]float32, len(data.FloatData.GetData()))
+			case *schemapb.ScalarField_DoubleData:
+				data.DoubleData.Data = make([]float64, len(data.DoubleData.GetData()))
+			case *schemapb.ScalarField_StringData:
+				for i := range data.StringData.GetData() {
+					data.StringData.Data[i] = maskValue
+				}
+			case *schemapb.ScalarField_JsonData:
+				for i := range data.JsonData.GetData() {
+					data.JsonData.Data[i] = []byte("{}")
+				}
+			case *schemapb.ScalarField_ArrayData:
+				for i := range data.ArrayData.GetData() {
+					data.ArrayData.Data[i] = &schemapb.ScalarField{}
+				}
+			}
+		case *schemapb.FieldData_Vectors:
+			vectors := field.Vectors
+			switch data := vectors.GetData().(type) {
+			case *schemapb.VectorField_FloatVector:
+				data.FloatVector.Data = make([]float32, len(data.FloatVector.GetData()))
+			case *schemapb.VectorField_BinaryVector:
+				data.BinaryVector = make([]byte, len(data.BinaryVector))
+			case *schemapb.VectorField_Float16Vector:
+				data.Float16Vector = make([]byte, len(data.Float16Vector))
+			case *schemapb.VectorField_Bfloat16Vector:
+				data.Bfloat16Vector = make([]byte, len(data.Bfloat16Vector))
+			case *schemapb.VectorField_Int8Vector:
+				data.Int8Vector = make([]byte, len(data.Int8Vector))
+			case *schemapb.VectorField_SparseFloatVector:
+				data.SparseFloatVector.Contents = make([][]byte, len(data.SparseFloatVector.GetContents()))
+			}
+		}
+	}
+}
+
 func validateIndexName(indexName string) error {
 	indexName = strings.TrimSpace(indexName)
 
@@ -1150,9 +1326,22 @@ func fillFieldsDataBySchema(schema *schemapb.CollectionSchema, insertMsg *msgstr
 				isPrimaryKeyNum++
 				continue
 			}
-			dataToAppend := &schemapb.FieldData{
-				Type:      fieldSchema.GetDataType(),
-				FieldName: fieldSchema.GetName(),
+			var dataToAppend *schemapb.FieldData
+			switch {
+			case fieldSchema.GetDefaultValue() != nil:
+				// filled with default value in validateUtil.fillWithDefaultValue
+				var err error
+				dataToAppend, err = typeutil.GenEmptyFieldData(fieldSchema)
+				if err != nil {
+					return err
+				}
+			case typeutil.IsFieldNullable(fieldSchema):
+				dataToAppend = typeutil.NewNullFieldData(fieldSchema)
+			default:
+				dataToAppend = &schemapb.FieldData{
+					Type:      fieldSchema.GetDataType(),
+					FieldName: fieldSchema.GetName(),
+				}
 			}
 			insertMsg.FieldsData = append(insertMsg.FieldsData, dataToAppend)
 		}
@@ -237,6 +237,12 @@ func TestValidateDimension(t *testing.T) {
 		},
 	}
 	assert.NotNil(t, validateDimension(fieldSchema))
+
+	// sparse float vector doesn't have dim
+	fieldSchema.DataType = typeutil.SparseFloatVector
+	assert.NotNil(t, validateDimension(fieldSchema))
+	fieldSchema.TypeParams = nil
+	assert.Nil(t, validateDimension(fieldSchema))
 }
 
 func TestValidateVectorFieldMetricType(t *testing.T) {
@@ -1381,6 +1387,69 @@ func Test_InsertTaskfillFieldsDataBySchema(t *testing.T) {
 	err = fillFieldsDataBySchema(case8.schema, case8.insertMsg)
 	assert.ErrorIs(t, merr.ErrParameterInvalid, err)
 	assert.Equal(t, len(case8.insertMsg.FieldsData), 0)
+
+	// fields not passed in are filled with default value or null
+	case9 := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name:        "TestInsertTask_fillFieldsDataBySchema",
+			Description: "TestInsertTask_fillFieldsDataBySchema",
+			AutoID:      false,
+			Fields: []*schemapb.FieldSchema{
+				{
+					Name:         "a",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_Int64,
+				},
+				{
+					Name:     "b",
+					DataType: schemapb.DataType_Int64,
+					DefaultValue: &schemapb.ValueField{
+						Data: &schemapb.ValueField_LongData{
+							LongData: 10,
+						},
+					},
+				},
+				{
+					Name:     "c",
+					DataType: schemapb.DataType_VarChar,
+					TypeParams: []*commonpb.KeyValuePair{
+						{Key: common.MaxLengthKey, Value: "8"},
+						{Key: common.NullableKey, Value: "true"},
+					},
+				},
+				{
+					Name:     "d",
+					DataType: schemapb.DataType_Int32,
+				},
+			},
+		},
+		insertMsg: &BaseInsertTask{
+			InsertRequest: msgpb.InsertRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Insert,
+				},
+				NumRows: 2,
+				FieldsData: []*schemapb.FieldData{
+					newScalarFieldData(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64}, "a", 2),
+				},
+			},
+		},
+	}
+
+	err = fillFieldsDataBySchema(case9.schema, case9.insertMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(case9.insertMsg.FieldsData))
+
+	// field d is neither nullable nor has default value
+	err = newValidateUtil().Validate(case9.insertMsg.FieldsData, case9.schema, 2)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	case9.insertMsg.FieldsData = case9.insertMsg.FieldsData[:3]
+	case9.schema.Fields = case9.schema.Fields[:3]
+	err = newValidateUtil().Validate(case9.insertMsg.FieldsData, case9.schema, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 10}, case9.insertMsg.FieldsData[1].GetScalars().GetLongData().GetData())
+	assert.True(t, typeutil.IsNullFieldData(case9.insertMsg.FieldsData[2]))
 }
 
 func Test_InsertTaskCheckPrimaryFieldData(t *testing.T) {
@@ -1989,6 +2058,35 @@ func Test_CheckDynamicFieldData(t *testing.T) {
 	})
 }
 
+func Test_validateNullable(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+
+	t.Run("normal case", func(t *testing.T) {
+		assert.NoError(t, validateNullable(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64}))
+		assert.NoError(t, validateNullable(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_JSON, TypeParams: nullableParams}))
+		assert.NoError(t, validateNullable(&schemapb.FieldSchema{
+			Name:       "a",
+			DataType:   schemapb.DataType_FloatVector,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "false"}},
+		}))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		err := validateNullable(&schemapb.FieldSchema{
+			Name:       "a",
+			DataType:   schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "yes"}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("not nullable fields", func(t *testing.T) {
+		assert.Error(t, validateNullable(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_FloatVector, TypeParams: nullableParams}))
+		assert.Error(t, validateNullable(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: nullableParams}))
+		assert.Error(t, validateNullable(&schemapb.FieldSchema{Name: "a", DataType: schemapb.DataType_Int64, IsPartitionKey: true, TypeParams: nullableParams}))
+	})
+}
+
 func Test_validateMaxCapacityPerRow(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		arrayField := &schemapb.FieldSchema{
@@ -2089,3 +2187,35 @@ func TestSendReplicateMessagePack(t *testing.T) {
 		SendReplicateMessagePack(ctx, mockStream, &milvuspb.ReleasePartitionsRequest{})
 	})
 }
+
+func TestMaskFieldsData(t *testing.T) {
+	paramtable.Init()
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldName: "name",
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"alice", "bob"}}},
+			}},
+		},
+		{
+			FieldName: "age",
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{18, 20}}},
+			}},
+		},
+		{
+			FieldName: "vec",
+			Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+			}},
+		},
+	}
+	maskFieldsData(fieldsData, []string{"name", "vec"})
+	assert.Equal(t, []string{"******", "******"}, fieldsData[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []int64{18, 20}, fieldsData[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{0, 0, 0, 0}, fieldsData[2].GetVectors().GetFloatVector().GetData())
+
+	maskFieldsData(fieldsData, []string{"age"})
+	assert.Equal(t, []int64{0, 0}, fieldsData[1].GetScalars().GetLongData().GetData())
+}
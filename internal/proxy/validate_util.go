@@ -68,6 +68,14 @@ func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.Col
 			return err
 		}
 
+		if typeutil.IsNullFieldData(field) {
+			if !typeutil.IsFieldNullable(fieldSchema) {
+				msg := fmt.Sprintf("field '%v' is not nullable and has no default value, but got no data", field.GetFieldName())
+				return merr.WrapErrParameterInvalid("not null", "null", msg)
+			}
+			continue
+		}
+
 		switch fieldSchema.GetDataType() {
 		case schemapb.DataType_FloatVector:
 			if err := v.checkFloatVectorFieldData(field, fieldSchema); err != nil {
@@ -77,10 +85,22 @@ func (v *validateUtil) Validate(data []*schemapb.FieldData, schema *schemapb.Col
 			if err := v.checkFloat16VectorFieldData(field, fieldSchema); err != nil {
 				return err
 			}
+		case typeutil.BFloat16Vector:
+			if err := v.checkBFloat16VectorFieldData(field, fieldSchema); err != nil {
+				return err
+			}
+		case typeutil.Int8Vector:
+			if err := v.checkInt8VectorFieldData(field, fieldSchema); err != nil {
+				return err
+			}
 		case schemapb.DataType_BinaryVector:
 			if err := v.checkBinaryVectorFieldData(field, fieldSchema); err != nil {
 				return err
 			}
+		case typeutil.SparseFloatVector:
+			if err := v.checkSparseFloatVectorFieldData(field, fieldSchema); err != nil {
+				return err
+			}
 		case schemapb.DataType_VarChar:
 			if err := v.checkVarCharFieldData(field, fieldSchema); err != nil {
 				return err
@@ -121,6 +141,11 @@ func (v *validateUtil) checkAligned(data []*schemapb.FieldData, schema *typeutil
 	}
 
 	for _, field := range data {
+		// all the rows of a null field data are null
+		if typeutil.IsNullFieldData(field) {
+			continue
+		}
+
 		switch field.GetType() {
 		case schemapb.DataType_FloatVector:
 			f, err := schema.GetFieldFromName(field.GetFieldName())
@@ -182,6 +207,46 @@ func (v *validateUtil) checkAligned(data []*schemapb.FieldData, schema *typeutil
 				return errNumRowsMismatch(field.GetFieldName(), n, numRows)
 			}
 
+		case typeutil.BFloat16Vector:
+			f, err := schema.GetFieldFromName(field.GetFieldName())
+			if err != nil {
+				return err
+			}
+
+			dim, err := typeutil.GetDim(f)
+			if err != nil {
+				return err
+			}
+
+			n, err := funcutil.GetNumRowsOfBFloat16VectorField(field.GetVectors().GetBfloat16Vector(), dim)
+			if err != nil {
+				return err
+			}
+
+			if n != numRows {
+				return errNumRowsMismatch(field.GetFieldName(), n, numRows)
+			}
+
+		case typeutil.Int8Vector:
+			f, err := schema.GetFieldFromName(field.GetFieldName())
+			if err != nil {
+				return err
+			}
+
+			dim, err := typeutil.GetDim(f)
+			if err != nil {
+				return err
+			}
+
+			n, err := funcutil.GetNumRowsOfInt8VectorField(field.GetVectors().GetInt8Vector(), dim)
+			if err != nil {
+				return err
+			}
+
+			if n != numRows {
+				return errNumRowsMismatch(field.GetFieldName(), n, numRows)
+			}
+
 		default:
 			// error won't happen here.
 			n, err := funcutil.GetNumRowOfFieldData(field)
@@ -207,7 +272,7 @@ func (v *validateUtil) fillWithDefaultValue(data []*schemapb.FieldData, schema *
 
 		// if default value is not set, continue
 		// compatible with 2.2.x
-		if fieldSchema.GetDefaultValue() == nil {
+		if fieldSchema.GetDefaultValue() == nil || typeutil.IsNullFieldData(field) {
 			continue
 		}
 
@@ -293,11 +358,48 @@ func (v *validateUtil) checkFloat16VectorFieldData(field *schemapb.FieldData, fi
 	return nil
 }
 
+func (v *validateUtil) checkBFloat16VectorFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
+	bf16Data := field.GetVectors().GetBfloat16Vector()
+	if field.GetType() != typeutil.BFloat16Vector || bf16Data == nil {
+		msg := fmt.Sprintf("bfloat16 vector field '%v' is illegal, array type mismatch", field.GetFieldName())
+		return merr.WrapErrParameterInvalid("need bfloat16 vector", "got nil", msg)
+	}
+
+	if v.checkNAN {
+		return typeutil.VerifyFloats32(typeutil.BFloat16BytesToFloat32Vector(bf16Data))
+	}
+
+	return nil
+}
+
+func (v *validateUtil) checkInt8VectorFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
+	if field.GetType() != typeutil.Int8Vector || field.GetVectors().GetInt8Vector() == nil {
+		msg := fmt.Sprintf("int8 vector field '%v' is illegal, array type mismatch", field.GetFieldName())
+		return merr.WrapErrParameterInvalid("need int8 vector", "got nil", msg)
+	}
+
+	return nil
+}
+
 func (v *validateUtil) checkBinaryVectorFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
 	// TODO
 	return nil
 }
 
+func (v *validateUtil) checkSparseFloatVectorFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
+	if field.GetType() != typeutil.SparseFloatVector || field.GetVectors().GetSparseFloatVector() == nil {
+		msg := fmt.Sprintf("sparse float vector field '%v' is illegal, array type mismatch", field.GetFieldName())
+		return merr.WrapErrParameterInvalid("need sparse float vector", "got nil", msg)
+	}
+
+	if err := typeutil.ValidateSparseFloatRows(typeutil.GetSparseFloatVectorRows(field)...); err != nil {
+		msg := fmt.Sprintf("sparse float vector field '%v' is illegal, %s", field.GetFieldName(), err.Error())
+		return merr.WrapErrParameterInvalid("valid sparse float vector", "invalid rows", msg)
+	}
+
+	return nil
+}
+
 func (v *validateUtil) checkVarCharFieldData(field *schemapb.FieldData, fieldSchema *schemapb.FieldSchema) error {
 	strArr := field.GetScalars().GetStringData().GetData()
 	if strArr == nil && fieldSchema.GetDefaultValue() == nil {
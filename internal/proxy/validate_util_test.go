@@ -181,6 +181,99 @@ func Test_validateUtil_checkBinaryVectorFieldData(t *testing.T) {
 	assert.NoError(t, newValidateUtil().checkBinaryVectorFieldData(nil, nil))
 }
 
+func Test_validateUtil_checkSparseFloatVectorFieldData(t *testing.T) {
+	t.Run("not sparse float vector", func(t *testing.T) {
+		f := &schemapb.FieldData{}
+		v := newValidateUtil()
+		err := v.checkSparseFloatVectorFieldData(f, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid rows", func(t *testing.T) {
+		v := newValidateUtil()
+		f := typeutil.NewSparseFloatVectorFieldData("sparse", [][]byte{{1, 2, 3}})
+		assert.Error(t, v.checkSparseFloatVectorFieldData(f, nil))
+
+		f = typeutil.NewSparseFloatVectorFieldData("sparse", [][]byte{
+			typeutil.CreateSparseFloatRow([]uint32{1}, []float32{float32(math.NaN())}),
+		})
+		assert.Error(t, v.checkSparseFloatVectorFieldData(f, nil))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		data := []*schemapb.FieldData{
+			typeutil.NewSparseFloatVectorFieldData("sparse", [][]byte{
+				typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.1, 0.2}),
+				typeutil.CreateSparseFloatRow(nil, nil),
+			}),
+		}
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:  100,
+					Name:     "sparse",
+					DataType: typeutil.SparseFloatVector,
+				},
+			},
+		}
+
+		v := newValidateUtil(withNANCheck())
+		assert.NoError(t, v.Validate(data, schema, 2))
+		assert.Error(t, v.Validate(data, schema, 3))
+	})
+}
+
+func Test_validateUtil_checkBytesVectorFieldData(t *testing.T) {
+	t.Run("type mismatch", func(t *testing.T) {
+		v := newValidateUtil()
+		f := typeutil.NewBytesVectorFieldData(schemapb.DataType_Float16Vector, "vec", 1, []byte{1, 2})
+		assert.Error(t, v.checkBFloat16VectorFieldData(f, nil))
+		assert.Error(t, v.checkInt8VectorFieldData(f, nil))
+		assert.Error(t, v.checkBFloat16VectorFieldData(&schemapb.FieldData{Type: typeutil.BFloat16Vector}, nil))
+		assert.Error(t, v.checkInt8VectorFieldData(&schemapb.FieldData{Type: typeutil.Int8Vector}, nil))
+
+		// the rows must be carried by the vector field of the data type
+		f.Type = typeutil.BFloat16Vector
+		assert.Error(t, v.checkBFloat16VectorFieldData(f, nil))
+		f.Type = typeutil.Int8Vector
+		assert.Error(t, v.checkInt8VectorFieldData(f, nil))
+	})
+
+	t.Run("bfloat16 nan", func(t *testing.T) {
+		// 1.0 and NaN in bfloat16
+		f := typeutil.NewBytesVectorFieldData(typeutil.BFloat16Vector, "vec", 2, []byte{0x80, 0x3f, 0xc0, 0x7f})
+		assert.NoError(t, newValidateUtil().checkBFloat16VectorFieldData(f, nil))
+		assert.Error(t, newValidateUtil(withNANCheck()).checkBFloat16VectorFieldData(f, nil))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		data := []*schemapb.FieldData{
+			typeutil.NewBytesVectorFieldData(typeutil.BFloat16Vector, "bf16", 2, []byte{0x80, 0x3f, 0x20, 0xc0, 0x80, 0x3f, 0x20, 0xc0}),
+			typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "int8", 2, []byte{1, 0xff, 2, 0xfe}),
+		}
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:    100,
+					Name:       "bf16",
+					DataType:   typeutil.BFloat16Vector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+				},
+				{
+					FieldID:    101,
+					Name:       "int8",
+					DataType:   typeutil.Int8Vector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+				},
+			},
+		}
+
+		v := newValidateUtil(withNANCheck())
+		assert.NoError(t, v.Validate(data, schema, 2))
+		assert.Error(t, v.Validate(data, schema, 4))
+	})
+}
+
 func Test_validateUtil_checkFloatVectorFieldData(t *testing.T) {
 	t.Run("not float vector", func(t *testing.T) {
 		f := &schemapb.FieldData{}
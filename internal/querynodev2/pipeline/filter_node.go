@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	base "github.com/milvus-io/milvus/internal/util/pipeline"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -124,6 +126,25 @@ func (fNode *filterNode) filtrate(c *Collection, msg msgstream.TsMsg) error {
 				return err
 			}
 		}
+	case commonpb.MsgType_AlterCollection:
+		// the schema is updated here, so that the inserts afterwards are aligned to it,
+		// the msg itself is filtered out as the insert node handles only inserts and deletes
+		alterMsg := msg.(*msgstream.AlterCollectionMsg)
+		if alterMsg.GetCollectionID() != fNode.collectionID {
+			return merr.WrapErrCollectionNotFound(alterMsg.GetCollectionID())
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(alterMsg.GetSchema(), schema); err != nil {
+			return err
+		}
+		if err := c.UpdateSchema(schema); err != nil {
+			return err
+		}
+		log.Info("schema of collection updated",
+			zap.Int64("collectionID", fNode.collectionID),
+			zap.String("channel", fNode.channel),
+			zap.Int("fieldNum", len(schema.GetFields())))
+		return merr.WrapErrParameterInvalid("msgType is Insert or Delete", "AlterCollection")
 	default:
 		return merr.WrapErrParameterInvalid("msgType is Insert or Delete", "not")
 	}
@@ -19,10 +19,13 @@ package pipeline
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
@@ -142,6 +145,43 @@ func (suite *FilterNodeSuite) TestWithLoadPartation() {
 	suite.Equal(suite.deleteSegmentSum, len(nodeMsg.deleteMsgs))
 }
 
+func (suite *FilterNodeSuite) TestAlterCollection() {
+	collection := segments.NewCollectionWithoutSchema(suite.collectionID, querypb.LoadType_LoadCollection)
+	mockCollectionManager := segments.NewMockCollectionManager(suite.T())
+	mockCollectionManager.EXPECT().Get(suite.collectionID).Return(collection)
+	suite.manager = &segments.Manager{
+		Collection: mockCollectionManager,
+		Segment:    segments.NewMockSegmentManager(suite.T()),
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "added", DataType: schemapb.DataType_Int64},
+		},
+	}
+	schemaBlob, err := proto.Marshal(schema)
+	suite.NoError(err)
+	msgPack := &msgstream.MsgPack{
+		Msgs: []msgstream.TsMsg{
+			&msgstream.AlterCollectionMsg{
+				CreateCollectionRequest: msgpb.CreateCollectionRequest{
+					Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+					CollectionID: suite.collectionID,
+					Schema:       schemaBlob,
+				},
+			},
+		},
+	}
+
+	node := newFilterNode(suite.collectionID, suite.channel, suite.manager, suite.excludedSegments, 8)
+	out := node.Operate(msgPack)
+	nodeMsg, ok := out.(*insertNodeMsg)
+	suite.True(ok)
+	suite.Empty(nodeMsg.insertMsgs)
+	suite.Equal(2, len(collection.Schema().GetFields()))
+}
+
 func (suite *FilterNodeSuite) buildMsgPack() *msgstream.MsgPack {
 	msgPack := &msgstream.MsgPack{
 		BeginTs: 0,
@@ -0,0 +1,64 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// aggReducer merges the partial aggregates of shards or workers.
+type aggReducer struct {
+	req *querypb.QueryRequest
+}
+
+func (r *aggReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	aggregator, err := reduce.GetAggregatorFromPlan(r.req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range results {
+		if err := aggregator.Merge(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &internalpb.RetrieveResults{
+		Status:     merr.Success(),
+		FieldsData: aggregator.Partial(),
+	}, nil
+}
+
+// aggReducerSegCore computes the partial aggregates of the entities retrieved from segments,
+// the entities with duplicated primary keys are counted once.
+type aggReducerSegCore struct {
+	req *querypb.QueryRequest
+}
+
+func (r *aggReducerSegCore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := reduce.GetAggregatorFromPlan(r.req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, err
+	}
+	idSet := make(map[interface{}]struct{})
+	for _, res := range results {
+		ids := res.GetIds()
+		err := aggregator.Add(res.GetFieldsData(), typeutil.GetSizeOfIDs(ids), func(row int) bool {
+			pk := typeutil.GetPK(ids, int64(row))
+			if _, ok := idSet[pk]; ok {
+				return false
+			}
+			idSet[pk] = struct{}{}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData: aggregator.Partial(),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+)
+
+type AggReducerSuite struct {
+	suite.Suite
+	req *querypb.QueryRequest
+}
+
+func (suite *AggReducerSuite) SetupTest() {
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{
+			Query: &planpb.QueryPlanNode{
+				GroupBy: []*planpb.ColumnInfo{{FieldId: 101, DataType: schemapb.DataType_Int64}},
+				Aggregates: []*planpb.Aggregate{
+					{Op: planpb.Aggregate_Count},
+				},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			IsAggregate:        true,
+			SerializedExprPlan: plan,
+		},
+	}
+}
+
+func TestAggReducerSuite(t *testing.T) {
+	suite.Run(t, new(AggReducerSuite))
+}
+
+func (suite *AggReducerSuite) genSegCoreResult(pks []int64, groups []int64) *segcorepb.RetrieveResults {
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groups}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (suite *AggReducerSuite) TestReduce() {
+	segCoreReducer := &aggReducerSegCore{req: suite.req}
+	// entity 2 is duplicated in both segments
+	partial1, err := segCoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		suite.genSegCoreResult([]int64{1, 2, 3}, []int64{10, 20, 10}),
+		suite.genSegCoreResult([]int64{2, 4}, []int64{20, 30}),
+	})
+	suite.NoError(err)
+	partial2, err := segCoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		suite.genSegCoreResult([]int64{5}, []int64{20}),
+	})
+	suite.NoError(err)
+
+	reducer := &aggReducer{req: suite.req}
+	res, err := reducer.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial1.GetFieldsData()},
+		{FieldsData: partial2.GetFieldsData()},
+	})
+	suite.NoError(err)
+	suite.Equal(3, len(res.GetFieldsData()))
+	suite.Equal([]int64{10, 20, 30}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal([]int64{2, 2, 1}, res.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+}
+
+func (suite *AggReducerSuite) TestInvalid() {
+	_, err := (&aggReducer{req: &querypb.QueryRequest{Req: &internalpb.RetrieveRequest{}}}).Reduce(context.TODO(), nil)
+	suite.Error(err)
+
+	_, err = (&aggReducerSegCore{req: suite.req}).Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}}},
+	})
+	suite.Error(err)
+}
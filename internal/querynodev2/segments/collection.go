@@ -25,6 +25,7 @@ package segments
 import "C"
 
 import (
+	"fmt"
 	"sync"
 	"unsafe"
 
@@ -73,7 +74,9 @@ func (m *collectionManager) PutOrRef(collectionID int64, schema *schemapb.Collec
 
 	if collection, ok := m.collections[collectionID]; ok {
 		// the schema may be changed even the collection is loaded
-		collection.schema = schema
+		if err := collection.UpdateSchema(schema); err != nil {
+			log.Warn("failed to update schema of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
 		collection.Ref(1)
 		return
 	}
@@ -116,7 +119,7 @@ func (m *collectionManager) Unref(collectionID int64, count uint32) bool {
 
 // Collection is a wrapper of the underlying C-structure C.CCollection
 type Collection struct {
-	mu            sync.RWMutex // protects colllectionPtr
+	mu            sync.RWMutex // protects colllectionPtr and schema
 	collectionPtr C.CCollection
 	id            int64
 	partitions    *typeutil.ConcurrentSet[int64]
@@ -134,9 +137,55 @@ func (c *Collection) ID() int64 {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.schema
 }
 
+// UpdateSchema updates the schema after a field is added to or dropped from the collection,
+// only the segments created afterwards get the new schema.
+// The dropped fields are kept in the schema, the segments created before still have them.
+func (c *Collection) UpdateSchema(schema *schemapb.CollectionSchema) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	schema = mergeDroppedFields(c.schema, schema)
+	if c.collectionPtr != nil {
+		/*
+			void
+			UpdateSchema(CCollection collection, const void* schema_proto_blob, const int64_t length);
+		*/
+		schemaBlob, err := proto.Marshal(schema)
+		if err != nil {
+			return err
+		}
+		C.UpdateSchema(c.collectionPtr, unsafe.Pointer(&schemaBlob[0]), (C.int64_t)(len(schemaBlob)))
+	}
+	c.schema = schema
+	return nil
+}
+
+// mergeDroppedFields returns the new schema with the fields of old schema which are dropped,
+// they're renamed in case a field of the same name is added again.
+func mergeDroppedFields(oldSchema, newSchema *schemapb.CollectionSchema) *schemapb.CollectionSchema {
+	if oldSchema == nil || newSchema == nil {
+		return newSchema
+	}
+	merged := proto.Clone(newSchema).(*schemapb.CollectionSchema)
+	for _, field := range oldSchema.GetFields() {
+		if typeutil.GetField(newSchema, field.GetFieldID()) != nil {
+			continue
+		}
+		dropped := proto.Clone(field).(*schemapb.FieldSchema)
+		if dropped.GetState() != schemapb.FieldState_FieldDropped {
+			dropped.Name = fmt.Sprintf("%s_dropped_%d", field.GetName(), field.GetFieldID())
+			dropped.State = schemapb.FieldState_FieldDropped
+		}
+		merged.Fields = append(merged.Fields, dropped)
+	}
+	return merged
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) GetPartitions() []int64 {
 	return c.partitions.Collect()
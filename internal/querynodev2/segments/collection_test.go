@@ -0,0 +1,42 @@
+package segments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestCollection_UpdateSchema(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	collection := NewCollectionWithoutSchema(1, querypb.LoadType_LoadCollection)
+	assert.NoError(t, collection.UpdateSchema(schema))
+	assert.Equal(t, schema, collection.Schema())
+
+	// drop age and add it again
+	assert.NoError(t, collection.UpdateSchema(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_VarChar},
+		},
+	}))
+	fields := collection.Schema().GetFields()
+	assert.Equal(t, 3, len(fields))
+	assert.Equal(t, int64(102), fields[1].GetFieldID())
+	assert.Equal(t, "age", fields[1].GetName())
+	// the dropped field is kept for the segments which still have it
+	assert.Equal(t, int64(101), fields[2].GetFieldID())
+	assert.Equal(t, "age_dropped_101", fields[2].GetName())
+	assert.Equal(t, schemapb.FieldState_FieldDropped, fields[2].GetState())
+
+	// the dropped field isn't renamed again
+	assert.NoError(t, collection.UpdateSchema(&schemapb.CollectionSchema{Fields: fields[:2]}))
+	assert.Equal(t, "age_dropped_101", collection.Schema().GetFields()[2].GetName())
+}
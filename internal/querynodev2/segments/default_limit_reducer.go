@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 )
 
 type defaultLimitReducer struct {
@@ -19,6 +20,8 @@ type mergeParam struct {
 	outputFieldsId   []int64
 	schema           *schemapb.CollectionSchema
 	mergeStopForBest bool
+	// orderBy merges the results in its order instead of primary keys if it's not nil
+	orderBy *reduce.OrderBy
 }
 
 func NewMergeParam(limit int64, outputFieldsId []int64, schema *schemapb.CollectionSchema, reduceStopForBest bool) *mergeParam {
@@ -33,6 +36,11 @@ func NewMergeParam(limit int64, outputFieldsId []int64, schema *schemapb.Collect
 func (r *defaultLimitReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
 	reduceParam := NewMergeParam(r.req.GetReq().GetLimit(), r.req.GetReq().GetOutputFieldsId(),
 		r.schema, r.req.GetReq().GetReduceStopForBest())
+	orderBy, err := reduce.GetOrderByFromPlan(r.req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, err
+	}
+	reduceParam.orderBy = orderBy
 	return mergeInternalRetrieveResultsAndFillIfEmpty(ctx, results, reduceParam)
 }
 
@@ -50,6 +58,11 @@ type defaultLimitReducerSegcore struct {
 
 func (r *defaultLimitReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
 	mergeParam := NewMergeParam(r.req.GetReq().GetLimit(), r.req.GetReq().GetOutputFieldsId(), r.schema, r.req.GetReq().GetReduceStopForBest())
+	orderBy, err := reduce.GetOrderByFromPlan(r.req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return nil, err
+	}
+	mergeParam.orderBy = orderBy
 	return mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, results, mergeParam)
 }
 
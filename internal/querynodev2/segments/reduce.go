@@ -25,7 +25,14 @@ package segments
 import "C"
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type SliceInfo struct {
@@ -106,6 +113,23 @@ func ReduceSearchResultsAndFillData(plan *SearchPlan, searchResults []*SearchRes
 	return cSearchResultDataBlobs, nil
 }
 
+// ReduceGroupBySearchResultBlob keeps the best hits of group values in the search result blob of a slice,
+// the blob is reduced from segments by segcore, which doesn't know the group by field.
+func ReduceGroupBySearchResultBlob(ctx context.Context, blob []byte, nq int64, topk int64, groupBy *reduce.GroupByInfo) ([]byte, error) {
+	data := &schemapb.SearchResultData{}
+	if err := proto.Unmarshal(blob, data); err != nil {
+		return nil, err
+	}
+	if typeutil.GetSizeOfIDs(data.GetIds()) == 0 {
+		return blob, nil
+	}
+	reduced, err := ReduceSearchResultData(ctx, []*schemapb.SearchResultData{data}, nq, topk, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(reduced)
+}
+
 func GetSearchResultDataBlob(cSearchResultDataBlobs searchResultDataBlobs, blobIndex int) ([]byte, error) {
 	var blob C.CProto
 	status := C.GetSearchResultDataBlob(&blob, cSearchResultDataBlobs, C.int32_t(blobIndex))
@@ -17,6 +17,9 @@ func CreateInternalReducer(req *querypb.QueryRequest, schema *schemapb.Collectio
 	if req.GetReq().GetIsCount() {
 		return &cntReducer{}
 	}
+	if req.GetReq().GetIsAggregate() {
+		return &aggReducer{req: req}
+	}
 	return newDefaultLimitReducer(req, schema)
 }
 
@@ -28,5 +31,8 @@ func CreateSegCoreReducer(req *querypb.QueryRequest, schema *schemapb.Collection
 	if req.GetReq().GetIsCount() {
 		return &cntReducerSegCore{}
 	}
+	if req.GetReq().GetIsAggregate() {
+		return &aggReducerSegCore{req: req}
+	}
 	return newDefaultLimitReducerSegcore(req, schema)
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -40,7 +41,8 @@ var _ typeutil.ResultWithID = &internalpb.RetrieveResults{}
 
 var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
-func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// ReduceSearchResults reduces the search results of segments or shards, groupBy is nil if the search is not grouped.
+func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupBy *reduce.GroupByInfo) (*internalpb.SearchResults, error) {
 	results = lo.Filter(results, func(result *internalpb.SearchResults, _ int) bool {
 		return result != nil && result.GetSlicedBlob() != nil
 	})
@@ -65,7 +67,7 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk, groupBy)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -92,7 +94,9 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// ReduceSearchResultData merges the search result data by scores and removes duplicated entities,
+// at most GroupSize hits of GroupLimit group values are kept for each query if groupBy is not nil.
+func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupBy *reduce.GroupByInfo) (*schemapb.SearchResultData, error) {
 	log := log.Ctx(ctx)
 
 	if len(searchResultData) == 0 {
@@ -122,6 +126,21 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
+	var groupValues []*schemapb.FieldData
+	if groupBy != nil {
+		groupValues = make([]*schemapb.FieldData, len(searchResultData))
+		for i, data := range searchResultData {
+			if typeutil.GetSizeOfIDs(data.GetIds()) == 0 {
+				continue
+			}
+			var err error
+			groupValues[i], err = groupBy.GroupValues(data.GetFieldsData())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
@@ -129,6 +148,10 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		offsets := make([]int64, len(searchResultData))
 
 		idSet := make(map[interface{}]struct{})
+		var counter *reduce.GroupCounter
+		if groupBy != nil {
+			counter = groupBy.NewCounter()
+		}
 		var j int64
 		for j = 0; j < topk; {
 			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -142,6 +165,13 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
+				// skip the hit if its group is full, or there are enough groups
+				if counter != nil {
+					if _, accepted := counter.Accept(groupValues[sel], idx); !accepted {
+						offsets[sel]++
+						continue
+					}
+				}
 				retSize += typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 				typeutil.AppendPKs(ret.Ids, id)
 				ret.Scores = append(ret.Scores, score)
@@ -152,6 +182,9 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 				skipDupCnt++
 			}
 			offsets[sel]++
+			if counter != nil && counter.Full() {
+				break
+			}
 		}
 
 		// if realTopK != -1 && realTopK != j {
@@ -278,11 +311,15 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idTsMap := make(map[interface{}]uint64)
 	cursors := make([]int64, len(validRetrieveResults))
+	selectMin, err := newRetrieveResultSelector(validRetrieveResults, param)
+	if err != nil {
+		return nil, err
+	}
 
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
 	for j := 0; j < loopEnd; {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors, param.mergeStopForBest, param.limit)
+		sel := selectMin(cursors)
 		if sel == -1 {
 			break
 		}
@@ -297,7 +334,8 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		} else {
 			// primary keys duplicate
 			skipDupCnt++
-			if ts != 0 && ts > idTsMap[pk] {
+			// duplicated entities are adjacent only if the results are ordered by primary keys
+			if ts != 0 && ts > idTsMap[pk] && param.orderBy == nil {
 				idTsMap[pk] = ts
 				typeutil.DeleteFieldData(ret.FieldsData)
 				retSize += typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
@@ -332,6 +370,21 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	return ret, nil
 }
 
+// newRetrieveResultSelector returns the function which selects the result whose entity at the cursor
+// is the next one to merge, the entities are in the order of primary keys if param has no order by.
+func newRetrieveResultSelector[T reduce.RetrieveResult](results []T, param *mergeParam) (func(cursors []int64) int, error) {
+	if param.orderBy == nil {
+		return func(cursors []int64) int {
+			return typeutil.SelectMinPK(results, cursors, param.mergeStopForBest, param.limit)
+		}, nil
+	}
+	merger, err := reduce.NewMerger(param.orderBy, results)
+	if err != nil {
+		return nil, err
+	}
+	return merger.SelectMin, nil
+}
+
 func getTS(i *internalpb.RetrieveResults, idx int64) uint64 {
 	if i.FieldsData == nil {
 		return 0
@@ -382,11 +435,15 @@ func MergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
+	selectMin, err := newRetrieveResultSelector(validRetrieveResults, param)
+	if err != nil {
+		return nil, err
+	}
 
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
 	for j := 0; j < loopEnd; j++ {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors, param.mergeStopForBest, param.limit)
+		sel := selectMin(cursors)
 		if sel == -1 {
 			break
 		}
@@ -27,7 +27,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -565,6 +567,98 @@ func (suite *ResultSuite) TestResult_MergeStopForBestResult() {
 	})
 }
 
+func (suite *ResultSuite) TestResult_MergeOrderByResult() {
+	const (
+		Int64FieldName = "Int64Field"
+		Int64FieldID   = common.StartOfUserFieldID + 1
+	)
+	orderBy := reduce.NewOrderBy(&planpb.QueryPlanNode{
+		OrderBy: []*planpb.OrderBy{{
+			Column:    &planpb.ColumnInfo{FieldId: Int64FieldID, DataType: schemapb.DataType_Int64},
+			Ascending: false,
+		}},
+	})
+	genIDs := func(pks ...int64) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+	}
+
+	suite.Run("test top n of segment", func() {
+		result := &segcorepb.RetrieveResults{
+			Ids:    genIDs(1, 2, 3, 4),
+			Offset: []int64{0, 1, 2, 3},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{10, 30, 20, 30}, 1),
+			},
+		}
+		ret, err := topNRetrieveResult(result, orderBy, 3)
+		suite.NoError(err)
+		suite.Equal([]int64{2, 4, 3}, ret.GetIds().GetIntId().GetData())
+		suite.Equal([]int64{1, 3, 2}, ret.GetOffset())
+		suite.Equal([]int64{30, 30, 20}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		ret, err = topNRetrieveResult(result, orderBy, typeutil.Unlimited)
+		suite.NoError(err)
+		suite.Equal([]int64{2, 4, 3, 1}, ret.GetIds().GetIntId().GetData())
+	})
+
+	suite.Run("test seg core merge", func() {
+		r1 := &segcorepb.RetrieveResults{
+			Ids:    genIDs(1, 3),
+			Offset: []int64{0, 1},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{30, 10}, 1),
+			},
+		}
+		r2 := &segcorepb.RetrieveResults{
+			Ids:    genIDs(2, 4),
+			Offset: []int64{0, 1},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{20, 20}, 1),
+			},
+		}
+		param := NewMergeParam(3, make([]int64, 0), nil, false)
+		param.orderBy = orderBy
+		result, err := MergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, param)
+		suite.NoError(err)
+		suite.Equal([]int64{1, 2, 4}, result.GetIds().GetIntId().GetData())
+		suite.Equal([]int64{30, 20, 20}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	suite.Run("test internal merge", func() {
+		r1 := &internalpb.RetrieveResults{
+			Ids: genIDs(1, 3),
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{30, 10}, 1),
+			},
+		}
+		r2 := &internalpb.RetrieveResults{
+			Ids: genIDs(2, 3),
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID, schemapb.DataType_Int64, []int64{20, 10}, 1),
+			},
+		}
+		param := NewMergeParam(typeutil.Unlimited, make([]int64, 0), nil, false)
+		param.orderBy = orderBy
+		result, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, param)
+		suite.NoError(err)
+		suite.Equal([]int64{1, 2, 3}, result.GetIds().GetIntId().GetData())
+		suite.Equal([]int64{30, 20, 10}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	suite.Run("test order by field not found", func() {
+		r := &internalpb.RetrieveResults{
+			Ids: genIDs(1),
+			FieldsData: []*schemapb.FieldData{
+				genFieldData(Int64FieldName, Int64FieldID+1, schemapb.DataType_Int64, []int64{30}, 1),
+			},
+		}
+		param := NewMergeParam(typeutil.Unlimited, make([]int64, 0), nil, false)
+		param.orderBy = orderBy
+		_, err := MergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r, r}, param)
+		suite.Error(err)
+	})
+}
+
 func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 	const (
 		nq         = 1
@@ -580,7 +674,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.Equal(ids, res.Ids.GetIntId().Data)
 		suite.Equal(scores, res.Scores)
@@ -597,10 +691,33 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, nil)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	suite.Run("group_by", func() {
+		genGroupField := func(values []int64) []*schemapb.FieldData {
+			return []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+					},
+				},
+			}}
+		}
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4})
+		data1.FieldsData = genGroupField([]int64{10, 10, 20, 30})
+		data2 := genSearchResultData(nq, topk, []int64{5, 6}, []float32{-1.5, -2.5}, []int64{2})
+		data2.FieldsData = genGroupField([]int64{10, 20})
+		groupBy := &reduce.GroupByInfo{FieldID: 101, GroupSize: 1, GroupLimit: 2}
+		res, err := ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk, groupBy)
+		suite.NoError(err)
+		suite.Equal([]int64{1, 6}, res.Ids.GetIntId().Data)
+		suite.Equal([]float32{-1.0, -2.5}, res.Scores)
+		suite.Equal([]int64{10, 20}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
 }
 
 func (suite *ResultSuite) TestResult_SelectSearchResultData_int() {
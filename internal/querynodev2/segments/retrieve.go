@@ -21,20 +21,177 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+// topNRetrieveResult keeps the first limit entities of segment result in the order of orderBy.
+func topNRetrieveResult(result *segcorepb.RetrieveResults, orderBy *reduce.OrderBy, limit int64) (*segcorepb.RetrieveResults, error) {
+	if typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+		return result, nil
+	}
+	rows, err := orderBy.TopN(result, limit)
+	if err != nil {
+		return nil, err
+	}
+	ret := &segcorepb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, len(result.GetFieldsData())),
+		Offset:     make([]int64, 0, len(rows)),
+	}
+	for _, row := range rows {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(result.GetIds(), row))
+		typeutil.AppendFieldData(ret.FieldsData, result.GetFieldsData(), row)
+		ret.Offset = append(ret.Offset, result.GetOffset()[row])
+	}
+	return ret, nil
+}
+
+// orderedRetriever retrieves the first limit entities of segment in the order of orderBy in two phases:
+// the primary keys and order by fields of all the matched entities are retrieved first to pick the top-N,
+// then the output fields are retrieved by the primary keys of the top-N only, so segcore never materializes
+// the output fields of the entities cut by limit.
+type orderedRetriever struct {
+	collection *Collection
+	plan       *planpb.PlanNode
+	keysPlan   *RetrievePlan
+	orderBy    *reduce.OrderBy
+	limit      int64
+	timestamp  Timestamp
+	msgID      UniqueID
+}
+
+func newOrderedRetriever(collection *Collection, req *internalpb.RetrieveRequest, orderBy *reduce.OrderBy) (*orderedRetriever, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetSerializedExprPlan(), plan); err != nil {
+		return nil, err
+	}
+	keysPlan := proto.Clone(plan).(*planpb.PlanNode)
+	keysPlan.OutputFieldIds = lo.Uniq(lo.Map(plan.GetQuery().GetOrderBy(), func(order *planpb.OrderBy, _ int) int64 {
+		return order.GetColumn().GetFieldId()
+	}))
+	r := &orderedRetriever{
+		collection: collection,
+		plan:       plan,
+		orderBy:    orderBy,
+		limit:      req.GetLimit(),
+		timestamp:  req.GetMvccTimestamp(),
+		msgID:      req.GetBase().GetMsgID(),
+	}
+	var err error
+	r.keysPlan, err = r.newRetrievePlan(keysPlan)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *orderedRetriever) newRetrievePlan(plan *planpb.PlanNode) (*RetrievePlan, error) {
+	expr, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+	return NewRetrievePlan(r.collection, expr, r.timestamp, r.msgID)
+}
+
+func (r *orderedRetriever) Retrieve(ctx context.Context, seg Segment) (*segcorepb.RetrieveResults, error) {
+	keys, err := seg.Retrieve(ctx, r.keysPlan)
+	if err != nil {
+		return nil, err
+	}
+	if typeutil.GetSizeOfIDs(keys.GetIds()) == 0 {
+		return keys, nil
+	}
+	rows, err := r.orderBy.TopN(keys, r.limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(r.collection.Schema())
+	if err != nil {
+		return nil, err
+	}
+	fetchPlan := proto.Clone(r.plan).(*planpb.PlanNode)
+	fetchPlan.GetQuery().Predicates = newPrimaryKeysExpr(pkField, keys.GetIds(), rows)
+	plan, err := r.newRetrievePlan(fetchPlan)
+	if err != nil {
+		return nil, err
+	}
+	defer plan.Delete()
+	fetched, err := seg.Retrieve(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// the entities are matched by offsets since the primary keys may be duplicated in growing segments
+	fetchedRows := make(map[int64]int64, len(fetched.GetOffset()))
+	for i, offset := range fetched.GetOffset() {
+		fetchedRows[offset] = int64(i)
+	}
+	ret := &segcorepb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, len(fetched.GetFieldsData())),
+		Offset:     make([]int64, 0, len(rows)),
+	}
+	for _, row := range rows {
+		i, ok := fetchedRows[keys.GetOffset()[row]]
+		if !ok {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("entity at offset %d of segment %d is not retrieved by primary key", keys.GetOffset()[row], seg.ID()))
+		}
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(fetched.GetIds(), i))
+		typeutil.AppendFieldData(ret.FieldsData, fetched.GetFieldsData(), i)
+		ret.Offset = append(ret.Offset, fetched.GetOffset()[i])
+	}
+	return ret, nil
+}
+
+func (r *orderedRetriever) Delete() {
+	r.keysPlan.Delete()
+}
+
+// newPrimaryKeysExpr returns the expression which matches the primary keys of rows.
+func newPrimaryKeysExpr(pkField *schemapb.FieldSchema, ids *schemapb.IDs, rows []int64) *planpb.Expr {
+	values := make([]*planpb.GenericValue, 0, len(rows))
+	for _, row := range rows {
+		switch pk := typeutil.GetPK(ids, row).(type) {
+		case int64:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}})
+		case string:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk}})
+		}
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_TermExpr{
+			TermExpr: &planpb.TermExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.GetFieldID(),
+					DataType:     pkField.GetDataType(),
+					IsPrimaryKey: true,
+					IsAutoID:     pkField.GetAutoID(),
+				},
+				Values: values,
+			},
+		},
+	}
+}
+
 // retrieveOnSegments performs retrieve on listed segments
-// all segment ids are validated before calling this function
-func retrieveOnSegments(ctx context.Context, segments []Segment, segType SegmentType, plan *RetrievePlan) ([]*segcorepb.RetrieveResults, error) {
+// all segment ids are validated before calling this function.
+func retrieveOnSegments(ctx context.Context, segments []Segment, segType SegmentType, retrieve func(ctx context.Context, seg Segment) (*segcorepb.RetrieveResults, error)) ([]*segcorepb.RetrieveResults, error) {
 	var (
 		resultCh = make(chan *segcorepb.RetrieveResults, len(segments))
 		errs     = make([]error, len(segments))
@@ -51,7 +208,7 @@ func retrieveOnSegments(ctx context.Context, segments []Segment, segType Segment
 		go func(seg Segment, i int) {
 			defer wg.Done()
 			tr := timerecord.NewTimeRecorder("retrieveOnSegments")
-			result, err := seg.Retrieve(ctx, plan)
+			result, err := retrieve(ctx, seg)
 			if err != nil {
 				errs[i] = err
 				return
@@ -141,7 +298,38 @@ func Retrieve(ctx context.Context, manager *Manager, plan *RetrievePlan, req *qu
 		return retrieveResults, retrieveSegments, err
 	}
 
-	retrieveResults, err = retrieveOnSegments(ctx, retrieveSegments, SegType, plan)
+	orderBy, err := reduce.GetOrderByFromPlan(req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		return retrieveResults, retrieveSegments, err
+	}
+
+	retrieve := func(ctx context.Context, seg Segment) (*segcorepb.RetrieveResults, error) {
+		return seg.Retrieve(ctx, plan)
+	}
+	switch {
+	case orderBy != nil && req.GetReq().GetLimit() != typeutil.Unlimited:
+		collection := manager.Collection.Get(collID)
+		if collection == nil {
+			return retrieveResults, retrieveSegments, merr.WrapErrCollectionNotFound(collID)
+		}
+		retriever, err := newOrderedRetriever(collection, req.GetReq(), orderBy)
+		if err != nil {
+			return retrieveResults, retrieveSegments, err
+		}
+		defer retriever.Delete()
+		retrieve = retriever.Retrieve
+	case orderBy != nil:
+		// every matched entity is returned, the results of segments are ordered to be merged
+		retrieve = func(ctx context.Context, seg Segment) (*segcorepb.RetrieveResults, error) {
+			result, err := seg.Retrieve(ctx, plan)
+			if err != nil {
+				return nil, err
+			}
+			return topNRetrieveResult(result, orderBy, typeutil.Unlimited)
+		}
+	}
+
+	retrieveResults, err = retrieveOnSegments(ctx, retrieveSegments, SegType, retrieve)
 	return retrieveResults, retrieveSegments, err
 }
 
@@ -21,17 +21,21 @@ import (
 	"io"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/initcore"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type RetrieveSuite struct {
@@ -180,6 +184,61 @@ func (suite *RetrieveSuite) TestRetrieveGrowing() {
 	suite.manager.Segment.Unpin(segments)
 }
 
+func (suite *RetrieveSuite) TestRetrieveOrdered() {
+	plan, err := genSimpleRetrievePlan(suite.collection)
+	suite.NoError(err)
+	defer plan.Delete()
+
+	expr, err := genSimpleRetrievePlanExpr(suite.collection.Schema())
+	suite.NoError(err)
+	planNode := &planpb.PlanNode{}
+	suite.NoError(proto.Unmarshal(expr, planNode))
+	pkColumn := planNode.GetPredicates().GetTermExpr().GetColumnInfo()
+	planNode.Node = &planpb.PlanNode_Query{
+		Query: &planpb.QueryPlanNode{
+			Predicates: planNode.GetPredicates(),
+			Limit:      typeutil.Unlimited,
+			OrderBy:    []*planpb.OrderBy{{Column: pkColumn, Ascending: false}},
+		},
+	}
+	planNode.OutputFieldIds = lo.Map(suite.collection.Schema().GetFields(), func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})
+	expr, err = proto.Marshal(planNode)
+	suite.NoError(err)
+
+	for _, tt := range []struct {
+		scope     querypb.DataScope
+		segmentID int64
+		limit     int64
+		expected  []int64
+	}{
+		{querypb.DataScope_Historical, suite.sealed.ID(), 2, []int64{3, 2}},
+		{querypb.DataScope_Streaming, suite.growing.ID(), 2, []int64{3, 2}},
+		{querypb.DataScope_Historical, suite.sealed.ID(), typeutil.Unlimited, []int64{3, 2, 1}},
+	} {
+		req := &querypb.QueryRequest{
+			Req: &internalpb.RetrieveRequest{
+				CollectionID:       suite.collectionID,
+				PartitionIDs:       []int64{suite.partitionID},
+				SerializedExprPlan: expr,
+				MvccTimestamp:      plan.Timestamp,
+				Limit:              tt.limit,
+			},
+			SegmentIDs: []int64{tt.segmentID},
+			Scope:      tt.scope,
+		}
+
+		res, segments, err := Retrieve(context.TODO(), suite.manager, plan, req)
+		suite.NoError(err)
+		suite.Len(res, 1)
+		suite.Equal(tt.expected, res[0].GetIds().GetIntId().GetData())
+		suite.Len(res[0].GetFieldsData(), len(planNode.OutputFieldIds))
+		suite.Len(res[0].GetOffset(), len(tt.expected))
+		suite.manager.Segment.Unpin(segments)
+	}
+}
+
 func (suite *RetrieveSuite) TestRetrieveStreamSealed() {
 	plan, err := genSimpleRetrievePlan(suite.collection)
 	suite.NoError(err)
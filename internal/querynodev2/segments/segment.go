@@ -720,6 +720,46 @@ func (s *LocalSegment) LoadFieldData(fieldID int64, rowCount int64, field *datap
 	return nil
 }
 
+// LoadFieldDataArray loads the field which has no binlog in the segment from fieldData,
+// the field is added to the collection after the segment was written.
+func (s *LocalSegment) LoadFieldDataArray(rowCount int64, fieldData *schemapb.FieldData) error {
+	s.ptrLock.RLock()
+	defer s.ptrLock.RUnlock()
+
+	if s.ptr == nil {
+		return merr.WrapErrSegmentNotLoaded(s.segmentID, "segment released")
+	}
+
+	log := log.With(
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("partitionID", s.Partition()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", fieldData.GetFieldId()),
+		zap.Int64("rowCount", rowCount),
+	)
+
+	fieldDataBlob, err := proto.Marshal(fieldData)
+	if err != nil {
+		return err
+	}
+
+	/*
+		CStatus
+		LoadFieldDataArray(CSegmentInterface c_segment, const void* field_data_blob, int64_t length, int64_t row_count);
+	*/
+	var status C.CStatus
+	GetDynamicPool().Submit(func() (any, error) {
+		status = C.LoadFieldDataArray(s.ptr, unsafe.Pointer(&fieldDataBlob[0]), C.int64_t(len(fieldDataBlob)), C.int64_t(rowCount))
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(&status, "LoadFieldDataArray failed"); err != nil {
+		return err
+	}
+
+	log.Info("load added field done")
+	return nil
+}
+
 func (s *LocalSegment) AddFieldDataInfo(rowCount int64, fields []*datapb.FieldBinlog) error {
 	s.ptrLock.RLock()
 	defer s.ptrLock.RUnlock()
@@ -550,7 +550,7 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		indexedFieldInfos := make(map[int64]*IndexedFieldInfo)
 		fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(loadInfo.BinlogPaths))
 
-		for _, fieldBinlog := range loadInfo.BinlogPaths {
+		for _, fieldBinlog := range filterDroppedFieldBinlogs(collection.Schema(), loadInfo.BinlogPaths) {
 			fieldID := fieldBinlog.FieldID
 			// check num rows of data meta and index meta are consistent
 			if indexInfo, ok := fieldID2IndexInfo[fieldID]; ok {
@@ -573,6 +573,9 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 			return err
 		}
+		if err := loader.loadAddedFields(ctx, collection.Schema(), segment, loadInfo); err != nil {
+			return err
+		}
 		if err := segment.AddFieldDataInfo(loadInfo.GetNumOfRows(), loadInfo.GetBinlogPaths()); err != nil {
 			return err
 		}
@@ -582,7 +585,7 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 			return err
 		}
 	} else {
-		if err := segment.LoadMultiFieldData(loadInfo.GetNumOfRows(), loadInfo.BinlogPaths); err != nil {
+		if err := segment.LoadMultiFieldData(loadInfo.GetNumOfRows(), filterDroppedFieldBinlogs(collection.Schema(), loadInfo.BinlogPaths)); err != nil {
 			return err
 		}
 	}
@@ -653,6 +656,42 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 	return nil
 }
 
+// filterDroppedFieldBinlogs filters out the binlogs of the fields which aren't in the schema,
+// they're dropped from the collection before the collection is loaded.
+func filterDroppedFieldBinlogs(schema *schemapb.CollectionSchema, fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	return lo.Filter(fieldBinlogs, func(fieldBinlog *datapb.FieldBinlog, _ int) bool {
+		return fieldBinlog.GetFieldID() < common.StartOfUserFieldID || typeutil.GetField(schema, fieldBinlog.GetFieldID()) != nil
+	})
+}
+
+// loadAddedFields fills the fields which have no binlog in the sealed segment, they're added to the
+// collection after the segment was written. The rows get the default value of the field, or null.
+func (loader *segmentLoader) loadAddedFields(ctx context.Context, schema *schemapb.CollectionSchema, segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) error {
+	loadedFields := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loadedFields.Insert(fieldBinlog.GetFieldID())
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || loadedFields.Contain(field.GetFieldID()) ||
+			field.GetState() == schemapb.FieldState_FieldDropped {
+			continue
+		}
+		// segcore doesn't keep the validity of rows, null rows are loaded as zero values
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(loadInfo.GetNumOfRows()))
+		if err != nil {
+			return err
+		}
+		if err := segment.LoadFieldDataArray(loadInfo.GetNumOfRows(), fieldData); err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info("load added field for sealed segment",
+			zap.Int64("collection", segment.collectionID),
+			zap.Int64("segment", segment.segmentID),
+			zap.Int64("fieldID", field.GetFieldID()))
+	}
+	return nil
+}
+
 func (loader *segmentLoader) loadFieldsIndex(ctx context.Context,
 	schema *schemapb.CollectionSchema,
 	segment *LocalSegment,
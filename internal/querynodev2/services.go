@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -823,7 +824,13 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	tr.RecordSpan()
-	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	groupBy, err := reduce.GetGroupByInfoFromPlan(req.Req.GetSerializedExprPlan())
+	if err != nil {
+		log.Warn("failed to parse group by of search plan", zap.Error(err))
+		failRet.Status = merr.Status(err)
+		return failRet, nil
+	}
+	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), groupBy)
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		failRet.Status = merr.Status(err)
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/collector"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/util/reduce"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -193,6 +194,11 @@ func (t *SearchTask) Execute() error {
 		return err
 	}
 	defer segments.DeleteSearchResultDataBlobs(blobs)
+	groupBy, err := reduce.GetGroupByInfoFromPlan(req.GetReq().GetSerializedExprPlan())
+	if err != nil {
+		log.Warn("failed to parse group by of search plan", zap.Error(err))
+		return err
+	}
 	metrics.QueryNodeReduceLatency.WithLabelValues(
 		fmt.Sprint(paramtable.GetNodeID()),
 		metrics.SearchLabel,
@@ -214,6 +220,13 @@ func (t *SearchTask) Execute() error {
 		// Note: blob is unsafe because get from C
 		bs := make([]byte, len(blob))
 		copy(bs, blob)
+		if groupBy != nil {
+			bs, err = segments.ReduceGroupBySearchResultBlob(t.ctx, bs, t.originNqs[i], t.originTopks[i], groupBy)
+			if err != nil {
+				log.Warn("failed to group search results", zap.Error(err))
+				return err
+			}
+		}
 
 		task.result = &internalpb.SearchResults{
 			Base: &commonpb.MsgBase{
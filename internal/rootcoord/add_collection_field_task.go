@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// addCollectionFieldTask adds a field to an existing collection, the rows written before get the default
+// value of the field.
+type addCollectionFieldTask struct {
+	baseTask
+	Req *rootcoordpb.AddCollectionFieldRequest
+}
+
+func (t *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return fmt.Errorf("add collection field failed, collection name does not exists")
+	}
+	return validateAddedField(t.Req.GetField())
+}
+
+// validateAddedField checks the field can be filled in the rows written before it's added.
+func validateAddedField(field *schemapb.FieldSchema) error {
+	if field == nil || field.GetName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the name of added field is empty")
+	}
+	if funcutil.SliceContain([]string{RowIDFieldName, TimeStampFieldName, MetaFieldName}, field.GetName()) {
+		return merr.WrapErrParameterInvalidMsg("can't add system field %s", field.GetName())
+	}
+	if field.GetIsPrimaryKey() || field.GetIsPartitionKey() || field.GetIsDynamic() || field.GetAutoID() {
+		return merr.WrapErrParameterInvalidMsg("can't add primary key, partition key, dynamic or auto id field %s", field.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return merr.WrapErrParameterInvalidMsg("can't add vector field %s to an existing collection", field.GetName())
+	}
+	// segcore can't read null yet, the rows written before must be filled with the default value
+	if field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the added field %s must have a default value", field.GetName())
+	}
+	return checkDefaultValue(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding collection field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+	for _, field := range oldColl.Fields {
+		if field.Name == t.Req.GetField().GetName() {
+			return merr.WrapErrParameterInvalidMsg("field %s already exists in collection %s", field.Name, oldColl.Name)
+		}
+	}
+
+	field := model.UnmarshalFieldModel(t.Req.GetField())
+	field.FieldID = oldColl.NextFieldID()
+	field.State = schemapb.FieldState_FieldCreated
+
+	newColl := oldColl.Clone()
+	newColl.Fields = append(newColl.Fields, field)
+	newColl.MaxFieldID = field.FieldID
+	newColl.SchemaVersion++
+
+	log.Info("add collection field",
+		zap.String("collectionName", oldColl.Name),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.String("fieldName", field.Name),
+		zap.Int64("fieldID", field.FieldID),
+		zap.Int32("schemaVersion", newColl.SchemaVersion))
+	return executeAlterSchema(ctx, t.core, t.Req.GetDbName(), oldColl, newColl, t.GetTs())
+}
+
+// executeAlterSchema persists the collection with a field added or dropped, and notifies the components about
+// the new schema. The dml channels get the schema, so that the inserts afterwards are aligned to it.
+func executeAlterSchema(ctx context.Context, core *Core, dbName string, oldColl, newColl *model.Collection, ts Timestamp) error {
+	redoTask := newBaseRedoTask(core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: core},
+		dbName:          dbName,
+		collectionNames: []string{oldColl.Name},
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+	})
+
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         dbName,
+			CollectionName: newColl.Name,
+			CollectionID:   newColl.CollectionID,
+			Properties:     newColl.Properties,
+		},
+		core: core,
+	})
+
+	redoTask.AddSyncStep(&broadcastAlteredSchemaStep{
+		baseStep: baseStep{core: core},
+		coll:     newColl,
+	})
+
+	return redoTask.Execute(ctx)
+}
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+
+	t.Run("invalid collection name", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &rootcoordpb.AddCollectionFieldRequest{}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			nil,
+			{Name: RowIDFieldName, DataType: schemapb.DataType_Int64, TypeParams: nullableParams},
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: nullableParams},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: nullableParams},
+			{Name: "not_nullable", DataType: schemapb.DataType_Int64},
+			{Name: "nullable", DataType: schemapb.DataType_VarChar, TypeParams: nullableParams},
+			{Name: "mismatched", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{
+				Data: &schemapb.ValueField_BoolData{BoolData: true},
+			}},
+		}
+		for _, field := range fields {
+			task := &addCollectionFieldTask{Req: &rootcoordpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field}}
+			err := task.Prepare(context.Background())
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			{Name: "defaulted", DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{
+				Data: &schemapb.ValueField_LongData{LongData: 1},
+			}},
+		}
+		for _, field := range fields {
+			task := &addCollectionFieldTask{Req: &rootcoordpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field}}
+			err := task.Prepare(context.Background())
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+	}
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: common.RowIDField, Name: RowIDFieldName},
+				{FieldID: common.TimeStampField, Name: TimeStampFieldName},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+			MaxFieldID: 102,
+		}
+	}
+
+	t.Run("failed to get collection", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("field already exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &rootcoordpb.AddCollectionFieldRequest{
+				CollectionName: "cn",
+				Field:          &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_Int64},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("alter step failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(errors.New("err"))
+
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		var altered *model.Collection
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Run(func(args mock.Arguments) {
+			altered = args.Get(2).(*model.Collection)
+		}).Return(nil)
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		ticker := newTickerWithMockNormalStream()
+		tsoAllocator := newMockTsoAllocator()
+		tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+			return 100, nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker),
+			withTtSynchronizer(ticker), withTsoAllocator(tsoAllocator))
+		core.ddlTsLockManager = newDdlTsLockManager(tsoAllocator)
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+
+		// the id of the dropped field 102 is not reused
+		assert.Equal(t, 5, len(altered.Fields))
+		assert.Equal(t, int64(103), altered.Fields[4].FieldID)
+		assert.Equal(t, "added", altered.Fields[4].Name)
+		assert.Equal(t, int64(103), altered.MaxFieldID)
+		assert.Equal(t, int32(1), altered.SchemaVersion)
+	})
+}
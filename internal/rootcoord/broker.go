@@ -273,10 +273,11 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	dcReq := &datapb.AlterCollectionRequest{
 		CollectionID: req.GetCollectionID(),
 		Schema: &schemapb.CollectionSchema{
-			Name:        colMeta.Name,
-			Description: colMeta.Description,
-			AutoID:      colMeta.AutoID,
-			Fields:      model.MarshalFieldModels(colMeta.Fields),
+			Name:               colMeta.Name,
+			Description:        colMeta.Description,
+			AutoID:             colMeta.AutoID,
+			Fields:             model.MarshalFieldModels(colMeta.Fields),
+			EnableDynamicField: colMeta.EnableDynamicField,
 		},
 		PartitionIDs:   partitionIDs,
 		StartPositions: colMeta.StartPositions,
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// dropCollectionFieldTask drops a field from an existing collection, the binlogs of the field are left
+// in the existing segments and skipped when they are read.
+type dropCollectionFieldTask struct {
+	baseTask
+	Req *rootcoordpb.DropCollectionFieldRequest
+}
+
+func (t *dropCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return fmt.Errorf("drop collection field failed, collection name does not exists")
+	}
+	if t.Req.GetFieldName() == "" {
+		return merr.WrapErrParameterInvalidMsg("the name of dropped field is empty")
+	}
+	return nil
+}
+
+func (t *dropCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during dropping collection field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+	field, ok := lo.Find(oldColl.Fields, func(field *model.Field) bool {
+		return field.Name == t.Req.GetFieldName()
+	})
+	if !ok {
+		return merr.WrapErrFieldNotFound(t.Req.GetFieldName())
+	}
+	if field.FieldID < StartOfUserFieldID || field.IsPrimaryKey || field.IsPartitionKey || field.IsDynamic {
+		return merr.WrapErrParameterInvalidMsg("can't drop system, primary key, partition key or dynamic field %s", field.Name)
+	}
+	if typeutil.IsVectorType(field.DataType) {
+		return merr.WrapErrParameterInvalidMsg("can't drop vector field %s", field.Name)
+	}
+	if err := t.checkFieldNotIndexed(ctx, oldColl.CollectionID, field); err != nil {
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	newColl.Fields = lo.Filter(newColl.Fields, func(f *model.Field, _ int) bool {
+		return f.FieldID != field.FieldID
+	})
+	// the id of the dropped field is never reused
+	newColl.MaxFieldID = oldColl.NextFieldID() - 1
+	newColl.SchemaVersion++
+
+	log.Info("drop collection field",
+		zap.String("collectionName", oldColl.Name),
+		zap.Int64("collectionID", oldColl.CollectionID),
+		zap.String("fieldName", field.Name),
+		zap.Int64("fieldID", field.FieldID),
+		zap.Int32("schemaVersion", newColl.SchemaVersion))
+	return executeAlterSchema(ctx, t.core, t.Req.GetDbName(), oldColl, newColl, t.GetTs())
+}
+
+// checkFieldNotIndexed requires the index of field to be dropped first, the index is built on the binlogs of the field.
+func (t *dropCollectionFieldTask) checkFieldNotIndexed(ctx context.Context, collectionID UniqueID, field *model.Field) error {
+	resp, err := t.core.broker.DescribeIndex(ctx, collectionID)
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if errors.Is(err, merr.ErrIndexNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, ok := lo.Find(resp.GetIndexInfos(), func(info *indexpb.IndexInfo) bool {
+		return info.GetFieldID() == field.FieldID
+	}); ok {
+		return merr.WrapErrParameterInvalidMsg("field %s has index, drop the index before dropping the field", field.Name)
+	}
+	return nil
+}
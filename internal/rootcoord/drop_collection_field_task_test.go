@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_dropCollectionFieldTask_Prepare(t *testing.T) {
+	t.Run("invalid collection name", func(t *testing.T) {
+		task := &dropCollectionFieldTask{Req: &rootcoordpb.DropCollectionFieldRequest{FieldName: "f"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid field name", func(t *testing.T) {
+		task := &dropCollectionFieldTask{Req: &rootcoordpb.DropCollectionFieldRequest{CollectionName: "cn"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &dropCollectionFieldTask{Req: &rootcoordpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "f"}}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_dropCollectionFieldTask_Execute(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: common.RowIDField, Name: RowIDFieldName},
+				{FieldID: common.TimeStampField, Name: TimeStampFieldName},
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+			},
+		}
+	}
+	newMeta := func(t *testing.T) *mockrootcoord.IMetaTable {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		return meta
+	}
+
+	t.Run("invalid field", func(t *testing.T) {
+		for _, fieldName := range []string{"not_exist", "pk", "vec", RowIDFieldName} {
+			core := newTestCore(withMeta(newMeta(t)))
+			task := &dropCollectionFieldTask{
+				baseTask: newBaseTask(context.Background(), core),
+				Req:      &rootcoordpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: fieldName},
+			}
+			err := task.Execute(context.Background())
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("field has index", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{
+				Status:     merr.Success(),
+				IndexInfos: []*indexpb.IndexInfo{{FieldID: 102}},
+			}, nil
+		}
+
+		core := newTestCore(withMeta(newMeta(t)), withBroker(broker))
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "age"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("drop successfully", func(t *testing.T) {
+		var altered *model.Collection
+		meta := newMeta(t)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Run(func(args mock.Arguments) {
+			altered = args.Get(2).(*model.Collection)
+		}).Return(nil)
+
+		broker := newMockBroker()
+		broker.DescribeIndexFunc = func(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
+			return &indexpb.DescribeIndexResponse{
+				Status: merr.Status(merr.WrapErrIndexNotFoundForCollection("cn")),
+			}, nil
+		}
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		ticker := newTickerWithMockNormalStream()
+		tsoAllocator := newMockTsoAllocator()
+		tsoAllocator.GenerateTSOF = func(count uint32) (uint64, error) {
+			return 100, nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker),
+			withTtSynchronizer(ticker), withTsoAllocator(tsoAllocator))
+		core.ddlTsLockManager = newDdlTsLockManager(tsoAllocator)
+		task := &dropCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.DropCollectionFieldRequest{CollectionName: "cn", FieldName: "age"},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+
+		assert.Equal(t, 4, len(altered.Fields))
+		assert.Equal(t, int64(102), altered.MaxFieldID)
+		assert.Equal(t, int32(1), altered.SchemaVersion)
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -94,6 +95,8 @@ type IMetaTable interface {
 	DropGrant(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicy(tenant string) ([]string, error)
 	ListUserRole(tenant string) ([]string, error)
+	OperateRowPolicy(tenant string, policy *internalpb.RowPolicy, operateType rootcoordpb.OperateRowPolicyType) error
+	ListRowPolicy(tenant string) ([]*internalpb.RowPolicy, error)
 }
 
 type MetaTable struct {
@@ -1365,3 +1368,45 @@ func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+// OperateRowPolicy adds or drops the row policy of the role on the collection,
+// adding the policy with an existed name replaces its expr.
+func (mt *MetaTable) OperateRowPolicy(tenant string, policy *internalpb.RowPolicy, operateType rootcoordpb.OperateRowPolicyType) error {
+	if policy == nil {
+		return fmt.Errorf("the row policy is nil")
+	}
+	if funcutil.IsEmptyString(policy.GetRole()) {
+		return fmt.Errorf("the role of the row policy is empty")
+	}
+	if funcutil.IsEmptyString(policy.GetCollectionName()) {
+		return fmt.Errorf("the collection name of the row policy is empty")
+	}
+	if funcutil.IsEmptyString(policy.GetPolicyName()) {
+		return fmt.Errorf("the name of the row policy is empty")
+	}
+	if policy.GetDbName() == "" {
+		policy.DbName = util.DefaultDBName
+	}
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	switch operateType {
+	case rootcoordpb.OperateRowPolicyType_AddRowPolicy:
+		if funcutil.IsEmptyString(policy.GetExpr()) {
+			return fmt.Errorf("the expr of the row policy is empty")
+		}
+		return mt.catalog.SaveRowPolicy(mt.ctx, tenant, policy)
+	case rootcoordpb.OperateRowPolicyType_DropRowPolicy:
+		return mt.catalog.DropRowPolicy(mt.ctx, tenant, policy)
+	default:
+		return fmt.Errorf("the operate type of the row policy is invalid")
+	}
+}
+
+func (mt *MetaTable) ListRowPolicy(tenant string) ([]*internalpb.RowPolicy, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.ListRowPolicy(mt.ctx, tenant)
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	mocktso "github.com/milvus-io/milvus/internal/tso/mocks"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -447,6 +448,47 @@ func TestRbacListPolicy(t *testing.T) {
 	assert.Equal(t, 0, len(userRoles))
 }
 
+func TestRbacOperateRowPolicy(t *testing.T) {
+	mt := generateMetaTable(t)
+
+	tests := []struct {
+		description string
+
+		isValid     bool
+		policy      *internalpb.RowPolicy
+		operateType rootcoordpb.OperateRowPolicyType
+	}{
+		{"nil policy", false, nil, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"empty role", false, &internalpb.RowPolicy{CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 1"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"empty collection", false, &internalpb.RowPolicy{Role: "role", PolicyName: "tenant", Expr: "tenant_id == 1"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"empty policy name", false, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", Expr: "tenant_id == 1"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"empty expr", false, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", PolicyName: "tenant"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"invalid operate type", false, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 1"}, rootcoordpb.OperateRowPolicyType(100)},
+		{"valid add", true, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 1"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy},
+		{"valid drop", true, &internalpb.RowPolicy{Role: "role", DbName: util.DefaultDBName, CollectionName: "coll", PolicyName: "tenant"}, rootcoordpb.OperateRowPolicyType_DropRowPolicy},
+		{"drop not existed", false, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", PolicyName: "tenant"}, rootcoordpb.OperateRowPolicyType_DropRowPolicy},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := mt.OperateRowPolicy(util.DefaultTenant, test.policy, test.operateType)
+			if test.isValid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	err := mt.OperateRowPolicy(util.DefaultTenant, &internalpb.RowPolicy{Role: "role", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 2"}, rootcoordpb.OperateRowPolicyType_AddRowPolicy)
+	assert.NoError(t, err)
+	policies, err := mt.ListRowPolicy(util.DefaultTenant)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(policies))
+	assert.Equal(t, util.DefaultDBName, policies[0].GetDbName())
+	assert.Equal(t, "tenant_id == 2", policies[0].GetExpr())
+}
+
 func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
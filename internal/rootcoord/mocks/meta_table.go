@@ -13,6 +13,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/milvus-io/milvus/internal/metastore/model"
+
+	rootcoordpb "github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 )
 
 // IMetaTable is an autogenerated mock type for the IMetaTable type
@@ -1556,6 +1558,60 @@ func (_c *IMetaTable_ListPolicy_Call) RunAndReturn(run func(string) ([]string, e
 	return _c
 }
 
+// ListRowPolicy provides a mock function with given fields: tenant
+func (_m *IMetaTable) ListRowPolicy(tenant string) ([]*internalpb.RowPolicy, error) {
+	ret := _m.Called(tenant)
+
+	var r0 []*internalpb.RowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*internalpb.RowPolicy, error)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*internalpb.RowPolicy); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicy'
+type IMetaTable_ListRowPolicy_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicy is a helper method to define mock.On call
+//   - tenant string
+func (_e *IMetaTable_Expecter) ListRowPolicy(tenant interface{}) *IMetaTable_ListRowPolicy_Call {
+	return &IMetaTable_ListRowPolicy_Call{Call: _e.mock.On("ListRowPolicy", tenant)}
+}
+
+func (_c *IMetaTable_ListRowPolicy_Call) Run(run func(tenant string)) *IMetaTable_ListRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicy_Call) Return(_a0 []*internalpb.RowPolicy, _a1 error) *IMetaTable_ListRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicy_Call) RunAndReturn(run func(string) ([]*internalpb.RowPolicy, error)) *IMetaTable_ListRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -1654,6 +1710,50 @@ func (_c *IMetaTable_OperatePrivilege_Call) RunAndReturn(run func(string, *milvu
 	return _c
 }
 
+// OperateRowPolicy provides a mock function with given fields: tenant, policy, operateType
+func (_m *IMetaTable) OperateRowPolicy(tenant string, policy *internalpb.RowPolicy, operateType rootcoordpb.OperateRowPolicyType) error {
+	ret := _m.Called(tenant, policy, operateType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *internalpb.RowPolicy, rootcoordpb.OperateRowPolicyType) error); ok {
+		r0 = rf(tenant, policy, operateType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_OperateRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateRowPolicy'
+type IMetaTable_OperateRowPolicy_Call struct {
+	*mock.Call
+}
+
+// OperateRowPolicy is a helper method to define mock.On call
+//   - tenant string
+//   - policy *internalpb.RowPolicy
+//   - operateType rootcoordpb.OperateRowPolicyType
+func (_e *IMetaTable_Expecter) OperateRowPolicy(tenant interface{}, policy interface{}, operateType interface{}) *IMetaTable_OperateRowPolicy_Call {
+	return &IMetaTable_OperateRowPolicy_Call{Call: _e.mock.On("OperateRowPolicy", tenant, policy, operateType)}
+}
+
+func (_c *IMetaTable_OperateRowPolicy_Call) Run(run func(tenant string, policy *internalpb.RowPolicy, operateType rootcoordpb.OperateRowPolicyType)) *IMetaTable_OperateRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*internalpb.RowPolicy), args[2].(rootcoordpb.OperateRowPolicyType))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_OperateRowPolicy_Call) Return(_a0 error) *IMetaTable_OperateRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_OperateRowPolicy_Call) RunAndReturn(run func(string, *internalpb.RowPolicy, rootcoordpb.OperateRowPolicyType) error) *IMetaTable_OperateRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OperateUserRole provides a mock function with given fields: tenant, userEntity, roleEntity, operateType
 func (_m *IMetaTable) OperateUserRole(tenant string, userEntity *milvuspb.UserEntity, roleEntity *milvuspb.RoleEntity, operateType milvuspb.OperateUserRoleType) error {
 	ret := _m.Called(tenant, userEntity, roleEntity, operateType)
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -106,26 +107,42 @@ type QuotaCenter struct {
 	quotaStates  map[int64]collectionStates
 	tsoAllocator tso.Allocator
 
+	// database level rates, they are tracked only if any limit of the level is configured
+	databaseRates map[string]collectionRates
+	// user and role level rates, which are applied to every user and role by proxies,
+	// nil if no limit of the level is configured
+	userRates collectionRates
+	roleRates collectionRates
+	// collectionDatabases maps collections to their database names
+	collectionDatabases map[int64]string
+
 	rateAllocateStrategy RateAllocateStrategy
 
+	ctx      context.Context
+	cancel   context.CancelFunc
 	stopOnce sync.Once
 	stopChan chan struct{}
 }
 
 // NewQuotaCenter returns a new QuotaCenter.
 func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoordClient, dataCoord types.DataCoordClient, tsoAllocator tso.Allocator, meta IMetaTable) *QuotaCenter {
+	ctx, cancel := context.WithCancel(context.Background())
 	return &QuotaCenter{
 		proxies:             proxies,
 		queryCoord:          queryCoord,
 		dataCoord:           dataCoord,
 		currentRates:        make(map[int64]map[internalpb.RateType]Limit),
 		quotaStates:         make(map[int64]map[milvuspb.QuotaState]commonpb.ErrorCode),
+		databaseRates:       make(map[string]collectionRates),
+		collectionDatabases: make(map[int64]string),
 		tsoAllocator:        tsoAllocator,
 		meta:                meta,
 		readableCollections: make([]int64, 0),
 		writableCollections: make([]int64, 0),
 
 		rateAllocateStrategy: DefaultRateAllocateStrategy,
+		ctx:                  ctx,
+		cancel:               cancel,
 		stopChan:             make(chan struct{}),
 	}
 }
@@ -164,6 +181,7 @@ func (q *QuotaCenter) run() {
 // stop would stop the service of QuotaCenter.
 func (q *QuotaCenter) stop() {
 	q.stopOnce.Do(func() {
+		q.cancel()
 		q.stopChan <- struct{}{}
 	})
 }
@@ -419,6 +437,35 @@ func (q *QuotaCenter) calculateReadRates() {
 	realTimeSearchRate := q.getRealTimeRate(internalpb.RateType_DQLSearch)
 	realTimeQueryRate := q.getRealTimeRate(internalpb.RateType_DQLQuery)
 	coolOff(realTimeSearchRate, realTimeQueryRate, limitCollectionSet.Collect()...)
+
+	// databases of limited collections, all users and roles are cooled off as well
+	if limitCollectionSet.Len() == 0 {
+		return
+	}
+	coolOffRates := func(rates collectionRates) {
+		if rates[internalpb.RateType_DQLSearch] != Inf && realTimeSearchRate > 0 {
+			rates[internalpb.RateType_DQLSearch] = Limit(realTimeSearchRate * coolOffSpeed)
+		}
+		if rates[internalpb.RateType_DQLQuery] != Inf && realTimeQueryRate > 0 {
+			rates[internalpb.RateType_DQLQuery] = Limit(realTimeQueryRate * coolOffSpeed)
+		}
+	}
+	limitDatabaseSet := typeutil.NewSet[string]()
+	for _, collection := range limitCollectionSet.Collect() {
+		if database, ok := q.collectionDatabases[collection]; ok {
+			limitDatabaseSet.Insert(database)
+		}
+	}
+	for database := range limitDatabaseSet {
+		if rates, ok := q.databaseRates[database]; ok {
+			coolOffRates(rates)
+		}
+	}
+	for _, rates := range []collectionRates{q.userRates, q.roleRates} {
+		if rates != nil {
+			coolOffRates(rates)
+		}
+	}
 }
 
 // calculateWriteRates calculates and sets dml rates.
@@ -484,9 +531,49 @@ func (q *QuotaCenter) calculateWriteRates() error {
 			zap.Float64("factor", factor))
 	}
 
+	q.coolOffDatabaseAndUserWriteRates(collectionFactors)
 	return nil
 }
 
+// coolOffDatabaseAndUserWriteRates cools dml rates of databases off by the min factor of their collections,
+// and dml rates of users and roles off by the min factor of all collections. Denied collections are skipped, they
+// have been denied to write at collection level.
+func (q *QuotaCenter) coolOffDatabaseAndUserWriteRates(collectionFactors map[int64]float64) {
+	coolOff := func(rates collectionRates, factor float64) {
+		for _, rt := range []internalpb.RateType{internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete} {
+			if rates[rt] != Inf {
+				rates[rt] *= Limit(factor)
+			}
+		}
+	}
+
+	minFactor := float64(1)
+	databaseFactors := make(map[string]float64)
+	for collection, factor := range collectionFactors {
+		if factor <= 0 {
+			continue
+		}
+		minFactor = math.Min(minFactor, factor)
+		database, ok := q.collectionDatabases[collection]
+		if !ok {
+			continue
+		}
+		if f, ok := databaseFactors[database]; !ok || factor < f {
+			databaseFactors[database] = factor
+		}
+	}
+	for database, factor := range databaseFactors {
+		if rates, ok := q.databaseRates[database]; ok {
+			coolOff(rates, factor)
+		}
+	}
+	for _, rates := range []collectionRates{q.userRates, q.roleRates} {
+		if rates != nil {
+			coolOff(rates, minFactor)
+		}
+	}
+}
+
 func (q *QuotaCenter) getTimeTickDelayFactor(ts Timestamp) map[int64]float64 {
 	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
 	if !Params.QuotaConfig.TtProtectionEnabled.GetAsBool() {
@@ -706,6 +793,93 @@ func (q *QuotaCenter) resetAllCurrentRates() {
 		q.resetCurrentRate(internalpb.RateType_DQLSearch, collection)
 		q.resetCurrentRate(internalpb.RateType_DQLQuery, collection)
 	}
+	q.resetDatabaseAndUserRates()
+}
+
+// databaseRateParams returns the max rate configs of database level.
+func databaseRateParams() map[internalpb.RateType]*paramtable.ParamItem {
+	quotaConfig := &Params.QuotaConfig
+	return map[internalpb.RateType]*paramtable.ParamItem{
+		internalpb.RateType_DMLInsert:   &quotaConfig.DMLMaxInsertRatePerDB,
+		internalpb.RateType_DMLUpsert:   &quotaConfig.DMLMaxUpsertRatePerDB,
+		internalpb.RateType_DMLDelete:   &quotaConfig.DMLMaxDeleteRatePerDB,
+		internalpb.RateType_DMLBulkLoad: &quotaConfig.DMLMaxBulkLoadRatePerDB,
+		internalpb.RateType_DQLSearch:   &quotaConfig.DQLMaxSearchRatePerDB,
+		internalpb.RateType_DQLQuery:    &quotaConfig.DQLMaxQueryRatePerDB,
+	}
+}
+
+// userRateParams returns the max rate configs of user level.
+func userRateParams() map[internalpb.RateType]*paramtable.ParamItem {
+	quotaConfig := &Params.QuotaConfig
+	return map[internalpb.RateType]*paramtable.ParamItem{
+		internalpb.RateType_DMLInsert:   &quotaConfig.DMLMaxInsertRatePerUser,
+		internalpb.RateType_DMLUpsert:   &quotaConfig.DMLMaxUpsertRatePerUser,
+		internalpb.RateType_DMLDelete:   &quotaConfig.DMLMaxDeleteRatePerUser,
+		internalpb.RateType_DMLBulkLoad: &quotaConfig.DMLMaxBulkLoadRatePerUser,
+		internalpb.RateType_DQLSearch:   &quotaConfig.DQLMaxSearchRatePerUser,
+		internalpb.RateType_DQLQuery:    &quotaConfig.DQLMaxQueryRatePerUser,
+	}
+}
+
+// roleRateParams returns the max rate configs of role level.
+func roleRateParams() map[internalpb.RateType]*paramtable.ParamItem {
+	quotaConfig := &Params.QuotaConfig
+	return map[internalpb.RateType]*paramtable.ParamItem{
+		internalpb.RateType_DMLInsert:   &quotaConfig.DMLMaxInsertRatePerRole,
+		internalpb.RateType_DMLUpsert:   &quotaConfig.DMLMaxUpsertRatePerRole,
+		internalpb.RateType_DMLDelete:   &quotaConfig.DMLMaxDeleteRatePerRole,
+		internalpb.RateType_DMLBulkLoad: &quotaConfig.DMLMaxBulkLoadRatePerRole,
+		internalpb.RateType_DQLSearch:   &quotaConfig.DQLMaxSearchRatePerRole,
+		internalpb.RateType_DQLQuery:    &quotaConfig.DQLMaxQueryRatePerRole,
+	}
+}
+
+// configuredRates returns the configured rates of params, and whether any of them is limited.
+func configuredRates(params map[internalpb.RateType]*paramtable.ParamItem) (collectionRates, bool) {
+	rates := make(collectionRates, len(params))
+	limited := false
+	for rt, param := range params {
+		rates[rt] = Limit(param.GetAsFloat())
+		if rates[rt] < 0 {
+			rates[rt] = Inf // no limit
+		}
+		limited = limited || rates[rt] != Inf
+	}
+	return rates, limited
+}
+
+// resetDatabaseAndUserRates resets rates of all databases, users and roles to configured rates, databases
+// are not tracked if no limit of their level is configured. Users and roles aren't listed, since users
+// authenticated by JWT or client certificate are unknown to rootcoord, the rates of their level are
+// applied to every user and role by proxies.
+func (q *QuotaCenter) resetDatabaseAndUserRates() {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	q.databaseRates = make(map[string]collectionRates)
+	q.userRates, q.roleRates = nil, nil
+	q.collectionDatabases = make(map[int64]string)
+
+	if _, limited := configuredRates(databaseRateParams()); limited {
+		dbs, err := q.meta.ListDatabases(q.ctx, typeutil.MaxTimestamp)
+		if err != nil {
+			log.RatedWarn(10, "failed to list databases for database rate limits", zap.Error(err))
+		}
+		// collections of databases are listed from meta cache at once
+		dbCollections := q.meta.ListAllAvailCollections(q.ctx)
+		for _, db := range dbs {
+			q.databaseRates[db.Name], _ = configuredRates(databaseRateParams())
+			for _, collection := range dbCollections[db.ID] {
+				q.collectionDatabases[collection] = db.Name
+			}
+		}
+	}
+
+	if rates, limited := configuredRates(userRateParams()); limited {
+		q.userRates = rates
+	}
+	if rates, limited := configuredRates(roleRateParams()); limited {
+		q.roleRates = rates
+	}
 }
 
 // resetCurrentRates resets all current rates to configured rates.
@@ -831,6 +1005,25 @@ func (q *QuotaCenter) setRates() error {
 	for collection, rates := range q.currentRates {
 		collectionRates = append(collectionRates, toCollectionRate(collection, rates))
 	}
+	for database, rates := range q.databaseRates {
+		if rate := toCollectionRate(0, rates); rate != nil {
+			rate.Scope = proxypb.RateScope_Database
+			rate.Database = database
+			collectionRates = append(collectionRates, rate)
+		}
+	}
+	if q.userRates != nil {
+		if rate := toCollectionRate(0, q.userRates); rate != nil {
+			rate.Scope = proxypb.RateScope_User
+			collectionRates = append(collectionRates, rate)
+		}
+	}
+	if q.roleRates != nil {
+		if rate := toCollectionRate(0, q.roleRates); rate != nil {
+			rate.Scope = proxypb.RateScope_Role
+			collectionRates = append(collectionRates, rate)
+		}
+	}
 	timestamp := tsoutil.ComposeTSByTime(time.Now(), 0)
 	req := &proxypb.SetRatesRequest{
 		Base: commonpbutil.NewMsgBase(
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -587,6 +589,50 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, minRate, quotaCenter.currentRates[collectionID][internalpb.RateType_DQLSearch])
 	})
 
+	t.Run("test database, user and role rates", func(t *testing.T) {
+		paramtable.Get().Save(Params.QuotaConfig.DMLLimitEnabled.Key, "true")
+		paramtable.Get().Save(Params.QuotaConfig.DMLMaxInsertRatePerDB.Key, "10")
+		paramtable.Get().Save(Params.QuotaConfig.DMLMaxInsertRatePerUser.Key, "5")
+		paramtable.Get().Save(Params.QuotaConfig.DMLMaxInsertRatePerRole.Key, "8")
+		defer func() {
+			paramtable.Get().Reset(Params.QuotaConfig.DMLLimitEnabled.Key)
+			paramtable.Get().Reset(Params.QuotaConfig.DMLMaxInsertRatePerDB.Key)
+			paramtable.Get().Reset(Params.QuotaConfig.DMLMaxInsertRatePerUser.Key)
+			paramtable.Get().Reset(Params.QuotaConfig.DMLMaxInsertRatePerRole.Key)
+		}()
+
+		qc := mocks.NewMockQueryCoordClient(t)
+		p1 := mocks.NewMockProxyClient(t)
+		p1.EXPECT().SetRates(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			scopes := lo.Map(req.GetRates(), func(rate *proxypb.CollectionRate, _ int) proxypb.RateScope { return rate.GetScope() })
+			assert.ElementsMatch(t, []proxypb.RateScope{proxypb.RateScope_Collection, proxypb.RateScope_Database, proxypb.RateScope_User, proxypb.RateScope_Role}, scopes)
+			return merr.Success(), nil
+		})
+		pcm := &proxyClientManager{proxyClient: map[int64]types.ProxyClient{
+			TestProxyID: p1,
+		}}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{ID: 1, Name: "db1"}}, nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {1}})
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{DBID: 1}, nil).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, qc, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.writableCollections = []int64{1}
+		quotaCenter.resetAllCurrentRates()
+		assert.Equal(t, "db1", quotaCenter.collectionDatabases[1])
+		assert.Equal(t, Limit(10*1024*1024), quotaCenter.databaseRates["db1"][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Inf, quotaCenter.databaseRates["db1"][internalpb.RateType_DQLSearch])
+		assert.Equal(t, Limit(5*1024*1024), quotaCenter.userRates[internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(8*1024*1024), quotaCenter.roleRates[internalpb.RateType_DMLInsert])
+
+		quotaCenter.coolOffDatabaseAndUserWriteRates(map[int64]float64{1: 0.5, 2: 0})
+		assert.Equal(t, Limit(5*1024*1024), quotaCenter.databaseRates["db1"][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(2.5*1024*1024), quotaCenter.userRates[internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(4*1024*1024), quotaCenter.roleRates[internalpb.RateType_DMLInsert])
+
+		err := quotaCenter.setRates()
+		assert.NoError(t, err)
+	})
+
 	t.Run("test diskAllowance", func(t *testing.T) {
 		tests := []struct {
 			name            string
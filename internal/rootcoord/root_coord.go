@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
@@ -2305,6 +2306,17 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		ctxLog.Warn(errMsg, zap.Error(err))
 		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
 	}
+	rowPolicies, err := c.meta.ListRowPolicy(util.DefaultTenant)
+	if err != nil {
+		errMsg := "fail to list the row policies"
+		ctxLog.Warn(errMsg, zap.Error(err))
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
+	if lo.ContainsBy(rowPolicies, func(policy *internalpb.RowPolicy) bool { return policy.GetRole() == in.RoleName }) {
+		errMsg := "fail to drop the role that it has row policies. Drop its row policies first"
+		ctxLog.Warn(errMsg)
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
 	redoTask := newBaseRedoTask(c.stepExecutor)
 	redoTask.AddSyncStep(NewSimpleStep("drop role meta data", func(ctx context.Context) ([]nestedStep, error) {
 		err := c.meta.DropRole(util.DefaultTenant, in.RoleName)
@@ -2522,8 +2534,8 @@ func (c *Core) isValidObject(entity *milvuspb.ObjectEntity) error {
 	if entity == nil {
 		return errors.New("the object entity is nil")
 	}
-	if _, ok := commonpb.ObjectType_value[entity.Name]; !ok {
-		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", entity.Name, lo.Keys(commonpb.ObjectType_value))
+	if _, ok := commonpb.ObjectType_value[entity.Name]; !ok && entity.Name != util.ObjectTypeField {
+		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", entity.Name, lo.Keys(util.ObjectPrivileges))
 	}
 	return nil
 }
@@ -2553,7 +2565,7 @@ func (c *Core) isValidGrantor(entity *milvuspb.GrantorEntity, object string) err
 	}
 	privileges, ok := util.ObjectPrivileges[object]
 	if !ok {
-		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, lo.Keys(commonpb.ObjectType_value))
+		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, lo.Keys(util.ObjectPrivileges))
 	}
 	for _, privilege := range privileges {
 		if privilege == entity.Privilege.Name {
@@ -2747,6 +2759,15 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 		}, nil
 	}
 
+	rowPolicies, err := c.meta.ListRowPolicy(util.DefaultTenant)
+	if err != nil {
+		errMsg := "fail to list row policy"
+		ctxLog.Warn(errMsg, zap.Any("in", in), zap.Error(err))
+		return &internalpb.ListPolicyResponse{
+			Status: merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_ListPolicyFailure),
+		}, nil
+	}
+
 	ctxLog.Debug(method + " success")
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
 	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2754,9 +2775,86 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 		Status:      merr.Success(),
 		PolicyInfos: policies,
 		UserRoles:   userRoles,
+		RowPolicies: rowPolicies,
 	}, nil
 }
 
+// OperateRowPolicy adds or drops the row policy of a role
+// - check the node health
+// - check if the operate type and the role are valid
+// - operate the row policy by the meta api
+// - refresh the policy info cache of proxies
+func (c *Core) OperateRowPolicy(ctx context.Context, in *rootcoordpb.OperateRowPolicyRequest) (*commonpb.Status, error) {
+	method := "OperateRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.Any("in", in))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if in.Type != rootcoordpb.OperateRowPolicyType_AddRowPolicy && in.Type != rootcoordpb.OperateRowPolicyType_DropRowPolicy {
+		errMsg := fmt.Sprintf("invalid operate row policy type, current type: %s, valid value: [%s, %s]", in.Type, rootcoordpb.OperateRowPolicyType_AddRowPolicy, rootcoordpb.OperateRowPolicyType_DropRowPolicy)
+		ctxLog.Warn(errMsg)
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_OperatePrivilegeFailure), nil
+	}
+	if in.Policy == nil {
+		errMsg := "the row policy in the request is nil"
+		ctxLog.Warn(errMsg)
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_OperatePrivilegeFailure), nil
+	}
+	if err := c.isValidRole(&milvuspb.RoleEntity{Name: in.Policy.Role}); err != nil {
+		ctxLog.Warn("", zap.Error(err))
+		return merr.StatusWithErrorCode(err, commonpb.ErrorCode_OperatePrivilegeFailure), nil
+	}
+	if in.Policy.DbName == "" {
+		in.Policy.DbName = util.DefaultDBName
+	}
+
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("operate row policy meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.OperateRowPolicy(util.DefaultTenant, in.Policy, in.Type)
+		if err != nil && !common.IsIgnorableError(err) {
+			log.Warn("fail to operate the row policy", zap.Any("in", in), zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("operate row policy cache", func(ctx context.Context) ([]nestedStep, error) {
+		opType := int32(typeutil.CacheAddRowPolicy)
+		if in.Type == rootcoordpb.OperateRowPolicyType_DropRowPolicy {
+			opType = int32(typeutil.CacheDropRowPolicy)
+		}
+		opKey, err := json.Marshal(in.Policy)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+			OpType: opType,
+			OpKey:  string(opKey),
+		}); err != nil {
+			log.Warn("fail to refresh policy info cache", zap.Any("in", in), zap.Error(err))
+			return nil, err
+		}
+		return nil, nil
+	}))
+
+	err := redoTask.Execute(ctx)
+	if err != nil {
+		errMsg := "fail to execute task when operating the row policy"
+		log.Warn(errMsg, zap.Error(err))
+		return merr.StatusWithErrorCode(err, commonpb.ErrorCode_OperatePrivilegeFailure), nil
+	}
+
+	ctxLog.Info(method+" success", zap.String("type", in.Type.String()), zap.String("role_name", in.Policy.Role),
+		zap.String("db", in.Policy.DbName), zap.String("collection", in.Policy.CollectionName),
+		zap.String("policy", in.Policy.PolicyName), zap.String("expr", in.Policy.Expr))
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
 func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -2791,6 +2889,76 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	return merr.Success(), nil
 }
 
+// AddCollectionField adds a defaulted field to an existing collection
+func (c *Core) AddCollectionField(ctx context.Context, req *rootcoordpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("collectionName", req.GetCollectionName()), zap.String("fieldName", req.GetField().GetName()))
+	log.Info("received request to add collection field")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+	t := &addCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection field", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to add collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// DropCollectionField drops a scalar field from an existing collection
+func (c *Core) DropCollectionField(ctx context.Context, req *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("collectionName", req.GetCollectionName()), zap.String("fieldName", req.GetFieldName()))
+	log.Info("received request to drop collection field")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropCollectionField")
+	t := &dropCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop collection field", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to drop collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
 func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -18,6 +18,7 @@ package rootcoord
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"os"
@@ -43,11 +44,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -890,6 +893,86 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AddCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AddCollectionField(ctx, &rootcoordpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &rootcoordpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &rootcoordpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &rootcoordpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DropCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropCollectionField(ctx, &rootcoordpb.DropCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_ShowConfigurations(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
@@ -2068,6 +2151,69 @@ func TestRootCoord_RBACError(t *testing.T) {
 	})
 }
 
+func TestCore_OperateRowPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := &internalpb.RowPolicy{Role: "tenant1", CollectionName: "coll", PolicyName: "tenant", Expr: "tenant_id == 1"}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, errors.New("mock error"))
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{Policy: policy, Type: rootcoordpb.OperateRowPolicyType(100)})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		resp, err = c.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		// the role isn't existed
+		resp, err = c.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, nil)
+		meta.EXPECT().OperateRowPolicy(util.DefaultTenant, mock.Anything, rootcoordpb.OperateRowPolicyType_AddRowPolicy).Return(nil)
+		var refreshed *proxypb.RefreshPolicyInfoCacheRequest
+		p := newMockProxy()
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			refreshed = request
+			return merr.Success(), nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.proxyClientManager = &proxyClientManager{proxyClient: map[UniqueID]types.ProxyClient{TestProxyID: p}}
+		resp, err := c.OperateRowPolicy(ctx, &rootcoordpb.OperateRowPolicyRequest{Policy: policy, Type: rootcoordpb.OperateRowPolicyType_AddRowPolicy})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, int32(typeutil.CacheAddRowPolicy), refreshed.GetOpType())
+		cached := &internalpb.RowPolicy{}
+		assert.NoError(t, json.Unmarshal([]byte(refreshed.GetOpKey()), cached))
+		assert.Equal(t, util.DefaultDBName, cached.GetDbName())
+		assert.Equal(t, policy.GetExpr(), cached.GetExpr())
+	})
+
+	t.Run("drop role with row policies", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, nil)
+		meta.EXPECT().SelectGrant(util.DefaultTenant, mock.Anything).Return(nil, nil)
+		meta.EXPECT().ListRowPolicy(util.DefaultTenant).Return([]*internalpb.RowPolicy{policy}, nil)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: policy.GetRole()})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestCore_Stop(t *testing.T) {
 	t.Run("abnormal stop before component is ready", func(t *testing.T) {
 		c := &Core{}
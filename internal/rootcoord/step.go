@@ -21,9 +21,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	ms "github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 )
 
 type stepPriority int
@@ -442,6 +449,71 @@ func (b *BroadcastAlteredCollectionStep) Desc() string {
 	return fmt.Sprintf("broadcast altered collection, collectionID: %d", b.req.CollectionID)
 }
 
+// broadcastAlteredSchemaStep sends the schema after a field is added or dropped to the dml channels of collection,
+// so that datanodes and querynodes apply it to the inserts consumed afterwards without reloading the collection.
+type broadcastAlteredSchemaStep struct {
+	baseStep
+	coll *model.Collection
+}
+
+func (s *broadcastAlteredSchemaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	schema := &schemapb.CollectionSchema{
+		Name:               s.coll.Name,
+		Description:        s.coll.Description,
+		AutoID:             s.coll.AutoID,
+		Fields:             model.MarshalFieldModels(s.coll.Fields),
+		EnableDynamicField: s.coll.EnableDynamicField,
+	}
+	marshaledSchema, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// the schema msg must be ordered with the time ticks of dml channels
+	s.core.ddlTsLockManager.Lock()
+	s.core.ddlTsLockManager.AddRefCnt(1)
+	defer s.core.ddlTsLockManager.AddRefCnt(-1)
+	defer s.core.ddlTsLockManager.Unlock()
+
+	ts, err := s.core.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		return nil, err
+	}
+	msgPack := ms.MsgPack{}
+	msg := &ms.AlterCollectionMsg{
+		BaseMsg: ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     []uint32{0},
+		},
+		CreateCollectionRequest: msgpb.CreateCollectionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection),
+				commonpbutil.WithTimeStamp(ts),
+				commonpbutil.WithSourceID(s.core.session.ServerID),
+			),
+			CollectionName:       s.coll.Name,
+			DbID:                 s.coll.DBID,
+			CollectionID:         s.coll.CollectionID,
+			Schema:               marshaledSchema,
+			VirtualChannelNames:  s.coll.VirtualChannelNames,
+			PhysicalChannelNames: s.coll.PhysicalChannelNames,
+		},
+	}
+	msgPack.Msgs = append(msgPack.Msgs, msg)
+	if err := s.core.chanTimeTick.broadcastDmlChannels(s.coll.PhysicalChannelNames, &msgPack); err != nil {
+		return nil, err
+	}
+	s.core.ddlTsLockManager.UpdateLastTs(ts)
+	return nil, nil
+}
+
+func (s *broadcastAlteredSchemaStep) Desc() string {
+	return fmt.Sprintf("broadcast altered schema, collectionID: %d, schemaVersion: %d",
+		s.coll.CollectionID, s.coll.SchemaVersion)
+}
+
 var (
 	confirmGCInterval          = time.Minute * 20
 	allPartition      UniqueID = -1
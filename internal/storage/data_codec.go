@@ -246,6 +246,12 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BinaryVectorFieldData).Dim)
 			case schemapb.DataType_Float16Vector:
 				eventWriter, err = writer.NextInsertEventWriter(singleData.(*Float16VectorFieldData).Dim)
+			case typeutil.BFloat16Vector:
+				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BFloat16VectorFieldData).Dim)
+			case typeutil.Int8Vector:
+				eventWriter, err = writer.NextInsertEventWriter(singleData.(*Int8VectorFieldData).Dim)
+			case typeutil.SparseFloatVector:
+				eventWriter, err = writer.NextInsertEventWriter(singleData.(*SparseFloatVectorFieldData).Dim)
 			default:
 				return nil, fmt.Errorf("undefined data type %d", field.DataType)
 			}
@@ -258,119 +264,154 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 
 		eventWriter.SetEventTimestamp(startTs, endTs)
-		switch field.DataType {
-		case schemapb.DataType_Bool:
-			err = eventWriter.AddBoolToPayload(singleData.(*BoolFieldData).Data)
+		if nullableData, ok := singleData.(NullableFieldData); ok && nullableData.GetValidData() != nil {
+			// the field has null rows, write it with the validity of rows
+			err = eventWriter.AddNullableDataToPayload(nullableData.GetDataRows(), nullableData.GetValidData())
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
 				return nil, err
 			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BoolFieldData).GetMemorySize()))
-		case schemapb.DataType_Int8:
-			err = eventWriter.AddInt8ToPayload(singleData.(*Int8FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8FieldData).GetMemorySize()))
-		case schemapb.DataType_Int16:
-			err = eventWriter.AddInt16ToPayload(singleData.(*Int16FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int16FieldData).GetMemorySize()))
-		case schemapb.DataType_Int32:
-			err = eventWriter.AddInt32ToPayload(singleData.(*Int32FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int32FieldData).GetMemorySize()))
-		case schemapb.DataType_Int64:
-			err = eventWriter.AddInt64ToPayload(singleData.(*Int64FieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int64FieldData).GetMemorySize()))
-		case schemapb.DataType_Float:
-			err = eventWriter.AddFloatToPayload(singleData.(*FloatFieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatFieldData).GetMemorySize()))
-		case schemapb.DataType_Double:
-			err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
-		case schemapb.DataType_String, schemapb.DataType_VarChar:
-			for _, singleString := range singleData.(*StringFieldData).Data {
-				err = eventWriter.AddOneStringToPayload(singleString)
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.GetMemorySize()))
+		} else {
+			switch field.DataType {
+			case schemapb.DataType_Bool:
+				err = eventWriter.AddBoolToPayload(singleData.(*BoolFieldData).Data)
 				if err != nil {
 					eventWriter.Close()
 					writer.Close()
 					return nil, err
 				}
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
-		case schemapb.DataType_Array:
-			for _, singleArray := range singleData.(*ArrayFieldData).Data {
-				err = eventWriter.AddOneArrayToPayload(singleArray)
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BoolFieldData).GetMemorySize()))
+			case schemapb.DataType_Int8:
+				err = eventWriter.AddInt8ToPayload(singleData.(*Int8FieldData).Data)
 				if err != nil {
 					eventWriter.Close()
 					writer.Close()
 					return nil, err
 				}
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
-		case schemapb.DataType_JSON:
-			for _, singleJSON := range singleData.(*JSONFieldData).Data {
-				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8FieldData).GetMemorySize()))
+			case schemapb.DataType_Int16:
+				err = eventWriter.AddInt16ToPayload(singleData.(*Int16FieldData).Data)
 				if err != nil {
 					eventWriter.Close()
 					writer.Close()
 					return nil, err
 				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int16FieldData).GetMemorySize()))
+			case schemapb.DataType_Int32:
+				err = eventWriter.AddInt32ToPayload(singleData.(*Int32FieldData).Data)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int32FieldData).GetMemorySize()))
+			case schemapb.DataType_Int64:
+				err = eventWriter.AddInt64ToPayload(singleData.(*Int64FieldData).Data)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int64FieldData).GetMemorySize()))
+			case schemapb.DataType_Float:
+				err = eventWriter.AddFloatToPayload(singleData.(*FloatFieldData).Data)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatFieldData).GetMemorySize()))
+			case schemapb.DataType_Double:
+				err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
+			case schemapb.DataType_String, schemapb.DataType_VarChar:
+				for _, singleString := range singleData.(*StringFieldData).Data {
+					err = eventWriter.AddOneStringToPayload(singleString)
+					if err != nil {
+						eventWriter.Close()
+						writer.Close()
+						return nil, err
+					}
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+			case schemapb.DataType_Array:
+				for _, singleArray := range singleData.(*ArrayFieldData).Data {
+					err = eventWriter.AddOneArrayToPayload(singleArray)
+					if err != nil {
+						eventWriter.Close()
+						writer.Close()
+						return nil, err
+					}
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
+			case schemapb.DataType_JSON:
+				for _, singleJSON := range singleData.(*JSONFieldData).Data {
+					err = eventWriter.AddOneJSONToPayload(singleJSON)
+					if err != nil {
+						eventWriter.Close()
+						writer.Close()
+						return nil, err
+					}
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+			case schemapb.DataType_BinaryVector:
+				err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
+			case schemapb.DataType_FloatVector:
+				err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+			case schemapb.DataType_Float16Vector:
+				err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+			case typeutil.BFloat16Vector:
+				err = eventWriter.AddBFloat16VectorToPayload(singleData.(*BFloat16VectorFieldData).Data, singleData.(*BFloat16VectorFieldData).Dim)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BFloat16VectorFieldData).GetMemorySize()))
+			case typeutil.Int8Vector:
+				err = eventWriter.AddInt8VectorToPayload(singleData.(*Int8VectorFieldData).Data, singleData.(*Int8VectorFieldData).Dim)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8VectorFieldData).GetMemorySize()))
+			case typeutil.SparseFloatVector:
+				err = eventWriter.AddSparseFloatVectorToPayload(singleData.(*SparseFloatVectorFieldData).Data)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, err
+				}
+				writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*SparseFloatVectorFieldData).GetMemorySize()))
+			default:
+				return nil, fmt.Errorf("undefined data type %d", field.DataType)
 			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
-		case schemapb.DataType_BinaryVector:
-			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
-		case schemapb.DataType_FloatVector:
-			err = eventWriter.AddFloatVectorToPayload(singleData.(*FloatVectorFieldData).Data, singleData.(*FloatVectorFieldData).Dim)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
-		case schemapb.DataType_Float16Vector:
-			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
-			if err != nil {
-				eventWriter.Close()
-				writer.Close()
-				return nil, err
-			}
-			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
-		default:
-			return nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
 		if err != nil {
 			return nil, err
@@ -444,6 +485,11 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 
 		dataType := binlogReader.PayloadDataType
 		fieldID := binlogReader.FieldID
+		// the binlogs of the fields dropped from the collection are left in the segment
+		if insertCodec.isDroppedField(fieldID) {
+			binlogReader.Close()
+			continue
+		}
 		totalLength := 0
 		dim := 0
 
@@ -455,6 +501,12 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			if eventReader == nil {
 				break
 			}
+			validData, err := eventReader.GetValidDataFromPayload()
+			if err != nil {
+				eventReader.Close()
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
 			switch dataType {
 			case schemapb.DataType_Bool:
 				singleData, err := eventReader.GetBoolFromPayload()
@@ -471,6 +523,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				boolFieldData := insertData.Data[fieldID].(*BoolFieldData)
 
+				boolFieldData.ValidData = mergeValidData(boolFieldData.ValidData, len(boolFieldData.Data), validData, len(singleData))
 				boolFieldData.Data = append(boolFieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = boolFieldData
@@ -490,6 +543,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				int8FieldData := insertData.Data[fieldID].(*Int8FieldData)
 
+				int8FieldData.ValidData = mergeValidData(int8FieldData.ValidData, len(int8FieldData.Data), validData, len(singleData))
 				int8FieldData.Data = append(int8FieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = int8FieldData
@@ -509,6 +563,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				int16FieldData := insertData.Data[fieldID].(*Int16FieldData)
 
+				int16FieldData.ValidData = mergeValidData(int16FieldData.ValidData, len(int16FieldData.Data), validData, len(singleData))
 				int16FieldData.Data = append(int16FieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = int16FieldData
@@ -528,6 +583,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				int32FieldData := insertData.Data[fieldID].(*Int32FieldData)
 
+				int32FieldData.ValidData = mergeValidData(int32FieldData.ValidData, len(int32FieldData.Data), validData, len(singleData))
 				int32FieldData.Data = append(int32FieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = int32FieldData
@@ -547,6 +603,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				int64FieldData := insertData.Data[fieldID].(*Int64FieldData)
 
+				int64FieldData.ValidData = mergeValidData(int64FieldData.ValidData, len(int64FieldData.Data), validData, len(singleData))
 				int64FieldData.Data = append(int64FieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = int64FieldData
@@ -566,6 +623,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				floatFieldData := insertData.Data[fieldID].(*FloatFieldData)
 
+				floatFieldData.ValidData = mergeValidData(floatFieldData.ValidData, len(floatFieldData.Data), validData, len(singleData))
 				floatFieldData.Data = append(floatFieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = floatFieldData
@@ -585,6 +643,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				doubleFieldData := insertData.Data[fieldID].(*DoubleFieldData)
 
+				doubleFieldData.ValidData = mergeValidData(doubleFieldData.ValidData, len(doubleFieldData.Data), validData, len(singleData))
 				doubleFieldData.Data = append(doubleFieldData.Data, singleData...)
 				totalLength += len(singleData)
 				insertData.Data[fieldID] = doubleFieldData
@@ -604,6 +663,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				stringFieldData := insertData.Data[fieldID].(*StringFieldData)
 
+				stringFieldData.ValidData = mergeValidData(stringFieldData.ValidData, len(stringFieldData.Data), validData, len(stringPayload))
 				stringFieldData.Data = append(stringFieldData.Data, stringPayload...)
 				totalLength += len(stringPayload)
 				insertData.Data[fieldID] = stringFieldData
@@ -623,6 +683,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				arrayFieldData := insertData.Data[fieldID].(*ArrayFieldData)
 
+				arrayFieldData.ValidData = mergeValidData(arrayFieldData.ValidData, len(arrayFieldData.Data), validData, len(arrayPayload))
 				arrayFieldData.Data = append(arrayFieldData.Data, arrayPayload...)
 				totalLength += len(arrayPayload)
 				insertData.Data[fieldID] = arrayFieldData
@@ -642,6 +703,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				}
 				jsonFieldData := insertData.Data[fieldID].(*JSONFieldData)
 
+				jsonFieldData.ValidData = mergeValidData(jsonFieldData.ValidData, len(jsonFieldData.Data), validData, len(jsonPayload))
 				jsonFieldData.Data = append(jsonFieldData.Data, jsonPayload...)
 				totalLength += len(jsonPayload)
 				insertData.Data[fieldID] = jsonFieldData
@@ -700,6 +762,60 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				float16VectorFieldData.Dim = dim
 				insertData.Data[fieldID] = float16VectorFieldData
 
+			case typeutil.BFloat16Vector:
+				var singleData []byte
+				singleData, dim, err = eventReader.GetBFloat16VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &BFloat16VectorFieldData{
+						Data: make([]byte, 0, rowNum*dim),
+					}
+				}
+				bfloat16VectorFieldData := insertData.Data[fieldID].(*BFloat16VectorFieldData)
+
+				bfloat16VectorFieldData.Data = append(bfloat16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				bfloat16VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = bfloat16VectorFieldData
+
+			case typeutil.Int8Vector:
+				var singleData []int8
+				singleData, dim, err = eventReader.GetInt8VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &Int8VectorFieldData{
+						Data: make([]int8, 0, rowNum*dim),
+					}
+				}
+				int8VectorFieldData := insertData.Data[fieldID].(*Int8VectorFieldData)
+
+				int8VectorFieldData.Data = append(int8VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				int8VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = int8VectorFieldData
+
 			case schemapb.DataType_FloatVector:
 				var singleData []float32
 				singleData, dim, err = eventReader.GetFloatVectorFromPayload()
@@ -727,6 +843,29 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				floatVectorFieldData.Dim = dim
 				insertData.Data[fieldID] = floatVectorFieldData
 
+			case typeutil.SparseFloatVector:
+				var rows [][]byte
+				rows, dim, err = eventReader.GetSparseFloatVectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &SparseFloatVectorFieldData{
+						Data: make([][]byte, 0, rowNum),
+					}
+				}
+				sparseFloatVectorFieldData := insertData.Data[fieldID].(*SparseFloatVectorFieldData)
+
+				sparseFloatVectorFieldData.Data = append(sparseFloatVectorFieldData.Data, rows...)
+				totalLength += len(rows)
+				if dim > sparseFloatVectorFieldData.Dim {
+					sparseFloatVectorFieldData.Dim = dim
+				}
+				insertData.Data[fieldID] = sparseFloatVectorFieldData
+
 			default:
 				eventReader.Close()
 				binlogReader.Close()
@@ -748,9 +887,79 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 		binlogReader.Close()
 	}
 
+	if err := insertCodec.fillAddedFields(insertData, rowNum); err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+	}
 	return collectionID, partitionID, segmentID, nil
 }
 
+// isDroppedField returns true if the user field isn't in the schema of codec any more.
+func (insertCodec *InsertCodec) isDroppedField(fieldID FieldID) bool {
+	if insertCodec.Schema == nil || fieldID < common.StartOfUserFieldID {
+		return false
+	}
+	return typeutil.GetField(insertCodec.Schema.GetSchema(), fieldID) == nil
+}
+
+// fillAddedFields fills the user fields of the schema which have no binlogs, they're added to the collection
+// after the segment was written. The rows get the default value of the field, or null if it has none.
+func (insertCodec *InsertCodec) fillAddedFields(insertData *InsertData, rowNum int) error {
+	if insertCodec.Schema == nil || rowNum <= 0 {
+		return nil
+	}
+	for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+		if _, ok := insertData.Data[field.GetFieldID()]; ok || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		row, err := defaultValueRow(field)
+		if err != nil {
+			return err
+		}
+		fieldData, err := NewFieldData(field.GetDataType(), field)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < rowNum; i++ {
+			if err := fieldData.AppendRow(row); err != nil {
+				return err
+			}
+		}
+		insertData.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
+// defaultValueRow returns the default value of field as a row of its FieldData, or nil if it has no default value.
+func defaultValueRow(field *schemapb.FieldSchema) (any, error) {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		if !typeutil.IsFieldNullable(field) {
+			return nil, fmt.Errorf("field %s is neither nullable nor has default value", field.GetName())
+		}
+		return nil, nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), nil
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), nil
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), nil
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), nil
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), nil
+	default:
+		return nil, fmt.Errorf("%s field %s can't have default value", field.GetDataType().String(), field.GetName())
+	}
+}
+
 // func deserializeEntity[T any, U any](
 // 	eventReader *EventReader,
 // 	binlogReader *BinlogReader,
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
@@ -321,21 +322,21 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:         &Int64FieldData{[]int64{}},
-			TimestampField:     &Int64FieldData{[]int64{}},
-			BoolField:          &BoolFieldData{[]bool{}},
-			Int8Field:          &Int8FieldData{[]int8{}},
-			Int16Field:         &Int16FieldData{[]int16{}},
-			Int32Field:         &Int32FieldData{[]int32{}},
-			Int64Field:         &Int64FieldData{[]int64{}},
-			FloatField:         &FloatFieldData{[]float32{}},
-			DoubleField:        &DoubleFieldData{[]float64{}},
-			StringField:        &StringFieldData{[]string{}},
+			RowIDField:         &Int64FieldData{Data: []int64{}},
+			TimestampField:     &Int64FieldData{Data: []int64{}},
+			BoolField:          &BoolFieldData{Data: []bool{}},
+			Int8Field:          &Int8FieldData{Data: []int8{}},
+			Int16Field:         &Int16FieldData{Data: []int16{}},
+			Int32Field:         &Int32FieldData{Data: []int32{}},
+			Int64Field:         &Int64FieldData{Data: []int64{}},
+			FloatField:         &FloatFieldData{Data: []float32{}},
+			DoubleField:        &DoubleFieldData{Data: []float64{}},
+			StringField:        &StringFieldData{Data: []string{}},
 			BinaryVectorField:  &BinaryVectorFieldData{[]byte{}, 8},
 			FloatVectorField:   &FloatVectorFieldData{[]float32{}, 4},
 			Float16VectorField: &Float16VectorFieldData{[]byte{}, 4},
-			ArrayField:         &ArrayFieldData{schemapb.DataType_Int32, []*schemapb.ScalarField{}},
-			JSONField:          &JSONFieldData{[][]byte{}},
+			ArrayField:         &ArrayFieldData{ElementType: schemapb.DataType_Int32, Data: []*schemapb.ScalarField{}},
+			JSONField:          &JSONFieldData{Data: [][]byte{}},
 		},
 	}
 	b, err := insertCodec.Serialize(PartitionID, SegmentID, insertDataEmpty)
@@ -419,6 +420,191 @@ func TestInsertCodec(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestInsertCodecSparseFloatVector(t *testing.T) {
+	const sparseFloatVectorField = 113
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: sparseFloatVectorField, Name: "field_sparse_float_vector", DataType: typeutil.SparseFloatVector},
+			},
+		},
+	}
+	insertCodec := NewInsertCodecWithSchema(schema)
+	rows := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 100}, []float32{0.1, 0.2}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{0.3}),
+	}
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{2, 1}},
+			TimestampField: &Int64FieldData{Data: []int64{2, 1}},
+			Int64Field:     &Int64FieldData{Data: []int64{2, 1}},
+			sparseFloatVectorField: &SparseFloatVectorFieldData{
+				Data: [][]byte{rows[1], rows[0]},
+				Dim:  101,
+			},
+		},
+	}
+	blobs, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.NoError(t, err)
+
+	_, _, _, resultData, err := insertCodec.DeserializeAll(blobs)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	sparseData := resultData.Data[sparseFloatVectorField].(*SparseFloatVectorFieldData)
+	assert.Equal(t, rows, sparseData.Data)
+	assert.Equal(t, 101, sparseData.Dim)
+	assert.Equal(t, 2, sparseData.RowNum())
+}
+
+func TestInsertCodecBytesVector(t *testing.T) {
+	const (
+		bfloat16VectorField = 114
+		int8VectorField     = 115
+	)
+	dimParams := []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}}
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: bfloat16VectorField, Name: "field_bfloat16_vector", DataType: typeutil.BFloat16Vector, TypeParams: dimParams},
+				{FieldID: int8VectorField, Name: "field_int8_vector", DataType: typeutil.Int8Vector, TypeParams: dimParams},
+			},
+		},
+	}
+	insertCodec := NewInsertCodecWithSchema(schema)
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:          &Int64FieldData{Data: []int64{2, 1}},
+			TimestampField:      &Int64FieldData{Data: []int64{2, 1}},
+			Int64Field:          &Int64FieldData{Data: []int64{2, 1}},
+			bfloat16VectorField: &BFloat16VectorFieldData{Data: []byte{5, 6, 7, 8, 1, 2, 3, 4}, Dim: 2},
+			int8VectorField:     &Int8VectorFieldData{Data: []int8{3, -4, -1, 2}, Dim: 2},
+		},
+	}
+	blobs, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.NoError(t, err)
+
+	_, _, _, resultData, err := insertCodec.DeserializeAll(blobs)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, &BFloat16VectorFieldData{Data: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Dim: 2}, resultData.Data[bfloat16VectorField])
+	assert.Equal(t, &Int8VectorFieldData{Data: []int8{-1, 2, 3, -4}, Dim: 2}, resultData.Data[int8VectorField])
+
+	record, err := TransferInsertDataToInsertRecord(resultData)
+	assert.NoError(t, err)
+	for _, fieldData := range record.GetFieldsData() {
+		switch fieldData.GetFieldId() {
+		case bfloat16VectorField:
+			assert.Equal(t, typeutil.BFloat16Vector, fieldData.GetType())
+			assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, fieldData.GetVectors().GetBfloat16Vector())
+		case int8VectorField:
+			assert.Equal(t, typeutil.Int8Vector, fieldData.GetType())
+			assert.Equal(t, []byte{0xff, 2, 3, 0xfc}, fieldData.GetVectors().GetInt8Vector())
+		}
+	}
+}
+
+func TestInsertCodecNullable(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32, TypeParams: nullableParams},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_VarChar, TypeParams: nullableParams},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double, TypeParams: nullableParams},
+			},
+		},
+	}
+	insertCodec := NewInsertCodecWithSchema(schema)
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{3, 1, 2}},
+			TimestampField: &Int64FieldData{Data: []int64{3, 1, 2}},
+			Int64Field:     &Int64FieldData{Data: []int64{3, 1, 2}},
+			Int32Field:     &Int32FieldData{Data: []int32{3, 0, 2}, ValidData: []bool{true, false, true}},
+			StringField:    &StringFieldData{Data: []string{"", "1", ""}, ValidData: []bool{false, true, false}},
+			DoubleField:    &DoubleFieldData{Data: []float64{3, 1, 2}},
+		},
+	}
+	blobs, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.NoError(t, err)
+
+	_, _, _, resultData, err := insertCodec.DeserializeAll(blobs)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, &Int32FieldData{Data: []int32{0, 2, 3}, ValidData: []bool{false, true, true}}, resultData.Data[Int32Field])
+	assert.Equal(t, &StringFieldData{Data: []string{"1", "", ""}, ValidData: []bool{true, false, false}}, resultData.Data[StringField])
+	assert.Nil(t, resultData.Data[DoubleField].(*DoubleFieldData).ValidData)
+	assert.Nil(t, resultData.Data[Int32Field].GetRow(0))
+	assert.Equal(t, int32(2), resultData.Data[Int32Field].GetRow(1))
+
+	MergeFieldData(resultData, DoubleField, &DoubleFieldData{Data: []float64{0}, ValidData: []bool{false}})
+	assert.Equal(t, []bool{true, true, true, false}, resultData.Data[DoubleField].(*DoubleFieldData).ValidData)
+}
+
+func TestInsertCodecAlteredSchema(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32},
+			},
+		},
+	}
+	blobs, err := NewInsertCodecWithSchema(schema).Serialize(PartitionID, SegmentID, &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{Data: []int64{1, 2}},
+			Int64Field:     &Int64FieldData{Data: []int64{1, 2}},
+			Int32Field:     &Int32FieldData{Data: []int32{1, 2}},
+		},
+	})
+	assert.NoError(t, err)
+
+	// drop field_int32, and add a defaulted field and a nullable field
+	schema.Schema.Fields = append(schema.Schema.Fields[:3],
+		&schemapb.FieldSchema{
+			FieldID:      StringField,
+			Name:         "field_string",
+			DataType:     schemapb.DataType_VarChar,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+		},
+		&schemapb.FieldSchema{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double, TypeParams: nullableParams},
+	)
+	_, _, _, resultData, err := NewInsertCodecWithSchema(schema).DeserializeAll(blobs)
+	assert.NoError(t, err)
+	assert.NotContains(t, resultData.Data, int64(Int32Field))
+	assert.Equal(t, []int64{1, 2}, resultData.Data[Int64Field].(*Int64FieldData).Data)
+	assert.Equal(t, []string{"a", "a"}, resultData.Data[StringField].(*StringFieldData).Data)
+	assert.Equal(t, 2, resultData.Data[DoubleField].RowNum())
+	assert.Nil(t, resultData.Data[DoubleField].GetRow(1))
+
+	// the added field must be nullable or have a default value
+	schema.Schema.Fields = append(schema.Schema.Fields, &schemapb.FieldSchema{FieldID: FloatField, Name: "field_float", DataType: schemapb.DataType_Float})
+	_, _, _, _, err = NewInsertCodecWithSchema(schema).DeserializeAll(blobs)
+	assert.Error(t, err)
+}
+
 func TestDeleteCodec(t *testing.T) {
 	t.Run("int64 pk", func(t *testing.T) {
 		deleteCodec := NewDeleteCodec()
@@ -694,16 +880,16 @@ func TestMemorySize(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}},
-			BoolField:         &BoolFieldData{[]bool{}},
-			Int8Field:         &Int8FieldData{[]int8{}},
-			Int16Field:        &Int16FieldData{[]int16{}},
-			Int32Field:        &Int32FieldData{[]int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}},
-			FloatField:        &FloatFieldData{[]float32{}},
-			DoubleField:       &DoubleFieldData{[]float64{}},
-			StringField:       &StringFieldData{[]string{}},
+			RowIDField:        &Int64FieldData{Data: []int64{}},
+			TimestampField:    &Int64FieldData{Data: []int64{}},
+			BoolField:         &BoolFieldData{Data: []bool{}},
+			Int8Field:         &Int8FieldData{Data: []int8{}},
+			Int16Field:        &Int16FieldData{Data: []int16{}},
+			Int32Field:        &Int32FieldData{Data: []int32{}},
+			Int64Field:        &Int64FieldData{Data: []int64{}},
+			FloatField:        &FloatFieldData{Data: []float32{}},
+			DoubleField:       &DoubleFieldData{Data: []float64{}},
+			StringField:       &StringFieldData{Data: []string{}},
 			BinaryVectorField: &BinaryVectorFieldData{[]byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]float32{}, 4},
 		},
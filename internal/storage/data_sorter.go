@@ -19,6 +19,7 @@ package storage
 import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // DataSorter sorts insert data
@@ -55,6 +56,10 @@ func (ds *DataSorter) Swap(i, j int) {
 		if !has {
 			continue
 		}
+		if nullableData, ok := singleData.(NullableFieldData); ok && nullableData.GetValidData() != nil {
+			validData := nullableData.GetValidData()
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 		switch field.DataType {
 		case schemapb.DataType_Bool:
 			data := singleData.(*BoolFieldData).Data
@@ -101,12 +106,28 @@ func (ds *DataSorter) Swap(i, j int) {
 			for idx := 0; idx < steps; idx++ {
 				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
 			}
+		case typeutil.BFloat16Vector:
+			data := singleData.(*BFloat16VectorFieldData).Data
+			dim := singleData.(*BFloat16VectorFieldData).Dim
+			steps := dim * 2
+			for idx := 0; idx < steps; idx++ {
+				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
+			}
+		case typeutil.Int8Vector:
+			data := singleData.(*Int8VectorFieldData).Data
+			dim := singleData.(*Int8VectorFieldData).Dim
+			for idx := 0; idx < dim; idx++ {
+				data[i*dim+idx], data[j*dim+idx] = data[j*dim+idx], data[i*dim+idx]
+			}
 		case schemapb.DataType_Array:
 			data := singleData.(*ArrayFieldData).Data
 			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_JSON:
 			data := singleData.(*JSONFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case typeutil.SparseFloatVector:
+			data := singleData.(*SparseFloatVectorFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		default:
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // TODO: fill it
@@ -123,6 +124,15 @@ type FieldData interface {
 	AppendRow(row interface{}) error
 }
 
+// NullableFieldData defines the field data of scalar field which may have null rows,
+// ValidData marks the rows which are not null, and all the rows are valid if it's nil.
+// GetRow returns nil for a null row, and AppendRow appends a null row for nil.
+type NullableFieldData interface {
+	FieldData
+	GetDataRows() any
+	GetValidData() []bool
+}
+
 func NewFieldData(dataType schemapb.DataType, fieldSchema *schemapb.FieldSchema) (FieldData, error) {
 	typeParams := fieldSchema.GetTypeParams()
 	switch dataType {
@@ -135,6 +145,24 @@ func NewFieldData(dataType schemapb.DataType, fieldSchema *schemapb.FieldSchema)
 			Data: make([]byte, 0),
 			Dim:  dim,
 		}, nil
+	case typeutil.BFloat16Vector:
+		dim, err := GetDimFromParams(typeParams)
+		if err != nil {
+			return nil, err
+		}
+		return &BFloat16VectorFieldData{
+			Data: make([]byte, 0),
+			Dim:  dim,
+		}, nil
+	case typeutil.Int8Vector:
+		dim, err := GetDimFromParams(typeParams)
+		if err != nil {
+			return nil, err
+		}
+		return &Int8VectorFieldData{
+			Data: make([]int8, 0),
+			Dim:  dim,
+		}, nil
 	case schemapb.DataType_FloatVector:
 		dim, err := GetDimFromParams(typeParams)
 		if err != nil {
@@ -153,6 +181,10 @@ func NewFieldData(dataType schemapb.DataType, fieldSchema *schemapb.FieldSchema)
 			Data: make([]byte, 0),
 			Dim:  dim,
 		}, nil
+	case typeutil.SparseFloatVector:
+		return &SparseFloatVectorFieldData{
+			Data: make([][]byte, 0),
+		}, nil
 
 	case schemapb.DataType_Bool:
 		return &BoolFieldData{
@@ -206,35 +238,45 @@ func NewFieldData(dataType schemapb.DataType, fieldSchema *schemapb.FieldSchema)
 }
 
 type BoolFieldData struct {
-	Data []bool
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	Data []int8
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	Data []int16
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	Data []int32
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	Data []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	Data []float32
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	Data []float64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	Data []string
+	Data      []string
+	ValidData []bool
 }
 type ArrayFieldData struct {
 	ElementType schemapb.DataType
 	Data        []*schemapb.ScalarField
+	ValidData   []bool
 }
 type JSONFieldData struct {
-	Data [][]byte
+	Data      [][]byte
+	ValidData []bool
 }
 type BinaryVectorFieldData struct {
 	Data []byte
@@ -248,33 +290,119 @@ type Float16VectorFieldData struct {
 	Data []byte
 	Dim  int
 }
+type BFloat16VectorFieldData struct {
+	Data []byte
+	Dim  int
+}
+type Int8VectorFieldData struct {
+	Data []int8
+	Dim  int
+}
+
+// SparseFloatVectorFieldData holds the rows of sparse float vector,
+// Dim is the max index of rows plus one.
+type SparseFloatVectorFieldData struct {
+	Data [][]byte
+	Dim  int
+}
 
 // RowNum implements FieldData.RowNum
-func (data *BoolFieldData) RowNum() int          { return len(data.Data) }
-func (data *Int8FieldData) RowNum() int          { return len(data.Data) }
-func (data *Int16FieldData) RowNum() int         { return len(data.Data) }
-func (data *Int32FieldData) RowNum() int         { return len(data.Data) }
-func (data *Int64FieldData) RowNum() int         { return len(data.Data) }
-func (data *FloatFieldData) RowNum() int         { return len(data.Data) }
-func (data *DoubleFieldData) RowNum() int        { return len(data.Data) }
-func (data *StringFieldData) RowNum() int        { return len(data.Data) }
-func (data *ArrayFieldData) RowNum() int         { return len(data.Data) }
-func (data *JSONFieldData) RowNum() int          { return len(data.Data) }
-func (data *BinaryVectorFieldData) RowNum() int  { return len(data.Data) * 8 / data.Dim }
-func (data *FloatVectorFieldData) RowNum() int   { return len(data.Data) / data.Dim }
-func (data *Float16VectorFieldData) RowNum() int { return len(data.Data) / 2 / data.Dim }
+func (data *BoolFieldData) RowNum() int              { return len(data.Data) }
+func (data *Int8FieldData) RowNum() int              { return len(data.Data) }
+func (data *Int16FieldData) RowNum() int             { return len(data.Data) }
+func (data *Int32FieldData) RowNum() int             { return len(data.Data) }
+func (data *Int64FieldData) RowNum() int             { return len(data.Data) }
+func (data *FloatFieldData) RowNum() int             { return len(data.Data) }
+func (data *DoubleFieldData) RowNum() int            { return len(data.Data) }
+func (data *StringFieldData) RowNum() int            { return len(data.Data) }
+func (data *ArrayFieldData) RowNum() int             { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int              { return len(data.Data) }
+func (data *BinaryVectorFieldData) RowNum() int      { return len(data.Data) * 8 / data.Dim }
+func (data *FloatVectorFieldData) RowNum() int       { return len(data.Data) / data.Dim }
+func (data *Float16VectorFieldData) RowNum() int     { return len(data.Data) / 2 / data.Dim }
+func (data *BFloat16VectorFieldData) RowNum() int    { return len(data.Data) / 2 / data.Dim }
+func (data *Int8VectorFieldData) RowNum() int        { return len(data.Data) / data.Dim }
+func (data *SparseFloatVectorFieldData) RowNum() int { return len(data.Data) }
 
 // GetRow implements FieldData.GetRow
-func (data *BoolFieldData) GetRow(i int) any   { return data.Data[i] }
-func (data *Int8FieldData) GetRow(i int) any   { return data.Data[i] }
-func (data *Int16FieldData) GetRow(i int) any  { return data.Data[i] }
-func (data *Int32FieldData) GetRow(i int) any  { return data.Data[i] }
-func (data *Int64FieldData) GetRow(i int) any  { return data.Data[i] }
-func (data *FloatFieldData) GetRow(i int) any  { return data.Data[i] }
-func (data *DoubleFieldData) GetRow(i int) any { return data.Data[i] }
-func (data *StringFieldData) GetRow(i int) any { return data.Data[i] }
-func (data *ArrayFieldData) GetRow(i int) any  { return data.Data[i] }
-func (data *JSONFieldData) GetRow(i int) any   { return data.Data[i] }
+func (data *BoolFieldData) GetRow(i int) any   { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *Int8FieldData) GetRow(i int) any   { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *Int16FieldData) GetRow(i int) any  { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *Int32FieldData) GetRow(i int) any  { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *Int64FieldData) GetRow(i int) any  { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *FloatFieldData) GetRow(i int) any  { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *DoubleFieldData) GetRow(i int) any { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *StringFieldData) GetRow(i int) any { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *ArrayFieldData) GetRow(i int) any  { return getNullableRow(data.Data, data.ValidData, i) }
+func (data *JSONFieldData) GetRow(i int) any   { return getNullableRow(data.Data, data.ValidData, i) }
+
+// GetDataRows implements NullableFieldData.GetDataRows
+func (data *BoolFieldData) GetDataRows() any   { return data.Data }
+func (data *Int8FieldData) GetDataRows() any   { return data.Data }
+func (data *Int16FieldData) GetDataRows() any  { return data.Data }
+func (data *Int32FieldData) GetDataRows() any  { return data.Data }
+func (data *Int64FieldData) GetDataRows() any  { return data.Data }
+func (data *FloatFieldData) GetDataRows() any  { return data.Data }
+func (data *DoubleFieldData) GetDataRows() any { return data.Data }
+func (data *StringFieldData) GetDataRows() any { return data.Data }
+func (data *ArrayFieldData) GetDataRows() any  { return data.Data }
+func (data *JSONFieldData) GetDataRows() any   { return data.Data }
+
+// GetValidData implements NullableFieldData.GetValidData
+func (data *BoolFieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int8FieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int16FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int32FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int64FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *FloatFieldData) GetValidData() []bool  { return data.ValidData }
+func (data *DoubleFieldData) GetValidData() []bool { return data.ValidData }
+func (data *StringFieldData) GetValidData() []bool { return data.ValidData }
+func (data *ArrayFieldData) GetValidData() []bool  { return data.ValidData }
+func (data *JSONFieldData) GetValidData() []bool   { return data.ValidData }
+
+func getNullableRow[T any](data []T, validData []bool, i int) any {
+	if validData != nil && !validData[i] {
+		return nil
+	}
+	return data[i]
+}
+
+// mergeValidData returns the validity of dstRows rows of dst followed by srcRows rows of src,
+// it's nil if all the rows are valid.
+func mergeValidData(dst []bool, dstRows int, src []bool, srcRows int) []bool {
+	if dst == nil && src == nil {
+		return nil
+	}
+	if dst == nil {
+		dst = make([]bool, dstRows, dstRows+srcRows)
+		for i := range dst {
+			dst[i] = true
+		}
+	}
+	if src == nil {
+		for i := 0; i < srcRows; i++ {
+			dst = append(dst, true)
+		}
+		return dst
+	}
+	return append(dst, src...)
+}
+
+// appendValidData appends the validity of a row to validData of rows rows,
+// validData is kept nil until the first null row is appended.
+func appendValidData(validData []bool, rows int, valid bool) []bool {
+	if validData == nil {
+		if valid {
+			return nil
+		}
+		validData = make([]bool, rows, rows+1)
+		for i := range validData {
+			validData[i] = true
+		}
+	}
+	return append(validData, valid)
+}
+
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -287,93 +415,165 @@ func (data *Float16VectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
 }
 
+func (data *BFloat16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+
+func (data *Int8VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim : (i+1)*data.Dim]
+}
+
+func (data *SparseFloatVectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i]
+}
+
 // AppendRow implements FieldData.AppendRow
 func (data *BoolFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, false)
+		return nil
+	}
 	v, ok := row.(bool)
 	if !ok {
 		return merr.WrapErrParameterInvalid("bool", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *Int8FieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(int8)
 	if !ok {
 		return merr.WrapErrParameterInvalid("int8", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *Int16FieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(int16)
 	if !ok {
 		return merr.WrapErrParameterInvalid("int16", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *Int32FieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(int32)
 	if !ok {
 		return merr.WrapErrParameterInvalid("int32", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *Int64FieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(int64)
 	if !ok {
 		return merr.WrapErrParameterInvalid("int64", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *FloatFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(float32)
 	if !ok {
 		return merr.WrapErrParameterInvalid("float32", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *DoubleFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, 0)
+		return nil
+	}
 	v, ok := row.(float64)
 	if !ok {
 		return merr.WrapErrParameterInvalid("float64", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *StringFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, "")
+		return nil
+	}
 	v, ok := row.(string)
 	if !ok {
 		return merr.WrapErrParameterInvalid("string", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *ArrayFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, &schemapb.ScalarField{})
+		return nil
+	}
 	v, ok := row.(*schemapb.ScalarField)
 	if !ok {
 		return merr.WrapErrParameterInvalid("*schemapb.ScalarField", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
 
 func (data *JSONFieldData) AppendRow(row interface{}) error {
+	if row == nil {
+		data.ValidData = appendValidData(data.ValidData, len(data.Data), false)
+		data.Data = append(data.Data, nil)
+		return nil
+	}
 	v, ok := row.([]byte)
 	if !ok {
 		return merr.WrapErrParameterInvalid("[]byte", row, "Wrong row type")
 	}
+	data.ValidData = appendValidData(data.ValidData, len(data.Data), true)
 	data.Data = append(data.Data, v)
 	return nil
 }
@@ -405,17 +605,73 @@ func (data *Float16VectorFieldData) AppendRow(row interface{}) error {
 	return nil
 }
 
+func (data *BFloat16VectorFieldData) AppendRow(row interface{}) error {
+	v, ok := row.([]byte)
+	if !ok || len(v) != data.Dim*2 {
+		return merr.WrapErrParameterInvalid("[]byte", row, "Wrong row type")
+	}
+	data.Data = append(data.Data, v...)
+	return nil
+}
+
+func (data *Int8VectorFieldData) AppendRow(row interface{}) error {
+	v, ok := row.([]int8)
+	if !ok || len(v) != data.Dim {
+		return merr.WrapErrParameterInvalid("[]int8", row, "Wrong row type")
+	}
+	data.Data = append(data.Data, v...)
+	return nil
+}
+
+func (data *SparseFloatVectorFieldData) AppendRow(row interface{}) error {
+	v, ok := row.([]byte)
+	if !ok {
+		return merr.WrapErrParameterInvalid("[]byte", row, "Wrong row type")
+	}
+	if err := typeutil.ValidateSparseFloatRows(v); err != nil {
+		return merr.WrapErrParameterInvalid("sparse float vector row", row, err.Error())
+	}
+	data.Data = append(data.Data, v)
+	if dim := int(typeutil.SparseFloatRowDim(v)); dim > data.Dim {
+		data.Dim = dim
+	}
+	return nil
+}
+
 // GetMemorySize implements FieldData.GetMemorySize
-func (data *BoolFieldData) GetMemorySize() int          { return binary.Size(data.Data) }
-func (data *Int8FieldData) GetMemorySize() int          { return binary.Size(data.Data) }
-func (data *Int16FieldData) GetMemorySize() int         { return binary.Size(data.Data) }
-func (data *Int32FieldData) GetMemorySize() int         { return binary.Size(data.Data) }
-func (data *Int64FieldData) GetMemorySize() int         { return binary.Size(data.Data) }
-func (data *FloatFieldData) GetMemorySize() int         { return binary.Size(data.Data) }
-func (data *DoubleFieldData) GetMemorySize() int        { return binary.Size(data.Data) }
-func (data *BinaryVectorFieldData) GetMemorySize() int  { return binary.Size(data.Data) + 4 }
-func (data *FloatVectorFieldData) GetMemorySize() int   { return binary.Size(data.Data) + 4 }
-func (data *Float16VectorFieldData) GetMemorySize() int { return binary.Size(data.Data) + 4 }
+func (data *BoolFieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *Int8FieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *Int16FieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *Int32FieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *Int64FieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *FloatFieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *DoubleFieldData) GetMemorySize() int {
+	return binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *BinaryVectorFieldData) GetMemorySize() int   { return binary.Size(data.Data) + 4 }
+func (data *FloatVectorFieldData) GetMemorySize() int    { return binary.Size(data.Data) + 4 }
+func (data *Float16VectorFieldData) GetMemorySize() int  { return binary.Size(data.Data) + 4 }
+func (data *BFloat16VectorFieldData) GetMemorySize() int { return binary.Size(data.Data) + 4 }
+func (data *Int8VectorFieldData) GetMemorySize() int     { return binary.Size(data.Data) + 4 }
 
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
@@ -426,7 +682,7 @@ func (data *StringFieldData) GetMemorySize() int {
 	for _, val := range data.Data {
 		size += len(val) + 16
 	}
-	return size
+	return size + binary.Size(data.ValidData)
 }
 
 func (data *ArrayFieldData) GetMemorySize() int {
@@ -451,7 +707,7 @@ func (data *ArrayFieldData) GetMemorySize() int {
 			size += (&StringFieldData{Data: val.GetStringData().GetData()}).GetMemorySize()
 		}
 	}
-	return size
+	return size + binary.Size(data.ValidData)
 }
 
 func (data *JSONFieldData) GetMemorySize() int {
@@ -459,5 +715,13 @@ func (data *JSONFieldData) GetMemorySize() int {
 	for _, val := range data.Data {
 		size += len(val) + 16
 	}
-	return size
+	return size + binary.Size(data.ValidData)
+}
+
+func (data *SparseFloatVectorFieldData) GetMemorySize() int {
+	var size int
+	for _, val := range data.Data {
+		size += len(val) + 16
+	}
+	return size + 4
 }
@@ -99,6 +99,29 @@ func (s *InsertDataSuite) TestInsertData() {
 	})
 }
 
+func (s *InsertDataSuite) TestNullableRows() {
+	fieldData := &Int64FieldData{Data: []int64{}}
+	s.NoError(fieldData.AppendRow(int64(1)))
+	s.Nil(fieldData.GetValidData())
+
+	s.NoError(fieldData.AppendRow(nil))
+	s.NoError(fieldData.AppendRow(int64(3)))
+	s.Equal([]int64{1, 0, 3}, fieldData.Data)
+	s.Equal([]bool{true, false, true}, fieldData.GetValidData())
+	s.Equal(int64(1), fieldData.GetRow(0))
+	s.Nil(fieldData.GetRow(1))
+	s.Equal(3*8+3, fieldData.GetMemorySize())
+
+	arrayData := &ArrayFieldData{ElementType: schemapb.DataType_Int32}
+	s.NoError(arrayData.AppendRow(nil))
+	s.Equal(1, arrayData.RowNum())
+	s.Nil(arrayData.GetRow(0))
+
+	s.Nil(mergeValidData(nil, 2, nil, 1))
+	s.Equal([]bool{true, true, false}, mergeValidData(nil, 2, []bool{false}, 1))
+	s.Equal([]bool{false, true}, mergeValidData([]bool{false}, 1, nil, 1))
+}
+
 func (s *InsertDataSuite) TestMemorySize() {
 	s.Equal(s.iDataEmpty.Data[RowIDField].GetMemorySize(), 0)
 	s.Equal(s.iDataEmpty.Data[TimestampField].GetMemorySize(), 0)
@@ -37,6 +37,10 @@ type PayloadWriterInterface interface {
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	AddFloat16VectorToPayload(binVec []byte, dim int) error
+	AddBFloat16VectorToPayload(binVec []byte, dim int) error
+	AddInt8VectorToPayload(binVec []int8, dim int) error
+	AddSparseFloatVectorToPayload(rows [][]byte) error
+	AddNullableDataToPayload(msgs any, validData []bool) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -61,6 +65,10 @@ type PayloadReaderInterface interface {
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloat16VectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetBFloat16VectorFromPayload() ([]byte, int, error)
+	GetInt8VectorFromPayload() ([]int8, int, error)
+	GetSparseFloatVectorFromPayload() ([][]byte, int, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader() error
 	Close() error
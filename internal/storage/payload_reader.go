@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -68,6 +69,12 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 		return r.GetFloatVectorFromPayload()
 	case schemapb.DataType_Float16Vector:
 		return r.GetFloat16VectorFromPayload()
+	case typeutil.BFloat16Vector:
+		return r.GetBFloat16VectorFromPayload()
+	case typeutil.Int8Vector:
+		return r.GetInt8VectorFromPayload()
+	case typeutil.SparseFloatVector:
+		return r.GetSparseFloatVectorFromPayload()
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
@@ -347,6 +354,61 @@ func (r *PayloadReader) GetFloat16VectorFromPayload() ([]byte, int, error) {
 	return ret, dim, nil
 }
 
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != typeutil.BFloat16Vector {
+		return nil, -1, fmt.Errorf("failed to get bfloat16 vector from datatype %v", r.colType.String())
+	}
+	col, err := r.reader.RowGroup(0).Column(0)
+	if err != nil {
+		return nil, -1, err
+	}
+	dim := col.Descriptor().TypeLength() / 2
+	values := make([]parquet.FixedLenByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([]byte, int64(dim*2)*r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		copy(ret[i*dim*2:(i+1)*dim*2], values[i])
+	}
+	return ret, dim, nil
+}
+
+// GetInt8VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetInt8VectorFromPayload() ([]int8, int, error) {
+	if r.colType != typeutil.Int8Vector {
+		return nil, -1, fmt.Errorf("failed to get int8 vector from datatype %v", r.colType.String())
+	}
+	col, err := r.reader.RowGroup(0).Column(0)
+	if err != nil {
+		return nil, -1, err
+	}
+	dim := col.Descriptor().TypeLength()
+	values := make([]parquet.FixedLenByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([]int8, int64(dim)*r.numRows)
+	retBytes := typeutil.Int8VectorToBytes(ret)
+	for i := 0; i < int(r.numRows); i++ {
+		copy(retBytes[i*dim:(i+1)*dim], values[i])
+	}
+	return ret, dim, nil
+}
+
 // GetFloatVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	if r.colType != schemapb.DataType_FloatVector {
@@ -376,6 +438,63 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return ret, dim, nil
 }
 
+// GetSparseFloatVectorFromPayload returns rows, dimension, error
+// the dimension of sparse float vector is the max index of rows plus one.
+func (r *PayloadReader) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	if !typeutil.IsSparseFloatVectorType(r.colType) {
+		return nil, -1, fmt.Errorf("failed to get sparse float vector from datatype %v", r.colType.String())
+	}
+
+	rows, err := readByteAndConvert(r, func(bytes parquet.ByteArray) []byte {
+		return bytes
+	})
+	if err != nil {
+		return nil, -1, err
+	}
+	return rows, int(typeutil.SparseFloatRowsDim(rows)), nil
+}
+
+// GetValidDataFromPayload returns the validity of rows of a nullable scalar field,
+// it returns nil if there is no null row in payload.
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	if r.reader.MetaData().Schema.Column(0).MaxDefinitionLevel() == 0 {
+		return nil, nil
+	}
+
+	switch r.colType {
+	case schemapb.DataType_Bool:
+		return readValidData[bool, *file.BooleanColumnChunkReader](r)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return readValidData[int32, *file.Int32ColumnChunkReader](r)
+	case schemapb.DataType_Int64:
+		return readValidData[int64, *file.Int64ColumnChunkReader](r)
+	case schemapb.DataType_Float:
+		return readValidData[float32, *file.Float32ColumnChunkReader](r)
+	case schemapb.DataType_Double:
+		return readValidData[float64, *file.Float64ColumnChunkReader](r)
+	case schemapb.DataType_String, schemapb.DataType_VarChar, schemapb.DataType_Array, schemapb.DataType_JSON:
+		return readValidData[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r)
+	default:
+		return nil, fmt.Errorf("failed to get valid data from datatype %v", r.colType.String())
+	}
+}
+
+func readValidData[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](r *PayloadReader) ([]bool, error) {
+	values := make([]T, r.numRows)
+	validData := make([]bool, r.numRows)
+	rowsRead, err := readDataFromAllRowGroups[T, E](r.reader, values, validData, 0, r.numRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got rowsRead = %d", r.numRows, rowsRead)
+	}
+	return validData, nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	return int(r.numRows), nil
 }
@@ -387,9 +506,16 @@ func (r *PayloadReader) Close() error {
 
 // ReadDataFromAllRowGroups iterates all row groups of file.Reader, and convert column to E.
 // then calls ReadBatch with provided parameters.
+// The values of null rows of an optional column are left zero.
 func ReadDataFromAllRowGroups[T any, E interface {
 	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
 }](reader *file.Reader, values []T, columnIdx int, numRows int64) (int64, error) {
+	return readDataFromAllRowGroups[T, E](reader, values, nil, columnIdx, numRows)
+}
+
+func readDataFromAllRowGroups[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](reader *file.Reader, values []T, validData []bool, columnIdx int, numRows int64) (int64, error) {
 	var offset int64
 
 	for i := 0; i < reader.NumRowGroups(); i++ {
@@ -406,12 +532,39 @@ func ReadDataFromAllRowGroups[T any, E interface {
 			return -1, fmt.Errorf("expect type %T, but got %T", *new(E), column)
 		}
 
-		_, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], nil, nil)
+		maxDefLevel := column.Descriptor().MaxDefinitionLevel()
+		if maxDefLevel == 0 {
+			_, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], nil, nil)
+			if err != nil {
+				return -1, err
+			}
+			offset += int64(valuesRead)
+			continue
+		}
+
+		// values of an optional column are read packed, spread them to the positions of valid rows
+		defLevels := make([]int16, len(values)-int(offset))
+		rowsRead, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], defLevels, nil)
 		if err != nil {
 			return -1, err
 		}
+		rowValues := values[offset : offset+rowsRead]
+		j := valuesRead - 1
+		for k := len(rowValues) - 1; k >= 0; k-- {
+			valid := defLevels[k] == maxDefLevel
+			if valid {
+				rowValues[k] = rowValues[j]
+				j--
+			} else {
+				var zero T
+				rowValues[k] = zero
+			}
+			if validData != nil {
+				validData[offset+int64(k)] = valid
+			}
+		}
 
-		offset += int64(valuesRead)
+		offset += rowsRead
 	}
 
 	return offset, nil
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func TestPayload_ReaderAndWriter(t *testing.T) {
@@ -567,6 +568,124 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		assert.ElementsMatch(t, []byte{1, 2, 3, 4}, float16Vecs)
 	})
 
+	t.Run("TestSparseFloatVector", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.SparseFloatVector, 1)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		rows := [][]byte{
+			typeutil.CreateSparseFloatRow([]uint32{1, 50}, []float32{0.1, 0.2}),
+			typeutil.CreateSparseFloatRow([]uint32{7}, []float32{1}),
+		}
+		err = w.AddSparseFloatVectorToPayload(rows[:1])
+		assert.NoError(t, err)
+		err = w.AddDataToPayload(rows[1:], 1)
+		assert.NoError(t, err)
+		err = w.AddSparseFloatVectorToPayload([][]byte{{1, 2, 3}})
+		assert.Error(t, err)
+		err = w.FinishPayloadWriter()
+		assert.NoError(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.NoError(t, err)
+
+		r, err := NewPayloadReader(typeutil.SparseFloatVector, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.NoError(t, err)
+		assert.Equal(t, length, 2)
+
+		sparseRows, dim, err := r.GetSparseFloatVectorFromPayload()
+		assert.NoError(t, err)
+		assert.Equal(t, 51, dim)
+		assert.Equal(t, rows, sparseRows)
+
+		_, _, err = r.GetFloatVectorFromPayload()
+		assert.Error(t, err)
+	})
+
+	t.Run("TestBFloat16Vector", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.BFloat16Vector, 1)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddBFloat16VectorToPayload([]byte{1, 2, 3, 4}, 1)
+		assert.NoError(t, err)
+		err = w.AddDataToPayload([]byte{5, 6}, 1)
+		assert.NoError(t, err)
+		err = w.FinishPayloadWriter()
+		assert.NoError(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.NoError(t, err)
+
+		r, err := NewPayloadReader(typeutil.BFloat16Vector, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.NoError(t, err)
+		assert.Equal(t, length, 3)
+
+		vecs, dim, err := r.GetBFloat16VectorFromPayload()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, dim)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, vecs)
+
+		ivecs, dim, err := r.GetDataFromPayload()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, dim)
+		assert.Equal(t, vecs, ivecs)
+
+		_, _, err = r.GetFloat16VectorFromPayload()
+		assert.Error(t, err)
+	})
+
+	t.Run("TestInt8Vector", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.Int8Vector, 2)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddInt8VectorToPayload([]int8{-1, 2, -3, 4}, 2)
+		assert.NoError(t, err)
+		err = w.AddDataToPayload([]int8{127, -128}, 2)
+		assert.NoError(t, err)
+		err = w.AddDataToPayload([]byte{1, 2}, 2)
+		assert.Error(t, err)
+		err = w.FinishPayloadWriter()
+		assert.NoError(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.NoError(t, err)
+		assert.Equal(t, 3, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.NoError(t, err)
+
+		r, err := NewPayloadReader(typeutil.Int8Vector, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.NoError(t, err)
+		assert.Equal(t, length, 3)
+
+		vecs, dim, err := r.GetInt8VectorFromPayload()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, dim)
+		assert.Equal(t, []int8{-1, 2, -3, 4, 127, -128}, vecs)
+
+		_, _, err = r.GetBFloat16VectorFromPayload()
+		assert.Error(t, err)
+	})
+
 	// t.Run("TestAddDataToPayload", func(t *testing.T) {
 	// 	w, err := NewPayloadWriter(schemapb.DataType_Bool)
 	// 	w.colType = 999
@@ -158,6 +158,24 @@ func (w *NativePayloadWriter) AddDataToPayload(data interface{}, dim ...int) err
 				return errors.New("incorrect data type")
 			}
 			return w.AddFloat16VectorToPayload(val, dim[0])
+		case typeutil.BFloat16Vector:
+			val, ok := data.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddBFloat16VectorToPayload(val, dim[0])
+		case typeutil.Int8Vector:
+			val, ok := data.([]int8)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddInt8VectorToPayload(val, dim[0])
+		case typeutil.SparseFloatVector:
+			val, ok := data.([][]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -444,6 +462,144 @@ func (w *NativePayloadWriter) AddFloat16VectorToPayload(data []byte, dim int) er
 	return nil
 }
 
+func (w *NativePayloadWriter) AddBFloat16VectorToPayload(data []byte, dim int) error {
+	if w.finished {
+		return errors.New("can't append data to finished writer")
+	}
+
+	if len(data) == 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+
+	builder, ok := w.builder.(*array.FixedSizeBinaryBuilder)
+	if !ok {
+		return errors.New("failed to cast ArrayBuilder")
+	}
+
+	byteLength := dim * 2
+	length := len(data) / byteLength
+
+	builder.Reserve(length)
+	for i := 0; i < length; i++ {
+		builder.Append(data[i*byteLength : (i+1)*byteLength])
+	}
+
+	return nil
+}
+
+func (w *NativePayloadWriter) AddInt8VectorToPayload(data []int8, dim int) error {
+	if w.finished {
+		return errors.New("can't append data to finished writer")
+	}
+
+	if len(data) == 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+
+	builder, ok := w.builder.(*array.FixedSizeBinaryBuilder)
+	if !ok {
+		return errors.New("failed to cast ArrayBuilder")
+	}
+
+	bytesData := typeutil.Int8VectorToBytes(data)
+	length := len(data) / dim
+
+	builder.Reserve(length)
+	for i := 0; i < length; i++ {
+		builder.Append(bytesData[i*dim : (i+1)*dim])
+	}
+
+	return nil
+}
+
+// AddSparseFloatVectorToPayload adds the rows of sparse float vector, every row is a variable-length
+// list of index/value pairs, see typeutil.CreateSparseFloatRow.
+func (w *NativePayloadWriter) AddSparseFloatVectorToPayload(rows [][]byte) error {
+	if w.finished {
+		return errors.New("can't append data to finished writer")
+	}
+
+	if len(rows) == 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+
+	if err := typeutil.ValidateSparseFloatRows(rows...); err != nil {
+		return err
+	}
+
+	builder, ok := w.builder.(*array.BinaryBuilder)
+	if !ok {
+		return errors.New("failed to cast ArrayBuilder")
+	}
+
+	builder.AppendValues(rows, nil)
+
+	return nil
+}
+
+// AddNullableDataToPayload appends the rows of a nullable scalar field, validData marks the rows which are not null
+// and all the rows are valid if it's nil.
+func (w *NativePayloadWriter) AddNullableDataToPayload(data any, validData []bool) error {
+	if w.finished {
+		return errors.New("can't append data to finished writer")
+	}
+
+	switch val := data.(type) {
+	case []bool:
+		return appendNullableValues(w.builder, val, validData)
+	case []int8:
+		return appendNullableValues(w.builder, val, validData)
+	case []int16:
+		return appendNullableValues(w.builder, val, validData)
+	case []int32:
+		return appendNullableValues(w.builder, val, validData)
+	case []int64:
+		return appendNullableValues(w.builder, val, validData)
+	case []float32:
+		return appendNullableValues(w.builder, val, validData)
+	case []float64:
+		return appendNullableValues(w.builder, val, validData)
+	case []string:
+		return appendNullableValues(w.builder, val, validData)
+	case [][]byte:
+		return appendNullableValues(w.builder, val, validData)
+	case []*schemapb.ScalarField:
+		rows := make([][]byte, len(val))
+		for i, row := range val {
+			if validData != nil && !validData[i] {
+				continue
+			}
+			bytes, err := proto.Marshal(row)
+			if err != nil {
+				return errors.New("Marshal ListValue failed")
+			}
+			rows[i] = bytes
+		}
+		return appendNullableValues(w.builder, rows, validData)
+	default:
+		return fmt.Errorf("unsupported nullable data type %T", data)
+	}
+}
+
+func appendNullableValues[T any](builder array.Builder, data []T, validData []bool) error {
+	if len(data) == 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+	if validData != nil && len(validData) != len(data) {
+		return fmt.Errorf("the length of valid data %d doesn't match the rows %d", len(validData), len(data))
+	}
+
+	typedBuilder, ok := builder.(interface {
+		AppendValues([]T, []bool)
+	})
+	if !ok {
+		return errors.New("failed to cast ArrayBuilder")
+	}
+	typedBuilder.AppendValues(data, validData)
+
+	return nil
+}
+
 func (w *NativePayloadWriter) FinishPayloadWriter() error {
 	if w.finished {
 		return errors.New("can't reuse a finished writer")
@@ -451,17 +607,19 @@ func (w *NativePayloadWriter) FinishPayloadWriter() error {
 
 	w.finished = true
 
+	w.flushedRows += w.builder.Len()
+	data := w.builder.NewArray()
+	defer data.Release()
+
+	// the column is optional only if it has null rows, payloads without null rows are written as before
 	field := arrow.Field{
-		Name: "val",
-		Type: w.arrowType,
+		Name:     "val",
+		Type:     w.arrowType,
+		Nullable: data.NullN() > 0,
 	}
 	schema := arrow.NewSchema([]arrow.Field{
 		field,
 	}, nil)
-
-	w.flushedRows += w.builder.Len()
-	data := w.builder.NewArray()
-	defer data.Release()
 	column := arrow.NewColumnFromArr(field, data)
 	defer column.Release()
 
@@ -535,10 +693,17 @@ func milvusDataTypeToArrowType(dataType schemapb.DataType, dim int) arrow.DataTy
 		return &arrow.FixedSizeBinaryType{
 			ByteWidth: dim / 8,
 		}
-	case schemapb.DataType_Float16Vector:
+	case schemapb.DataType_Float16Vector, typeutil.BFloat16Vector:
 		return &arrow.FixedSizeBinaryType{
 			ByteWidth: dim * 2,
 		}
+	case typeutil.Int8Vector:
+		return &arrow.FixedSizeBinaryType{
+			ByteWidth: dim,
+		}
+	case typeutil.SparseFloatVector:
+		// rows of sparse float vector have variable length, dim is ignored
+		return &arrow.BinaryType{}
 	default:
 		panic("unsupported data type")
 	}
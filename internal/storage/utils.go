@@ -275,6 +275,26 @@ func readFloat16Vectors(blobReaders []io.Reader, dim int) []byte {
 	return ret
 }
 
+func readBFloat16Vectors(blobReaders []io.Reader, dim int) []byte {
+	ret := make([]byte, 0)
+	for _, r := range blobReaders {
+		v := make([]byte, dim*2)
+		ReadBinary(r, &v, typeutil.BFloat16Vector)
+		ret = append(ret, v...)
+	}
+	return ret
+}
+
+func readInt8Vectors(blobReaders []io.Reader, dim int) []int8 {
+	ret := make([]int8, 0)
+	for _, r := range blobReaders {
+		v := make([]int8, dim)
+		ReadBinary(r, &v, typeutil.Int8Vector)
+		ret = append(ret, v...)
+	}
+	return ret
+}
+
 func readBoolArray(blobReaders []io.Reader) []bool {
 	ret := make([]bool, 0)
 	for _, r := range blobReaders {
@@ -385,6 +405,32 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 				Dim:  dim,
 			}
 
+		case typeutil.BFloat16Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			vecs := readBFloat16Vectors(blobReaders, dim)
+			idata.Data[field.FieldID] = &BFloat16VectorFieldData{
+				Data: vecs,
+				Dim:  dim,
+			}
+
+		case typeutil.Int8Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			vecs := readInt8Vectors(blobReaders, dim)
+			idata.Data[field.FieldID] = &Int8VectorFieldData{
+				Data: vecs,
+				Dim:  dim,
+			}
+
 		case schemapb.DataType_BinaryVector:
 			var dim int
 			dim, err := GetDimFromParams(field.TypeParams)
@@ -456,6 +502,9 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	for _, field := range msg.FieldsData {
 		srcFields[field.FieldId] = field
 	}
+	if err := fillAddedFields(srcFields, collSchema, int(msg.NRows())); err != nil {
+		return nil, err
+	}
 
 	idata = &InsertData{
 		Data: make(map[FieldID]FieldData),
@@ -464,6 +513,21 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	}
 
 	for _, field := range collSchema.Fields {
+		if srcField, ok := srcFields[field.FieldID]; ok && typeutil.IsNullFieldData(srcField) {
+			// all the rows of the field are null
+			fieldData, err := NewFieldData(field.DataType, field)
+			if err != nil {
+				return nil, err
+			}
+			for i := uint64(0); i < msg.NRows(); i++ {
+				if err := fieldData.AppendRow(nil); err != nil {
+					return nil, err
+				}
+			}
+			idata.Data[field.FieldID] = fieldData
+			continue
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			dim, err := GetDimFromParams(field.TypeParams)
@@ -516,6 +580,51 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			idata.Data[field.FieldID] = fieldData
 
+		case typeutil.BFloat16Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := srcFields[field.FieldID].GetVectors().GetBfloat16Vector()
+
+			fieldData := &BFloat16VectorFieldData{
+				Data: make([]byte, 0, len(srcData)),
+				Dim:  dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
+		case typeutil.Int8Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := typeutil.BytesToInt8Vector(srcFields[field.FieldID].GetVectors().GetInt8Vector())
+
+			fieldData := &Int8VectorFieldData{
+				Data: make([]int8, 0, len(srcData)),
+				Dim:  dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
+		case typeutil.SparseFloatVector:
+			srcData := typeutil.GetSparseFloatVectorRows(srcFields[field.FieldID])
+
+			fieldData := &SparseFloatVectorFieldData{
+				Data: make([][]byte, 0, len(srcData)),
+				Dim:  int(typeutil.SparseFloatRowsDim(srcData)),
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
 		case schemapb.DataType_Bool:
 			srcData := srcFields[field.FieldID].GetScalars().GetBoolData().GetData()
 
@@ -639,6 +748,26 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	return idata, nil
 }
 
+// fillAddedFields fills the user fields of schema missing from srcFields, which are the fields added to the
+// collection after the msg was produced. Their rows get the default value, or null if the field has none.
+func fillAddedFields(srcFields map[FieldID]*schemapb.FieldData, collSchema *schemapb.CollectionSchema, numRows int) error {
+	for _, field := range collSchema.GetFields() {
+		if _, ok := srcFields[field.GetFieldID()]; ok || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		if field.GetDefaultValue() == nil && typeutil.IsFieldNullable(field) {
+			srcFields[field.GetFieldID()] = typeutil.NewNullFieldData(field)
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, numRows)
+		if err != nil {
+			return err
+		}
+		srcFields[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
 func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
 	if msg.IsRowBased() {
 		return RowBasedInsertMsgToInsertData(msg, schema)
@@ -654,6 +783,7 @@ func mergeBoolField(data *InsertData, fid FieldID, field *BoolFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*BoolFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -665,6 +795,7 @@ func mergeInt8Field(data *InsertData, fid FieldID, field *Int8FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int8FieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -676,6 +807,7 @@ func mergeInt16Field(data *InsertData, fid FieldID, field *Int16FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int16FieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -687,6 +819,7 @@ func mergeInt32Field(data *InsertData, fid FieldID, field *Int32FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int32FieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -698,6 +831,7 @@ func mergeInt64Field(data *InsertData, fid FieldID, field *Int64FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int64FieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -709,6 +843,7 @@ func mergeFloatField(data *InsertData, fid FieldID, field *FloatFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*FloatFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -720,6 +855,7 @@ func mergeDoubleField(data *InsertData, fid FieldID, field *DoubleFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*DoubleFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -731,6 +867,7 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*StringFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -743,6 +880,7 @@ func mergeArrayField(data *InsertData, fid FieldID, field *ArrayFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*ArrayFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -754,6 +892,7 @@ func mergeJSONField(data *InsertData, fid FieldID, field *JSONFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*JSONFieldData)
+	fieldData.ValidData = mergeValidData(fieldData.ValidData, len(fieldData.Data), field.ValidData, len(field.Data))
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
@@ -793,6 +932,45 @@ func mergeFloat16VectorField(data *InsertData, fid FieldID, field *Float16Vector
 	fieldData.Data = append(fieldData.Data, field.Data...)
 }
 
+func mergeBFloat16VectorField(data *InsertData, fid FieldID, field *BFloat16VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &BFloat16VectorFieldData{
+			Data: nil,
+			Dim:  field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*BFloat16VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+}
+
+func mergeInt8VectorField(data *InsertData, fid FieldID, field *Int8VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &Int8VectorFieldData{
+			Data: nil,
+			Dim:  field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*Int8VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+}
+
+func mergeSparseFloatVectorField(data *InsertData, fid FieldID, field *SparseFloatVectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &SparseFloatVectorFieldData{
+			Data: nil,
+			Dim:  field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*SparseFloatVectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	if field.Dim > fieldData.Dim {
+		fieldData.Dim = field.Dim
+	}
+}
+
 // MergeFieldData merge field into data.
 func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	if field == nil {
@@ -825,6 +1003,12 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeFloatVectorField(data, fid, field)
 	case *Float16VectorFieldData:
 		mergeFloat16VectorField(data, fid, field)
+	case *BFloat16VectorFieldData:
+		mergeBFloat16VectorField(data, fid, field)
+	case *Int8VectorFieldData:
+		mergeInt8VectorField(data, fid, field)
+	case *SparseFloatVectorFieldData:
+		mergeSparseFloatVectorField(data, fid, field)
 	}
 }
 
@@ -1150,6 +1334,18 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *Float16VectorFieldData:
+			fieldData = typeutil.NewBytesVectorFieldData(schemapb.DataType_Float16Vector, "", int64(rawData.Dim), rawData.Data)
+			fieldData.FieldId = fieldID
+		case *BFloat16VectorFieldData:
+			fieldData = typeutil.NewBytesVectorFieldData(typeutil.BFloat16Vector, "", int64(rawData.Dim), rawData.Data)
+			fieldData.FieldId = fieldID
+		case *Int8VectorFieldData:
+			fieldData = typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "", int64(rawData.Dim), typeutil.Int8VectorToBytes(rawData.Data))
+			fieldData.FieldId = fieldID
+		case *SparseFloatVectorFieldData:
+			fieldData = typeutil.NewSparseFloatVectorFieldData("", rawData.Data)
+			fieldData.FieldId = fieldID
 		default:
 			return insertRecord, fmt.Errorf("unsupported data type when transter storage.InsertData to internalpb.InsertRecord")
 		}
@@ -1175,7 +1371,33 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 		NumRows: int64(msg.NumRows),
 	}
 
-	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	srcFields := make(map[FieldID]*schemapb.FieldData)
+	for _, fieldData := range msg.FieldsData {
+		srcFields[fieldData.GetFieldId()] = fieldData
+	}
+	// segcore requires every field of schema, the fields added or dropped after the msg was produced
+	// are aligned to the schema.
+	for _, fieldSchema := range schema.GetFields() {
+		if fieldSchema.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		fieldData, ok := srcFields[fieldSchema.GetFieldID()]
+		if ok && !typeutil.IsNullFieldData(fieldData) {
+			insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+			continue
+		}
+		var err error
+		if ok {
+			// segcore doesn't keep the validity of rows, null rows are inserted as zero values
+			fieldData, err = typeutil.GenZeroFieldData(fieldSchema, int(msg.NumRows))
+		} else {
+			fieldData, err = typeutil.GenDefaultFieldData(fieldSchema, int(msg.NumRows))
+		}
+		if err != nil {
+			return nil, err
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+	}
 
 	return insertRecord, nil
 }
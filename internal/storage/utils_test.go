@@ -932,6 +932,66 @@ func TestColumnBasedInsertMsgToInsertData(t *testing.T) {
 	}
 }
 
+func TestColumnBasedInsertMsgToInsertData_AddedFields(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim := 2, 2, 8, 2
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim)
+
+	// the fields added after the msg was produced
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      1000,
+		Name:         "added_default",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+	}, &schemapb.FieldSchema{
+		FieldID:    1001,
+		Name:       "added_nullable",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	})
+
+	idata, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{7, 7}, idata.Data[1000].(*Int32FieldData).Data)
+	assert.Equal(t, numRows, idata.Data[1001].RowNum())
+	assert.Nil(t, idata.Data[1001].GetRow(0))
+}
+
+func TestTransferInsertMsgToInsertRecord(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim := 2, 2, 8, 2
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim)
+
+	record, err := TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msg.FieldsData), len(record.GetFieldsData()))
+
+	// drop the first user field and add a new one
+	dropped := msg.FieldsData[0].GetFieldId()
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		if field.GetFieldID() != dropped {
+			fields = append(fields, field)
+		}
+	}
+	schema.Fields = append(fields, &schemapb.FieldSchema{
+		FieldID:      1000,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	})
+
+	record, err = TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msg.FieldsData), len(record.GetFieldsData()))
+	for _, fieldData := range record.GetFieldsData() {
+		assert.NotEqual(t, dropped, fieldData.GetFieldId())
+		if fieldData.GetFieldId() == 1000 {
+			assert.Equal(t, []int64{7, 7}, fieldData.GetScalars().GetLongData().GetData())
+		}
+	}
+}
+
 func TestInsertMsgToInsertData(t *testing.T) {
 	numRows, fVecDim, bVecDim, f16VecDim := 10, 8, 8, 8
 	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
@@ -0,0 +1,62 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+// ExplainRequest explains the filter expression of query, or of search if AnnsField is given,
+// the plan is created as the query or search does but it's never executed.
+type ExplainRequest struct {
+	DbName         string   `json:"db_name,omitempty"`
+	CollectionName string   `json:"collection_name,omitempty"`
+	PartitionNames []string `json:"partition_names,omitempty"`
+	Expr           string   `json:"expr,omitempty"`
+	AnnsField      string   `json:"anns_field,omitempty"`
+}
+
+// ExplainSegment is a loaded segment the predicate is evaluated on.
+type ExplainSegment struct {
+	SegmentID   int64 `json:"segment_id"`
+	PartitionID int64 `json:"partition_id"`
+	NumRows     int64 `json:"num_rows"`
+	// IndexName is the index of field used by the predicate, empty if raw data is scanned
+	IndexName string `json:"index_name,omitempty"`
+}
+
+// ExplainPredicate is a leaf predicate of the expression.
+type ExplainPredicate struct {
+	Expr        interface{}       `json:"expr"`
+	Fields      []string          `json:"fields"`
+	Selectivity float64           `json:"selectivity"`
+	Segments    []*ExplainSegment `json:"segments"`
+}
+
+// ExplainResponse is the parsed plan, the selectivity is estimated by the kind of predicates
+// since there is no statistics of field values.
+type ExplainResponse struct {
+	Status *commonpb.Status `json:"status,omitempty"`
+	// PlanType is search or query
+	PlanType      string              `json:"plan_type,omitempty"`
+	VectorField   string              `json:"vector_field,omitempty"`
+	Plan          interface{}         `json:"plan,omitempty"`
+	Predicates    []*ExplainPredicate `json:"predicates,omitempty"`
+	Selectivity   float64             `json:"selectivity"`
+	NumRows       int64               `json:"num_rows"`
+	EstimatedRows int64               `json:"estimated_rows"`
+}
@@ -37,7 +37,7 @@ import (
 // If Limit function return true, the request will be rejected.
 // Otherwise, the request will pass. Limit also returns limit of limiter.
 type Limiter interface {
-	Check(collectionID int64, rt internalpb.RateType, n int) error
+	Check(database string, user string, roles []string, collectionID int64, rt internalpb.RateType, n int) error
 }
 
 // Component is the interface all services implement
@@ -258,6 +258,21 @@ type ProxyComponent interface {
 	// UpdateStateCode updates state code for Proxy
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
+
+	// DropCollectionField drops a scalar field from an existing collection
+	DropCollectionField(ctx context.Context, request *rootcoordpb.DropCollectionFieldRequest) (*commonpb.Status, error)
+
+	// QueryStream sends the query results to sender batch by batch
+	QueryStream(ctx context.Context, request *milvuspb.QueryRequest, sender func(*milvuspb.QueryResults) error) error
+
+	// QueryIterator reads one page of query iterator, it returns the token of next page
+	QueryIterator(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, string, error)
+
+	// SearchIterator reads one page of search iterator, it returns the token of next page
+	SearchIterator(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, string, error)
+
+	// Explain returns the plan of filter expression without executing it
+	Explain(ctx context.Context, request *ExplainRequest) (*ExplainResponse, error)
 }
 
 type QueryNodeClient interface {
@@ -104,6 +104,17 @@ func initBlockData(collectionSchema *schemapb.CollectionSchema) BlockData {
 				Data: make([]float32, 0),
 				Dim:  dim,
 			}
+		case typeutil.SparseFloatVector:
+			blockData[schema.GetFieldID()] = &storage.SparseFloatVectorFieldData{
+				Data: make([][]byte, 0),
+			}
+		case schemapb.DataType_Float16Vector, typeutil.BFloat16Vector, typeutil.Int8Vector, schemapb.DataType_Array:
+			fieldData, err := storage.NewFieldData(schema.DataType, schema)
+			if err != nil {
+				log.Warn("Import util: failed to create field data", zap.String("fieldName", schema.GetName()), zap.Error(err))
+				return nil
+			}
+			blockData[schema.GetFieldID()] = fieldData
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			blockData[schema.GetFieldID()] = &storage.StringFieldData{
 				Data: make([]string, 0),
@@ -326,6 +337,20 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 
 				return nil
 			}
+		case typeutil.SparseFloatVector:
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				// a sparse row is a map from index to value, such as {"1": 0.5, "100": 0.3},
+				// or the lists of indices and values, such as {"indices": [1, 100], "values": [0.5, 0.3]}
+				mp, ok := obj.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("'%v' is not an object for sparse float vector field '%s'", obj, schema.GetName())
+				}
+				row, err := typeutil.ParseSparseFloatRow(mp)
+				if err != nil {
+					return fmt.Errorf("failed to parse value '%v' for sparse float vector field '%s', error: %w", obj, schema.GetName(), err)
+				}
+				return field.AppendRow(row)
+			}
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			validators[schema.GetFieldID()].isString = true
 
@@ -625,6 +650,16 @@ func getTypeName(dt schemapb.DataType) string {
 		return "BinaryVector"
 	case schemapb.DataType_FloatVector:
 		return "FloatVector"
+	case schemapb.DataType_Float16Vector:
+		return "Float16Vector"
+	case typeutil.BFloat16Vector:
+		return "BFloat16Vector"
+	case typeutil.Int8Vector:
+		return "Int8Vector"
+	case typeutil.SparseFloatVector:
+		return "SparseFloatVector"
+	case schemapb.DataType_Array:
+		return "Array"
 	case schemapb.DataType_JSON:
 		return "JSON"
 	default:
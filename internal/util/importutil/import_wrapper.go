@@ -36,11 +36,12 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
-	CSVFileExt   = ".csv"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	CSVFileExt     = ".csv"
+	ParquetFileExt = ".parquet"
 
-	// parsers read JSON/Numpy/CSV files buffer by buffer, this limitation is to define the buffer size.
+	// parsers read JSON/Numpy/CSV/Parquet files buffer by buffer, this limitation is to define the buffer size.
 	ReadBufferSize = 16 * 1024 * 1024 // 16MB
 
 	// this limitation is to avoid this OOM risk:
@@ -187,21 +188,22 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file, numpy file and csv file
-		if fileType != JSONFileExt && fileType != NumpyFileExt && fileType != CSVFileExt {
+		// only allow json file, numpy file, csv file and parquet file
+		if fileType != JSONFileExt && fileType != NumpyFileExt && fileType != CSVFileExt && fileType != ParquetFileExt {
 			log.Warn("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && (fileType == JSONFileExt || fileType == CSVFileExt) {
+		// a parquet file holds all the fields, it is imported file by file like the row-based files
+		if i == 0 && (fileType == JSONFileExt || fileType == CSVFileExt || fileType == ParquetFileExt) {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json and csv type, column-based only support numpy type
+		// row-based only support json, csv and parquet type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt && fileType != CSVFileExt {
+			if fileType != JSONFileExt && fileType != CSVFileExt && fileType != ParquetFileExt {
 				log.Warn("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -285,6 +287,12 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 					log.Warn("import wrapper: failed to parse row-based csv file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+			} else if fileType == ParquetFileExt {
+				err = p.parseParquet(filePath, options.OnlyValidate)
+				if err != nil {
+					log.Warn("import wrapper: failed to parse parquet file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
 			} // no need to check else, since the fileValidation() already do this
 
 			// trigger gc after each file finished
@@ -514,6 +522,44 @@ func (p *ImportWrapper) parseRowBasedCSV(filePath string, onlyValidate bool) err
 	return nil
 }
 
+func (p *ImportWrapper) parseParquet(filePath string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+	// parquet parser reads the file by ranges, no need to open a reader here
+	parser, err := NewParquetParser(p.ctx, p.collectionInfo, p.chunkManager, p.updateProgressPercent)
+	if err != nil {
+		return err
+	}
+
+	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if onlyValidate {
+		flushFunc = func(fields BlockData, shardID int, partitionID int64) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields BlockData, shardID int, partitionID int64) error {
+			filePaths := []string{filePath}
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", filePaths)
+			return p.flushFunc(fields, shardID, partitionID)
+		}
+	}
+
+	consumer, err := NewParquetBlockConsumer(p.ctx, p.collectionInfo, p.rowIDAllocator, p.binlogSize, flushFunc)
+	if err != nil {
+		return err
+	}
+
+	err = parser.Parse(filePath, consumer)
+	if err != nil {
+		return err
+	}
+	p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
+
+	tr.Elapse("parsed")
+	return nil
+}
+
 // flushFunc is the callback function for parsers generate segment and save binlog files
 func (p *ImportWrapper) flushFunc(fields BlockData, shardID int, partitionID int64) error {
 	logFields := []zap.Field{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -327,7 +328,7 @@ func (p *NumpyParser) validateHeader(columnReader *NumpyColumnReader) error {
 				shape[1]*8, columnReader.fieldName, columnReader.dimension)
 		}
 	} else {
-		// JSON field and VARCHAR field are using string type numpy
+		// JSON field, VARCHAR field and sparse float vector field are using string type numpy
 		// legal input if columnReader.dataType is JSON and elementType is VARCHAR
 		if elementType != schemapb.DataType_VarChar && columnReader.dataType != schemapb.DataType_JSON {
 			if elementType != columnReader.dataType {
@@ -570,6 +571,39 @@ func (p *NumpyParser) readData(columnReader *NumpyColumnReader, rowCount int) (s
 		return &storage.JSONFieldData{
 			Data: byteArr,
 		}, nil
+	case typeutil.SparseFloatVector:
+		// sparse float vector field read data from string array numpy, each string is a JSON object of a row,
+		// such as {"1": 0.5, "100": 0.3} or {"indices": [1, 100], "values": [0.5, 0.3]}
+		data, err := columnReader.reader.ReadString(rowCount)
+		if err != nil {
+			log.Warn("Numpy parser: failed to read sparse float vector string array", zap.Error(err))
+			return nil, fmt.Errorf("failed to read sparse float vector string array: %s", err.Error())
+		}
+
+		fieldData := &storage.SparseFloatVectorFieldData{
+			Data: make([][]byte, 0, len(data)),
+		}
+		for _, str := range data {
+			var obj map[string]interface{}
+			decoder := json.NewDecoder(strings.NewReader(str))
+			decoder.UseNumber()
+			err = decoder.Decode(&obj)
+			if err == nil {
+				var row []byte
+				row, err = typeutil.ParseSparseFloatRow(obj)
+				if err == nil {
+					err = fieldData.AppendRow(row)
+				}
+			}
+			if err != nil {
+				log.Warn("Numpy parser: illegal string value for sparse float vector field",
+					zap.String("value", str), zap.String("FieldName", columnReader.fieldName), zap.Error(err))
+				return nil, fmt.Errorf("failed to parse value '%v' for sparse float vector field '%s', error: %w",
+					str, columnReader.fieldName, err)
+			}
+		}
+
+		return fieldData, nil
 	case schemapb.DataType_BinaryVector:
 		data, err := columnReader.reader.ReadUint8(rowCount * (columnReader.dimension / 8))
 		if err != nil {
@@ -701,6 +735,10 @@ func (p *NumpyParser) appendFunc(schema *schemapb.FieldSchema) func(src storage.
 			arr.Data = append(arr.Data, src.GetRow(n).([]byte))
 			return nil
 		}
+	case typeutil.SparseFloatVector:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			return target.AppendRow(src.GetRow(n))
+		}
 	default:
 		return nil
 	}
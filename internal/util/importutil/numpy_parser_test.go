@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func createLocalChunkManager(t *testing.T) storage.ChunkManager {
@@ -654,6 +655,61 @@ func Test_NumpyParserReadData(t *testing.T) {
 	})
 }
 
+func Test_NumpyParserReadSparseFloatVector(t *testing.T) {
+	ctx := context.Background()
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.NoError(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "FieldInt64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "FieldSparseFloatVector", DataType: typeutil.SparseFloatVector},
+		},
+	}
+	cm := createLocalChunkManager(t)
+	flushFunc := func(fields BlockData, shardID int, partID int64) error {
+		return nil
+	}
+	collectionInfo, err := NewCollectionInfo(schema, 2, []int64{1})
+	assert.NoError(t, err)
+	parser, err := NewNumpyParser(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 100, cm, flushFunc, nil)
+	assert.NoError(t, err)
+
+	readFunc := func(data []string) (storage.FieldData, error) {
+		filePath := TempFilesPath + "FieldSparseFloatVector.npy"
+		content, err := CreateNumpyData(data)
+		assert.NoError(t, err)
+		err = cm.Write(ctx, filePath, content)
+		assert.NoError(t, err)
+
+		readers, err := parser.createReaders([]string{filePath})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(readers))
+		defer closeReaders(readers)
+		return parser.readData(readers[0], len(data))
+	}
+
+	fieldData, err := readFunc([]string{`{"10": 0.5, "1": 0.3}`, `{"indices": [2], "values": [0.1]}`, `{}`})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, fieldData.RowNum())
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.3, 0.5}), fieldData.GetRow(0))
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{2}, []float32{0.1}), fieldData.GetRow(1))
+	assert.Equal(t, 11, fieldData.(*storage.SparseFloatVectorFieldData).Dim)
+
+	appendFunc := parser.appendFunc(schema.Fields[1])
+	target := &storage.SparseFloatVectorFieldData{}
+	assert.NoError(t, appendFunc(fieldData, 1, target))
+	assert.Equal(t, 1, target.RowNum())
+	assert.Equal(t, 3, target.Dim)
+
+	_, err = readFunc([]string{`{"a": 0.5}`})
+	assert.Error(t, err)
+	_, err = readFunc([]string{`[1, 2]`})
+	assert.Error(t, err)
+}
+
 func Test_NumpyParserPrepareAppendFunctions(t *testing.T) {
 	parser := createNumpyParser(t)
 
@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+type ParquetBlockHandler interface {
+	Handle(block BlockData) error
+}
+
+// ParquetBlockConsumer is parquet format consumer class, it splits the blocks converted from
+// record batches into shards
+type ParquetBlockConsumer struct {
+	ctx            context.Context        // for canceling parse process
+	collectionInfo *CollectionInfo        // collection details including schema
+	rowIDAllocator *allocator.IDAllocator // autoid allocator
+	rowCounter     int64                  // how many rows have been consumed
+	shardsData     []ShardData            // in-memory shards data
+	blockSize      int64                  // maximum size of a read block(unit:byte)
+	autoIDRange    []int64                // auto-generated id range, for example: [1, 10, 20, 25] means id from 1 to 10 and 20 to 25
+
+	callFlushFunc ImportFlushFunc // call back function to flush segment
+}
+
+func NewParquetBlockConsumer(ctx context.Context,
+	collectionInfo *CollectionInfo,
+	idAlloc *allocator.IDAllocator,
+	blockSize int64,
+	flushFunc ImportFlushFunc,
+) (*ParquetBlockConsumer, error) {
+	if collectionInfo == nil {
+		log.Warn("Parquet block consumer: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+
+	if idAlloc == nil {
+		log.Warn("Parquet block consumer: id allocator is nil")
+		return nil, errors.New("id allocator is nil")
+	}
+
+	if flushFunc == nil {
+		log.Warn("Parquet block consumer: flush function is nil")
+		return nil, errors.New("flush function is nil")
+	}
+
+	v := &ParquetBlockConsumer{
+		ctx:            ctx,
+		collectionInfo: collectionInfo,
+		rowIDAllocator: idAlloc,
+		rowCounter:     0,
+		shardsData:     make([]ShardData, 0, collectionInfo.ShardNum),
+		blockSize:      blockSize,
+		autoIDRange:    make([]int64, 0),
+		callFlushFunc:  flushFunc,
+	}
+
+	for i := 0; i < int(collectionInfo.ShardNum); i++ {
+		shardData := initShardData(collectionInfo.Schema, collectionInfo.PartitionIDs)
+		if shardData == nil {
+			log.Warn("Parquet block consumer: failed to initialize in-memory segment data", zap.Int("shardID", i))
+			return nil, fmt.Errorf("failed to initialize in-memory segment data for shard id %d", i)
+		}
+		v.shardsData = append(v.shardsData, shardData)
+	}
+
+	return v, nil
+}
+
+func (v *ParquetBlockConsumer) IDRange() []int64 {
+	return v.autoIDRange
+}
+
+func (v *ParquetBlockConsumer) RowCount() int64 {
+	return v.rowCounter
+}
+
+func (v *ParquetBlockConsumer) Handle(block BlockData) error {
+	// if block is nil, that means read to end of file, force flush all data
+	if block == nil {
+		err := tryFlushBlocks(v.ctx, v.shardsData, v.collectionInfo.Schema, v.callFlushFunc, v.blockSize, MaxTotalSizeInMemory, true)
+		log.Info("Parquet block consumer finished")
+		return err
+	}
+
+	// block is not nil, flush in necessary:
+	// 1. data block size larger than v.blockSize will be flushed
+	// 2. total data size exceeds MaxTotalSizeInMemory, the largest data block will be flushed
+	err := tryFlushBlocks(v.ctx, v.shardsData, v.collectionInfo.Schema, v.callFlushFunc, v.blockSize, MaxTotalSizeInMemory, false)
+	if err != nil {
+		log.Warn("Parquet block consumer: try flush data but failed", zap.Error(err))
+		return fmt.Errorf("try flush data but failed, error: %w", err)
+	}
+
+	rowCount := 0
+	for _, fieldData := range block {
+		rowCount = fieldData.RowNum()
+		break
+	}
+	if rowCount == 0 {
+		return nil
+	}
+
+	// prepare autoid, no matter int64 or varchar pk, we always generate autoid since the hidden field RowIDField requires them
+	rowIDBegin, rowIDEnd, err := v.rowIDAllocator.Alloc(uint32(rowCount))
+	if err != nil {
+		log.Warn("Parquet block consumer: failed to alloc row ID", zap.Int("rowCount", rowCount), zap.Error(err))
+		return fmt.Errorf("failed to alloc %d rows ID, error: %w", rowCount, err)
+	}
+	if rowIDEnd-rowIDBegin != int64(rowCount) {
+		log.Warn("Parquet block consumer: try to generate row IDs but allocated ids are not enough",
+			zap.Int("count", rowCount), zap.Int64("generated", rowIDEnd-rowIDBegin))
+		return fmt.Errorf("try to generate %d row IDs but only %d IDs were allocated", rowCount, rowIDEnd-rowIDBegin)
+	}
+
+	primaryKey := v.collectionInfo.PrimaryKey
+	if primaryKey.GetAutoID() {
+		log.Info("Parquet block consumer: auto-generate primary keys", zap.Int64("begin", rowIDBegin), zap.Int64("end", rowIDEnd))
+		if primaryKey.GetDataType() != schemapb.DataType_Int64 {
+			log.Warn("Parquet block consumer: string type primary key connot be auto-generated")
+			return errors.New("string type primary key connot be auto-generated")
+		}
+
+		primaryDataArr := &storage.Int64FieldData{
+			Data: make([]int64, 0, rowCount),
+		}
+		for i := rowIDBegin; i < rowIDEnd; i++ {
+			primaryDataArr.Data = append(primaryDataArr.Data, i)
+		}
+		block[primaryKey.GetFieldID()] = primaryDataArr
+		v.autoIDRange = append(v.autoIDRange, rowIDBegin, rowIDEnd)
+	}
+
+	primaryData, ok := block[primaryKey.GetFieldID()]
+	if !ok || primaryData.RowNum() != rowCount {
+		log.Warn("Parquet block consumer: primary key field is not provided", zap.String("keyName", primaryKey.GetName()))
+		return fmt.Errorf("primary key '%s' field data is not provided", primaryKey.GetName())
+	}
+
+	// split data into shards
+	for i := 0; i < rowCount; i++ {
+		rowNumber := v.rowCounter + int64(i)
+
+		// hash to a shard number and partition
+		shard, err := pkToShard(primaryData.GetRow(i), uint32(v.collectionInfo.ShardNum))
+		if err != nil {
+			return err
+		}
+
+		partitionID, err := v.hashToPartition(block, i)
+		if err != nil {
+			return err
+		}
+
+		rowIDField := v.shardsData[shard][partitionID][common.RowIDField].(*storage.Int64FieldData)
+		rowIDField.Data = append(rowIDField.Data, rowIDBegin+int64(i))
+
+		for _, schema := range v.collectionInfo.Schema.GetFields() {
+			srcData := block[schema.GetFieldID()]
+			targetData := v.shardsData[shard][partitionID][schema.GetFieldID()]
+			if srcData == nil || targetData == nil {
+				log.Warn("Parquet block consumer: cannot append data since source or target field data is nil",
+					zap.String("fieldName", schema.GetName()),
+					zap.Bool("sourceNil", srcData == nil), zap.Bool("targetNil", targetData == nil))
+				return fmt.Errorf("cannot append data for field '%s', the column is not provided", schema.GetName())
+			}
+			if err := targetData.AppendRow(srcData.GetRow(i)); err != nil {
+				log.Warn("Parquet block consumer: failed to append value for field at the row",
+					zap.String("fieldName", schema.GetName()), zap.Int64("rowNumber", rowNumber), zap.Error(err))
+				return fmt.Errorf("failed to append value for field '%s' at the row %d, error: %w",
+					schema.GetName(), rowNumber, err)
+			}
+		}
+	}
+
+	v.rowCounter += int64(rowCount)
+	return nil
+}
+
+// hashToPartition hash partition key to get an partition ID, return the first partition ID if no partition key exist
+// CollectionInfo ensures only one partition ID in the PartitionIDs if no partition key exist
+func (v *ParquetBlockConsumer) hashToPartition(block BlockData, rowNumber int) (int64, error) {
+	if v.collectionInfo.PartitionKey == nil {
+		if len(v.collectionInfo.PartitionIDs) != 1 {
+			return 0, fmt.Errorf("collection '%s' partition list is empty", v.collectionInfo.Schema.Name)
+		}
+		// no partition key, directly return the target partition id
+		return v.collectionInfo.PartitionIDs[0], nil
+	}
+
+	partitionKeyID := v.collectionInfo.PartitionKey.GetFieldID()
+	value := block[partitionKeyID].GetRow(rowNumber)
+	index, err := pkToShard(value, uint32(len(v.collectionInfo.PartitionIDs)))
+	if err != nil {
+		return 0, err
+	}
+
+	return v.collectionInfo.PartitionIDs[index], nil
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_ParquetBlockConsumerNew(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields BlockData, shardID int, partID int64) error {
+		return nil
+	}
+
+	consumer, err := NewParquetBlockConsumer(ctx, nil, nil, 16, nil)
+	assert.Error(t, err)
+	assert.Nil(t, consumer)
+
+	collectionInfo, err := NewCollectionInfo(parquetSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	consumer, err = NewParquetBlockConsumer(ctx, collectionInfo, nil, 16, flushFunc)
+	assert.Error(t, err)
+	assert.Nil(t, consumer)
+
+	consumer, err = NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 16, nil)
+	assert.Error(t, err)
+	assert.Nil(t, consumer)
+
+	consumer, err = NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 16, flushFunc)
+	assert.NoError(t, err)
+	assert.NotNil(t, consumer)
+	assert.Equal(t, 2, len(consumer.shardsData))
+	assert.Empty(t, consumer.IDRange())
+	assert.Equal(t, int64(0), consumer.RowCount())
+}
+
+func Test_ParquetBlockConsumerHandle(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				AutoID:       true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:        101,
+				Name:           "partition_key",
+				IsPartitionKey: true,
+				DataType:       schemapb.DataType_VarChar,
+				TypeParams:     []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+		},
+	}
+	collectionInfo, err := NewCollectionInfo(schema, 2, []int64{1, 2})
+	assert.NoError(t, err)
+
+	createBlock := func() BlockData {
+		return BlockData{
+			101: &storage.StringFieldData{Data: []string{"a", "b", "c"}},
+			102: &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 4, 5, 6}, Dim: 2},
+		}
+	}
+
+	t.Run("succeed", func(t *testing.T) {
+		flushedRowCount := 0
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			assert.Contains(t, []int64{1, 2}, partID)
+			assert.Equal(t, fields[common.RowIDField].RowNum(), fields[100].RowNum())
+			flushedRowCount += fields[100].RowNum()
+			return nil
+		}
+		consumer, err := NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 1024, flushFunc)
+		assert.NoError(t, err)
+
+		err = consumer.Handle(createBlock())
+		assert.NoError(t, err)
+		err = consumer.Handle(createBlock())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), consumer.RowCount())
+		assert.Equal(t, 4, len(consumer.IDRange()))
+
+		err = consumer.Handle(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, flushedRowCount)
+	})
+
+	t.Run("failed to alloc id", func(t *testing.T) {
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return nil
+		}
+		consumer, err := NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, errors.New("error")), 1024, flushFunc)
+		assert.NoError(t, err)
+		err = consumer.Handle(createBlock())
+		assert.Error(t, err)
+	})
+
+	t.Run("field not provided", func(t *testing.T) {
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return nil
+		}
+		consumer, err := NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 1024, flushFunc)
+		assert.NoError(t, err)
+		block := createBlock()
+		delete(block, 102)
+		err = consumer.Handle(block)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to flush", func(t *testing.T) {
+		flushFunc := func(fields BlockData, shardID int, partID int64) error {
+			return errors.New("error")
+		}
+		consumer, err := NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 1024, flushFunc)
+		assert.NoError(t, err)
+		err = consumer.Handle(createBlock())
+		assert.NoError(t, err)
+		err = consumer.Handle(nil)
+		assert.Error(t, err)
+	})
+}
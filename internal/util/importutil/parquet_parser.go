@@ -0,0 +1,762 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ParquetFileReader reads a parquet file through the ChunkManager by ranges, so that only the footer
+// and the required column chunks are fetched from the storage, instead of the whole file.
+type ParquetFileReader struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+	filePath     string
+	fileSize     int64
+	offset       int64
+}
+
+func NewParquetFileReader(ctx context.Context, chunkManager storage.ChunkManager, filePath string) (*ParquetFileReader, error) {
+	size, err := chunkManager.Size(ctx, filePath)
+	if err != nil {
+		log.Warn("Parquet parser: failed to get file size", zap.String("filePath", filePath), zap.Error(err))
+		return nil, fmt.Errorf("failed to get file size of '%s', error: %w", filePath, err)
+	}
+
+	return &ParquetFileReader{
+		ctx:          ctx,
+		chunkManager: chunkManager,
+		filePath:     filePath,
+		fileSize:     size,
+	}, nil
+}
+
+// ReadAt implements io.ReaderAt, the range beyond the file end is not requested from the storage
+func (r *ParquetFileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 || off >= r.fileSize {
+		return 0, io.EOF
+	}
+
+	length := int64(len(p))
+	if off+length > r.fileSize {
+		length = r.fileSize - off
+	}
+	data, err := r.chunkManager.ReadAt(r.ctx, r.filePath, off, length)
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Read implements io.Reader
+func (r *ParquetFileReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.offset)
+	r.offset += int64(n)
+	return n, err
+}
+
+// Seek implements io.Seeker, the parquet reader seeks to the end to get the file size
+func (r *ParquetFileReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.fileSize + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if abs < 0 {
+		return 0, fmt.Errorf("negative position %d", abs)
+	}
+	r.offset = abs
+	return abs, nil
+}
+
+// ParquetColumnConverter converts the values of a parquet column to rows of a field
+type ParquetColumnConverter struct {
+	fieldName   string                                    // name of the column
+	columnIndex int                                       // index of the column in the parquet schema
+	schema      *schemapb.FieldSchema                     // target field, nil for the column goes into dynamic field
+	convertFunc func(arr arrow.Array, i int) (any, error) // convert a value to the row type of storage.FieldData
+	marshalFunc func(arr arrow.Array, i int) any          // only for the column goes into dynamic field
+}
+
+type ParquetParser struct {
+	ctx                context.Context      // for canceling parse process
+	collectionInfo     *CollectionInfo      // collection details including schema
+	chunkManager       storage.ChunkManager // storage interfaces to read the file
+	bufRowCount        int                  // max rows in a record batch
+	updateProgressFunc func(percent int64)  // update working progress percent value
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context,
+	collectionInfo *CollectionInfo,
+	chunkManager storage.ChunkManager,
+	updateProgressFunc func(percent int64),
+) (*ParquetParser, error) {
+	if collectionInfo == nil {
+		log.Warn("Parquet parser: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+
+	if chunkManager == nil {
+		log.Warn("Parquet parser: chunk manager pointer is nil")
+		return nil, errors.New("chunk manager pointer is nil")
+	}
+
+	parser := &ParquetParser{
+		ctx:                ctx,
+		collectionInfo:     collectionInfo,
+		chunkManager:       chunkManager,
+		bufRowCount:        1024,
+		updateProgressFunc: updateProgressFunc,
+	}
+	parser.SetBufSize()
+	return parser, nil
+}
+
+// SetBufSize limits the row count of a record batch so that a batch doesn't exceed ReadBufferSize
+func (p *ParquetParser) SetBufSize() {
+	sizePerRecord, _ := typeutil.EstimateSizePerRecord(p.collectionInfo.Schema)
+	if sizePerRecord <= 0 {
+		return
+	}
+
+	bufRowCount := ReadBufferSize / sizePerRecord
+	if bufRowCount <= 0 {
+		bufRowCount = 1
+	}
+	log.Info("Parquet parser: reset bufRowCount", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufRowCount", bufRowCount))
+	p.bufRowCount = bufRowCount
+}
+
+// Parse reads the parquet file batch by batch, the row groups are read in sequence and
+// each batch is converted to a BlockData and passed to the handler
+func (p *ParquetParser) Parse(filePath string, handle ParquetBlockHandler) error {
+	if handle == nil {
+		log.Warn("Parquet parser: parquet parse handle is nil")
+		return errors.New("parquet parse handle is nil")
+	}
+
+	reader, err := NewParquetFileReader(p.ctx, p.chunkManager, filePath)
+	if err != nil {
+		return err
+	}
+
+	parquetReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Warn("Parquet parser: failed to open parquet file", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to open parquet file '%s', error: %w", filePath, err)
+	}
+	defer parquetReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(parquetReader,
+		pqarrow.ArrowReadProperties{BatchSize: int64(p.bufRowCount)}, memory.DefaultAllocator)
+	if err != nil {
+		log.Warn("Parquet parser: failed to create arrow reader", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to create arrow reader for '%s', error: %w", filePath, err)
+	}
+
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Warn("Parquet parser: failed to get arrow schema", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to get arrow schema of '%s', error: %w", filePath, err)
+	}
+
+	converters, err := p.createConverters(arrowSchema)
+	if err != nil {
+		return err
+	}
+
+	totalRowCount := parquetReader.NumRows()
+	if totalRowCount == 0 {
+		// empty file is allowed, don't return error
+		log.Info("Parquet parser: row count is 0")
+		return nil
+	}
+
+	// nil column indices and row groups means all of them, the record reader holds
+	// one batch at a time, the row groups are loaded on demand
+	recordReader, err := fileReader.GetRecordReader(p.ctx, nil, nil)
+	if err != nil {
+		log.Warn("Parquet parser: failed to create record reader", zap.String("filePath", filePath), zap.Error(err))
+		return fmt.Errorf("failed to create record reader for '%s', error: %w", filePath, err)
+	}
+	defer recordReader.Release()
+
+	readRowCount := int64(0)
+	for {
+		// outside context might be canceled(service stop, or future enhancement for canceling import task)
+		if isCanceled(p.ctx) {
+			log.Warn("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		// the record is owned by the reader and released on the next read
+		record, err := recordReader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Warn("Parquet parser: failed to read record batch", zap.Error(err))
+			return fmt.Errorf("failed to read record batch, error: %w", err)
+		}
+		if record == nil || record.NumRows() == 0 {
+			break
+		}
+
+		blockData, err := p.convertRecord(record, converters, readRowCount)
+		if err != nil {
+			return err
+		}
+
+		if err = handle.Handle(blockData); err != nil {
+			log.Warn("Parquet parser: failed to consume record batch", zap.Error(err))
+			return fmt.Errorf("failed to consume record batch, error: %w", err)
+		}
+
+		readRowCount += record.NumRows()
+		if p.updateProgressFunc != nil {
+			percent := (readRowCount * ProgressValueForPersist) / totalRowCount
+			log.Debug("Parquet parser: working progress", zap.Int64("readRowCount", readRowCount),
+				zap.Int64("totalRowCount", totalRowCount), zap.Int64("percent", percent))
+			p.updateProgressFunc(percent)
+		}
+	}
+
+	// send nil to notify the handler all have done
+	return handle.Handle(nil)
+}
+
+// createConverters maps the parquet columns to the fields by name, the columns not defined in schema
+// go into the dynamic field if the collection has one
+func (p *ParquetParser) createConverters(arrowSchema *arrow.Schema) ([]*ParquetColumnConverter, error) {
+	converters := make([]*ParquetColumnConverter, 0, len(arrowSchema.Fields()))
+	provided := make(map[string]struct{})
+	for i, arrowField := range arrowSchema.Fields() {
+		fieldName := arrowField.Name
+		fieldID, ok := p.collectionInfo.Name2FieldID[fieldName]
+		if !ok {
+			if p.collectionInfo.DynamicField == nil {
+				// no dynamic field. if user provided redundant field, return error
+				log.Warn("Parquet parser: the field is not defined in collection schema", zap.String("fieldName", fieldName))
+				return nil, fmt.Errorf("the field '%s' is not defined in collection schema", fieldName)
+			}
+			converters = append(converters, &ParquetColumnConverter{
+				fieldName:   fieldName,
+				columnIndex: i,
+				marshalFunc: func(arr arrow.Array, i int) any {
+					return arr.GetOneForMarshal(i)
+				},
+			})
+			continue
+		}
+
+		if fieldID == p.collectionInfo.PrimaryKey.GetFieldID() && p.collectionInfo.PrimaryKey.GetAutoID() {
+			// primary key is auto-id, no need to provide
+			log.Warn("Parquet parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", fieldName))
+			return nil, fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", fieldName)
+		}
+
+		var schema *schemapb.FieldSchema
+		for _, field := range p.collectionInfo.Schema.GetFields() {
+			if field.GetFieldID() == fieldID {
+				schema = field
+				break
+			}
+		}
+		convertFunc, err := p.convertFunc(schema, arrowField.Type)
+		if err != nil {
+			return nil, err
+		}
+		converters = append(converters, &ParquetColumnConverter{
+			fieldName:   fieldName,
+			columnIndex: i,
+			schema:      schema,
+			convertFunc: convertFunc,
+		})
+		provided[fieldName] = struct{}{}
+	}
+
+	// some fields not provided?
+	for _, schema := range p.collectionInfo.Schema.GetFields() {
+		if _, ok := provided[schema.GetName()]; ok {
+			continue
+		}
+		if schema.GetIsDynamic() || (schema.GetIsPrimaryKey() && schema.GetAutoID()) {
+			// user don't have to provide values for dynamic field and auto-generated primary key
+			continue
+		}
+		if typeutil.IsFieldNullable(schema) {
+			// the values are filled by null
+			continue
+		}
+		log.Warn("Parquet parser: a field is not provided", zap.String("fieldName", schema.GetName()))
+		return nil, fmt.Errorf("field '%s' not provided", schema.GetName())
+	}
+
+	return converters, nil
+}
+
+// convertRecord converts a record batch to a BlockData
+func (p *ParquetParser) convertRecord(record arrow.Record, converters []*ParquetColumnConverter, rowOffset int64) (BlockData, error) {
+	rowCount := int(record.NumRows())
+	blockData := make(BlockData)
+	provided := make(map[storage.FieldID]struct{})
+	for _, converter := range converters {
+		if converter.schema == nil || converter.schema.GetIsDynamic() {
+			continue
+		}
+		schema := converter.schema
+		fieldData, err := storage.NewFieldData(schema.GetDataType(), schema)
+		if err != nil {
+			return nil, err
+		}
+
+		arr := record.Column(converter.columnIndex)
+		for i := 0; i < rowCount; i++ {
+			var value any
+			if arr.IsNull(i) {
+				if !typeutil.IsFieldNullable(schema) {
+					log.Warn("Parquet parser: null value for not nullable field", zap.String("fieldName", schema.GetName()),
+						zap.Int64("rowNumber", rowOffset+int64(i)))
+					return nil, fmt.Errorf("null value for not nullable field '%s' at the row %d", schema.GetName(), rowOffset+int64(i))
+				}
+			} else {
+				value, err = converter.convertFunc(arr, i)
+				if err != nil {
+					log.Warn("Parquet parser: failed to convert value for field at the row", zap.String("fieldName", schema.GetName()),
+						zap.Int64("rowNumber", rowOffset+int64(i)), zap.Error(err))
+					return nil, fmt.Errorf("failed to convert value for field '%s' at the row %d, error: %w",
+						schema.GetName(), rowOffset+int64(i), err)
+				}
+			}
+			if err = fieldData.AppendRow(value); err != nil {
+				return nil, fmt.Errorf("failed to append value for field '%s' at the row %d, error: %w",
+					schema.GetName(), rowOffset+int64(i), err)
+			}
+		}
+		blockData[schema.GetFieldID()] = fieldData
+		provided[schema.GetFieldID()] = struct{}{}
+	}
+
+	// the nullable fields not provided are filled by null
+	for _, schema := range p.collectionInfo.Schema.GetFields() {
+		if _, ok := provided[schema.GetFieldID()]; ok || !typeutil.IsFieldNullable(schema) || schema.GetIsDynamic() {
+			continue
+		}
+		fieldData, err := storage.NewFieldData(schema.GetDataType(), schema)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < rowCount; i++ {
+			if err = fieldData.AppendRow(nil); err != nil {
+				return nil, err
+			}
+		}
+		blockData[schema.GetFieldID()] = fieldData
+	}
+
+	if p.collectionInfo.DynamicField != nil {
+		dynamicData, err := p.combineDynamicRows(record, converters, rowOffset)
+		if err != nil {
+			return nil, err
+		}
+		blockData[p.collectionInfo.DynamicField.GetFieldID()] = dynamicData
+	}
+
+	return blockData, nil
+}
+
+// combineDynamicRows combines the dynamic field column and the columns not defined in schema to JSON objects
+// valid input:
+//
+//	id, vector, x, $meta
+//	case1: 1, [], 8, {"y": 8} ==>> {"y": 8, "x": 8}
+//	case2: 1, [], 8, null     ==>> {"x": 8}
+//	case3: 1, [], null, null  ==>> {}
+func (p *ParquetParser) combineDynamicRows(record arrow.Record, converters []*ParquetColumnConverter, rowOffset int64) (storage.FieldData, error) {
+	dynamicFieldID := p.collectionInfo.DynamicField.GetFieldID()
+	var dynamicColumn arrow.Array
+	for _, converter := range converters {
+		if converter.schema != nil && converter.schema.GetFieldID() == dynamicFieldID {
+			dynamicColumn = record.Column(converter.columnIndex)
+			break
+		}
+	}
+
+	rowCount := int(record.NumRows())
+	dynamicData := &storage.JSONFieldData{
+		Data: make([][]byte, 0, rowCount),
+	}
+	for i := 0; i < rowCount; i++ {
+		mp := make(map[string]any)
+		if dynamicColumn != nil && !dynamicColumn.IsNull(i) {
+			value, err := parquetStringValue(dynamicColumn, i)
+			if err != nil {
+				return nil, err
+			}
+			// $meta is JSON type field, we first convert it to map[string]interface{}
+			// then merge other dynamic field into it
+			desc := json.NewDecoder(strings.NewReader(value))
+			desc.UseNumber()
+			if err = desc.Decode(&mp); err != nil {
+				log.Warn("Parquet parser: illegal value for dynamic field, not a JSON object",
+					zap.Int64("rowNumber", rowOffset+int64(i)))
+				return nil, fmt.Errorf("illegal value for dynamic field at the row %d, not a JSON object", rowOffset+int64(i))
+			}
+		}
+
+		for _, converter := range converters {
+			if converter.marshalFunc == nil {
+				continue
+			}
+			arr := record.Column(converter.columnIndex)
+			// ignore null field
+			if arr.IsNull(i) {
+				continue
+			}
+			mp[converter.fieldName] = converter.marshalFunc(arr, i)
+		}
+
+		bs, err := json.Marshal(mp)
+		if err != nil {
+			log.Warn("Parquet parser: failed to marshal dynamic field", zap.Int64("rowNumber", rowOffset+int64(i)), zap.Error(err))
+			return nil, fmt.Errorf("failed to marshal dynamic field at the row %d, error: %w", rowOffset+int64(i), err)
+		}
+		dynamicData.Data = append(dynamicData.Data, bs)
+	}
+
+	return dynamicData, nil
+}
+
+// convertFunc returns the method to convert a value of the column to the row of a field,
+// the arrow type of column is checked here so that mismatched file is rejected before reading data
+func (p *ParquetParser) convertFunc(schema *schemapb.FieldSchema, arrowType arrow.DataType) (func(arr arrow.Array, i int) (any, error), error) {
+	mismatched := func() error {
+		log.Warn("Parquet parser: arrow type of column mismatch with field", zap.String("fieldName", schema.GetName()),
+			zap.String("arrowType", arrowType.String()), zap.String("fieldType", getTypeName(schema.GetDataType())))
+		return fmt.Errorf("arrow type '%s' of column '%s' is not compatible with field type %s",
+			arrowType.String(), schema.GetName(), getTypeName(schema.GetDataType()))
+	}
+
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool:
+		if arrowType.ID() != arrow.BOOL {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Boolean).Value(i), nil
+		}, nil
+	case schemapb.DataType_Int8:
+		if arrowType.ID() != arrow.INT8 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Int8).Value(i), nil
+		}, nil
+	case schemapb.DataType_Int16:
+		if arrowType.ID() != arrow.INT16 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Int16).Value(i), nil
+		}, nil
+	case schemapb.DataType_Int32:
+		if arrowType.ID() != arrow.INT32 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Int32).Value(i), nil
+		}, nil
+	case schemapb.DataType_Int64:
+		if arrowType.ID() != arrow.INT64 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Int64).Value(i), nil
+		}, nil
+	case schemapb.DataType_Float:
+		if arrowType.ID() != arrow.FLOAT32 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Float32).Value(i), nil
+		}, nil
+	case schemapb.DataType_Double:
+		if arrowType.ID() != arrow.FLOAT64 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.Float64).Value(i), nil
+		}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if arrowType.ID() != arrow.STRING {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return arr.(*array.String).Value(i), nil
+		}, nil
+	case schemapb.DataType_JSON:
+		// JSON values are stored as string or binary column
+		if arrowType.ID() != arrow.STRING && arrowType.ID() != arrow.BINARY {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			value, err := parquetStringValue(arr, i)
+			if err != nil {
+				return nil, err
+			}
+			var dummy any
+			if err := json.Unmarshal([]byte(value), &dummy); err != nil {
+				return nil, fmt.Errorf("failed to parse value '%v' for JSON field '%s', error: %w", value, schema.GetName(), err)
+			}
+			return []byte(value), nil
+		}, nil
+	case schemapb.DataType_Array:
+		elementType, ok := parquetListElementType(arrowType)
+		if !ok || !isArrayElementCompatible(schema.GetElementType(), elementType) {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			return convertParquetArray(arr, i, schema.GetElementType())
+		}, nil
+	case typeutil.SparseFloatVector:
+		// sparse float vector is stored as string column, each string is a JSON object of a row,
+		// such as {"1": 0.5, "100": 0.3} or {"indices": [1, 100], "values": [0.5, 0.3]}
+		if arrowType.ID() != arrow.STRING {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			var obj map[string]any
+			decoder := json.NewDecoder(strings.NewReader(arr.(*array.String).Value(i)))
+			decoder.UseNumber()
+			if err := decoder.Decode(&obj); err != nil {
+				return nil, err
+			}
+			return typeutil.ParseSparseFloatRow(obj)
+		}, nil
+	}
+
+	if !typeutil.IsVectorType(schema.GetDataType()) {
+		log.Warn("Parquet parser: unsupported data type of field", zap.String("fieldName", schema.GetName()),
+			zap.String("dataType", getTypeName(schema.GetDataType())))
+		return nil, fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+
+	// dense vectors are stored as list or fixed-size list column, each list is a row
+	dim, err := getFieldDimension(schema)
+	if err != nil {
+		return nil, err
+	}
+	elementType, ok := parquetListElementType(arrowType)
+	if !ok {
+		return nil, mismatched()
+	}
+	switch schema.GetDataType() {
+	case schemapb.DataType_FloatVector:
+		// float64 list is accepted since it is the default float type of many tools
+		if elementType != arrow.FLOAT32 && elementType != arrow.FLOAT64 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			values, start, end := parquetListValues(arr, i)
+			if int(end-start) != dim {
+				return nil, fmt.Errorf("array size %d doesn't equal to vector dimension %d", end-start, dim)
+			}
+			if float32Values, ok := values.(*array.Float32); ok {
+				vector := make([]float32, dim)
+				copy(vector, float32Values.Float32Values()[start:end])
+				return vector, nil
+			}
+			vector := make([]float32, 0, dim)
+			for _, v := range values.(*array.Float64).Float64Values()[start:end] {
+				vector = append(vector, float32(v))
+			}
+			return vector, nil
+		}, nil
+	case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, typeutil.BFloat16Vector:
+		// each uint8 value represents 8 dimensions of binary vector, two uint8 values represent a dimension of float16 vector
+		if elementType != arrow.UINT8 {
+			return nil, mismatched()
+		}
+		byteCount := dim * 2
+		if schema.GetDataType() == schemapb.DataType_BinaryVector {
+			byteCount = dim / 8
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			values, start, end := parquetListValues(arr, i)
+			if int(end-start) != byteCount {
+				return nil, fmt.Errorf("byte size %d doesn't equal to %d bytes of vector dimension %d", end-start, byteCount, dim)
+			}
+			vector := make([]byte, byteCount)
+			copy(vector, values.(*array.Uint8).Uint8Values()[start:end])
+			return vector, nil
+		}, nil
+	case typeutil.Int8Vector:
+		if elementType != arrow.INT8 {
+			return nil, mismatched()
+		}
+		return func(arr arrow.Array, i int) (any, error) {
+			values, start, end := parquetListValues(arr, i)
+			if int(end-start) != dim {
+				return nil, fmt.Errorf("array size %d doesn't equal to vector dimension %d", end-start, dim)
+			}
+			vector := make([]int8, dim)
+			copy(vector, values.(*array.Int8).Int8Values()[start:end])
+			return vector, nil
+		}, nil
+	default:
+		log.Warn("Parquet parser: unsupported data type of field", zap.String("fieldName", schema.GetName()),
+			zap.String("dataType", getTypeName(schema.GetDataType())))
+		return nil, fmt.Errorf("unsupported data type %s of field '%s'", getTypeName(schema.GetDataType()), schema.GetName())
+	}
+}
+
+// parquetListValues returns the child values of list or fixed-size list array and the range of the i-th list
+func parquetListValues(arr arrow.Array, i int) (arrow.Array, int64, int64) {
+	switch a := arr.(type) {
+	case *array.List:
+		start, end := a.ValueOffsets(i)
+		return a.ListValues(), start, end
+	case *array.FixedSizeList:
+		n := int64(a.DataType().(*arrow.FixedSizeListType).Len())
+		offset := int64(a.Data().Offset()) + int64(i)
+		return a.ListValues(), offset * n, (offset + 1) * n
+	default:
+		return nil, 0, 0
+	}
+}
+
+// parquetListElementType returns the element type of list or fixed-size list type
+func parquetListElementType(arrowType arrow.DataType) (arrow.Type, bool) {
+	switch t := arrowType.(type) {
+	case *arrow.ListType:
+		return t.Elem().ID(), true
+	case *arrow.FixedSizeListType:
+		return t.Elem().ID(), true
+	default:
+		return arrow.NULL, false
+	}
+}
+
+func isArrayElementCompatible(elementType schemapb.DataType, arrowType arrow.Type) bool {
+	switch elementType {
+	case schemapb.DataType_Bool:
+		return arrowType == arrow.BOOL
+	case schemapb.DataType_Int8:
+		return arrowType == arrow.INT8
+	case schemapb.DataType_Int16:
+		return arrowType == arrow.INT16
+	case schemapb.DataType_Int32:
+		return arrowType == arrow.INT32
+	case schemapb.DataType_Int64:
+		return arrowType == arrow.INT64
+	case schemapb.DataType_Float:
+		return arrowType == arrow.FLOAT32
+	case schemapb.DataType_Double:
+		return arrowType == arrow.FLOAT64
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return arrowType == arrow.STRING
+	default:
+		return false
+	}
+}
+
+// convertParquetArray converts a list of the column to the row of Array field
+func convertParquetArray(arr arrow.Array, i int, elementType schemapb.DataType) (*schemapb.ScalarField, error) {
+	values, start, end := parquetListValues(arr, i)
+	switch elementType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, end-start)
+		for k := start; k < end; k++ {
+			data = append(data, values.(*array.Boolean).Value(int(k)))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		// int8/int16/int32 elements are all stored as int32 in ScalarField
+		data := make([]int32, 0, end-start)
+		for k := start; k < end; k++ {
+			switch v := values.(type) {
+			case *array.Int8:
+				data = append(data, int32(v.Value(int(k))))
+			case *array.Int16:
+				data = append(data, int32(v.Value(int(k))))
+			case *array.Int32:
+				data = append(data, v.Value(int(k)))
+			}
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}, nil
+	case schemapb.DataType_Int64:
+		data := make([]int64, end-start)
+		copy(data, values.(*array.Int64).Int64Values()[start:end])
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}, nil
+	case schemapb.DataType_Float:
+		data := make([]float32, end-start)
+		copy(data, values.(*array.Float32).Float32Values()[start:end])
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}, nil
+	case schemapb.DataType_Double:
+		data := make([]float64, end-start)
+		copy(data, values.(*array.Float64).Float64Values()[start:end])
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, 0, end-start)
+		for k := start; k < end; k++ {
+			data = append(data, values.(*array.String).Value(int(k)))
+		}
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type %s of array", getTypeName(elementType))
+	}
+}
+
+// parquetStringValue returns the value of string or binary column
+func parquetStringValue(arr arrow.Array, i int) (string, error) {
+	switch v := arr.(type) {
+	case *array.String:
+		return v.Value(i), nil
+	case *array.Binary:
+		return string(v.Value(i)), nil
+	default:
+		return "", fmt.Errorf("arrow type '%s' is not string or binary", arr.DataType().String())
+	}
+}
@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func parquetSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name:               "schema",
+		Description:        "schema",
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "bin",
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}},
+			},
+			{
+				FieldID:  103,
+				Name:     "json",
+				DataType: schemapb.DataType_JSON,
+			},
+			{
+				FieldID:     104,
+				Name:        "arr",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Int32,
+			},
+			{
+				FieldID:    105,
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}, {Key: common.NullableKey, Value: "true"}},
+			},
+			{
+				FieldID:   106,
+				Name:      "$meta",
+				DataType:  schemapb.DataType_JSON,
+				IsDynamic: true,
+			},
+		},
+	}
+}
+
+// writeParquetFile writes the record to a parquet file, each row group holds rowGroupSize rows at most
+func writeParquetFile(t *testing.T, cm storage.ChunkManager, fileName string, record arrow.Record, rowGroupSize int64) string {
+	table := array.NewTableFromRecords(record.Schema(), []arrow.Record{record})
+	defer table.Release()
+
+	buf := new(bytes.Buffer)
+	err := pqarrow.WriteTable(table, buf, rowGroupSize, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
+
+	filePath := path.Join(cm.RootPath(), fileName)
+	err = cm.Write(context.Background(), filePath, buf.Bytes())
+	assert.NoError(t, err)
+	return filePath
+}
+
+// sampleParquetRecord creates 4 rows for parquetSchema, the column "x" is not defined in schema
+func sampleParquetRecord() arrow.Record {
+	arrowSchema := arrow.NewSchema([]arrow.Field{
+		{Name: "pk", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "vec", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64)},
+		{Name: "bin", Type: arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Uint8)},
+		{Name: "json", Type: arrow.BinaryTypes.String},
+		{Name: "arr", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "$meta", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "x", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	}, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+
+	builder.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3, 4}, nil)
+
+	vecBuilder := builder.Field(1).(*array.ListBuilder)
+	binBuilder := builder.Field(2).(*array.FixedSizeListBuilder)
+	arrBuilder := builder.Field(4).(*array.ListBuilder)
+	for i := 0; i < 4; i++ {
+		vecBuilder.Append(true)
+		vecBuilder.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{float64(i), float64(i) + 0.5}, nil)
+		binBuilder.Append(true)
+		binBuilder.ValueBuilder().(*array.Uint8Builder).AppendValues([]uint8{uint8(i), 255}, nil)
+		arrBuilder.Append(true)
+		arrBuilder.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{int32(i), int32(i * 10)}, nil)
+	}
+
+	builder.Field(3).(*array.StringBuilder).AppendValues([]string{`{"a": 1}`, `{"b": 2}`, `{}`, `[1, 2]`}, nil)
+	builder.Field(5).(*array.StringBuilder).AppendValues([]string{"a", "", "c", ""}, []bool{true, false, true, false})
+	builder.Field(6).(*array.StringBuilder).AppendValues([]string{`{"y": 8}`, "", `{}`, ""}, []bool{true, false, true, false})
+	builder.Field(7).(*array.Int64Builder).AppendValues([]int64{8, 9, 0, 0}, []bool{true, true, false, false})
+
+	return builder.NewRecord()
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser, err := NewParquetParser(ctx, nil, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	collectionInfo, err := NewCollectionInfo(parquetSchema(), 2, []int64{1})
+	assert.NoError(t, err)
+	parser, err = NewParquetParser(ctx, collectionInfo, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, parser)
+
+	parser, err = NewParquetParser(ctx, collectionInfo, createLocalChunkManager(t), nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, parser)
+	assert.Greater(t, parser.bufRowCount, 0)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	cm := createLocalChunkManager(t)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	record := sampleParquetRecord()
+	defer record.Release()
+	filePath := writeParquetFile(t, cm, "sample.parquet", record, 3)
+
+	collectionInfo, err := NewCollectionInfo(parquetSchema(), 1, []int64{1})
+	assert.NoError(t, err)
+
+	var flushed BlockData
+	flushFunc := func(fields BlockData, shardID int, partID int64) error {
+		flushed = fields
+		return nil
+	}
+	consumer, err := NewParquetBlockConsumer(ctx, collectionInfo, newIDAllocator(ctx, t, nil), 1024*1024, flushFunc)
+	assert.NoError(t, err)
+
+	updateProgress := int64(0)
+	parser, err := NewParquetParser(ctx, collectionInfo, cm, func(percent int64) {
+		updateProgress = percent
+	})
+	assert.NoError(t, err)
+	// read 2 rows in a batch, the batches don't align with the row groups
+	parser.bufRowCount = 2
+
+	t.Run("nil handler", func(t *testing.T) {
+		err = parser.Parse(filePath, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("file not exist", func(t *testing.T) {
+		err = parser.Parse(path.Join(cm.RootPath(), "dummy.parquet"), consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("succeed", func(t *testing.T) {
+		err = parser.Parse(filePath, consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), consumer.RowCount())
+		assert.Equal(t, int64(ProgressValueForPersist), updateProgress)
+		assert.NotNil(t, flushed)
+
+		assert.Equal(t, []int64{1, 2, 3, 4}, flushed[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []float32{1, 1.5}, flushed[101].GetRow(1))
+		assert.Equal(t, []byte{2, 255}, flushed[102].GetRow(2))
+		assert.Equal(t, []byte(`[1, 2]`), flushed[103].GetRow(3))
+		assert.Equal(t, []int32{3, 30}, flushed[104].GetRow(3).(*schemapb.ScalarField).GetIntData().GetData())
+		assert.Equal(t, "a", flushed[105].GetRow(0))
+		assert.Nil(t, flushed[105].GetRow(1))
+
+		dynamicRows := make([]map[string]any, 0)
+		for i := 0; i < 4; i++ {
+			mp := make(map[string]any)
+			err = json.Unmarshal(flushed[106].GetRow(i).([]byte), &mp)
+			assert.NoError(t, err)
+			dynamicRows = append(dynamicRows, mp)
+		}
+		assert.Equal(t, map[string]any{"x": float64(8), "y": float64(8)}, dynamicRows[0])
+		assert.Equal(t, map[string]any{"x": float64(9)}, dynamicRows[1])
+		assert.Empty(t, dynamicRows[2])
+		assert.Empty(t, dynamicRows[3])
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		parser.ctx = cancelCtx
+		err = parser.Parse(filePath, consumer)
+		assert.Error(t, err)
+		parser.ctx = ctx
+	})
+
+	t.Run("extra column without dynamic field", func(t *testing.T) {
+		schema := parquetSchema()
+		schema.EnableDynamicField = false
+		schema.Fields = schema.Fields[:len(schema.Fields)-1]
+		info, err := NewCollectionInfo(schema, 1, []int64{1})
+		assert.NoError(t, err)
+		p, err := NewParquetParser(ctx, info, cm, nil)
+		assert.NoError(t, err)
+		err = p.Parse(filePath, consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("auto-generated primary key is provided", func(t *testing.T) {
+		schema := parquetSchema()
+		schema.Fields[0].AutoID = true
+		info, err := NewCollectionInfo(schema, 1, []int64{1})
+		assert.NoError(t, err)
+		p, err := NewParquetParser(ctx, info, cm, nil)
+		assert.NoError(t, err)
+		err = p.Parse(filePath, consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("field not provided", func(t *testing.T) {
+		schema := parquetSchema()
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			FieldID:  107,
+			Name:     "not_provided",
+			DataType: schemapb.DataType_Int64,
+		})
+		info, err := NewCollectionInfo(schema, 1, []int64{1})
+		assert.NoError(t, err)
+		p, err := NewParquetParser(ctx, info, cm, nil)
+		assert.NoError(t, err)
+		err = p.Parse(filePath, consumer)
+		assert.Error(t, err)
+	})
+
+	t.Run("null value for not nullable field", func(t *testing.T) {
+		schema := parquetSchema()
+		schema.Fields[5].TypeParams = []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}}
+		info, err := NewCollectionInfo(schema, 1, []int64{1})
+		assert.NoError(t, err)
+		p, err := NewParquetParser(ctx, info, cm, nil)
+		assert.NoError(t, err)
+		err = p.Parse(filePath, consumer)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ParquetParserConvertFunc(t *testing.T) {
+	ctx := context.Background()
+	collectionInfo, err := NewCollectionInfo(parquetSchema(), 1, []int64{1})
+	assert.NoError(t, err)
+	parser, err := NewParquetParser(ctx, collectionInfo, createLocalChunkManager(t), nil)
+	assert.NoError(t, err)
+
+	compatible := []struct {
+		schema    *schemapb.FieldSchema
+		arrowType arrow.DataType
+	}{
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Bool}, arrow.FixedWidthTypes.Boolean},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int8}, arrow.PrimitiveTypes.Int8},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int16}, arrow.PrimitiveTypes.Int16},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int32}, arrow.PrimitiveTypes.Int32},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int64}, arrow.PrimitiveTypes.Int64},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Float}, arrow.PrimitiveTypes.Float32},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Double}, arrow.PrimitiveTypes.Float64},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}, arrow.BinaryTypes.String},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_JSON}, arrow.BinaryTypes.Binary},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_VarChar}, arrow.ListOf(arrow.BinaryTypes.String)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+			arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float32)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Float16Vector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+			arrow.ListOf(arrow.PrimitiveTypes.Uint8)},
+	}
+	for _, c := range compatible {
+		convertFunc, err := parser.convertFunc(c.schema, c.arrowType)
+		assert.NoError(t, err)
+		assert.NotNil(t, convertFunc)
+	}
+
+	mismatched := []struct {
+		schema    *schemapb.FieldSchema
+		arrowType arrow.DataType
+	}{
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Bool}, arrow.PrimitiveTypes.Int8},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int64}, arrow.PrimitiveTypes.Int32},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}, arrow.BinaryTypes.Binary},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Int64}, arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+			arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+			arrow.PrimitiveTypes.Float32},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}, arrow.ListOf(arrow.PrimitiveTypes.Float32)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_None}, arrow.PrimitiveTypes.Int64},
+	}
+	for _, c := range mismatched {
+		convertFunc, err := parser.convertFunc(c.schema, c.arrowType)
+		assert.Error(t, err)
+		assert.Nil(t, convertFunc)
+	}
+}
@@ -0,0 +1,589 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Aggregator computes the aggregates of a retrieve plan for every group of entities. The entities of
+// segments are added by querynodes, and the partial aggregates are merged by querynodes and proxy.
+//
+// The partial aggregates are the group by columns followed by two columns for each aggregate, the
+// count of values and the sum, min or max value. The value column is int64 for the aggregates of
+// integer fields and double for the others, group values of json paths are json encoded.
+type Aggregator struct {
+	groupBy    []*planpb.ColumnInfo
+	aggregates []*planpb.Aggregate
+	groups     map[string]*aggregateGroup
+	keys       []string // keys of groups in the order they are found
+}
+
+type aggregateGroup struct {
+	values []interface{}
+	states []*aggregateState
+}
+
+type aggregateState struct {
+	count      int64
+	intValue   int64
+	floatValue float64
+}
+
+func (s *aggregateState) merge(op planpb.Aggregate_Op, count int64, intValue int64, floatValue float64) {
+	if count == 0 {
+		return
+	}
+	first := s.count == 0
+	s.count += count
+	switch op {
+	case planpb.Aggregate_Sum, planpb.Aggregate_Avg:
+		s.intValue += intValue
+		s.floatValue += floatValue
+	case planpb.Aggregate_Min:
+		if first || intValue < s.intValue {
+			s.intValue = intValue
+		}
+		if first || floatValue < s.floatValue {
+			s.floatValue = floatValue
+		}
+	case planpb.Aggregate_Max:
+		if first || intValue > s.intValue {
+			s.intValue = intValue
+		}
+		if first || floatValue > s.floatValue {
+			s.floatValue = floatValue
+		}
+	}
+}
+
+// NewAggregator returns the aggregator of retrieve plan, nil if it has neither aggregates nor group by columns.
+func NewAggregator(query *planpb.QueryPlanNode) *Aggregator {
+	if len(query.GetAggregates()) == 0 && len(query.GetGroupBy()) == 0 {
+		return nil
+	}
+	return &Aggregator{
+		groupBy:    query.GetGroupBy(),
+		aggregates: query.GetAggregates(),
+		groups:     make(map[string]*aggregateGroup),
+	}
+}
+
+// GetAggregatorFromPlan returns the aggregator of the serialized retrieve plan.
+func GetAggregatorFromPlan(serializedPlan []byte) (*Aggregator, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	aggregator := NewAggregator(plan.GetQuery())
+	if aggregator == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("retrieve plan has no aggregate")
+	}
+	return aggregator, nil
+}
+
+func isIntegerAggregate(aggregate *planpb.Aggregate) bool {
+	return aggregate.GetOp() == planpb.Aggregate_Count || typeutil.IsIntegerType(aggregate.GetColumn().GetDataType())
+}
+
+func (a *Aggregator) group(values []interface{}) *aggregateGroup {
+	var key strings.Builder
+	for _, value := range values {
+		fmt.Fprintf(&key, "%T%#v;", value, value)
+	}
+	group, ok := a.groups[key.String()]
+	if !ok {
+		group = &aggregateGroup{
+			values: values,
+			states: make([]*aggregateState, len(a.aggregates)),
+		}
+		for i := range group.states {
+			group.states[i] = &aggregateState{}
+		}
+		a.groups[key.String()] = group
+		a.keys = append(a.keys, key.String())
+	}
+	return group
+}
+
+func findFieldData(fieldsData []*schemapb.FieldData, column *planpb.ColumnInfo) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == column.GetFieldId() {
+			return fieldData, nil
+		}
+	}
+	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("field %d not found in retrieve result", column.GetFieldId()))
+}
+
+// Add accumulates the entities of fieldsData, the rows which accept returns false are skipped.
+func (a *Aggregator) Add(fieldsData []*schemapb.FieldData, numRows int, accept func(row int) bool) error {
+	groupData := make([]*schemapb.FieldData, len(a.groupBy))
+	for i, column := range a.groupBy {
+		fieldData, err := findFieldData(fieldsData, column)
+		if err != nil {
+			return err
+		}
+		groupData[i] = fieldData
+	}
+	aggregateData := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, aggregate := range a.aggregates {
+		if aggregate.GetColumn() == nil {
+			continue
+		}
+		fieldData, err := findFieldData(fieldsData, aggregate.GetColumn())
+		if err != nil {
+			return err
+		}
+		aggregateData[i] = fieldData
+	}
+
+	for row := 0; row < numRows; row++ {
+		if accept != nil && !accept(row) {
+			continue
+		}
+		values := make([]interface{}, len(a.groupBy))
+		for i, column := range a.groupBy {
+			values[i] = groupValue(groupData[i], column, row)
+		}
+		group := a.group(values)
+		for i, aggregate := range a.aggregates {
+			if aggregate.GetColumn() == nil {
+				group.states[i].count++
+				continue
+			}
+			value, ok := columnValue(aggregateData[i], aggregate.GetColumn(), row)
+			if !ok {
+				continue
+			}
+			if aggregate.GetOp() == planpb.Aggregate_Count {
+				group.states[i].count++
+				continue
+			}
+			if intValue, floatValue, ok := numericValue(value); ok {
+				group.states[i].merge(aggregate.GetOp(), 1, intValue, floatValue)
+			}
+		}
+	}
+	return nil
+}
+
+// Merge merges the partial aggregates returned by Partial.
+func (a *Aggregator) Merge(partial []*schemapb.FieldData) error {
+	if len(partial) == 0 {
+		return nil
+	}
+	if len(partial) != len(a.groupBy)+2*len(a.aggregates) {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("partial aggregates should have %d columns, but got %d",
+			len(a.groupBy)+2*len(a.aggregates), len(partial)))
+	}
+	numRows := rowCount(partial[0])
+	for _, fieldData := range partial {
+		if rowCount(fieldData) != numRows {
+			return merr.WrapErrServiceInternal("columns of partial aggregates have different number of rows")
+		}
+	}
+
+	aggregateData := partial[len(a.groupBy):]
+	for row := 0; row < numRows; row++ {
+		values := make([]interface{}, len(a.groupBy))
+		for i := range a.groupBy {
+			values[i] = fieldValue(partial[i], row)
+		}
+		group := a.group(values)
+		for i, aggregate := range a.aggregates {
+			count := aggregateData[2*i].GetScalars().GetLongData().GetData()[row]
+			valueData := aggregateData[2*i+1]
+			if isIntegerAggregate(aggregate) {
+				group.states[i].merge(aggregate.GetOp(), count, valueData.GetScalars().GetLongData().GetData()[row], 0)
+			} else {
+				group.states[i].merge(aggregate.GetOp(), count, 0, valueData.GetScalars().GetDoubleData().GetData()[row])
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Aggregator) orderedGroups() []*aggregateGroup {
+	// aggregates without group by have exactly one group, even if there is no entity
+	if len(a.groupBy) == 0 {
+		a.group(nil)
+	}
+	groups := make([]*aggregateGroup, 0, len(a.keys))
+	for _, key := range a.keys {
+		groups = append(groups, a.groups[key])
+	}
+	return groups
+}
+
+func (a *Aggregator) groupByFieldsData(groups []*aggregateGroup, names []string) []*schemapb.FieldData {
+	fieldsData := make([]*schemapb.FieldData, 0, len(a.groupBy))
+	for i, column := range a.groupBy {
+		name := ""
+		if names != nil {
+			name = names[i]
+		}
+		fieldData := newFieldData(groupDataType(column), column.GetFieldId(), name)
+		for _, group := range groups {
+			appendValue(fieldData, group.values[i])
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData
+}
+
+// Partial returns the partial aggregates of the entities added or merged.
+func (a *Aggregator) Partial() []*schemapb.FieldData {
+	groups := a.orderedGroups()
+	fieldsData := a.groupByFieldsData(groups, nil)
+	for i, aggregate := range a.aggregates {
+		counts := make([]int64, len(groups))
+		for j, group := range groups {
+			counts[j] = group.states[i].count
+		}
+		fieldsData = append(fieldsData, newLongFieldData("", counts))
+		if isIntegerAggregate(aggregate) {
+			values := make([]int64, len(groups))
+			for j, group := range groups {
+				values[j] = group.states[i].intValue
+			}
+			fieldsData = append(fieldsData, newLongFieldData("", values))
+		} else {
+			values := make([]float64, len(groups))
+			for j, group := range groups {
+				values[j] = group.states[i].floatValue
+			}
+			fieldsData = append(fieldsData, newDoubleFieldData("", values))
+		}
+	}
+	return fieldsData
+}
+
+// Result returns the final aggregates of the groups ordered by group values, offset and limit are applied
+// to the groups. The group by columns are followed by the aggregates, they are named by OutputNames.
+// min, max and avg are 0 for the groups without any value.
+func (a *Aggregator) Result(schema *schemapb.CollectionSchema, offset int64, limit int64) []*schemapb.FieldData {
+	groups := a.orderedGroups()
+	sort.SliceStable(groups, func(i, j int) bool {
+		for k := range a.groupBy {
+			if c := compareValues(groups[i].values[k], groups[j].values[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	if offset >= int64(len(groups)) {
+		groups = groups[:0]
+	} else {
+		groups = groups[offset:]
+	}
+	if limit != typeutil.Unlimited && limit < int64(len(groups)) {
+		groups = groups[:limit]
+	}
+
+	names := OutputNames(&planpb.QueryPlanNode{GroupBy: a.groupBy, Aggregates: a.aggregates}, schema)
+	fieldsData := a.groupByFieldsData(groups, names)
+	names = names[len(a.groupBy):]
+	for i, aggregate := range a.aggregates {
+		switch {
+		case aggregate.GetOp() == planpb.Aggregate_Count:
+			values := make([]int64, len(groups))
+			for j, group := range groups {
+				values[j] = group.states[i].count
+			}
+			fieldsData = append(fieldsData, newLongFieldData(names[i], values))
+		case aggregate.GetOp() == planpb.Aggregate_Avg:
+			values := make([]float64, len(groups))
+			for j, group := range groups {
+				state := group.states[i]
+				if state.count > 0 {
+					values[j] = (float64(state.intValue) + state.floatValue) / float64(state.count)
+				}
+			}
+			fieldsData = append(fieldsData, newDoubleFieldData(names[i], values))
+		case isIntegerAggregate(aggregate):
+			values := make([]int64, len(groups))
+			for j, group := range groups {
+				values[j] = group.states[i].intValue
+			}
+			fieldsData = append(fieldsData, newLongFieldData(names[i], values))
+		default:
+			values := make([]float64, len(groups))
+			for j, group := range groups {
+				values[j] = group.states[i].floatValue
+			}
+			fieldsData = append(fieldsData, newDoubleFieldData(names[i], values))
+		}
+	}
+	return fieldsData
+}
+
+// ColumnName returns the name of column, like price, meta["a"]["b"], or the key of dynamic field.
+func ColumnName(schema *schemapb.CollectionSchema, column *planpb.ColumnInfo) string {
+	var field *schemapb.FieldSchema
+	for _, f := range schema.GetFields() {
+		if f.GetFieldID() == column.GetFieldId() {
+			field = f
+			break
+		}
+	}
+	path := column.GetNestedPath()
+	if field.GetIsDynamic() && len(path) == 1 {
+		return path[0]
+	}
+	name := field.GetName()
+	for _, key := range path {
+		name += "[" + strconv.Quote(key) + "]"
+	}
+	return name
+}
+
+// OutputNames returns the names of group by columns followed by the names of aggregates, like count(*) or sum(price).
+func OutputNames(query *planpb.QueryPlanNode, schema *schemapb.CollectionSchema) []string {
+	names := make([]string, 0, len(query.GetGroupBy())+len(query.GetAggregates()))
+	for _, column := range query.GetGroupBy() {
+		names = append(names, ColumnName(schema, column))
+	}
+	for _, aggregate := range query.GetAggregates() {
+		op := strings.ToLower(aggregate.GetOp().String())
+		if aggregate.GetColumn() == nil {
+			names = append(names, op+"(*)")
+		} else {
+			names = append(names, op+"("+ColumnName(schema, aggregate.GetColumn())+")")
+		}
+	}
+	return names
+}
+
+// jsonPathValue returns the value of path in json, false if the path doesn't exist.
+func jsonPathValue(data []byte, path []string) (interface{}, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	for _, key := range path {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			value = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
+
+// columnValue returns the value of column in the row, false if it's a missing json path.
+func columnValue(fieldData *schemapb.FieldData, column *planpb.ColumnInfo, row int) (interface{}, bool) {
+	if fieldData.GetType() != schemapb.DataType_JSON {
+		return typeutil.GetData(fieldData, row), true
+	}
+	return jsonPathValue(fieldData.GetScalars().GetJsonData().GetData()[row], column.GetNestedPath())
+}
+
+// groupValue returns the value of group by column in the row, the value of json path is json encoded.
+func groupValue(fieldData *schemapb.FieldData, column *planpb.ColumnInfo, row int) interface{} {
+	value, ok := columnValue(fieldData, column, row)
+	if fieldData.GetType() != schemapb.DataType_JSON {
+		return value
+	}
+	if !ok {
+		return []byte("null")
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return []byte("null")
+	}
+	return bs
+}
+
+// fieldValue returns the value of the row in the column of partial aggregates.
+func fieldValue(fieldData *schemapb.FieldData, row int) interface{} {
+	if fieldData.GetType() == schemapb.DataType_JSON {
+		return fieldData.GetScalars().GetJsonData().GetData()[row]
+	}
+	return typeutil.GetData(fieldData, row)
+}
+
+func numericValue(value interface{}) (int64, float64, bool) {
+	switch v := value.(type) {
+	case int32:
+		return int64(v), 0, true
+	case int64:
+		return v, 0, true
+	case float32:
+		return 0, float64(v), true
+	case float64:
+		return 0, v, true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, 0, false
+		}
+		return 0, f, true
+	}
+	return 0, 0, false
+}
+
+func compareValues(a, b interface{}) int {
+	switch a := a.(type) {
+	case bool:
+		b := b.(bool)
+		if a == b {
+			return 0
+		} else if !a {
+			return -1
+		}
+		return 1
+	case int32:
+		b := b.(int32)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case int64:
+		b := b.(int64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case float32:
+		b := b.(float32)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case float64:
+		b := b.(float64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case string:
+		return strings.Compare(a, b.(string))
+	case []byte:
+		return bytes.Compare(a, b.([]byte))
+	}
+	return 0
+}
+
+func groupDataType(column *planpb.ColumnInfo) schemapb.DataType {
+	if column.GetDataType() == schemapb.DataType_String {
+		return schemapb.DataType_VarChar
+	}
+	return column.GetDataType()
+}
+
+func newFieldData(dataType schemapb.DataType, fieldID int64, name string) *schemapb.FieldData {
+	scalars := &schemapb.ScalarField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: []bool{}}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{}}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{}}}
+	case schemapb.DataType_VarChar:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{}}}
+	case schemapb.DataType_JSON:
+		scalars.Data = &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{}}}
+	}
+	return &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: name,
+		FieldId:   fieldID,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+}
+
+func appendValue(fieldData *schemapb.FieldData, value interface{}) {
+	scalars := fieldData.GetScalars()
+	switch v := value.(type) {
+	case bool:
+		scalars.GetBoolData().Data = append(scalars.GetBoolData().GetData(), v)
+	case int32:
+		scalars.GetIntData().Data = append(scalars.GetIntData().GetData(), v)
+	case int64:
+		scalars.GetLongData().Data = append(scalars.GetLongData().GetData(), v)
+	case string:
+		scalars.GetStringData().Data = append(scalars.GetStringData().GetData(), v)
+	case []byte:
+		scalars.GetJsonData().Data = append(scalars.GetJsonData().GetData(), v)
+	}
+}
+
+func newLongFieldData(name string, values []int64) *schemapb.FieldData {
+	fieldData := newFieldData(schemapb.DataType_Int64, 0, name)
+	fieldData.GetScalars().GetLongData().Data = values
+	return fieldData
+}
+
+func newDoubleFieldData(name string, values []float64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Double,
+		FieldName: name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}},
+			},
+		},
+	}
+}
+
+func rowCount(fieldData *schemapb.FieldData) int {
+	scalars := fieldData.GetScalars()
+	switch fieldData.GetType() {
+	case schemapb.DataType_Bool:
+		return len(scalars.GetBoolData().GetData())
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return len(scalars.GetIntData().GetData())
+	case schemapb.DataType_Int64:
+		return len(scalars.GetLongData().GetData())
+	case schemapb.DataType_Double:
+		return len(scalars.GetDoubleData().GetData())
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return len(scalars.GetStringData().GetData())
+	case schemapb.DataType_JSON:
+		return len(scalars.GetJsonData().GetData())
+	}
+	return 0
+}
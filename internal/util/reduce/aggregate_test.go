@@ -0,0 +1,165 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+type AggregatorSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	query  *planpb.QueryPlanNode
+}
+
+func (s *AggregatorSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 104, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	priceColumn := &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_Int64}
+	scoreColumn := &planpb.ColumnInfo{FieldId: 103, DataType: schemapb.DataType_JSON, NestedPath: []string{"score"}}
+	s.query = &planpb.QueryPlanNode{
+		GroupBy: []*planpb.ColumnInfo{{FieldId: 101, DataType: schemapb.DataType_VarChar}},
+		Aggregates: []*planpb.Aggregate{
+			{Op: planpb.Aggregate_Count},
+			{Op: planpb.Aggregate_Sum, Column: priceColumn},
+			{Op: planpb.Aggregate_Min, Column: priceColumn},
+			{Op: planpb.Aggregate_Max, Column: scoreColumn},
+			{Op: planpb.Aggregate_Avg, Column: scoreColumn},
+		},
+	}
+}
+
+func (s *AggregatorSuite) genFieldsData(categories []string, prices []int64, metas []string) []*schemapb.FieldData {
+	jsons := make([][]byte, len(metas))
+	for i, meta := range metas {
+		jsons[i] = []byte(meta)
+	}
+	category := newFieldData(schemapb.DataType_VarChar, 101, "category")
+	category.GetScalars().GetStringData().Data = categories
+	price := newLongFieldData("price", prices)
+	price.FieldId = 102
+	meta := newFieldData(schemapb.DataType_JSON, 103, "meta")
+	meta.GetScalars().GetJsonData().Data = jsons
+	return []*schemapb.FieldData{category, price, meta}
+}
+
+func (s *AggregatorSuite) TestAggregate() {
+	// two segments are aggregated on different nodes, the partial aggregates are merged
+	aggregator1 := NewAggregator(s.query)
+	err := aggregator1.Add(s.genFieldsData(
+		[]string{"b", "a", "b"},
+		[]int64{10, 20, 30},
+		[]string{`{"score": 1.5}`, `{"score": 2}`, `{}`},
+	), 3, nil)
+	s.NoError(err)
+
+	aggregator2 := NewAggregator(s.query)
+	err = aggregator2.Add(s.genFieldsData(
+		[]string{"a", "c", "a"},
+		[]int64{5, 7, 100},
+		[]string{`{"score": 4}`, `{"score": "x"}`, `{"score": 8}`},
+	), 3, func(row int) bool {
+		// the last row is duplicated
+		return row != 2
+	})
+	s.NoError(err)
+
+	aggregator := NewAggregator(s.query)
+	s.NoError(aggregator.Merge(aggregator1.Partial()))
+	s.NoError(aggregator.Merge(aggregator2.Partial()))
+	s.NoError(aggregator.Merge(nil))
+
+	result := aggregator.Result(s.schema, 0, -1)
+	s.Equal(6, len(result))
+	s.Equal("category", result[0].GetFieldName())
+	s.Equal([]string{"a", "b", "c"}, result[0].GetScalars().GetStringData().GetData())
+	s.Equal("count(*)", result[1].GetFieldName())
+	s.Equal([]int64{2, 2, 1}, result[1].GetScalars().GetLongData().GetData())
+	s.Equal("sum(price)", result[2].GetFieldName())
+	s.Equal([]int64{25, 40, 7}, result[2].GetScalars().GetLongData().GetData())
+	s.Equal("min(price)", result[3].GetFieldName())
+	s.Equal([]int64{5, 10, 7}, result[3].GetScalars().GetLongData().GetData())
+	s.Equal(`max(meta["score"])`, result[4].GetFieldName())
+	s.Equal([]float64{4, 1.5, 0}, result[4].GetScalars().GetDoubleData().GetData())
+	s.Equal(`avg(meta["score"])`, result[5].GetFieldName())
+	s.Equal([]float64{3, 1.5, 0}, result[5].GetScalars().GetDoubleData().GetData())
+
+	result = aggregator.Result(s.schema, 1, 1)
+	s.Equal([]string{"b"}, result[0].GetScalars().GetStringData().GetData())
+	result = aggregator.Result(s.schema, 5, 1)
+	s.Equal(0, len(result[0].GetScalars().GetStringData().GetData()))
+
+	s.Error(aggregator.Merge(result[:2]))
+	s.Error(aggregator.Add(nil, 1, nil))
+}
+
+func (s *AggregatorSuite) TestGroupByJSONPath() {
+	query := &planpb.QueryPlanNode{
+		GroupBy:    []*planpb.ColumnInfo{{FieldId: 104, DataType: schemapb.DataType_JSON, NestedPath: []string{"tag"}}},
+		Aggregates: []*planpb.Aggregate{{Op: planpb.Aggregate_Count}},
+	}
+	dynamic := newFieldData(schemapb.DataType_JSON, 104, "$meta")
+	dynamic.GetScalars().GetJsonData().Data = [][]byte{
+		[]byte(`{"tag": "x"}`), []byte(`{"tag": 1}`), []byte(`{}`), []byte(`{"tag": "x"}`),
+	}
+	aggregator := NewAggregator(query)
+	s.NoError(aggregator.Add([]*schemapb.FieldData{dynamic}, 4, nil))
+
+	merged := NewAggregator(query)
+	s.NoError(merged.Merge(aggregator.Partial()))
+	result := merged.Result(s.schema, 0, -1)
+	s.Equal("tag", result[0].GetFieldName())
+	s.Equal([][]byte{[]byte(`"x"`), []byte(`1`), []byte(`null`)}, result[0].GetScalars().GetJsonData().GetData())
+	s.Equal([]int64{2, 1, 1}, result[1].GetScalars().GetLongData().GetData())
+}
+
+func (s *AggregatorSuite) TestWithoutGroupBy() {
+	query := &planpb.QueryPlanNode{
+		Aggregates: []*planpb.Aggregate{{Op: planpb.Aggregate_Count}},
+	}
+	aggregator := NewAggregator(query)
+	result := aggregator.Result(s.schema, 0, -1)
+	s.Equal(1, len(result))
+	s.Equal([]int64{0}, result[0].GetScalars().GetLongData().GetData())
+
+	plan, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Query{Query: query}})
+	s.NoError(err)
+	aggregator, err = GetAggregatorFromPlan(plan)
+	s.NoError(err)
+	s.NotNil(aggregator)
+
+	s.Nil(NewAggregator(&planpb.QueryPlanNode{}))
+	_, err = GetAggregatorFromPlan(nil)
+	s.Error(err)
+}
+
+func TestAggregator(t *testing.T) {
+	suite.Run(t, new(AggregatorSuite))
+}
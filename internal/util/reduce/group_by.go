@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// GroupByInfo describes how the hits of a search are grouped by a scalar field,
+// it's shared by the reduce of segments, delegator and proxy.
+type GroupByInfo struct {
+	FieldID int64
+	// GroupSize is the max number of hits kept for a group value
+	GroupSize int64
+	// GroupLimit is the max number of group values kept for a query
+	GroupLimit int64
+}
+
+// NewGroupByInfo returns the group by info of query info, nil if the search is not grouped.
+func NewGroupByInfo(queryInfo *planpb.QueryInfo) *GroupByInfo {
+	if queryInfo.GetGroupByFieldId() <= 0 {
+		return nil
+	}
+	groupSize := queryInfo.GetGroupSize()
+	if groupSize <= 0 {
+		groupSize = 1
+	}
+	return &GroupByInfo{
+		FieldID:    queryInfo.GetGroupByFieldId(),
+		GroupSize:  groupSize,
+		GroupLimit: queryInfo.GetGroupLimit(),
+	}
+}
+
+// GetGroupByInfoFromPlan returns the group by info of the serialized search plan, nil if the search is not grouped.
+func GetGroupByInfoFromPlan(serializedPlan []byte) (*GroupByInfo, error) {
+	if len(serializedPlan) == 0 {
+		return nil, nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	return NewGroupByInfo(plan.GetVectorAnns().GetQueryInfo()), nil
+}
+
+// GroupValues returns the field data of group values in the fields data of search result.
+func (info *GroupByInfo) GroupValues(fieldsData []*schemapb.FieldData) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == info.FieldID {
+			return fieldData, nil
+		}
+	}
+	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("group by field %d not found in search result", info.FieldID))
+}
+
+type groupState struct {
+	rank  int64
+	count int64
+}
+
+// GroupCounter decides which hits of a query are kept, the hits must be fed in descending order of scores.
+type GroupCounter struct {
+	info   *GroupByInfo
+	groups map[interface{}]*groupState
+	full   int64
+}
+
+// NewCounter creates the counter for the hits of a query.
+func (info *GroupByInfo) NewCounter() *GroupCounter {
+	return &GroupCounter{
+		info:   info,
+		groups: make(map[interface{}]*groupState),
+	}
+}
+
+// Accept returns whether the hit of group value is kept, and the rank of its group in the kept groups.
+// The hit is counted if it's kept.
+func (c *GroupCounter) Accept(values *schemapb.FieldData, idx int64) (int64, bool) {
+	value := typeutil.GetData(values, int(idx))
+	group, ok := c.groups[value]
+	if !ok {
+		if int64(len(c.groups)) >= c.info.GroupLimit {
+			return -1, false
+		}
+		group = &groupState{rank: int64(len(c.groups))}
+		c.groups[value] = group
+	}
+	if group.count >= c.info.GroupSize {
+		return group.rank, false
+	}
+	group.count++
+	if group.count == c.info.GroupSize {
+		c.full++
+	}
+	return group.rank, true
+}
+
+// Full returns whether every group is full, so no more hits could be kept.
+func (c *GroupCounter) Full() bool {
+	return c.full >= c.info.GroupLimit
+}
+
+// Truncated returns whether some groups of the query may be missing: fewer than GroupLimit groups are found
+// while some group is full, the candidates searched could be taken by the full groups.
+func (c *GroupCounter) Truncated() bool {
+	return int64(len(c.groups)) < c.info.GroupLimit && c.full > 0
+}
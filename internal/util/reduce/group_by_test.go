@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func TestNewGroupByInfo(t *testing.T) {
+	assert.Nil(t, NewGroupByInfo(nil))
+	assert.Nil(t, NewGroupByInfo(&planpb.QueryInfo{Topk: 10}))
+
+	info := NewGroupByInfo(&planpb.QueryInfo{Topk: 40, GroupByFieldId: 101, GroupLimit: 10})
+	assert.Equal(t, &GroupByInfo{FieldID: 101, GroupSize: 1, GroupLimit: 10}, info)
+
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				QueryInfo: &planpb.QueryInfo{Topk: 40, GroupByFieldId: 101, GroupSize: 2, GroupLimit: 5},
+			},
+		},
+	}
+	bs, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+	info, err = GetGroupByInfoFromPlan(bs)
+	assert.NoError(t, err)
+	assert.Equal(t, &GroupByInfo{FieldID: 101, GroupSize: 2, GroupLimit: 5}, info)
+
+	info, err = GetGroupByInfoFromPlan(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	_, err = GetGroupByInfoFromPlan([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestGroupCounter(t *testing.T) {
+	info := &GroupByInfo{FieldID: 101, GroupSize: 2, GroupLimit: 2}
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: []string{"a", "b", "a", "c", "a", "b"}},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := info.GroupValues(nil)
+	assert.Error(t, err)
+	values, err := info.GroupValues(fieldsData)
+	assert.NoError(t, err)
+
+	counter := info.NewCounter()
+	type accepted struct {
+		rank int64
+		ok   bool
+	}
+	expected := []accepted{
+		{0, true},   // a
+		{1, true},   // b
+		{0, true},   // a
+		{-1, false}, // c, no more groups
+		{0, false},  // a is full
+		{1, true},   // b
+	}
+	for i, e := range expected {
+		assert.False(t, counter.Full())
+		rank, ok := counter.Accept(values, int64(i))
+		assert.Equal(t, e.rank, rank, i)
+		assert.Equal(t, e.ok, ok, i)
+	}
+	assert.True(t, counter.Full())
+	assert.False(t, counter.Truncated())
+
+	// group a takes all the hits
+	counter = info.NewCounter()
+	for _, i := range []int64{0, 2, 4} {
+		counter.Accept(values, i)
+	}
+	assert.False(t, counter.Full())
+	assert.True(t, counter.Truncated())
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// RetrieveResult is the retrieve result of segments or querynodes.
+type RetrieveResult interface {
+	GetIds() *schemapb.IDs
+	GetFieldsData() []*schemapb.FieldData
+}
+
+// OrderBy orders the entities of retrieve results by the values of order by fields,
+// the entities with the same values are ordered by primary keys.
+type OrderBy struct {
+	orderBy []*planpb.OrderBy
+}
+
+// NewOrderBy returns the order of retrieve plan, nil if the entities are ordered by primary keys.
+func NewOrderBy(query *planpb.QueryPlanNode) *OrderBy {
+	if len(query.GetOrderBy()) == 0 {
+		return nil
+	}
+	return &OrderBy{orderBy: query.GetOrderBy()}
+}
+
+// GetOrderByFromPlan returns the order of the serialized retrieve plan, nil if the entities are ordered by primary keys.
+func GetOrderByFromPlan(serializedPlan []byte) (*OrderBy, error) {
+	if len(serializedPlan) == 0 {
+		return nil, nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, err
+	}
+	return NewOrderBy(plan.GetQuery()), nil
+}
+
+type orderedRows struct {
+	ids     *schemapb.IDs
+	columns []*schemapb.FieldData
+}
+
+func (o *OrderBy) rows(result RetrieveResult) (*orderedRows, error) {
+	rows := &orderedRows{
+		ids:     result.GetIds(),
+		columns: make([]*schemapb.FieldData, len(o.orderBy)),
+	}
+	for i, orderBy := range o.orderBy {
+		column, err := findFieldData(result.GetFieldsData(), orderBy.GetColumn())
+		if err != nil {
+			return nil, err
+		}
+		rows.columns[i] = column
+	}
+	return rows, nil
+}
+
+// compare returns a negative number if row i of a is before row j of b, a positive number if it's after.
+func (o *OrderBy) compare(a *orderedRows, i int64, b *orderedRows, j int64) int {
+	for k, orderBy := range o.orderBy {
+		c := compareValues(typeutil.GetData(a.columns[k], int(i)), typeutil.GetData(b.columns[k], int(j)))
+		if c != 0 {
+			if !orderBy.GetAscending() {
+				return -c
+			}
+			return c
+		}
+	}
+	pkA, pkB := typeutil.GetPK(a.ids, i), typeutil.GetPK(b.ids, j)
+	if typeutil.ComparePK(pkA, pkB) {
+		return -1
+	} else if typeutil.ComparePK(pkB, pkA) {
+		return 1
+	}
+	return 0
+}
+
+// TopN returns the rows of the first n entities of result in order, all the rows are returned
+// if n is typeutil.Unlimited.
+func (o *OrderBy) TopN(result RetrieveResult, n int64) ([]int64, error) {
+	rows, err := o.rows(result)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(typeutil.GetSizeOfIDs(rows.ids))
+	indexes := make([]int64, size)
+	for i := range indexes {
+		indexes[i] = int64(i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return o.compare(rows, indexes[i], rows, indexes[j]) < 0
+	})
+	if n != typeutil.Unlimited && n < size {
+		indexes = indexes[:n]
+	}
+	return indexes, nil
+}
+
+// Merger k-way merges the retrieve results whose entities are in the order of OrderBy.
+type Merger struct {
+	orderBy *OrderBy
+	rows    []*orderedRows
+}
+
+// NewMerger creates the merger of results.
+func NewMerger[T RetrieveResult](orderBy *OrderBy, results []T) (*Merger, error) {
+	merger := &Merger{
+		orderBy: orderBy,
+		rows:    make([]*orderedRows, len(results)),
+	}
+	for i, result := range results {
+		rows, err := orderBy.rows(result)
+		if err != nil {
+			return nil, err
+		}
+		merger.rows[i] = rows
+	}
+	return merger, nil
+}
+
+// SelectMin returns the index of the result whose entity at the cursor is the first in order,
+// -1 if all the results are drained.
+func (m *Merger) SelectMin(cursors []int64) int {
+	sel := -1
+	for i, cursor := range cursors {
+		if cursor >= int64(typeutil.GetSizeOfIDs(m.rows[i].ids)) {
+			continue
+		}
+		if sel == -1 || m.orderBy.compare(m.rows[i], cursor, m.rows[sel], cursors[sel]) < 0 {
+			sel = i
+		}
+	}
+	return sel
+}
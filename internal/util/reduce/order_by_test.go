@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reduce
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+func genOrderByResult(pks []int64, categories []string, prices []float64) *internalpb.RetrieveResults {
+	category := newFieldData(schemapb.DataType_VarChar, 101, "category")
+	category.GetScalars().GetStringData().Data = categories
+	price := newDoubleFieldData("price", prices)
+	price.FieldId = 102
+	return &internalpb.RetrieveResults{
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{category, price},
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	query := &planpb.QueryPlanNode{
+		OrderBy: []*planpb.OrderBy{
+			{Column: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_VarChar}, Ascending: true},
+			{Column: &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_Double}, Ascending: false},
+		},
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		orderBy, err := GetOrderByFromPlan(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, orderBy)
+
+		bytes, err := proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{}}})
+		assert.NoError(t, err)
+		orderBy, err = GetOrderByFromPlan(bytes)
+		assert.NoError(t, err)
+		assert.Nil(t, orderBy)
+
+		bytes, err = proto.Marshal(&planpb.PlanNode{Node: &planpb.PlanNode_Query{Query: query}})
+		assert.NoError(t, err)
+		orderBy, err = GetOrderByFromPlan(bytes)
+		assert.NoError(t, err)
+		assert.NotNil(t, orderBy)
+
+		_, err = GetOrderByFromPlan([]byte("invalid"))
+		assert.Error(t, err)
+	})
+
+	t.Run("top n", func(t *testing.T) {
+		orderBy := NewOrderBy(query)
+		result := genOrderByResult(
+			[]int64{1, 2, 3, 4, 5},
+			[]string{"b", "a", "b", "a", "a"},
+			[]float64{1.5, 2, 3, 1, 2},
+		)
+		rows, err := orderBy.TopN(result, 4)
+		assert.NoError(t, err)
+		// ties of category and price are ordered by primary keys
+		assert.Equal(t, []int64{1, 4, 3, 2}, rows)
+
+		rows, err = orderBy.TopN(result, -1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 4, 3, 2, 0}, rows)
+
+		_, err = orderBy.TopN(&internalpb.RetrieveResults{}, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		orderBy := NewOrderBy(query)
+		results := []*internalpb.RetrieveResults{
+			genOrderByResult([]int64{2, 3}, []string{"a", "b"}, []float64{2, 3}),
+			genOrderByResult([]int64{5, 4, 1}, []string{"a", "a", "b"}, []float64{2, 1, 1.5}),
+		}
+		merger, err := NewMerger(orderBy, results)
+		assert.NoError(t, err)
+
+		cursors := make([]int64, len(results))
+		var pks []int64
+		for sel := merger.SelectMin(cursors); sel != -1; sel = merger.SelectMin(cursors) {
+			pks = append(pks, results[sel].GetIds().GetIntId().GetData()[cursors[sel]])
+			cursors[sel]++
+		}
+		assert.Equal(t, []int64{2, 5, 4, 3, 1}, pks)
+
+		_, err = NewMerger(orderBy, []*internalpb.RetrieveResults{{}})
+		assert.Error(t, err)
+	})
+}
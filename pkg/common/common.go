@@ -100,6 +100,9 @@ const (
 	DimKey         = "dim"
 	MaxLengthKey   = "max_length"
 	MaxCapacityKey = "max_capacity"
+
+	// NullableKey is the type param of scalar field which accepts null values
+	NullableKey = "nullable"
 )
 
 //  Collection properties key
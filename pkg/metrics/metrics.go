@@ -61,6 +61,9 @@ const (
 	HookAfter  = "after"
 	HookMock   = "mock"
 
+	AuditEventSpilled = "spilled"
+	AuditEventDropped = "dropped"
+
 	ReduceSegments = "segments"
 	ReduceShards   = "shards"
 
@@ -83,6 +86,7 @@ const (
 	indexCountLabelName      = "indexed_field_count"
 	requestScope             = "scope"
 	fullMethodLabelName      = "full_method"
+	auditSinkLabelName       = "audit_sink"
 	reduceLevelName          = "reduce_level"
 	lockName                 = "lock_name"
 	lockSource               = "lock_source"
@@ -288,6 +288,16 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	// ProxyUndeliveredAuditEvents counts the audit events failed to be delivered to a sink,
+	// which are either spilled to the local file sink or dropped.
+	ProxyUndeliveredAuditEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "undelivered_audit_event_count",
+			Help:      "count of audit events failed to be delivered to the sink",
+		}, []string{nodeIDLabelName, auditSinkLabelName, statusLabelName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -331,6 +341,8 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyWorkLoadScore)
 	registry.MustRegister(ProxyExecutingTotalNq)
+
+	registry.MustRegister(ProxyUndeliveredAuditEvents)
 }
 
 func CleanupCollectionMetrics(nodeID int64, collection string) {
@@ -213,6 +213,10 @@ func (it *InsertMsg) CheckAligned() error {
 	rowNums := it.NRows()
 	if it.IsColumnBased() {
 		for _, field := range it.FieldsData {
+			// all the rows of a null field data are null
+			if typeutil.IsNullFieldData(field) {
+				continue
+			}
 			fieldNumRows, err := funcutil.GetNumRowOfFieldData(field)
 			if err != nil {
 				return err
@@ -543,6 +547,71 @@ func (cc *CreateCollectionMsg) Size() int {
 	return proto.Size(&cc.CreateCollectionRequest)
 }
 
+/////////////////////////////////////////AlterCollection//////////////////////////////////////////
+
+// AlterCollectionMsg is a message pack that contains the schema of collection after a field is added or dropped,
+// msgpb has no alter collection request, so the schema is carried by CreateCollectionRequest
+type AlterCollectionMsg struct {
+	BaseMsg
+	msgpb.CreateCollectionRequest
+}
+
+// interface implementation validation
+var _ TsMsg = &AlterCollectionMsg{}
+
+// ID returns the ID of this message pack
+func (ac *AlterCollectionMsg) ID() UniqueID {
+	return ac.Base.MsgID
+}
+
+// SetID set the ID of this message pack
+func (ac *AlterCollectionMsg) SetID(id UniqueID) {
+	ac.Base.MsgID = id
+}
+
+// Type returns the type of this message pack
+func (ac *AlterCollectionMsg) Type() MsgType {
+	return ac.Base.MsgType
+}
+
+// SourceID indicates which component generated this message
+func (ac *AlterCollectionMsg) SourceID() int64 {
+	return ac.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (ac *AlterCollectionMsg) Marshal(input TsMsg) (MarshalType, error) {
+	alterCollectionMsg := input.(*AlterCollectionMsg)
+	alterCollectionRequest := &alterCollectionMsg.CreateCollectionRequest
+	mb, err := proto.Marshal(alterCollectionRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (ac *AlterCollectionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	alterCollectionRequest := msgpb.CreateCollectionRequest{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &alterCollectionRequest)
+	if err != nil {
+		return nil, err
+	}
+	alterCollectionMsg := &AlterCollectionMsg{CreateCollectionRequest: alterCollectionRequest}
+	alterCollectionMsg.BeginTimestamp = alterCollectionMsg.Base.Timestamp
+	alterCollectionMsg.EndTimestamp = alterCollectionMsg.Base.Timestamp
+
+	return alterCollectionMsg, nil
+}
+
+func (ac *AlterCollectionMsg) Size() int {
+	return proto.Size(&ac.CreateCollectionRequest)
+}
+
 /////////////////////////////////////////DropCollection//////////////////////////////////////////
 
 // DropCollectionMsg is a message pack that contains drop collection request
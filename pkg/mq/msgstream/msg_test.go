@@ -209,6 +209,10 @@ func TestInsertMsg_CheckAligned(t *testing.T) {
 
 	msg1.Version = msgpb.InsertDataVersion_ColumnBased
 	assert.NoError(t, msg1.CheckAligned())
+
+	// null field data has no rows
+	msg1.InsertRequest.FieldsData = append(msg1.InsertRequest.FieldsData, &schemapb.FieldData{Type: schemapb.DataType_Int32})
+	assert.NoError(t, msg1.CheckAligned())
 }
 
 func TestInsertMsg_IndexMsg(t *testing.T) {
@@ -413,6 +417,46 @@ func TestCreateCollectionMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.Nil(t, tsMsg)
 }
 
+func TestAlterCollectionMsg(t *testing.T) {
+	alterCollectionMsg := &AlterCollectionMsg{
+		BaseMsg: generateBaseMsg(),
+		CreateCollectionRequest: msgpb.CreateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterCollection,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			DbName:         "test_db",
+			CollectionName: "test_collection",
+			DbID:           4,
+			CollectionID:   5,
+			Schema:         []byte{1, 2, 3},
+		},
+	}
+
+	assert.Equal(t, int64(1), alterCollectionMsg.ID())
+	assert.Equal(t, commonpb.MsgType_AlterCollection, alterCollectionMsg.Type())
+	assert.Equal(t, int64(3), alterCollectionMsg.SourceID())
+
+	bytes, err := alterCollectionMsg.Marshal(alterCollectionMsg)
+	assert.NoError(t, err)
+
+	tsMsg, err := alterCollectionMsg.Unmarshal(bytes)
+	assert.NoError(t, err)
+
+	alterCollectionMsg2, ok := tsMsg.(*AlterCollectionMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), alterCollectionMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_AlterCollection, alterCollectionMsg2.Type())
+	assert.Equal(t, uint64(2), alterCollectionMsg2.EndTs())
+	assert.Equal(t, []byte{1, 2, 3}, alterCollectionMsg2.GetSchema())
+
+	tsMsg, err = alterCollectionMsg.Unmarshal(10)
+	assert.Error(t, err)
+	assert.Nil(t, tsMsg)
+}
+
 func TestDropCollectionMsg(t *testing.T) {
 	dropCollectionMsg := &DropCollectionMsg{
 		BaseMsg: generateBaseMsg(),
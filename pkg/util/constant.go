@@ -59,8 +59,14 @@ const (
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
 
+	// ObjectTypeField is the object type of field-level privileges, which isn't defined by milvus proto yet,
+	// its object name is collection.field.
+	ObjectTypeField = "Field"
+
 	IdentifierKey = "identifier"
 	HeaderDBName  = "dbName"
+	// HeaderRequestPriority is the priority of request given by client, high, normal or low, it could only lower the priority of user
+	HeaderRequestPriority = "request-priority"
 )
 
 const (
@@ -123,6 +129,11 @@ var (
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeUpdateUser.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSelectUser.String()),
 		},
+		// reading the field in output fields of query or search
+		ObjectTypeField: {
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeQuery.String()),
+			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSearch.String()),
+		},
 	}
 )
 
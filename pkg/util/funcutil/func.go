@@ -117,7 +117,7 @@ func CheckCtxValid(ctx context.Context) bool {
 func GetVecFieldIDs(schema *schemapb.CollectionSchema) []int64 {
 	var vecFieldIDs []int64
 	for _, field := range schema.Fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_Float16Vector {
+		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector || typeutil.IsBytesVectorType(field.DataType) {
 			vecFieldIDs = append(vecFieldIDs, field.FieldID)
 		}
 	}
@@ -242,6 +242,28 @@ func GetNumRowsOfFloat16VectorField(f16Datas []byte, dim int64) (uint64, error)
 	return uint64((int64(l)) / dim / 2), nil
 }
 
+func GetNumRowsOfBFloat16VectorField(bf16Datas []byte, dim int64) (uint64, error) {
+	if dim <= 0 {
+		return 0, fmt.Errorf("dim(%d) should be greater than 0", dim)
+	}
+	l := len(bf16Datas)
+	if int64(l)%(dim*2) != 0 {
+		return 0, fmt.Errorf("the length(%d) of bfloat16 data should divide the dim(%d)", l, dim)
+	}
+	return uint64((int64(l)) / dim / 2), nil
+}
+
+func GetNumRowsOfInt8VectorField(i8Datas []byte, dim int64) (uint64, error) {
+	if dim <= 0 {
+		return 0, fmt.Errorf("dim(%d) should be greater than 0", dim)
+	}
+	l := len(i8Datas)
+	if int64(l)%dim != 0 {
+		return 0, fmt.Errorf("the length(%d) of int8 data should divide the dim(%d)", l, dim)
+	}
+	return uint64(int64(l) / dim), nil
+}
+
 func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 	var fieldNumRows uint64
 	var err error
@@ -289,6 +311,20 @@ func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 			if err != nil {
 				return 0, err
 			}
+		case *schemapb.VectorField_Bfloat16Vector:
+			dim := vectorField.GetDim()
+			fieldNumRows, err = GetNumRowsOfBFloat16VectorField(vectorField.GetBfloat16Vector(), dim)
+			if err != nil {
+				return 0, err
+			}
+		case *schemapb.VectorField_Int8Vector:
+			dim := vectorField.GetDim()
+			fieldNumRows, err = GetNumRowsOfInt8VectorField(vectorField.GetInt8Vector(), dim)
+			if err != nil {
+				return 0, err
+			}
+		case *schemapb.VectorField_SparseFloatVector:
+			fieldNumRows = uint64(len(vectorField.GetSparseFloatVector().GetContents()))
 		default:
 			return 0, fmt.Errorf("%s is not supported now", vectorFieldType)
 		}
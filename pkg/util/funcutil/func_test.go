@@ -33,6 +33,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_CheckGrpcReady(t *testing.T) {
@@ -256,6 +257,70 @@ func TestGetNumRowsOfFloat16VectorField(t *testing.T) {
 	}
 }
 
+func TestGetNumRowsOfBFloat16VectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint64
+		errIsNil bool
+	}{
+		{[]byte{}, -1, 0, false},        // dim <= 0
+		{[]byte{1.0, 2.0}, 2, 0, false}, // length % (dim * 2) != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1.0, 2.0, 3.0, 4.0}, 1, 2, true},
+		{[]byte{1.0, 2.0, 3.0, 4.0}, 2, 1, true},
+	}
+
+	for _, test := range cases {
+		got, err := GetNumRowsOfBFloat16VectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		} else {
+			assert.Error(t, err)
+		}
+	}
+}
+
+func TestGetNumRowsOfInt8VectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint64
+		errIsNil bool
+	}{
+		{[]byte{}, 0, 0, false},        // dim <= 0
+		{[]byte{1, 2, 3}, 2, 0, false}, // length % dim != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1, 2, 3, 4}, 2, 2, true},
+	}
+
+	for _, test := range cases {
+		got, err := GetNumRowsOfInt8VectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		} else {
+			assert.Error(t, err)
+		}
+	}
+
+	fieldData := typeutil.NewBytesVectorFieldData(typeutil.Int8Vector, "vec", 2, []byte{1, 2, 3, 4, 5, 6})
+	rows, err := GetNumRowOfFieldData(fieldData)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), rows)
+}
+
+func TestGetNumRowOfSparseFloatVectorField(t *testing.T) {
+	fieldData := typeutil.NewSparseFloatVectorFieldData("sparse", [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1}, []float32{0.1}),
+		typeutil.CreateSparseFloatRow(nil, nil),
+	})
+	rows, err := GetNumRowOfFieldData(fieldData)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), rows)
+}
+
 func TestGetNumRowsOfBinaryVectorField(t *testing.T) {
 	cases := []struct {
 		bDatas   []byte
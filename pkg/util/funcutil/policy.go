@@ -110,6 +110,11 @@ func SplitObjectName(objectName string) (string, string) {
 	if !strings.Contains(objectName, ".") {
 		return util.DefaultDBName, objectName
 	}
-	names := strings.Split(objectName, ".")
+	names := strings.SplitN(objectName, ".", 2)
 	return names[0], names[1]
 }
+
+// FieldObjectName returns the object name of field-level privileges.
+func FieldObjectName(collectionName string, fieldName string) string {
+	return fmt.Sprintf("%s.%s", collectionName, fieldName)
+}
@@ -20,7 +20,7 @@ func Test_GetPrivilegeExtObj(t *testing.T) {
 	assert.Equal(t, commonpb.ObjectPrivilege_PrivilegeLoad, privilegeExt.ObjectPrivilege)
 	assert.Equal(t, int32(3), privilegeExt.ObjectNameIndex)
 
-	request2 := &milvuspb.CreatePartitionRequest{}
+	request2 := &milvuspb.CheckHealthRequest{}
 	_, err = GetPrivilegeExtObj(request2)
 	assert.Error(t, err)
 }
@@ -72,3 +72,17 @@ func Test_PolicyForResource(t *testing.T) {
 		`COLLECTION-db.col1`,
 		PolicyForResource("db", "COLLECTION", "col1"))
 }
+
+func Test_SplitObjectName(t *testing.T) {
+	dbName, objectName := SplitObjectName("col1")
+	assert.Equal(t, "default", dbName)
+	assert.Equal(t, "col1", objectName)
+
+	dbName, objectName = SplitObjectName("db.col1")
+	assert.Equal(t, "db", dbName)
+	assert.Equal(t, "col1", objectName)
+
+	dbName, objectName = SplitObjectName(CombineObjectName("db", FieldObjectName("col1", "field1")))
+	assert.Equal(t, "db", dbName)
+	assert.Equal(t, "col1.field1", objectName)
+}
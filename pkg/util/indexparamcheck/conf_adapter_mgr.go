@@ -54,6 +54,8 @@ func (mgr *indexCheckerMgrImpl) registerIndexChecker() {
 	mgr.checkers[IndexFaissBinIvfFlat] = newBinIVFFlatChecker()
 	mgr.checkers[IndexHNSW] = newHnswChecker()
 	mgr.checkers[IndexDISKANN] = newDiskannChecker()
+	mgr.checkers[IndexSparseInverted] = newSparseInvertedIndexChecker()
+	mgr.checkers[IndexSparseWand] = newSparseInvertedIndexChecker()
 }
 
 func newIndexCheckerMgr() *indexCheckerMgrImpl {
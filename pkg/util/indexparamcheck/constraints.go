@@ -36,6 +36,9 @@ const (
 
 	EFConstruction = "efConstruction"
 	HNSWM          = "M"
+
+	// SparseDropRatioBuild is the ratio of the smallest values of every sparse row dropped when building index
+	SparseDropRatioBuild = "drop_ratio_build"
 )
 
 // METRICS is a set of all metrics types supported for float vector.
@@ -48,9 +51,11 @@ var (
 	HnswMetrics               = []string{metric.L2, metric.IP, metric.COSINE, metric.HAMMING, metric.JACCARD}        // const
 	supportDimPerSubQuantizer = []int{32, 28, 24, 20, 16, 12, 10, 8, 6, 4, 3, 2, 1}                                  // const
 	supportSubQuantizer       = []int{96, 64, 56, 48, 40, 32, 28, 24, 20, 16, 12, 8, 4, 3, 2, 1}                     // const
+	SparseMetrics             = []string{metric.IP}                                                                  // const
 )
 
 const (
-	FloatVectorDefaultMetricType  = metric.IP
-	BinaryVectorDefaultMetricType = metric.JACCARD
+	FloatVectorDefaultMetricType       = metric.IP
+	BinaryVectorDefaultMetricType      = metric.JACCARD
+	SparseFloatVectorDefaultMetricType = metric.IP
 )
@@ -1,9 +1,27 @@
 package indexparamcheck
 
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
 type flatChecker struct {
 	floatVectorBaseChecker
 }
 
+// CheckValidDataType accepts int8 vector besides float vectors, brute force search doesn't need a trained model.
+func (c flatChecker) CheckValidDataType(dType schemapb.DataType) error {
+	if typeutil.IsInt8VectorType(dType) {
+		return nil
+	}
+	if err := c.floatVectorBaseChecker.CheckValidDataType(dType); err != nil {
+		return fmt.Errorf("float, float16, bfloat16 or int8 vector are only supported")
+	}
+	return nil
+}
+
 func newFlatChecker() IndexChecker {
 	return &flatChecker{}
 }
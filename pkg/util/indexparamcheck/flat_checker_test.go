@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_flatChecker_CheckTrain(t *testing.T) {
@@ -62,3 +64,13 @@ func Test_flatChecker_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func Test_flatChecker_CheckValidDataType(t *testing.T) {
+	c := newFlatChecker()
+	assert.NoError(t, c.CheckValidDataType(schemapb.DataType_FloatVector))
+	assert.NoError(t, c.CheckValidDataType(schemapb.DataType_Float16Vector))
+	assert.NoError(t, c.CheckValidDataType(typeutil.BFloat16Vector))
+	assert.NoError(t, c.CheckValidDataType(typeutil.Int8Vector))
+	assert.Error(t, c.CheckValidDataType(schemapb.DataType_BinaryVector))
+	assert.Error(t, c.CheckValidDataType(typeutil.SparseFloatVector))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_floatVectorBaseChecker_CheckValidDataType(t *testing.T) {
@@ -65,6 +66,18 @@ func Test_floatVectorBaseChecker_CheckValidDataType(t *testing.T) {
 			dType:    schemapb.DataType_BinaryVector,
 			errIsNil: false,
 		},
+		{
+			dType:    schemapb.DataType_Float16Vector,
+			errIsNil: true,
+		},
+		{
+			dType:    typeutil.BFloat16Vector,
+			errIsNil: true,
+		},
+		{
+			dType:    typeutil.Int8Vector,
+			errIsNil: false,
+		},
 	}
 
 	c := newFloatVectorBaseChecker()
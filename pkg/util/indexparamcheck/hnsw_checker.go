@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type hnswChecker struct {
@@ -31,8 +32,8 @@ func (c hnswChecker) CheckTrain(params map[string]string) error {
 }
 
 func (c hnswChecker) CheckValidDataType(dType schemapb.DataType) error {
-	if dType != schemapb.DataType_FloatVector && dType != schemapb.DataType_BinaryVector && dType != schemapb.DataType_Float16Vector {
-		return fmt.Errorf("only support float vector or binary vector")
+	if dType != schemapb.DataType_FloatVector && dType != schemapb.DataType_BinaryVector && !typeutil.IsBytesVectorType(dType) {
+		return fmt.Errorf("only support float, float16, bfloat16, int8 or binary vector")
 	}
 	return nil
 }
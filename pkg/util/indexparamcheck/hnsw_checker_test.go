@@ -8,6 +8,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_hnswChecker_CheckTrain(t *testing.T) {
@@ -160,6 +161,18 @@ func Test_hnswChecker_CheckValidDataType(t *testing.T) {
 			dType:    schemapb.DataType_BinaryVector,
 			errIsNil: true,
 		},
+		{
+			dType:    schemapb.DataType_Float16Vector,
+			errIsNil: true,
+		},
+		{
+			dType:    typeutil.BFloat16Vector,
+			errIsNil: true,
+		},
+		{
+			dType:    typeutil.Int8Vector,
+			errIsNil: true,
+		},
 	}
 
 	c := newHnswChecker()
@@ -0,0 +1,48 @@
+package indexparamcheck
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// sparseInvertedIndexChecker checks the params of SPARSE_INVERTED_INDEX and SPARSE_WAND,
+// sparse float vector doesn't have a fixed dim, so dim is not checked.
+type sparseInvertedIndexChecker struct {
+	baseChecker
+}
+
+func (c sparseInvertedIndexChecker) StaticCheck(params map[string]string) error {
+	if !CheckStrByValues(params, Metric, SparseMetrics) {
+		return fmt.Errorf("metric type not found or not supported, supported: %v", SparseMetrics)
+	}
+	if dropRatioStr, ok := params[SparseDropRatioBuild]; ok {
+		dropRatio, err := strconv.ParseFloat(dropRatioStr, 64)
+		if err != nil || dropRatio < 0 || dropRatio >= 1 {
+			return fmt.Errorf("%s must be in range [0, 1)", SparseDropRatioBuild)
+		}
+	}
+	return nil
+}
+
+func (c sparseInvertedIndexChecker) CheckTrain(params map[string]string) error {
+	return c.StaticCheck(params)
+}
+
+func (c sparseInvertedIndexChecker) CheckValidDataType(dType schemapb.DataType) error {
+	if !typeutil.IsSparseFloatVectorType(dType) {
+		return fmt.Errorf("only sparse float vector is supported for the specified index type")
+	}
+	return nil
+}
+
+func (c sparseInvertedIndexChecker) SetDefaultMetricTypeIfNotExist(params map[string]string) {
+	setDefaultIfNotExist(params, common.MetricTypeKey, SparseFloatVectorDefaultMetricType)
+}
+
+func newSparseInvertedIndexChecker() IndexChecker {
+	return &sparseInvertedIndexChecker{}
+}
@@ -0,0 +1,57 @@
+package indexparamcheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_sparseInvertedIndexChecker_CheckTrain(t *testing.T) {
+	cases := []struct {
+		params   map[string]string
+		errIsNil bool
+	}{
+		{map[string]string{Metric: metric.IP}, true},
+		{map[string]string{Metric: metric.IP, SparseDropRatioBuild: "0.2"}, true},
+		{map[string]string{Metric: metric.IP, SparseDropRatioBuild: "0"}, true},
+		{map[string]string{Metric: metric.IP, SparseDropRatioBuild: "1"}, false},
+		{map[string]string{Metric: metric.IP, SparseDropRatioBuild: "-0.1"}, false},
+		{map[string]string{Metric: metric.IP, SparseDropRatioBuild: "abc"}, false},
+		{map[string]string{Metric: metric.L2}, false},
+		{map[string]string{}, false},
+	}
+
+	c := newSparseInvertedIndexChecker()
+	for _, test := range cases {
+		err := c.CheckTrain(test.params)
+		if test.errIsNil {
+			assert.NoError(t, err)
+		} else {
+			assert.Error(t, err)
+		}
+	}
+}
+
+func Test_sparseInvertedIndexChecker_CheckValidDataType(t *testing.T) {
+	c := newSparseInvertedIndexChecker()
+	assert.NoError(t, c.CheckValidDataType(typeutil.SparseFloatVector))
+	assert.Error(t, c.CheckValidDataType(schemapb.DataType_FloatVector))
+	assert.Error(t, c.CheckValidDataType(schemapb.DataType_VarChar))
+
+	params := map[string]string{}
+	c.SetDefaultMetricTypeIfNotExist(params)
+	assert.Equal(t, metric.IP, params[Metric])
+}
+
+func Test_sparseInvertedIndexChecker_Registered(t *testing.T) {
+	mgr := newIndexCheckerMgr()
+	for _, indexType := range []IndexType{IndexSparseInverted, IndexSparseWand} {
+		c, err := mgr.GetChecker(indexType)
+		assert.NoError(t, err)
+		assert.NoError(t, c.CheckValidDataType(typeutil.SparseFloatVector))
+	}
+}
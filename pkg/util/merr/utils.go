@@ -358,8 +358,12 @@ func WrapErrServiceDiskLimitExceeded(predict, limit float32, msg ...string) erro
 	return err
 }
 
-func WrapErrServiceRateLimit(rate float64) error {
-	return wrapFields(ErrServiceRateLimit, value("rate", rate))
+func WrapErrServiceRateLimit(rate float64, msg ...string) error {
+	err := wrapFields(ErrServiceRateLimit, value("rate", rate))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
 }
 
 func WrapErrServiceForceDeny(op string, reason error, method string) error {
@@ -881,16 +881,33 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 // /////////////////////////////////////////////////////////////////////////////
 // --- proxy ---
 type AccessLogConfig struct {
-	Enable        ParamItem `refreshable:"false"`
-	MinioEnable   ParamItem `refreshable:"false"`
-	LocalPath     ParamItem `refreshable:"false"`
-	Filename      ParamItem `refreshable:"false"`
-	MaxSize       ParamItem `refreshable:"false"`
-	CacheSize     ParamItem `refreshable:"false"`
-	RotatedTime   ParamItem `refreshable:"false"`
-	MaxBackups    ParamItem `refreshable:"false"`
-	RemotePath    ParamItem `refreshable:"false"`
-	RemoteMaxTime ParamItem `refreshable:"false"`
+	Enable        ParamItem  `refreshable:"false"`
+	MinioEnable   ParamItem  `refreshable:"false"`
+	LocalPath     ParamItem  `refreshable:"false"`
+	Filename      ParamItem  `refreshable:"false"`
+	MaxSize       ParamItem  `refreshable:"false"`
+	CacheSize     ParamItem  `refreshable:"false"`
+	RotatedTime   ParamItem  `refreshable:"false"`
+	MaxBackups    ParamItem  `refreshable:"false"`
+	RemotePath    ParamItem  `refreshable:"false"`
+	RemoteMaxTime ParamItem  `refreshable:"false"`
+	Formatters    ParamGroup `refreshable:"false"`
+}
+
+type AuditLogConfig struct {
+	Enable         ParamItem `refreshable:"false"`
+	Sinks          ParamItem `refreshable:"false"`
+	Categories     ParamItem `refreshable:"true"`
+	IncludeMethods ParamItem `refreshable:"true"`
+	ExcludeMethods ParamItem `refreshable:"true"`
+	LocalPath      ParamItem `refreshable:"false"`
+	Filename       ParamItem `refreshable:"false"`
+	MaxSize        ParamItem `refreshable:"false"`
+	MaxBackups     ParamItem `refreshable:"false"`
+	RotatedTime    ParamItem `refreshable:"false"`
+	RemotePath     ParamItem `refreshable:"false"`
+	FlushInterval  ParamItem `refreshable:"false"`
+	KafkaTopic     ParamItem `refreshable:"false"`
 }
 
 type proxyConfig struct {
@@ -912,12 +929,38 @@ type proxyConfig struct {
 	MaxRoleNum                   ParamItem `refreshable:"true"`
 	MaxTaskNum                   ParamItem `refreshable:"false"`
 	AccessLog                    AccessLogConfig
+	AuditLog                     AuditLogConfig
 	ShardLeaderCacheInterval     ParamItem `refreshable:"false"`
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
 	RetryTimesOnReplica          ParamItem `refreshable:"true"`
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
+	IteratorTTL                  ParamItem `refreshable:"true"`
+	MaxIteratorNum               ParamItem `refreshable:"true"`
+	GroupByCandidateRatio        ParamItem `refreshable:"true"`
+	QueryStreamTimeout           ParamItem `refreshable:"true"`
+
+	TaskPriorityEnabled      ParamItem `refreshable:"true"`
+	TaskPriorityHighWeight   ParamItem `refreshable:"true"`
+	TaskPriorityNormalWeight ParamItem `refreshable:"true"`
+	TaskPriorityLowWeight    ParamItem `refreshable:"true"`
+	TaskPriorityRoles        ParamItem `refreshable:"true"`
+	TaskPriorityMaxQueueWait ParamItem `refreshable:"true"`
+
+	JWTEnabled             ParamItem `refreshable:"true"`
+	JWTJWKSURL             ParamItem `refreshable:"true"`
+	JWTIssuer              ParamItem `refreshable:"true"`
+	JWTAudience            ParamItem `refreshable:"true"`
+	JWTUserClaim           ParamItem `refreshable:"true"`
+	JWTRolesClaim          ParamItem `refreshable:"true"`
+	JWTJWKSRefreshInterval ParamItem `refreshable:"true"`
+
+	MTLSEnabled          ParamItem `refreshable:"true"`
+	MTLSUserMappingRules ParamItem `refreshable:"true"`
+
+	FieldPrivilegeDeniedAction ParamItem `refreshable:"true"`
+	FieldPrivilegeMaskValue    ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1139,6 +1182,130 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.RemoteMaxTime.Init(base.mgr)
 
+	p.AccessLog.Formatters = ParamGroup{
+		KeyPrefix: "proxy.accessLog.formatters.",
+		Version:   "2.3.5",
+		Doc:       "formatters of access log, <name>.format is the template and <name>.methods selects the methods using it, the base formatter is used by other methods",
+		Export:    true,
+	}
+	p.AccessLog.Formatters.Init(base.mgr)
+
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.3.5",
+		DefaultValue: "false",
+		Doc:          "whether to write the audit log of requests in json",
+		Export:       true,
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.Sinks = ParamItem{
+		Key:          "proxy.auditLog.sinks",
+		Version:      "2.3.5",
+		DefaultValue: "file",
+		Doc:          "sinks of audit log separated by comma, file, objectStorage and kafka are supported, the events failed to deliver to objectStorage or kafka are spilled to file if it is configured",
+		Export:       true,
+	}
+	p.AuditLog.Sinks.Init(base.mgr)
+
+	p.AuditLog.Categories = ParamItem{
+		Key:          "proxy.auditLog.categories",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "categories of audited requests separated by comma, ddl, dml, dql, rbac and other are supported, empty means all",
+		Export:       true,
+	}
+	p.AuditLog.Categories.Init(base.mgr)
+
+	p.AuditLog.IncludeMethods = ParamItem{
+		Key:          "proxy.auditLog.includeMethods",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "methods audited regardless of their categories separated by comma, such as Search,Query",
+		Export:       true,
+	}
+	p.AuditLog.IncludeMethods.Init(base.mgr)
+
+	p.AuditLog.ExcludeMethods = ParamItem{
+		Key:          "proxy.auditLog.excludeMethods",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "methods never audited separated by comma, such as GetLoadingProgress",
+		Export:       true,
+	}
+	p.AuditLog.ExcludeMethods.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:     "proxy.auditLog.localPath",
+		Version: "2.3.5",
+		Doc:     "directory of audit log files, os.TempDir()/milvus_auditlog if empty",
+		Export:  true,
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.3.5",
+		DefaultValue: "milvus_audit_log.jsonl",
+		Doc:          "filename of audit log written by file sink",
+		Export:       true,
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.3.5",
+		DefaultValue: "64",
+		Doc:          "Max size for a single audit log file, in MB.",
+		Export:       true,
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.3.5",
+		DefaultValue: "8",
+		Doc:          "Maximum number of old audit log files to retain.",
+		Export:       true,
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.RotatedTime = ParamItem{
+		Key:          "proxy.auditLog.rotatedTime",
+		Version:      "2.3.5",
+		DefaultValue: "0",
+		Doc:          "Max time for single audit log file in seconds",
+		Export:       true,
+	}
+	p.AuditLog.RotatedTime.Init(base.mgr)
+
+	p.AuditLog.RemotePath = ParamItem{
+		Key:          "proxy.auditLog.remotePath",
+		Version:      "2.3.5",
+		DefaultValue: "audit_log/",
+		Doc:          "path of audit log objects in object storage",
+		Export:       true,
+	}
+	p.AuditLog.RemotePath.Init(base.mgr)
+
+	p.AuditLog.FlushInterval = ParamItem{
+		Key:          "proxy.auditLog.flushInterval",
+		Version:      "2.3.5",
+		DefaultValue: "60",
+		Doc:          "interval to upload the buffered audit log to object storage, in seconds",
+		Export:       true,
+	}
+	p.AuditLog.FlushInterval.Init(base.mgr)
+
+	p.AuditLog.KafkaTopic = ParamItem{
+		Key:          "proxy.auditLog.kafkaTopic",
+		Version:      "2.3.5",
+		DefaultValue: "milvus-audit-log",
+		Doc:          "topic of audit log produced by kafka sink, the kafka of mq config is used",
+		Export:       true,
+	}
+	p.AuditLog.KafkaTopic.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1186,6 +1353,198 @@ please adjust in embedded Milvus: false`,
 		Doc:          "set query node unavailable on proxy when heartbeat failures reach this limit",
 	}
 	p.RetryTimesOnHealthCheck.Init(base.mgr)
+
+	p.IteratorTTL = ParamItem{
+		Key:          "proxy.iterator.ttl",
+		Version:      "2.3.4",
+		DefaultValue: "300",
+		Doc:          "seconds, query and search iterator cursor is released if it's not resumed within this duration",
+		Export:       true,
+	}
+	p.IteratorTTL.Init(base.mgr)
+
+	p.MaxIteratorNum = ParamItem{
+		Key:          "proxy.iterator.maxNum",
+		Version:      "2.3.4",
+		DefaultValue: "10000",
+		Doc:          "max number of alive iterator cursors on each proxy",
+		Export:       true,
+	}
+	p.MaxIteratorNum.Init(base.mgr)
+
+	p.GroupByCandidateRatio = ParamItem{
+		Key:          "proxy.groupBy.candidateRatio",
+		Version:      "2.3.4",
+		DefaultValue: "4",
+		Doc:          "group by search searches ratio * groups * group_size candidates, more candidates make the groups at the tail more accurate",
+		Export:       true,
+	}
+	p.GroupByCandidateRatio.Init(base.mgr)
+
+	p.QueryStreamTimeout = ParamItem{
+		Key:          "proxy.queryStream.timeout",
+		Version:      "2.3.5",
+		DefaultValue: "600",
+		Doc:          "seconds, a streaming query is cancelled if it's not finished within this duration, so slow clients can't hold the query slots of proxy",
+		Export:       true,
+	}
+	p.QueryStreamTimeout.Init(base.mgr)
+
+	p.TaskPriorityEnabled = ParamItem{
+		Key:          "proxy.taskPriority.enabled",
+		Version:      "2.3.5",
+		DefaultValue: "false",
+		Doc:          "whether to schedule the queued search and query tasks by the priority of requests, which is given by the roles of user, the request-priority metadata of client could only lower it, ddl and dml tasks are always scheduled in order",
+		Export:       true,
+	}
+	p.TaskPriorityEnabled.Init(base.mgr)
+
+	p.TaskPriorityHighWeight = ParamItem{
+		Key:          "proxy.taskPriority.weight.high",
+		Version:      "2.3.5",
+		DefaultValue: "8",
+		Doc:          "number of high priority tasks dequeued in every round of weighted fair dequeuing",
+		Export:       true,
+	}
+	p.TaskPriorityHighWeight.Init(base.mgr)
+
+	p.TaskPriorityNormalWeight = ParamItem{
+		Key:          "proxy.taskPriority.weight.normal",
+		Version:      "2.3.5",
+		DefaultValue: "4",
+		Doc:          "number of normal priority tasks dequeued in every round of weighted fair dequeuing",
+		Export:       true,
+	}
+	p.TaskPriorityNormalWeight.Init(base.mgr)
+
+	p.TaskPriorityLowWeight = ParamItem{
+		Key:          "proxy.taskPriority.weight.low",
+		Version:      "2.3.5",
+		DefaultValue: "1",
+		Doc:          "number of low priority tasks dequeued in every round of weighted fair dequeuing",
+		Export:       true,
+	}
+	p.TaskPriorityLowWeight.Init(base.mgr)
+
+	p.TaskPriorityRoles = ParamItem{
+		Key:          "proxy.taskPriority.roles",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          `json map from role to priority of its requests, such as {"admin": "high", "etl": "low"}`,
+		Export:       true,
+	}
+	p.TaskPriorityRoles.Init(base.mgr)
+
+	p.TaskPriorityMaxQueueWait = ParamItem{
+		Key:          "proxy.taskPriority.maxQueueWait",
+		Version:      "2.3.5",
+		DefaultValue: "3000",
+		Doc:          "ms, low priority search and query requests are rejected if the oldest queued one has waited longer than it, non-positive value means no limit",
+		Export:       true,
+	}
+	p.TaskPriorityMaxQueueWait.Init(base.mgr)
+
+	p.JWTEnabled = ParamItem{
+		Key:          "proxy.jwt.enabled",
+		Version:      "2.3.5",
+		DefaultValue: "false",
+		Doc:          "whether to accept the bearer JWT issued by OIDC provider in authorization header of grpc and RESTful requests, it works only if authorization is enabled",
+		Export:       true,
+	}
+	p.JWTEnabled.Init(base.mgr)
+
+	p.JWTJWKSURL = ParamItem{
+		Key:          "proxy.jwt.jwksURL",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "url of the json web key set of OIDC provider, which is used to verify the signature of JWT",
+		Export:       true,
+	}
+	p.JWTJWKSURL.Init(base.mgr)
+
+	p.JWTIssuer = ParamItem{
+		Key:          "proxy.jwt.issuer",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "required iss claim of JWT, empty means not checked",
+		Export:       true,
+	}
+	p.JWTIssuer.Init(base.mgr)
+
+	p.JWTAudience = ParamItem{
+		Key:          "proxy.jwt.audience",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc:          "required aud claim of JWT, empty means not checked",
+		Export:       true,
+	}
+	p.JWTAudience.Init(base.mgr)
+
+	p.JWTUserClaim = ParamItem{
+		Key:          "proxy.jwt.userClaim",
+		Version:      "2.3.5",
+		DefaultValue: "sub",
+		Doc:          "claim of JWT which is mapped to milvus user",
+		Export:       true,
+	}
+	p.JWTUserClaim.Init(base.mgr)
+
+	p.JWTRolesClaim = ParamItem{
+		Key:          "proxy.jwt.rolesClaim",
+		Version:      "2.3.5",
+		DefaultValue: "roles",
+		Doc:          "claim of JWT which is mapped to milvus roles, a list of role names or a string of them separated by space or comma",
+		Export:       true,
+	}
+	p.JWTRolesClaim.Init(base.mgr)
+
+	p.JWTJWKSRefreshInterval = ParamItem{
+		Key:          "proxy.jwt.jwksRefreshInterval",
+		Version:      "2.3.5",
+		DefaultValue: "3600",
+		Doc:          "seconds, the interval to refresh json web key set, it's also refreshed if JWT is signed by an unknown key",
+		Export:       true,
+	}
+	p.JWTJWKSRefreshInterval.Init(base.mgr)
+
+	p.MTLSEnabled = ParamItem{
+		Key:          "proxy.mtls.enabled",
+		Version:      "2.3.5",
+		DefaultValue: "false",
+		Doc:          "whether to authenticate the requests without authorization header by the verified client certificate, it works only if authorization is enabled and tlsMode is 2",
+		Export:       true,
+	}
+	p.MTLSEnabled.Init(base.mgr)
+
+	p.MTLSUserMappingRules = ParamItem{
+		Key:          "proxy.mtls.userMappingRules",
+		Version:      "2.3.5",
+		DefaultValue: "",
+		Doc: `json list of rules mapping client certificate to user, the first matched rule wins. field is one of subject, cn, dns, uri and email,
+match is the regexp matched against the whole value of field, user is the template of user name expanded by the submatches, default is the whole match,
+such as [{"field": "uri", "match": "^spiffe://cluster.local/ns/milvus/sa/(.+)$", "user": "svc-$1"}, {"field": "cn", "match": ".+"}]`,
+		Export: true,
+	}
+	p.MTLSUserMappingRules.Init(base.mgr)
+
+	p.FieldPrivilegeDeniedAction = ParamItem{
+		Key:          "proxy.fieldPrivilege.deniedAction",
+		Version:      "2.3.5",
+		DefaultValue: "reject",
+		Doc: `reject or mask, the action on output fields the user isn't granted to read when field privileges are granted on them.
+reject fails the request if the field is requested explicitly, and omits it from the output of *; mask returns the field with masked values`,
+		Export: true,
+	}
+	p.FieldPrivilegeDeniedAction.Init(base.mgr)
+
+	p.FieldPrivilegeMaskValue = ParamItem{
+		Key:          "proxy.fieldPrivilege.maskValue",
+		Version:      "2.3.5",
+		DefaultValue: "******",
+		Doc:          "the value of masked string fields, other fields are masked by zero values",
+		Export:       true,
+	}
+	p.FieldPrivilegeMaskValue.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -164,6 +164,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.CostMetricsExpireTime.GetAsInt(), 1000)
 		assert.Equal(t, Params.RetryTimesOnReplica.GetAsInt(), 2)
 		assert.EqualValues(t, Params.HealthCheckTimeout.GetAsInt64(), 3000)
+		assert.Equal(t, 300*time.Second, Params.IteratorTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 10000, Params.MaxIteratorNum.GetAsInt())
+		assert.Equal(t, int64(4), Params.GroupByCandidateRatio.GetAsInt64())
+		assert.Equal(t, 600*time.Second, Params.QueryStreamTimeout.GetAsDuration(time.Second))
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -78,6 +78,18 @@ type quotaConfig struct {
 	DMLMinDeleteRatePerCollection   ParamItem `refreshable:"true"`
 	DMLMaxBulkLoadRatePerCollection ParamItem `refreshable:"true"`
 	DMLMinBulkLoadRatePerCollection ParamItem `refreshable:"true"`
+	DMLMaxInsertRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxUpsertRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerDB         ParamItem `refreshable:"true"`
+	DMLMaxInsertRatePerUser         ParamItem `refreshable:"true"`
+	DMLMaxInsertRatePerRole         ParamItem `refreshable:"true"`
+	DMLMaxUpsertRatePerUser         ParamItem `refreshable:"true"`
+	DMLMaxUpsertRatePerRole         ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerUser         ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerRole         ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerUser       ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerRole       ParamItem `refreshable:"true"`
 
 	// dql
 	DQLLimitEnabled               ParamItem `refreshable:"true"`
@@ -89,6 +101,12 @@ type quotaConfig struct {
 	DQLMinSearchRatePerCollection ParamItem `refreshable:"true"`
 	DQLMaxQueryRatePerCollection  ParamItem `refreshable:"true"`
 	DQLMinQueryRatePerCollection  ParamItem `refreshable:"true"`
+	DQLMaxSearchRatePerDB         ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerDB          ParamItem `refreshable:"true"`
+	DQLMaxSearchRatePerUser       ParamItem `refreshable:"true"`
+	DQLMaxSearchRatePerRole       ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerUser        ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerRole        ParamItem `refreshable:"true"`
 
 	// limits
 	MaxCollectionNum      ParamItem `refreshable:"true"`
@@ -383,6 +401,75 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinInsertRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxInsertRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxInsertRatePerDB.Init(base.mgr)
+
+	p.DMLMaxInsertRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxInsertRatePerUser.Init(base.mgr)
+
+	p.DMLMaxInsertRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxInsertRatePerRole.Init(base.mgr)
+
 	p.DMLMaxUpsertRate = ParamItem{
 		Key:          "quotaAndLimits.dml.upsertRate.max",
 		Version:      "2.3.0",
@@ -471,6 +558,75 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinUpsertRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxUpsertRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.upsertRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxUpsertRatePerDB.Init(base.mgr)
+
+	p.DMLMaxUpsertRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dml.upsertRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxUpsertRatePerUser.Init(base.mgr)
+
+	p.DMLMaxUpsertRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dml.upsertRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxUpsertRatePerRole.Init(base.mgr)
+
 	p.DMLMaxDeleteRate = ParamItem{
 		Key:          "quotaAndLimits.dml.deleteRate.max",
 		Version:      "2.2.0",
@@ -559,6 +715,75 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinDeleteRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxDeleteRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxDeleteRatePerDB.Init(base.mgr)
+
+	p.DMLMaxDeleteRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxDeleteRatePerUser.Init(base.mgr)
+
+	p.DMLMaxDeleteRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxDeleteRatePerRole.Init(base.mgr)
+
 	p.DMLMaxBulkLoadRate = ParamItem{
 		Key:          "quotaAndLimits.dml.bulkLoadRate.max",
 		Version:      "2.2.0",
@@ -647,6 +872,75 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinBulkLoadRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxBulkLoadRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxBulkLoadRatePerDB.Init(base.mgr)
+
+	p.DMLMaxBulkLoadRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxBulkLoadRatePerUser.Init(base.mgr)
+
+	p.DMLMaxBulkLoadRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return max
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxBulkLoadRatePerRole.Init(base.mgr)
+
 	// dql
 	p.DQLLimitEnabled = ParamItem{
 		Key:          "quotaAndLimits.dql.enabled",
@@ -738,6 +1032,63 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DQLMinSearchRatePerCollection.Init(base.mgr)
 
+	p.DQLMaxSearchRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "vps (vectors per second), default no limit",
+		Export: true,
+	}
+	p.DQLMaxSearchRatePerDB.Init(base.mgr)
+
+	p.DQLMaxSearchRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "vps (vectors per second), default no limit",
+		Export: true,
+	}
+	p.DQLMaxSearchRatePerUser.Init(base.mgr)
+
+	p.DQLMaxSearchRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "vps (vectors per second), default no limit",
+		Export: true,
+	}
+	p.DQLMaxSearchRatePerRole.Init(base.mgr)
+
 	p.DQLMaxQueryRate = ParamItem{
 		Key:          "quotaAndLimits.dql.queryRate.max",
 		Version:      "2.2.0",
@@ -818,6 +1169,63 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DQLMinQueryRatePerCollection.Init(base.mgr)
 
+	p.DQLMaxQueryRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.db.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "qps, default no limit",
+		Export: true,
+	}
+	p.DQLMaxQueryRatePerDB.Init(base.mgr)
+
+	p.DQLMaxQueryRatePerUser = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.user.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "qps, default no limit",
+		Export: true,
+	}
+	p.DQLMaxQueryRatePerUser.Init(base.mgr)
+
+	p.DQLMaxQueryRatePerRole = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.role.max",
+		Version:      "2.3.5",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return max
+			}
+			return v
+		},
+		Doc:    "qps, default no limit",
+		Export: true,
+	}
+	p.DQLMaxQueryRatePerRole.Init(base.mgr)
+
 	// limits
 	p.MaxCollectionNum = ParamItem{
 		Key:          "quotaAndLimits.limits.maxCollectionNum",
@@ -10,6 +10,8 @@ const (
 	CacheDeleteUser
 	CacheDropRole
 	CacheRefresh
+	CacheAddRowPolicy
+	CacheDropRowPolicy
 )
 
 type CacheOp struct {
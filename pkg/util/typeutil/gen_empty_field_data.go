@@ -165,25 +165,22 @@ func genEmptyFloatVectorFieldData(field *schemapb.FieldSchema) (*schemapb.FieldD
 	}, nil
 }
 
-func genEmptyFloat16VectorFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error) {
+func genEmptyBytesVectorFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error) {
 	dim, err := GetDim(field)
 	if err != nil {
 		return nil, err
 	}
-	return &schemapb.FieldData{
-		Type:      field.GetDataType(),
-		FieldName: field.GetName(),
-		Field: &schemapb.FieldData_Vectors{
-			Vectors: &schemapb.VectorField{
-				Dim: dim,
-				Data: &schemapb.VectorField_Float16Vector{
-					Float16Vector: nil,
-				},
-			},
-		},
-		FieldId:   field.GetFieldID(),
-		IsDynamic: field.GetIsDynamic(),
-	}, nil
+	fieldData := NewBytesVectorFieldData(field.GetDataType(), field.GetName(), dim, nil)
+	fieldData.FieldId = field.GetFieldID()
+	fieldData.IsDynamic = field.GetIsDynamic()
+	return fieldData, nil
+}
+
+func genEmptySparseFloatVectorFieldData(field *schemapb.FieldSchema) *schemapb.FieldData {
+	fieldData := NewSparseFloatVectorFieldData(field.GetName(), nil)
+	fieldData.FieldId = field.GetFieldID()
+	fieldData.IsDynamic = field.GetIsDynamic()
+	return fieldData
 }
 
 func GenEmptyFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error) {
@@ -209,8 +206,10 @@ func GenEmptyFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error)
 		return genEmptyBinaryVectorFieldData(field)
 	case schemapb.DataType_FloatVector:
 		return genEmptyFloatVectorFieldData(field)
-	case schemapb.DataType_Float16Vector:
-		return genEmptyFloat16VectorFieldData(field)
+	case schemapb.DataType_Float16Vector, BFloat16Vector, Int8Vector:
+		return genEmptyBytesVectorFieldData(field)
+	case SparseFloatVector:
+		return genEmptySparseFloatVectorFieldData(field), nil
 	default:
 		return nil, fmt.Errorf("unsupported data type: %s", dataType.String())
 	}
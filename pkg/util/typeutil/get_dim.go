@@ -13,6 +13,9 @@ func GetDim(field *schemapb.FieldSchema) (int64, error) {
 	if !IsVectorType(field.GetDataType()) {
 		return 0, fmt.Errorf("%s is not of vector type", field.GetDataType())
 	}
+	if IsSparseFloatVectorType(field.GetDataType()) {
+		return 0, fmt.Errorf("sparse float vector doesn't have a fixed dim")
+	}
 	h := NewKvPairs(append(field.GetIndexParams(), field.GetTypeParams()...))
 	dimStr, err := h.Get(common.DimKey)
 	if err != nil {
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// IsFieldNullable returns true if the field is declared nullable by the type param `nullable`.
+func IsFieldNullable(field *schemapb.FieldSchema) bool {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.NullableKey {
+			nullable, err := strconv.ParseBool(param.GetValue())
+			return err == nil && nullable
+		}
+	}
+	return false
+}
+
+// IsNullFieldData returns true if the field data carries no data, which means all the rows of it are null.
+// The field data of insert request has no validity of rows, so a nullable field omitted by the request
+// is sent as a null field data.
+func IsNullFieldData(fieldData *schemapb.FieldData) bool {
+	return fieldData.GetField() == nil
+}
+
+// NewNullFieldData returns the field data whose rows are all null.
+func NewNullFieldData(field *schemapb.FieldSchema) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		IsDynamic: field.GetIsDynamic(),
+	}
+}
+
+// GenZeroFieldData returns the field data of numRows zero values, it's used in place of a null field data
+// where the validity of rows can't be kept.
+func GenZeroFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	fieldData, err := GenEmptyFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+	scalars := fieldData.GetScalars()
+	if scalars == nil {
+		return nil, fmt.Errorf("%s field %s can't be null", field.GetDataType().String(), field.GetName())
+	}
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		data.BoolData.Data = make([]bool, numRows)
+	case *schemapb.ScalarField_IntData:
+		data.IntData.Data = make([]int32, numRows)
+	case *schemapb.ScalarField_LongData:
+		data.LongData.Data = make([]int64, numRows)
+	case *schemapb.ScalarField_FloatData:
+		data.FloatData.Data = make([]float32, numRows)
+	case *schemapb.ScalarField_DoubleData:
+		data.DoubleData.Data = make([]float64, numRows)
+	case *schemapb.ScalarField_StringData:
+		data.StringData.Data = make([]string, numRows)
+	case *schemapb.ScalarField_JsonData:
+		data.JsonData.Data = make([][]byte, numRows)
+		for i := range data.JsonData.Data {
+			data.JsonData.Data[i] = []byte("{}")
+		}
+	case *schemapb.ScalarField_ArrayData:
+		data.ArrayData.Data = make([]*schemapb.ScalarField, numRows)
+		for i := range data.ArrayData.Data {
+			data.ArrayData.Data[i] = &schemapb.ScalarField{}
+		}
+	default:
+		return nil, fmt.Errorf("%s field %s can't be null", field.GetDataType().String(), field.GetName())
+	}
+	return fieldData, nil
+}
+
+// GenDefaultFieldData returns the field data of numRows default values of the field, or zero values if the
+// field has no default value. It fills the rows written before the field was added to the collection.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	fieldData, err := GenZeroFieldData(field, numRows)
+	if err != nil || field.GetDefaultValue() == nil {
+		return fieldData, err
+	}
+	defaultValue := field.GetDefaultValue()
+	switch data := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		for i := range data.BoolData.Data {
+			data.BoolData.Data[i] = defaultValue.GetBoolData()
+		}
+	case *schemapb.ScalarField_IntData:
+		for i := range data.IntData.Data {
+			data.IntData.Data[i] = defaultValue.GetIntData()
+		}
+	case *schemapb.ScalarField_LongData:
+		for i := range data.LongData.Data {
+			data.LongData.Data[i] = defaultValue.GetLongData()
+		}
+	case *schemapb.ScalarField_FloatData:
+		for i := range data.FloatData.Data {
+			data.FloatData.Data[i] = defaultValue.GetFloatData()
+		}
+	case *schemapb.ScalarField_DoubleData:
+		for i := range data.DoubleData.Data {
+			data.DoubleData.Data[i] = defaultValue.GetDoubleData()
+		}
+	case *schemapb.ScalarField_StringData:
+		for i := range data.StringData.Data {
+			data.StringData.Data[i] = defaultValue.GetStringData()
+		}
+	default:
+		return nil, fmt.Errorf("%s field %s can't have default value", field.GetDataType().String(), field.GetName())
+	}
+	return fieldData, nil
+}
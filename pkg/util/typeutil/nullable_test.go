@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestIsFieldNullable(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}
+	assert.False(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	assert.True(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "false"}}
+	assert.False(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "yes"}}
+	assert.False(t, IsFieldNullable(field))
+}
+
+func TestNullFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{FieldID: 100, Name: "age", DataType: schemapb.DataType_Int64}
+	null := NewNullFieldData(field)
+	assert.True(t, IsNullFieldData(null))
+	assert.Equal(t, int64(100), null.GetFieldId())
+	assert.Equal(t, schemapb.DataType_Int64, null.GetType())
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{null}, 0)
+	assert.True(t, IsNullFieldData(dst[0]))
+	assert.Equal(t, "age", dst[0].GetFieldName())
+}
+
+func TestGenZeroFieldData(t *testing.T) {
+	cases := []struct {
+		dataType schemapb.DataType
+		rows     func(fieldData *schemapb.FieldData) int
+	}{
+		{schemapb.DataType_Bool, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetBoolData().GetData()) }},
+		{schemapb.DataType_Int16, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetIntData().GetData()) }},
+		{schemapb.DataType_Int64, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetLongData().GetData()) }},
+		{schemapb.DataType_Float, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetFloatData().GetData()) }},
+		{schemapb.DataType_Double, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetDoubleData().GetData()) }},
+		{schemapb.DataType_VarChar, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetStringData().GetData()) }},
+		{schemapb.DataType_JSON, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetJsonData().GetData()) }},
+		{schemapb.DataType_Array, func(fd *schemapb.FieldData) int { return len(fd.GetScalars().GetArrayData().GetData()) }},
+	}
+	for _, c := range cases {
+		field := &schemapb.FieldSchema{Name: "field", DataType: c.dataType, ElementType: schemapb.DataType_Int64}
+		fieldData, err := GenZeroFieldData(field, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, c.rows(fieldData), c.dataType.String())
+	}
+
+	_, err := GenZeroFieldData(&schemapb.FieldSchema{
+		Name:       "vec",
+		DataType:   schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+	}, 3)
+	assert.Error(t, err)
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32}
+	fieldData, err := GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{0, 0}, fieldData.GetScalars().GetIntData().GetData())
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}}
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{18, 18}, fieldData.GetScalars().GetIntData().GetData())
+
+	field = &schemapb.FieldSchema{
+		Name:         "name",
+		DataType:     schemapb.DataType_VarChar,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "unknown"}},
+	}
+	fieldData, err = GenDefaultFieldData(field, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"unknown"}, fieldData.GetScalars().GetStringData().GetData())
+
+	field = &schemapb.FieldSchema{
+		Name:         "info",
+		DataType:     schemapb.DataType_JSON,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "{}"}},
+	}
+	_, err = GenDefaultFieldData(field, 1)
+	assert.Error(t, err)
+}
@@ -107,7 +107,7 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
-		case schemapb.DataType_Float16Vector:
+		case schemapb.DataType_Float16Vector, BFloat16Vector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == common.DimKey {
 					v, err := strconv.Atoi(kv.Value)
@@ -118,6 +118,19 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
+		case Int8Vector:
+			for _, kv := range fs.TypeParams {
+				if kv.Key == common.DimKey {
+					v, err := strconv.Atoi(kv.Value)
+					if err != nil {
+						return -1, err
+					}
+					res += v
+					break
+				}
+			}
+		case SparseFloatVector:
+			res += estimateSparseFloatRowSize
 		}
 	}
 	return res, nil
@@ -155,6 +168,10 @@ func CalcColumnSize(column *schemapb.FieldData) int {
 		for _, str := range column.GetScalars().GetJsonData().GetData() {
 			res += len(str)
 		}
+	case SparseFloatVector:
+		for _, row := range GetSparseFloatVectorRows(column) {
+			res += len(row)
+		}
 	}
 	return res
 }
@@ -194,6 +211,14 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
 			res += int(fs.GetVectors().GetDim() * 4)
+		case schemapb.DataType_Float16Vector, BFloat16Vector, Int8Vector:
+			res += int(fs.GetVectors().GetDim() * GetBytesVectorElementSize(fs.GetType()))
+		case SparseFloatVector:
+			rows := GetSparseFloatVectorRows(fs)
+			if rowOffset >= len(rows) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(rows[rowOffset])
 		}
 	}
 	return res, nil
@@ -318,7 +343,8 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector,
+		BFloat16Vector, Int8Vector, SparseFloatVector:
 		return true
 	default:
 		return false
@@ -387,6 +413,16 @@ func IsVariableDataType(dataType schemapb.DataType) bool {
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) (appendSize int64) {
 	for i, fieldData := range src {
 		switch fieldType := fieldData.Field.(type) {
+		case nil:
+			// null field data, all the rows of it are null
+			if dst[i] == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					FieldId:   fieldData.FieldId,
+					IsDynamic: fieldData.IsDynamic,
+				}
+			}
 		case *schemapb.FieldData_Scalars:
 			if dst[i] == nil || dst[i].GetScalars() == nil {
 				dst[i] = &schemapb.FieldData{
@@ -557,6 +593,46 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				}
 				/* #nosec G103 */
 				appendSize += int64(unsafe.Sizeof(srcVector.Float16Vector[idx*(dim*2) : (idx+1)*(dim*2)]))
+			case *schemapb.VectorField_Bfloat16Vector:
+				if dstVector.GetBfloat16Vector() == nil {
+					srcToCopy := srcVector.Bfloat16Vector[idx*(dim*2) : (idx+1)*(dim*2)]
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: make([]byte, len(srcToCopy)),
+					}
+					copy(dstVector.Data.(*schemapb.VectorField_Bfloat16Vector).Bfloat16Vector, srcToCopy)
+				} else {
+					dstBfloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBfloat16Vector.Bfloat16Vector = append(dstBfloat16Vector.Bfloat16Vector, srcVector.Bfloat16Vector[idx*(dim*2):(idx+1)*(dim*2)]...)
+				}
+				/* #nosec G103 */
+				appendSize += int64(unsafe.Sizeof(srcVector.Bfloat16Vector[idx*(dim*2) : (idx+1)*(dim*2)]))
+			case *schemapb.VectorField_Int8Vector:
+				if dstVector.GetInt8Vector() == nil {
+					srcToCopy := srcVector.Int8Vector[idx*dim : (idx+1)*dim]
+					dstVector.Data = &schemapb.VectorField_Int8Vector{
+						Int8Vector: make([]byte, len(srcToCopy)),
+					}
+					copy(dstVector.Data.(*schemapb.VectorField_Int8Vector).Int8Vector, srcToCopy)
+				} else {
+					dstInt8Vector := dstVector.Data.(*schemapb.VectorField_Int8Vector)
+					dstInt8Vector.Int8Vector = append(dstInt8Vector.Int8Vector, srcVector.Int8Vector[idx*dim:(idx+1)*dim]...)
+				}
+				/* #nosec G103 */
+				appendSize += int64(unsafe.Sizeof(srcVector.Int8Vector[idx*dim : (idx+1)*dim]))
+			case *schemapb.VectorField_SparseFloatVector:
+				row := srcVector.SparseFloatVector.Contents[idx]
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: [][]byte{row},
+						},
+					}
+				} else {
+					dstSparseVector := dstVector.GetSparseFloatVector()
+					dstSparseVector.Contents = append(dstSparseVector.Contents, row)
+				}
+				updateSparseFloatVectorDim(dstVector, SparseFloatRowDim(row))
+				appendSize += int64(len(row))
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -610,6 +686,16 @@ func DeleteFieldData(dst []*schemapb.FieldData) {
 			case *schemapb.VectorField_Float16Vector:
 				dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
 				dstFloat16Vector.Float16Vector = dstFloat16Vector.Float16Vector[:len(dstFloat16Vector.Float16Vector)-int(dim*2)]
+			case *schemapb.VectorField_Bfloat16Vector:
+				dstBfloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+				dstBfloat16Vector.Bfloat16Vector = dstBfloat16Vector.Bfloat16Vector[:len(dstBfloat16Vector.Bfloat16Vector)-int(dim*2)]
+			case *schemapb.VectorField_Int8Vector:
+				dstInt8Vector := dstVector.Data.(*schemapb.VectorField_Int8Vector)
+				dstInt8Vector.Int8Vector = dstInt8Vector.Int8Vector[:len(dstInt8Vector.Int8Vector)-int(dim)]
+			case *schemapb.VectorField_SparseFloatVector:
+				// the dim is kept, it's still an upper bound of the remaining rows
+				dstSparseVector := dstVector.GetSparseFloatVector()
+				dstSparseVector.Contents = dstSparseVector.Contents[:len(dstSparseVector.Contents)-1]
 			default:
 				log.Error("wrong field type added", zap.String("field type", fieldData.Type.String()))
 			}
@@ -762,6 +848,45 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) error
 				} else {
 					dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data...)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				if dstVector.GetFloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Float16Vector{
+						Float16Vector: srcVector.Float16Vector,
+					}
+				} else {
+					dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
+					dstFloat16Vector.Float16Vector = append(dstFloat16Vector.Float16Vector, srcVector.Float16Vector...)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				if dstVector.GetBfloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: srcVector.Bfloat16Vector,
+					}
+				} else {
+					dstBfloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBfloat16Vector.Bfloat16Vector = append(dstBfloat16Vector.Bfloat16Vector, srcVector.Bfloat16Vector...)
+				}
+			case *schemapb.VectorField_Int8Vector:
+				if dstVector.GetInt8Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Int8Vector{
+						Int8Vector: srcVector.Int8Vector,
+					}
+				} else {
+					dstInt8Vector := dstVector.Data.(*schemapb.VectorField_Int8Vector)
+					dstInt8Vector.Int8Vector = append(dstInt8Vector.Int8Vector, srcVector.Int8Vector...)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: srcVector.SparseFloatVector.Contents,
+						},
+					}
+				} else {
+					dstSparseVector := dstVector.GetSparseFloatVector()
+					dstSparseVector.Contents = append(dstSparseVector.Contents, srcVector.SparseFloatVector.Contents...)
+				}
+				updateSparseFloatVectorDim(dstVector, srcVector.SparseFloatVector.GetDim())
 			default:
 				log.Error("Not supported data type", zap.String("data type", srcFieldData.Type.String()))
 				return errors.New("unsupported data type: " + srcFieldData.Type.String())
@@ -952,10 +1077,12 @@ func GetData(field *schemapb.FieldData, idx int) interface{} {
 		dim := int(field.GetVectors().GetDim())
 		dataBytes := dim / 8
 		return field.GetVectors().GetBinaryVector()[idx*dataBytes : (idx+1)*dataBytes]
-	case schemapb.DataType_Float16Vector:
+	case schemapb.DataType_Float16Vector, BFloat16Vector, Int8Vector:
 		dim := int(field.GetVectors().GetDim())
-		dataBytes := dim * 2
-		return field.GetVectors().GetFloat16Vector()[idx*dataBytes : (idx+1)*dataBytes]
+		dataBytes := dim * int(GetBytesVectorElementSize(field.GetType()))
+		return GetBytesVectorData(field.GetVectors())[idx*dataBytes : (idx+1)*dataBytes]
+	case SparseFloatVector:
+		return GetSparseFloatVectorRows(field)[idx]
 	}
 	return nil
 }
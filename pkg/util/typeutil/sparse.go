@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// SparseFloatVector is the data type of sparse float vector.
+// The rows of sparse float vector are carried by the sparse float array of vector field, see CreateSparseFloatRow.
+const SparseFloatVector = schemapb.DataType_SparseFloatVector
+
+// sparseFloatElementSize is the size of an index/value pair of sparse float row
+const sparseFloatElementSize = 8
+
+// estimateSparseFloatRowSize is the estimated size of a sparse float row, the number of non-zero elements
+// of SPLADE embeddings is about 600.
+const estimateSparseFloatRowSize = 600 * sparseFloatElementSize
+
+// IsSparseFloatVectorType returns true if input is the sparse float vector type.
+func IsSparseFloatVectorType(dataType schemapb.DataType) bool {
+	return dataType == SparseFloatVector
+}
+
+// CreateSparseFloatRow encodes a sparse float row, which is a list of index/value pairs sorted by index,
+// an index is an uint32 and a value is a float32 both in little endian.
+// The indices must be unique, and they are sorted if not.
+func CreateSparseFloatRow(indices []uint32, values []float32) []byte {
+	row := make([]byte, len(indices)*sparseFloatElementSize)
+	for i := range indices {
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize:], indices[i])
+		binary.LittleEndian.PutUint32(row[i*sparseFloatElementSize+4:], math.Float32bits(values[i]))
+	}
+	if !sort.IsSorted(sparseFloatRow(row)) {
+		sort.Sort(sparseFloatRow(row))
+	}
+	return row
+}
+
+// sparseFloatRow sorts the elements of sparse float row by index
+type sparseFloatRow []byte
+
+func (r sparseFloatRow) Len() int { return len(r) / sparseFloatElementSize }
+
+func (r sparseFloatRow) Less(i, j int) bool {
+	return SparseFloatRowIndexAt(r, i) < SparseFloatRowIndexAt(r, j)
+}
+
+func (r sparseFloatRow) Swap(i, j int) {
+	var tmp [sparseFloatElementSize]byte
+	a := r[i*sparseFloatElementSize : (i+1)*sparseFloatElementSize]
+	b := r[j*sparseFloatElementSize : (j+1)*sparseFloatElementSize]
+	copy(tmp[:], a)
+	copy(a, b)
+	copy(b, tmp[:])
+}
+
+// SparseFloatRowElementCount returns the number of non-zero elements of sparse float row.
+func SparseFloatRowElementCount(row []byte) int {
+	return len(row) / sparseFloatElementSize
+}
+
+// SparseFloatRowIndexAt returns the index of i-th element of sparse float row.
+func SparseFloatRowIndexAt(row []byte, i int) uint32 {
+	return binary.LittleEndian.Uint32(row[i*sparseFloatElementSize:])
+}
+
+// SparseFloatRowValueAt returns the value of i-th element of sparse float row.
+func SparseFloatRowValueAt(row []byte, i int) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(row[i*sparseFloatElementSize+4:]))
+}
+
+// SparseFloatRowDim returns the dimension of sparse float row, which is the max index plus one.
+func SparseFloatRowDim(row []byte) int64 {
+	count := SparseFloatRowElementCount(row)
+	if count == 0 {
+		return 0
+	}
+	return int64(SparseFloatRowIndexAt(row, count-1)) + 1
+}
+
+// SparseFloatRowsDim returns the max dimension of sparse float rows.
+func SparseFloatRowsDim(rows [][]byte) int64 {
+	var dim int64
+	for _, row := range rows {
+		if rowDim := SparseFloatRowDim(row); rowDim > dim {
+			dim = rowDim
+		}
+	}
+	return dim
+}
+
+// ValidateSparseFloatRows checks the rows are well encoded, the indices are strictly increasing and less than
+// math.MaxUint32, and the values are finite.
+func ValidateSparseFloatRows(rows ...[]byte) error {
+	for i, row := range rows {
+		if len(row)%sparseFloatElementSize != 0 {
+			return fmt.Errorf("invalid data length %d of sparse float vector row %d", len(row), i)
+		}
+		for j := 0; j < SparseFloatRowElementCount(row); j++ {
+			index := SparseFloatRowIndexAt(row, j)
+			if index == math.MaxUint32 {
+				return fmt.Errorf("index %d of sparse float vector row %d exceeds the limit", index, i)
+			}
+			if j > 0 && index <= SparseFloatRowIndexAt(row, j-1) {
+				return fmt.Errorf("indices of sparse float vector row %d are not unique and sorted", i)
+			}
+			value := SparseFloatRowValueAt(row, j)
+			if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+				return fmt.Errorf("value of sparse float vector row %d is not a finite number", i)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseSparseFloatRow parses a sparse float row from json object, which is either a map from index to value,
+// such as {"1": 0.5, "100": 0.3}, or a pair of lists, such as {"indices": [1, 100], "values": [0.5, 0.3]}.
+func ParseSparseFloatRow(obj map[string]interface{}) ([]byte, error) {
+	toFloat := func(v interface{}) (float64, error) {
+		switch n := v.(type) {
+		case float64:
+			return n, nil
+		case json.Number:
+			return n.Float64()
+		default:
+			return 0, fmt.Errorf("invalid value %v of sparse float vector", v)
+		}
+	}
+	var indices []uint32
+	var values []float32
+	if rawIndices, ok := obj["indices"]; ok {
+		rawValues, ok := obj["values"]
+		if !ok || len(obj) != 2 {
+			return nil, fmt.Errorf("sparse float vector of indices must be along with values only")
+		}
+		indexList, ok1 := rawIndices.([]interface{})
+		valueList, ok2 := rawValues.([]interface{})
+		if !ok1 || !ok2 || len(indexList) != len(valueList) {
+			return nil, fmt.Errorf("indices and values of sparse float vector must be lists of the same length")
+		}
+		for i := range indexList {
+			index, err := toFloat(indexList[i])
+			if err != nil {
+				return nil, err
+			}
+			if index < 0 || index >= math.MaxUint32 || index != math.Trunc(index) {
+				return nil, fmt.Errorf("invalid index %v of sparse float vector", indexList[i])
+			}
+			value, err := toFloat(valueList[i])
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, uint32(index))
+			values = append(values, float32(value))
+		}
+	} else {
+		for key, v := range obj {
+			index, err := strconv.ParseUint(key, 10, 32)
+			if err != nil || index >= math.MaxUint32 {
+				return nil, fmt.Errorf("invalid index %s of sparse float vector", key)
+			}
+			value, err := toFloat(v)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, uint32(index))
+			values = append(values, float32(value))
+		}
+	}
+	row := CreateSparseFloatRow(indices, values)
+	if err := ValidateSparseFloatRows(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// GetSparseFloatVectorRows returns the rows of sparse float vector field data.
+func GetSparseFloatVectorRows(field *schemapb.FieldData) [][]byte {
+	return field.GetVectors().GetSparseFloatVector().GetContents()
+}
+
+// NewSparseFloatVectorFieldData returns the field data of sparse float vector rows,
+// the dim is the max dimension of the rows.
+func NewSparseFloatVectorFieldData(fieldName string, rows [][]byte) *schemapb.FieldData {
+	dim := SparseFloatRowsDim(rows)
+	return &schemapb.FieldData{
+		Type:      SparseFloatVector,
+		FieldName: fieldName,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim: dim,
+				Data: &schemapb.VectorField_SparseFloatVector{
+					SparseFloatVector: &schemapb.SparseFloatArray{
+						Contents: rows,
+						Dim:      dim,
+					},
+				},
+			},
+		},
+	}
+}
+
+// updateSparseFloatVectorDim raises the dim of sparse float vector field to dim if it's larger.
+func updateSparseFloatVectorDim(field *schemapb.VectorField, dim int64) {
+	if dim > field.GetDim() {
+		field.Dim = dim
+	}
+	if sparse := field.GetSparseFloatVector(); sparse != nil && dim > sparse.GetDim() {
+		sparse.Dim = dim
+	}
+}
@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestSparseFloatRow(t *testing.T) {
+	row := CreateSparseFloatRow([]uint32{100, 1, 20}, []float32{0.3, 0.1, 0.2})
+	assert.Equal(t, 3, SparseFloatRowElementCount(row))
+	assert.Equal(t, uint32(1), SparseFloatRowIndexAt(row, 0))
+	assert.Equal(t, uint32(20), SparseFloatRowIndexAt(row, 1))
+	assert.Equal(t, uint32(100), SparseFloatRowIndexAt(row, 2))
+	assert.Equal(t, float32(0.1), SparseFloatRowValueAt(row, 0))
+	assert.Equal(t, float32(0.3), SparseFloatRowValueAt(row, 2))
+	assert.Equal(t, int64(101), SparseFloatRowDim(row))
+	assert.NoError(t, ValidateSparseFloatRows(row))
+
+	empty := CreateSparseFloatRow(nil, nil)
+	assert.Equal(t, int64(0), SparseFloatRowDim(empty))
+	assert.Equal(t, int64(101), SparseFloatRowsDim([][]byte{empty, row}))
+
+	assert.Error(t, ValidateSparseFloatRows(row[:5]))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1, 1}, []float32{0.1, 0.2})))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{1}, []float32{float32(math.NaN())})))
+	assert.Error(t, ValidateSparseFloatRows(CreateSparseFloatRow([]uint32{math.MaxUint32}, []float32{0.1})))
+}
+
+func TestParseSparseFloatRow(t *testing.T) {
+	parse := func(s string) ([]byte, error) {
+		var obj map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(s), &obj))
+		return ParseSparseFloatRow(obj)
+	}
+	expected := CreateSparseFloatRow([]uint32{1, 100}, []float32{0.5, 0.3})
+
+	row, err := parse(`{"100": 0.3, "1": 0.5}`)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = parse(`{"indices": [100, 1], "values": [0.3, 0.5]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = parse(`{}`)
+	assert.NoError(t, err)
+	assert.Empty(t, row)
+
+	for _, s := range []string{
+		`{"a": 0.3}`,
+		`{"-1": 0.3}`,
+		`{"1": "x"}`,
+		`{"1": 0.1, "01": 0.2}`,
+		`{"indices": [1, 2], "values": [0.3]}`,
+		`{"indices": [1.5], "values": [0.3]}`,
+		`{"indices": [1], "values": [0.3], "other": 1}`,
+		`{"indices": [1]}`,
+	} {
+		_, err = parse(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSparseFloatVectorFieldData(t *testing.T) {
+	rows := [][]byte{
+		CreateSparseFloatRow([]uint32{1}, []float32{0.1}),
+		CreateSparseFloatRow([]uint32{2, 3}, []float32{0.2, 0.3}),
+	}
+	src := NewSparseFloatVectorFieldData("sparse", rows)
+	src.FieldId = 100
+	assert.Equal(t, "SparseFloatVector", schemapb.DataType_name[int32(SparseFloatVector)])
+	assert.True(t, IsVectorType(SparseFloatVector))
+	assert.Equal(t, 24, CalcColumnSize(src))
+	size, err := EstimateEntitySize([]*schemapb.FieldData{src}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 16, size)
+	assert.Equal(t, rows[1], GetData(src, 1))
+	assert.Equal(t, int64(4), src.GetVectors().GetDim())
+	assert.Equal(t, int64(4), src.GetVectors().GetSparseFloatVector().GetDim())
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{src}, 1)
+	AppendFieldData(dst, []*schemapb.FieldData{src}, 0)
+	assert.Equal(t, [][]byte{rows[1], rows[0]}, GetSparseFloatVectorRows(dst[0]))
+	assert.Equal(t, int64(4), dst[0].GetVectors().GetSparseFloatVector().GetDim())
+	DeleteFieldData(dst)
+	assert.Equal(t, [][]byte{rows[1]}, GetSparseFloatVectorRows(dst[0]))
+
+	assert.NoError(t, MergeFieldData(dst, []*schemapb.FieldData{src}))
+	assert.Equal(t, [][]byte{rows[1], rows[0], rows[1]}, GetSparseFloatVectorRows(dst[0]))
+
+	empty, err := GenEmptyFieldData(&schemapb.FieldSchema{FieldID: 100, Name: "sparse", DataType: SparseFloatVector})
+	assert.NoError(t, err)
+	assert.Equal(t, SparseFloatVector, empty.GetType())
+	assert.Empty(t, GetSparseFloatVectorRows(empty))
+}
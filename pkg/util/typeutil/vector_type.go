@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"math"
+	"unsafe"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// BFloat16Vector and Int8Vector are the data types of bfloat16 vector and int8 vector.
+// Both of them are fixed size vectors whose rows are carried by bytes, see GetBytesVectorElementSize.
+const (
+	BFloat16Vector = schemapb.DataType_BFloat16Vector
+	Int8Vector     = schemapb.DataType_Int8Vector
+)
+
+// IsBFloat16VectorType returns true if input is the bfloat16 vector type.
+func IsBFloat16VectorType(dataType schemapb.DataType) bool {
+	return dataType == BFloat16Vector
+}
+
+// IsInt8VectorType returns true if input is the int8 vector type.
+func IsInt8VectorType(dataType schemapb.DataType) bool {
+	return dataType == Int8Vector
+}
+
+// IsBytesVectorType returns true if the rows of input type are carried by bytes of vector field,
+// which are float16, bfloat16 and int8 vector.
+func IsBytesVectorType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Float16Vector, BFloat16Vector, Int8Vector:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetBytesVectorElementSize returns the size in bytes of an element of vector carried by bytes,
+// which is 1 for int8 vector and 2 for float16 and bfloat16 vector.
+func GetBytesVectorElementSize(dataType schemapb.DataType) int64 {
+	if dataType == Int8Vector {
+		return 1
+	}
+	return 2
+}
+
+// Int8VectorToBytes reinterprets int8 vector data as bytes without copy.
+func Int8VectorToBytes(data []int8) []byte {
+	if len(data) == 0 {
+		return nil
+	}
+	/* #nosec G103 */
+	return unsafe.Slice((*byte)(unsafe.Pointer(&data[0])), len(data))
+}
+
+// BytesToInt8Vector reinterprets bytes as int8 vector data without copy.
+func BytesToInt8Vector(data []byte) []int8 {
+	if len(data) == 0 {
+		return nil
+	}
+	/* #nosec G103 */
+	return unsafe.Slice((*int8)(unsafe.Pointer(&data[0])), len(data))
+}
+
+// BFloat16BytesToFloat32Vector converts little endian bfloat16 vector data to float32 vector,
+// a bfloat16 is the upper half of a float32.
+func BFloat16BytesToFloat32Vector(data []byte) []float32 {
+	ret := make([]float32, len(data)/2)
+	for i := range ret {
+		ret[i] = math.Float32frombits(uint32(binary.LittleEndian.Uint16(data[i*2:])) << 16)
+	}
+	return ret
+}
+
+// Float32VectorToBFloat16Bytes converts float32 vector to little endian bfloat16 vector data,
+// the values are rounded to the nearest even.
+func Float32VectorToBFloat16Bytes(data []float32) []byte {
+	ret := make([]byte, len(data)*2)
+	for i, f := range data {
+		bits := math.Float32bits(f)
+		if math.IsNaN(float64(f)) {
+			// keep it a nan after truncation
+			bits |= 0x00400000
+		} else {
+			bits += 0x7fff + (bits>>16)&1
+		}
+		binary.LittleEndian.PutUint16(ret[i*2:], uint16(bits>>16))
+	}
+	return ret
+}
+
+// GetBytesVectorData returns the bytes of float16, bfloat16 or int8 vector field, nil for other vector fields.
+func GetBytesVectorData(field *schemapb.VectorField) []byte {
+	switch data := field.GetData().(type) {
+	case *schemapb.VectorField_Float16Vector:
+		return data.Float16Vector
+	case *schemapb.VectorField_Bfloat16Vector:
+		return data.Bfloat16Vector
+	case *schemapb.VectorField_Int8Vector:
+		return data.Int8Vector
+	default:
+		return nil
+	}
+}
+
+// NewBytesVectorFieldData returns the field data of float16, bfloat16 or int8 vector,
+// the data is set to the vector field of the data type.
+func NewBytesVectorFieldData(dataType schemapb.DataType, fieldName string, dim int64, data []byte) *schemapb.FieldData {
+	vectors := &schemapb.VectorField{Dim: dim}
+	switch dataType {
+	case BFloat16Vector:
+		vectors.Data = &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: data}
+	case Int8Vector:
+		vectors.Data = &schemapb.VectorField_Int8Vector{Int8Vector: data}
+	default:
+		vectors.Data = &schemapb.VectorField_Float16Vector{Float16Vector: data}
+	}
+	return &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: fieldName,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: vectors,
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestBytesVectorType(t *testing.T) {
+	assert.Equal(t, "BFloat16Vector", BFloat16Vector.String())
+	assert.Equal(t, "Int8Vector", Int8Vector.String())
+	assert.True(t, IsVectorType(BFloat16Vector))
+	assert.True(t, IsVectorType(Int8Vector))
+	assert.True(t, IsBytesVectorType(schemapb.DataType_Float16Vector))
+	assert.False(t, IsBytesVectorType(schemapb.DataType_FloatVector))
+	assert.Equal(t, int64(2), GetBytesVectorElementSize(BFloat16Vector))
+	assert.Equal(t, int64(1), GetBytesVectorElementSize(Int8Vector))
+
+	int8s := []int8{-128, -1, 0, 127}
+	assert.Equal(t, []byte{0x80, 0xff, 0x00, 0x7f}, Int8VectorToBytes(int8s))
+	assert.Equal(t, int8s, BytesToInt8Vector(Int8VectorToBytes(int8s)))
+	assert.Nil(t, Int8VectorToBytes(nil))
+	assert.Nil(t, BytesToInt8Vector(nil))
+
+	// 1.0, -2.5 and +Inf in bfloat16
+	floats := BFloat16BytesToFloat32Vector([]byte{0x80, 0x3f, 0x20, 0xc0, 0x80, 0x7f})
+	assert.Equal(t, float32(1.0), floats[0])
+	assert.Equal(t, float32(-2.5), floats[1])
+	assert.Error(t, VerifyFloats32(floats))
+	assert.NoError(t, VerifyFloats32(floats[:2]))
+
+	// 1.00390625 is the midpoint of 1.0 and 1.0078125, rounded to the even one
+	bf16 := Float32VectorToBFloat16Bytes([]float32{1.0, -2.5, float32(math.Inf(1)), 1.00390625, float32(math.NaN())})
+	assert.Equal(t, []byte{0x80, 0x3f, 0x20, 0xc0, 0x80, 0x7f, 0x80, 0x3f}, bf16[:8])
+	assert.True(t, math.IsNaN(float64(BFloat16BytesToFloat32Vector(bf16)[4])))
+}
+
+func TestBytesVectorFieldData(t *testing.T) {
+	for _, dataType := range []schemapb.DataType{BFloat16Vector, Int8Vector} {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:    100,
+					Name:       "vec",
+					DataType:   dataType,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+				},
+			},
+		}
+		rowBytes := 4 * int(GetBytesVectorElementSize(dataType))
+		size, err := EstimateSizePerRecord(schema)
+		assert.NoError(t, err)
+		assert.Equal(t, rowBytes, size)
+
+		dim, err := GetDim(schema.Fields[0])
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), dim)
+
+		empty, err := GenEmptyFieldData(schema.Fields[0])
+		assert.NoError(t, err)
+		assert.Equal(t, dataType, empty.GetType())
+
+		data := make([]byte, rowBytes*3)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		src := NewBytesVectorFieldData(dataType, "vec", 4, data)
+		assert.Nil(t, src.GetVectors().GetFloat16Vector())
+		assert.Equal(t, data, GetBytesVectorData(src.GetVectors()))
+		rows, err := EstimateEntitySize([]*schemapb.FieldData{src}, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, rowBytes, rows)
+		assert.Equal(t, data[rowBytes:2*rowBytes], GetData(src, 1))
+
+		dst := make([]*schemapb.FieldData, 1)
+		AppendFieldData(dst, []*schemapb.FieldData{src}, 2)
+		AppendFieldData(dst, []*schemapb.FieldData{src}, 0)
+		assert.Equal(t, append(append([]byte{}, data[2*rowBytes:]...), data[:rowBytes]...), GetBytesVectorData(dst[0].GetVectors()))
+
+		DeleteFieldData(dst)
+		assert.Equal(t, data[2*rowBytes:], GetBytesVectorData(dst[0].GetVectors()))
+
+		merged := []*schemapb.FieldData{NewBytesVectorFieldData(dataType, "vec", 4, nil)}
+		assert.NoError(t, MergeFieldData(merged, []*schemapb.FieldData{src}))
+		assert.Equal(t, data, GetBytesVectorData(merged[0].GetVectors()))
+	}
+}